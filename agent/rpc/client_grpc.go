@@ -17,6 +17,7 @@ package rpc
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
@@ -49,15 +50,27 @@ type client struct {
 	client proto.WoodpeckerClient
 	conn   *grpc.ClientConn
 	logs   chan *proto.LogEntry
+	spool  *Spool
+	// flushes requests the log entries enqueued so far to be sent or spooled
+	flushes chan chan struct{}
+	// logsDone is closed once the logs are not processed anymore
+	logsDone chan struct{}
 }
 
-// NewGrpcClient returns a new grpc Client.
-func NewGrpcClient(ctx context.Context, conn *grpc.ClientConn) rpc.Peer {
+// NewGrpcClient returns a new grpc Client. If a spool is given, log entries
+// and step updates are persisted in it until the server received them.
+func NewGrpcClient(ctx context.Context, conn *grpc.ClientConn, spool *Spool) rpc.Peer {
 	client := new(client)
 	client.client = proto.NewWoodpeckerClient(conn)
 	client.conn = conn
 	client.logs = make(chan *proto.LogEntry, 10) // max memory use: 10 lines * 1 MiB
+	client.spool = spool
+	client.flushes = make(chan chan struct{})
+	client.logsDone = make(chan struct{})
 	go client.processLogs(ctx)
+	if spool != nil {
+		go client.processSpool(ctx)
+	}
 	return client
 }
 
@@ -240,6 +253,13 @@ func (c *client) Init(ctx context.Context, workflowID string, state rpc.Workflow
 
 // Done signals the workflow is complete.
 func (c *client) Done(ctx context.Context, workflowID string, state rpc.WorkflowState) (err error) {
+	// the server has to receive the spooled logs and step updates before the workflow is done
+	if c.spool != nil {
+		if err := c.spool.waitEmpty(ctx); err != nil {
+			return err
+		}
+	}
+
 	retry := c.newBackOff()
 	req := new(proto.DoneRequest)
 	req.Id = workflowID
@@ -331,8 +351,7 @@ func (c *client) Extend(ctx context.Context, workflowID string) (err error) {
 }
 
 // Update updates the workflow state.
-func (c *client) Update(ctx context.Context, workflowID string, state rpc.StepState) (err error) {
-	retry := c.newBackOff()
+func (c *client) Update(ctx context.Context, workflowID string, state rpc.StepState) error {
 	req := new(proto.UpdateRequest)
 	req.Id = workflowID
 	req.State = new(proto.StepState)
@@ -342,8 +361,37 @@ func (c *client) Update(ctx context.Context, workflowID string, state rpc.StepSt
 	req.State.Exited = state.Exited
 	req.State.ExitCode = int32(state.ExitCode)
 	req.State.Error = state.Error
+	for _, annotation := range state.Annotations {
+		req.State.Annotations = append(req.State.Annotations, &proto.Annotation{
+			Path:        annotation.Path,
+			StartLine:   int32(annotation.StartLine),
+			EndLine:     int32(annotation.EndLine),
+			StartColumn: int32(annotation.StartColumn),
+			EndColumn:   int32(annotation.EndColumn),
+			Level:       annotation.Level,
+			Title:       annotation.Title,
+			Message:     annotation.Message,
+		})
+	}
+
+	if c.spool != nil {
+		// the logs written before the step update are spooled first to keep them in order
+		c.flushLogs(ctx)
+		data, err := grpcproto.Marshal(req)
+		if err != nil {
+			return err
+		}
+		return c.spool.push(spoolKindUpdate, data, true)
+	}
+
+	return c.sendUpdate(ctx, req)
+}
+
+func (c *client) sendUpdate(ctx context.Context, req *proto.UpdateRequest) error {
+	retry := c.newBackOff()
+
 	for {
-		_, err = c.client.Update(ctx, req)
+		_, err := c.client.Update(ctx, req)
 		if err == nil {
 			break
 		}
@@ -392,7 +440,26 @@ func (c *client) EnqueueLog(logEntry *rpc.LogEntry) {
 	}
 }
 
+// flushLogs waits until the log entries enqueued so far were sent or spooled.
+func (c *client) flushLogs(ctx context.Context) {
+	done := make(chan struct{})
+	select {
+	case c.flushes <- done:
+	case <-c.logsDone:
+		return
+	case <-ctx.Done():
+		return
+	}
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+}
+
 func (c *client) processLogs(ctx context.Context) {
+	defer close(c.logsDone)
+
 	var entries []*proto.LogEntry
 	var bytes int
 
@@ -406,7 +473,12 @@ func (c *client) processLogs(ctx context.Context) {
 			Int("bytes", bytes).
 			Msg("log drain: sending queued logs")
 
-		if err := c.sendLogs(ctx, entries); err != nil {
+		if c.spool != nil {
+			if err := c.spoolLogs(entries); err != nil {
+				dropped := c.spool.drop(len(entries))
+				log.Error().Err(err).Int("entries", len(entries)).Int64("dropped_total", dropped).Msg("log drain: could not spool logs, dropping them")
+			}
+		} else if err := c.sendLogs(ctx, entries); err != nil {
 			log.Error().Err(err).Msg("log drain: could not send logs to server")
 		}
 
@@ -415,6 +487,15 @@ func (c *client) processLogs(ctx context.Context) {
 		bytes = 0
 	}
 
+	add := func(entry *proto.LogEntry) {
+		entries = append(entries, entry)
+		bytes += grpcproto.Size(entry) // cspell:words grpcproto
+
+		if bytes >= maxLogBatchSize {
+			send()
+		}
+	}
+
 	// ctx.Done() is covered by the log channel being closed
 	for {
 		select {
@@ -424,13 +505,17 @@ func (c *client) processLogs(ctx context.Context) {
 				send()
 				return
 			}
-
-			entries = append(entries, entry)
-			bytes += grpcproto.Size(entry) // cspell:words grpcproto
-
-			if bytes >= maxLogBatchSize {
-				send()
+			add(entry)
+
+		case done := <-c.flushes:
+			// take the entries enqueued before the flush, only this loop receives from the channel
+			for len(c.logs) > 0 {
+				if entry, ok := <-c.logs; ok {
+					add(entry)
+				}
 			}
+			send()
+			close(done)
 
 		case <-time.After(maxLogFlushPeriod):
 			send()
@@ -479,6 +564,94 @@ func (c *client) sendLogs(ctx context.Context, entries []*proto.LogEntry) error
 	return nil
 }
 
+func (c *client) spoolLogs(entries []*proto.LogEntry) error {
+	data, err := grpcproto.Marshal(&proto.LogRequest{LogEntries: entries})
+	if err != nil {
+		return err
+	}
+	return c.spool.push(spoolKindLog, data, false)
+}
+
+// processSpool replays the spooled log entries and step updates in order.
+// A record is dropped once the server received or rejected it for good,
+// on any other error it is retried as the server would miss it otherwise.
+func (c *client) processSpool(ctx context.Context) {
+	retry := c.newBackOff()
+	for {
+		seq, kind, data, ok, err := c.spool.peek()
+		if !ok {
+			if c.spool.wait(ctx) != nil {
+				return
+			}
+			continue
+		}
+
+		if err == nil {
+			err = c.replay(ctx, kind, data)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil && !isPermanent(err) {
+			log.Warn().Err(err).Msg("spool: could not replay record, retrying")
+			select {
+			case <-time.After(retry.NextBackOff()):
+			case <-ctx.Done():
+				return
+			}
+			continue
+		}
+		retry.Reset()
+		if err != nil {
+			log.Error().Err(err).Msg("spool: dropping record")
+		}
+
+		if err := c.spool.remove(seq); err != nil {
+			log.Error().Err(err).Msg("spool: could not remove record, stop replaying")
+			return
+		}
+	}
+}
+
+// isPermanent reports whether replaying a record can never succeed,
+// either as it is corrupt or as the server rejected it.
+func isPermanent(err error) bool {
+	s, ok := status.FromError(err)
+	if !ok {
+		return true
+	}
+	switch s.Code() {
+	case
+		codes.InvalidArgument,
+		codes.NotFound,
+		codes.PermissionDenied,
+		// the workflow is assigned to another agent
+		codes.FailedPrecondition:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *client) replay(ctx context.Context, kind byte, data []byte) error {
+	switch kind {
+	case spoolKindLog:
+		req := new(proto.LogRequest)
+		if err := grpcproto.Unmarshal(data, req); err != nil {
+			return err
+		}
+		return c.sendLogs(ctx, req.LogEntries)
+	case spoolKindUpdate:
+		req := new(proto.UpdateRequest)
+		if err := grpcproto.Unmarshal(data, req); err != nil {
+			return err
+		}
+		return c.sendUpdate(ctx, req)
+	default:
+		return fmt.Errorf("unknown spool record kind %q", kind)
+	}
+}
+
 func (c *client) RegisterAgent(ctx context.Context, info rpc.AgentInfo) (int64, error) {
 	req := new(proto.RegisterAgentRequest)
 	req.Info = &proto.AgentInfo{
@@ -487,6 +660,14 @@ func (c *client) RegisterAgent(ctx context.Context, info rpc.AgentInfo) (int64,
 		Version:      info.Version,
 		Capacity:     int32(info.Capacity),
 		CustomLabels: info.CustomLabels,
+		Capabilities: &proto.Capabilities{
+			Cpus:          int32(info.Capabilities.CPUs),
+			Memory:        info.Capabilities.Memory,
+			DiskFree:      info.Capabilities.DiskFree,
+			Architectures: info.Capabilities.Architectures,
+			Runtimes:      info.Capabilities.Runtimes,
+		},
+		Reload: info.Reload,
 	}
 
 	res, err := c.client.RegisterAgent(ctx, req)
@@ -498,10 +679,11 @@ func (c *client) UnregisterAgent(ctx context.Context) error {
 	return err
 }
 
-func (c *client) ReportHealth(ctx context.Context) (err error) {
+func (c *client) ReportHealth(ctx context.Context, diskFree int64) (err error) {
 	retry := c.newBackOff()
 	req := new(proto.ReportHealthRequest)
 	req.Status = "I am alive!"
+	req.DiskFree = diskFree
 
 	for {
 		_, err = c.client.ReportHealth(ctx, req)
@@ -539,3 +721,68 @@ func (c *client) ReportHealth(ctx context.Context) (err error) {
 		}
 	}
 }
+
+// Drain reports to the server that the agent stops taking new workflows.
+func (c *client) Drain(ctx context.Context, deadline int64) (err error) {
+	retry := c.newBackOff()
+	req := new(proto.DrainRequest)
+	req.Deadline = deadline
+
+	for {
+		_, err = c.client.Drain(ctx, req)
+		if err == nil {
+			return nil
+		}
+		switch status.Code(err) {
+		case codes.Canceled:
+			if ctx.Err() != nil {
+				// expected as context was canceled
+				log.Debug().Err(err).Msgf("grpc error: drain(): context canceled")
+				return nil
+			}
+			log.Error().Err(err).Msgf("grpc error: drain(): code: %v", status.Code(err))
+			return err
+		case
+			codes.Aborted,
+			codes.DataLoss,
+			codes.DeadlineExceeded,
+			codes.Internal,
+			codes.Unavailable:
+			// non-fatal errors
+			log.Warn().Err(err).Msgf("grpc error: drain(): code: %v", status.Code(err))
+		default:
+			log.Error().Err(err).Msgf("grpc error: drain(): code: %v", status.Code(err))
+			return err
+		}
+
+		select {
+		case <-time.After(retry.NextBackOff()):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// ReportWorkflows reports the workflows the agent is still running and returns the ones to cancel.
+func (c *client) ReportWorkflows(ctx context.Context, workflowIDs []string) ([]string, error) {
+	req := new(proto.ReportWorkflowsRequest)
+	req.WorkflowIds = workflowIDs
+
+	res, err := c.client.ReportWorkflows(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return res.GetCanceledWorkflowIds(), nil
+}
+
+// AgentConfig returns the config pushed to the agent by the server.
+func (c *client) AgentConfig(ctx context.Context) (*rpc.AgentConfig, error) {
+	res, err := c.client.AgentConfig(ctx, &proto.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.AgentConfig{
+		Capacity: int(res.GetCapacity()),
+		Labels:   res.GetLabels(),
+	}, nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	grpcproto "google.golang.org/protobuf/proto"
+
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/rpc"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/rpc/proto"
+)
+
+func newSpoolClient(t *testing.T, spool *Spool) *client {
+	c := &client{
+		logs:     make(chan *proto.LogEntry, 10),
+		spool:    spool,
+		flushes:  make(chan chan struct{}),
+		logsDone: make(chan struct{}),
+	}
+	go c.processLogs(t.Context())
+	t.Cleanup(func() { close(c.logs) })
+	return c
+}
+
+func TestUpdateSpoolsLogsFirst(t *testing.T) {
+	spool, err := NewSpool(t.TempDir(), 0)
+	require.NoError(t, err)
+	c := newSpoolClient(t, spool)
+
+	c.EnqueueLog(&rpc.LogEntry{StepUUID: "step", Line: 0, Data: []byte("first")})
+	c.EnqueueLog(&rpc.LogEntry{StepUUID: "step", Line: 1, Data: []byte("second")})
+	require.NoError(t, c.Update(t.Context(), "1", rpc.StepState{StepUUID: "step", Finished: 1}))
+
+	seq, kind, data, ok, err := spool.peek()
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, spoolKindLog, kind)
+	logs := new(proto.LogRequest)
+	require.NoError(t, grpcproto.Unmarshal(data, logs))
+	assert.Len(t, logs.GetLogEntries(), 2)
+	require.NoError(t, spool.remove(seq))
+
+	_, kind, _, ok, err = spool.peek()
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, spoolKindUpdate, kind)
+}
+
+func TestSpoolFullDropsLogs(t *testing.T) {
+	spool, err := NewSpool(t.TempDir(), 10)
+	require.NoError(t, err)
+	c := newSpoolClient(t, spool)
+
+	c.EnqueueLog(&rpc.LogEntry{StepUUID: "step", Data: []byte("does not fit into the spool")})
+	require.NoError(t, c.Update(t.Context(), "1", rpc.StepState{StepUUID: "step", Finished: 1}))
+
+	// the logs are counted as dropped while the step update is still spooled
+	assert.EqualValues(t, 1, spool.Dropped())
+	_, kind, _, ok, err := spool.peek()
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, spoolKindUpdate, kind)
+}
+
+type fakeLogClient struct {
+	proto.WoodpeckerClient
+	errs []error
+	logs []string
+}
+
+func (c *fakeLogClient) Log(_ context.Context, req *proto.LogRequest, _ ...grpc.CallOption) (*proto.Empty, error) {
+	var err error
+	if len(c.errs) != 0 {
+		err, c.errs = c.errs[0], c.errs[1:]
+	}
+	if err == nil {
+		c.logs = append(c.logs, string(req.GetLogEntries()[0].GetData()))
+	}
+	return &proto.Empty{}, err
+}
+
+func TestProcessSpoolRetries(t *testing.T) {
+	spool, err := NewSpool(t.TempDir(), 0)
+	require.NoError(t, err)
+	fake := &fakeLogClient{errs: []error{
+		status.Error(codes.ResourceExhausted, "too many requests"),
+		nil,
+		status.Error(codes.InvalidArgument, "invalid log entry"),
+	}}
+	c := newSpoolClient(t, spool)
+	c.client = fake
+
+	require.NoError(t, c.spoolLogs([]*proto.LogEntry{{StepUuid: "step", Data: []byte("retried")}}))
+	require.NoError(t, c.spoolLogs([]*proto.LogEntry{{StepUuid: "step", Data: []byte("rejected")}}))
+	require.NoError(t, c.spoolLogs([]*proto.LogEntry{{StepUuid: "step", Data: []byte("sent")}}))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan struct{})
+	go func() {
+		c.processSpool(ctx)
+		close(done)
+	}()
+	assert.Eventually(t, func() bool {
+		records, _ := spool.Depth()
+		return records == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	<-done
+
+	assert.Equal(t, []string{"retried", "sent"}, fake.logs)
+}
@@ -0,0 +1,230 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Kinds of spooled records, stored as the first byte of a record file.
+const (
+	spoolKindLog    byte = 'l'
+	spoolKindUpdate byte = 'u'
+)
+
+const spoolFileExt = ".spool"
+
+// ErrSpoolFull is returned if a record does not fit into the spool anymore.
+var ErrSpoolFull = errors.New("spool is full")
+
+// Spool persists log entries and step updates on disk until the server received them,
+// so they survive the server being unreachable and are replayed in order.
+type Spool struct {
+	dir     string
+	maxSize int64
+
+	mu      sync.Mutex
+	records []spoolRecord
+	size    int64
+	next    uint64
+	changed chan struct{}
+	// dropped counts the log entries which did not fit into the spool
+	dropped int64
+}
+
+type spoolRecord struct {
+	seq  uint64
+	size int64
+}
+
+// NewSpool opens the spool in the given directory and loads the records left from a previous run.
+func NewSpool(dir string, maxSize int64) (*Spool, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("could not create spool directory: %w", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not read spool directory: %w", err)
+	}
+
+	s := &Spool{
+		dir:     dir,
+		maxSize: maxSize,
+		changed: make(chan struct{}),
+	}
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), spoolFileExt)
+		if !ok || entry.IsDir() {
+			continue
+		}
+		seq, err := strconv.ParseUint(name, 10, 64)
+		if err != nil {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		s.records = append(s.records, spoolRecord{seq: seq, size: info.Size()})
+		s.size += info.Size()
+	}
+	slices.SortFunc(s.records, func(a, b spoolRecord) int {
+		return cmp.Compare(a.seq, b.seq)
+	})
+	if len(s.records) > 0 {
+		s.next = s.records[len(s.records)-1].seq + 1
+	}
+
+	return s, nil
+}
+
+// Depth returns the number of spooled records and their size in bytes.
+func (s *Spool) Depth() (int, int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.records), s.size
+}
+
+// Dropped returns the number of log entries dropped as they did not fit into the spool.
+func (s *Spool) Dropped() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.dropped
+}
+
+// drop counts log entries which could not be spooled and returns the total count.
+func (s *Spool) drop(entries int) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dropped += int64(entries)
+	return s.dropped
+}
+
+// push appends a record to the spool. Unless forced, records exceeding the
+// maximum size of the spool are rejected with ErrSpoolFull.
+func (s *Spool) push(kind byte, data []byte, force bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	size := int64(len(data) + 1)
+	if !force && s.maxSize > 0 && s.size+size > s.maxSize {
+		return ErrSpoolFull
+	}
+
+	seq := s.next
+	path := s.path(seq)
+	if err := os.WriteFile(path+".tmp", append([]byte{kind}, data...), 0o600); err != nil {
+		return err
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return err
+	}
+
+	s.next++
+	s.records = append(s.records, spoolRecord{seq: seq, size: size})
+	s.size += size
+	s.notify()
+	return nil
+}
+
+// peek returns the oldest record of the spool.
+func (s *Spool) peek() (seq uint64, kind byte, data []byte, ok bool, err error) {
+	s.mu.Lock()
+	if len(s.records) == 0 {
+		s.mu.Unlock()
+		return 0, 0, nil, false, nil
+	}
+	seq = s.records[0].seq
+	s.mu.Unlock()
+
+	raw, err := os.ReadFile(s.path(seq))
+	if err != nil {
+		return seq, 0, nil, true, err
+	}
+	if len(raw) == 0 {
+		return seq, 0, nil, true, errors.New("empty spool record")
+	}
+	return seq, raw[0], raw[1:], true, nil
+}
+
+// remove drops the oldest record of the spool once it was delivered.
+func (s *Spool) remove(seq uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.records) == 0 || s.records[0].seq != seq {
+		return nil
+	}
+	if err := os.Remove(s.path(seq)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	s.size -= s.records[0].size
+	s.records = s.records[1:]
+	s.notify()
+	return nil
+}
+
+// wait blocks until the spool changed or the context is done.
+func (s *Spool) wait(ctx context.Context) error {
+	s.mu.Lock()
+	changed := s.changed
+	s.mu.Unlock()
+
+	select {
+	case <-changed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// waitEmpty blocks until all records were delivered or the context is done.
+func (s *Spool) waitEmpty(ctx context.Context) error {
+	for {
+		s.mu.Lock()
+		empty := len(s.records) == 0
+		changed := s.changed
+		s.mu.Unlock()
+		if empty {
+			return nil
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// notify wakes up everyone waiting for the spool to change, the lock has to be held.
+func (s *Spool) notify() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+func (s *Spool) path(seq uint64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%020d%s", seq, spoolFileExt))
+}
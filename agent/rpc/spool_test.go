@@ -0,0 +1,91 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpool(t *testing.T) {
+	dir := t.TempDir()
+
+	spool, err := NewSpool(dir, 20)
+	require.NoError(t, err)
+
+	assert.NoError(t, spool.push(spoolKindLog, []byte("first"), false))
+	assert.NoError(t, spool.push(spoolKindUpdate, []byte("second"), false))
+	assert.ErrorIs(t, spool.push(spoolKindLog, []byte("dropped"), false), ErrSpoolFull)
+	assert.NoError(t, spool.push(spoolKindUpdate, []byte("forced"), true))
+
+	records, size := spool.Depth()
+	assert.Equal(t, 3, records)
+	assert.EqualValues(t, 20, size)
+
+	// records are kept on disk and replayed in order after a restart
+	spool, err = NewSpool(dir, 20)
+	require.NoError(t, err)
+
+	for _, expected := range []struct {
+		kind byte
+		data string
+	}{
+		{spoolKindLog, "first"},
+		{spoolKindUpdate, "second"},
+		{spoolKindUpdate, "forced"},
+	} {
+		seq, kind, data, ok, err := spool.peek()
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, expected.kind, kind)
+		assert.Equal(t, expected.data, string(data))
+		assert.NoError(t, spool.remove(seq))
+	}
+
+	_, _, _, ok, err := spool.peek()
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	records, size = spool.Depth()
+	assert.Equal(t, 0, records)
+	assert.EqualValues(t, 0, size)
+
+	// new records continue the sequence of the reloaded ones
+	assert.NoError(t, spool.push(spoolKindLog, []byte("next"), false))
+	seq, _, _, _, _ := spool.peek()
+	assert.EqualValues(t, 3, seq)
+}
+
+func TestSpoolWaitEmpty(t *testing.T) {
+	spool, err := NewSpool(t.TempDir(), 0)
+	require.NoError(t, err)
+	assert.NoError(t, spool.waitEmpty(t.Context()))
+
+	assert.NoError(t, spool.push(spoolKindLog, []byte("log"), false))
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, spool.waitEmpty(ctx), context.DeadlineExceeded)
+
+	go func() {
+		seq, _, _, _, _ := spool.peek()
+		assert.NoError(t, spool.remove(seq))
+	}()
+	assert.NoError(t, spool.waitEmpty(t.Context()))
+}
@@ -38,6 +38,8 @@ type Runner struct {
 	hostname string
 	counter  *State
 	backend  *backend.Backend
+	drain    bool
+	pollCtx  context.Context
 }
 
 func NewRunner(workEngine rpc.Peer, f rpc.Filter, h string, state *State, backend *backend.Backend) Runner {
@@ -50,14 +52,34 @@ func NewRunner(workEngine rpc.Peer, f rpc.Filter, h string, state *State, backen
 	}
 }
 
+// EnableDrain keeps running workflows alive on termination signals,
+// they are only canceled together with the runner context.
+func (r *Runner) EnableDrain() {
+	r.drain = true
+}
+
+// StopPollingWith stops the runner from waiting for a new workflow once the context is done,
+// a workflow it already received keeps running.
+func (r *Runner) StopPollingWith(ctx context.Context) {
+	r.pollCtx = ctx
+}
+
 func (r *Runner) Run(runnerCtx, shutdownCtx context.Context) error { //nolint:contextcheck
 	log.Debug().Msg("request next execution")
 
 	meta, _ := metadata.FromOutgoingContext(runnerCtx)
 	ctxMeta := metadata.NewOutgoingContext(context.Background(), meta)
 
+	pollCtx := runnerCtx
+	if r.pollCtx != nil {
+		var pollCancel context.CancelFunc
+		pollCtx, pollCancel = context.WithCancel(runnerCtx)
+		defer pollCancel()
+		defer context.AfterFunc(r.pollCtx, pollCancel)()
+	}
+
 	// get the next workflow from the queue
-	workflow, err := r.client.Next(runnerCtx, r.filter)
+	workflow, err := r.client.Next(pollCtx, r.filter)
 	if err != nil {
 		return err
 	}
@@ -73,14 +95,6 @@ func (r *Runner) Run(runnerCtx, shutdownCtx context.Context) error { //nolint:co
 	repoName := extractRepositoryName(workflow.Config)       // hack
 	pipelineNumber := extractPipelineNumber(workflow.Config) // hack
 
-	r.counter.Add(
-		workflow.ID,
-		timeout,
-		repoName,
-		pipelineNumber,
-	)
-	defer r.counter.Done(workflow.ID)
-
 	logger := log.With().
 		Str("repo", repoName).
 		Str("pipeline", pipelineNumber).
@@ -92,14 +106,37 @@ func (r *Runner) Run(runnerCtx, shutdownCtx context.Context) error { //nolint:co
 	workflowCtx, cancel := context.WithTimeout(ctxMeta, timeout)
 	defer cancel()
 
-	// Add sigterm support for internal context.
-	// Required when the pipeline is terminated by external signals
-	// like kubernetes.
-	workflowCtx = utils.WithContextSigtermCallback(workflowCtx, func() {
-		logger.Error().Msg("Received sigterm termination signal")
-	})
+	if r.drain {
+		// a draining agent cancels the runner context once the drain timeout passed
+		stop := context.AfterFunc(runnerCtx, func() {
+			logger.Error().Msg("drain timeout passed, canceling workflow")
+			cancel()
+		})
+		defer stop()
+	} else {
+		// Add sigterm support for internal context.
+		// Required when the pipeline is terminated by external signals
+		// like kubernetes.
+		workflowCtx = utils.WithContextSigtermCallback(workflowCtx, func() {
+			logger.Error().Msg("Received sigterm termination signal")
+		})
+	}
 
 	canceled := false
+	r.counter.Add(
+		workflow.ID,
+		timeout,
+		repoName,
+		pipelineNumber,
+		func() {
+			// the server does not know the workflow as running on this agent, e.g. as it was handed out again after a restart
+			canceled = true
+			logger.Warn().Msg("workflow is not assigned to this agent anymore, canceling it")
+			cancel()
+		},
+	)
+	defer r.counter.Done(workflow.ID)
+
 	go func() {
 		logger.Debug().Msg("listen for cancel signal")
 
@@ -18,6 +18,8 @@ package agent
 import (
 	"encoding/json"
 	"io"
+	"maps"
+	"slices"
 	"sync"
 	"time"
 )
@@ -27,6 +29,13 @@ type State struct {
 	Polling    int             `json:"polling_count"`
 	Running    int             `json:"running_count"`
 	Metadata   map[string]Info `json:"running"`
+	// Spooled is the number of log and step update records waiting to be sent to the server
+	Spooled      int   `json:"spooled_count"`
+	SpooledBytes int64 `json:"spooled_bytes"`
+	// SpoolDropped is the number of log entries dropped as the spool was full
+	SpoolDropped int64 `json:"spool_dropped_logs"`
+
+	capacity int
 }
 
 type Info struct {
@@ -35,9 +44,10 @@ type Info struct {
 	Pipeline string        `json:"pipeline_number"`
 	Started  time.Time     `json:"pipeline_started"`
 	Timeout  time.Duration `json:"pipeline_timeout"`
+	cancel   func()
 }
 
-func (s *State) Add(id string, timeout time.Duration, repo, pipeline string) {
+func (s *State) Add(id string, timeout time.Duration, repo, pipeline string, cancel func()) {
 	s.Lock()
 	s.Polling--
 	s.Running++
@@ -47,18 +57,56 @@ func (s *State) Add(id string, timeout time.Duration, repo, pipeline string) {
 		Pipeline: pipeline,
 		Timeout:  timeout,
 		Started:  time.Now().UTC(),
+		cancel:   cancel,
 	}
 	s.Unlock()
 }
 
+// WorkflowIDs returns the ids of the running workflows.
+func (s *State) WorkflowIDs() []string {
+	s.Lock()
+	defer s.Unlock()
+	return slices.Collect(maps.Keys(s.Metadata))
+}
+
+// Cancel cancels the running workflow with the given id.
+func (s *State) Cancel(id string) {
+	s.Lock()
+	info, ok := s.Metadata[id]
+	s.Unlock()
+	if ok && info.cancel != nil {
+		info.cancel()
+	}
+}
+
 func (s *State) Done(id string) {
 	s.Lock()
-	s.Polling++
+	// workflows finishing after the capacity was reduced free no polling slot
+	if s.capacity == 0 || s.Polling+s.Running <= s.capacity {
+		s.Polling++
+	}
 	s.Running--
 	delete(s.Metadata, id)
 	s.Unlock()
 }
 
+// SetCapacity sets how many workflows the agent runs at the same time.
+func (s *State) SetCapacity(capacity int) {
+	s.Lock()
+	s.capacity = capacity
+	s.Polling = max(capacity-s.Running, 0)
+	s.Unlock()
+}
+
+// SetSpool sets the depth of the log spool.
+func (s *State) SetSpool(records int, bytes, dropped int64) {
+	s.Lock()
+	s.Spooled = records
+	s.SpooledBytes = bytes
+	s.SpoolDropped = dropped
+	s.Unlock()
+}
+
 func (s *State) Healthy() bool {
 	s.Lock()
 	defer s.Unlock()
@@ -49,6 +49,13 @@ func (r *Runner) createTracer(ctxMeta context.Context, uploads *sync.WaitGroup,
 		if state.Process.Error != nil {
 			stepState.Error = state.Process.Error.Error()
 		}
+		if len(state.Process.Annotations) > 0 {
+			annotations, err := rpc.ParseAnnotations(state.Process.Annotations)
+			if err != nil {
+				stepLogger.Warn().Err(err).Msg("could not parse annotations file of step")
+			}
+			stepState.Annotations = annotations
+		}
 
 		defer func() {
 			stepLogger.Debug().Msg("update step status")
@@ -17,8 +17,10 @@ package admin
 import (
 	"github.com/urfave/cli/v3"
 
+	"go.woodpecker-ci.org/woodpecker/v3/cli/admin/agent"
 	"go.woodpecker-ci.org/woodpecker/v3/cli/admin/loglevel"
 	"go.woodpecker-ci.org/woodpecker/v3/cli/admin/org"
+	"go.woodpecker-ci.org/woodpecker/v3/cli/admin/queue"
 	"go.woodpecker-ci.org/woodpecker/v3/cli/admin/registry"
 	"go.woodpecker-ci.org/woodpecker/v3/cli/admin/secret"
 	"go.woodpecker-ci.org/woodpecker/v3/cli/admin/user"
@@ -29,8 +31,10 @@ var Command = &cli.Command{
 	Name:  "admin",
 	Usage: "manage server settings",
 	Commands: []*cli.Command{
+		agent.Command,
 		loglevel.Command,
 		org.Command,
+		queue.Command,
 		registry.Command,
 		secret.Command,
 		user.Command,
@@ -0,0 +1,96 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/template"
+	"time"
+
+	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/cli/common"
+	"go.woodpecker-ci.org/woodpecker/v3/cli/internal"
+	"go.woodpecker-ci.org/woodpecker/v3/woodpecker-go/woodpecker"
+)
+
+var agentDrainCmd = &cli.Command{
+	Name:      "drain",
+	Usage:     "stop scheduling workflows to an agent",
+	ArgsUsage: "<agent-id>",
+	Action:    agentDrain,
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "wait",
+			Usage: "wait until the agent finished its running workflows",
+		},
+		&cli.DurationFlag{
+			Name:  "timeout",
+			Usage: "maximum time to wait for the agent, 0 waits forever",
+		},
+		&cli.BoolFlag{
+			Name:  "cancel",
+			Usage: "schedule workflows to the agent again",
+		},
+		common.FormatFlag(tmplAgentDrain, false),
+	},
+}
+
+func agentDrain(ctx context.Context, c *cli.Command) error {
+	agentID, err := strconv.ParseInt(c.Args().First(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("missing or invalid agent id: %w", err)
+	}
+
+	client, err := internal.NewClient(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	var agent *woodpecker.Agent
+	if c.Bool("cancel") {
+		agent, err = client.AgentDrainCancel(agentID)
+	} else {
+		agent, err = client.AgentDrain(agentID)
+		if err == nil && c.Bool("wait") {
+			agent, err = client.AgentDrainWait(agentID, c.Duration("timeout"))
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := template.New("_").Funcs(template.FuncMap{
+		"time": func(t int64) string {
+			if t == 0 {
+				return "unknown"
+			}
+			return time.Unix(t, 0).Format(time.RFC3339)
+		},
+	}).Parse(c.String("format") + "\n")
+	if err != nil {
+		return err
+	}
+	return tmpl.Execute(os.Stdout, agent)
+}
+
+// Template for agent drain information.
+var tmplAgentDrain = `Agent: {{ .Name }}
+Status: {{ .Status }}
+Running workflows: {{ .RunningTasks }}{{ if eq .Status "draining" }}
+Drained at: {{ time .DrainETA }}{{ end }}`
@@ -0,0 +1,60 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"context"
+	"os"
+	"text/template"
+
+	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/cli/common"
+	"go.woodpecker-ci.org/woodpecker/v3/cli/internal"
+)
+
+var agentListCmd = &cli.Command{
+	Name:      "ls",
+	Usage:     "list agents",
+	ArgsUsage: " ",
+	Action:    agentList,
+	Flags:     []cli.Flag{common.FormatFlag(tmplAgentList, false)},
+}
+
+func agentList(ctx context.Context, c *cli.Command) error {
+	client, err := internal.NewClient(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	agents, err := client.AgentList()
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := template.New("_").Parse(c.String("format") + "\n")
+	if err != nil {
+		return err
+	}
+	for _, agent := range agents {
+		if err := tmpl.Execute(os.Stdout, agent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Template for agent list items.
+var tmplAgentList = `{{ .ID }}	{{ .Name }}	{{ .Status }}	{{ .RunningTasks }}/{{ .Capacity }}`
@@ -0,0 +1,339 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/cli/common"
+	"go.woodpecker-ci.org/woodpecker/v3/cli/internal"
+	"go.woodpecker-ci.org/woodpecker/v3/woodpecker-go/woodpecker"
+)
+
+var agentPoolCmd = &cli.Command{
+	Name:  "pool",
+	Usage: "manage agent pools",
+	Commands: []*cli.Command{
+		agentPoolListCmd,
+		agentPoolAddCmd,
+		agentPoolUpdateCmd,
+		agentPoolRemoveCmd,
+	},
+}
+
+var agentPoolFlags = []cli.Flag{
+	&cli.Int64SliceFlag{
+		Name:  "agent",
+		Usage: "id of a member agent",
+	},
+	&cli.StringSliceFlag{
+		Name:  "label",
+		Usage: "label agents need to be a member. Example: gpu=true",
+	},
+	&cli.StringSliceFlag{
+		Name:  "org",
+		Usage: "id or name of an organization allowed to use the pool",
+	},
+	&cli.StringSliceFlag{
+		Name:  "repo",
+		Usage: "id or full name of a repository allowed to use the pool",
+	},
+	&cli.IntFlag{
+		Name:  "max-concurrent",
+		Usage: "maximum workflows each organization or repository runs on the pool at the same time, 0 is unlimited",
+	},
+	&cli.StringSliceFlag{
+		Name:  "org-quota",
+		Usage: "maximum workflows of an organization given by id or name overriding max-concurrent, 0 is unlimited. Example: security=0",
+	},
+	&cli.StringSliceFlag{
+		Name:  "repo-quota",
+		Usage: "maximum workflows of an allowed repository given by id or full name overriding max-concurrent, 0 is unlimited. Example: octocat/hello-world=4",
+	},
+	common.FormatFlag(tmplAgentPool, false),
+}
+
+var agentPoolListCmd = &cli.Command{
+	Name:      "ls",
+	Usage:     "list agent pools",
+	ArgsUsage: " ",
+	Action:    agentPoolList,
+	Flags:     []cli.Flag{common.FormatFlag(tmplAgentPool, false)},
+}
+
+var agentPoolAddCmd = &cli.Command{
+	Name:      "add",
+	Usage:     "add an agent pool",
+	ArgsUsage: "<name>",
+	Action:    agentPoolAdd,
+	Flags:     agentPoolFlags,
+}
+
+var agentPoolUpdateCmd = &cli.Command{
+	Name:      "update",
+	Usage:     "update an agent pool",
+	ArgsUsage: "<pool-id>",
+	Action:    agentPoolUpdate,
+	Flags: append([]cli.Flag{
+		&cli.StringFlag{
+			Name:  "name",
+			Usage: "name of the pool",
+		},
+	}, agentPoolFlags...),
+}
+
+var agentPoolRemoveCmd = &cli.Command{
+	Name:      "rm",
+	Usage:     "remove an agent pool",
+	ArgsUsage: "<pool-id>",
+	Action:    agentPoolRemove,
+}
+
+func agentPoolList(ctx context.Context, c *cli.Command) error {
+	client, err := internal.NewClient(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	pools, err := client.AgentPoolList()
+	if err != nil {
+		return err
+	}
+	return printAgentPools(c, pools...)
+}
+
+func agentPoolAdd(ctx context.Context, c *cli.Command) error {
+	name := c.Args().First()
+	if name == "" {
+		return fmt.Errorf("missing pool name")
+	}
+
+	client, err := internal.NewClient(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	orgs, err := parsePoolOrgs(client, c.StringSlice("org"))
+	if err != nil {
+		return err
+	}
+	repos, err := parsePoolRepos(client, c.StringSlice("repo"))
+	if err != nil {
+		return err
+	}
+	orgQuotas, err := parsePoolQuotas(c.StringSlice("org-quota"), func(org string) (int64, error) {
+		return parsePoolOrg(client, org)
+	})
+	if err != nil {
+		return err
+	}
+	repoQuotas, err := parsePoolQuotas(c.StringSlice("repo-quota"), func(repo string) (int64, error) {
+		return parsePoolRepo(client, repo)
+	})
+	if err != nil {
+		return err
+	}
+
+	pool, err := client.AgentPoolCreate(&woodpecker.AgentPool{
+		Name:          name,
+		Agents:        c.Int64Slice("agent"),
+		LabelSelector: internal.ParseKeyPair(c.StringSlice("label")),
+		AllowedOrgs:   orgs,
+		AllowedRepos:  repos,
+		MaxConcurrent: c.Int("max-concurrent"),
+		OrgQuotas:     orgQuotas,
+		RepoQuotas:    repoQuotas,
+	})
+	if err != nil {
+		return err
+	}
+	return printAgentPools(c, pool)
+}
+
+func agentPoolUpdate(ctx context.Context, c *cli.Command) error {
+	poolID, err := strconv.ParseInt(c.Args().First(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("missing or invalid pool id: %w", err)
+	}
+
+	client, err := internal.NewClient(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	patch := new(woodpecker.AgentPoolPatch)
+	if c.IsSet("name") {
+		name := c.String("name")
+		patch.Name = &name
+	}
+	if c.IsSet("agent") {
+		agents := c.Int64Slice("agent")
+		patch.Agents = &agents
+	}
+	if c.IsSet("label") {
+		labels := internal.ParseKeyPair(c.StringSlice("label"))
+		patch.LabelSelector = &labels
+	}
+	if c.IsSet("org") {
+		orgs, err := parsePoolOrgs(client, c.StringSlice("org"))
+		if err != nil {
+			return err
+		}
+		patch.AllowedOrgs = &orgs
+	}
+	if c.IsSet("repo") {
+		repos, err := parsePoolRepos(client, c.StringSlice("repo"))
+		if err != nil {
+			return err
+		}
+		patch.AllowedRepos = &repos
+	}
+	if c.IsSet("max-concurrent") {
+		maxConcurrent := c.Int("max-concurrent")
+		patch.MaxConcurrent = &maxConcurrent
+	}
+	if c.IsSet("org-quota") {
+		orgQuotas, err := parsePoolQuotas(c.StringSlice("org-quota"), func(org string) (int64, error) {
+			return parsePoolOrg(client, org)
+		})
+		if err != nil {
+			return err
+		}
+		patch.OrgQuotas = &orgQuotas
+	}
+	if c.IsSet("repo-quota") {
+		repoQuotas, err := parsePoolQuotas(c.StringSlice("repo-quota"), func(repo string) (int64, error) {
+			return parsePoolRepo(client, repo)
+		})
+		if err != nil {
+			return err
+		}
+		patch.RepoQuotas = &repoQuotas
+	}
+
+	pool, err := client.AgentPoolUpdate(poolID, patch)
+	if err != nil {
+		return err
+	}
+	return printAgentPools(c, pool)
+}
+
+func agentPoolRemove(ctx context.Context, c *cli.Command) error {
+	poolID, err := strconv.ParseInt(c.Args().First(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("missing or invalid pool id: %w", err)
+	}
+
+	client, err := internal.NewClient(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	if err := client.AgentPoolDelete(poolID); err != nil {
+		return err
+	}
+	fmt.Printf("Successfully removed agent pool %d\n", poolID)
+	return nil
+}
+
+// parsePoolOrgs looks up the ids of organizations given by id or name.
+func parsePoolOrgs(client woodpecker.Client, orgs []string) ([]int64, error) {
+	ids := make([]int64, 0, len(orgs))
+	for _, org := range orgs {
+		orgID, err := parsePoolOrg(client, org)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, orgID)
+	}
+	return ids, nil
+}
+
+func parsePoolOrg(client woodpecker.Client, org string) (int64, error) {
+	if orgID, err := strconv.ParseInt(org, 10, 64); err == nil {
+		return orgID, nil
+	}
+
+	found, err := client.OrgLookup(org)
+	if err != nil {
+		return 0, fmt.Errorf("could not find organization %s: %w", org, err)
+	}
+	return found.ID, nil
+}
+
+// parsePoolRepos looks up the ids of repositories given by id or full name.
+func parsePoolRepos(client woodpecker.Client, repos []string) ([]int64, error) {
+	ids := make([]int64, 0, len(repos))
+	for _, repo := range repos {
+		repoID, err := parsePoolRepo(client, repo)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, repoID)
+	}
+	return ids, nil
+}
+
+func parsePoolRepo(client woodpecker.Client, repo string) (int64, error) {
+	repoID, err := internal.ParseRepo(client, repo)
+	if err != nil {
+		return 0, fmt.Errorf("could not find repository %s: %w", repo, err)
+	}
+	return repoID, nil
+}
+
+// parsePoolQuotas parses quotas given as <consumer>=<max workflows> and looks up the ids of the consumers.
+func parsePoolQuotas(quotas []string, lookup func(string) (int64, error)) (map[int64]int, error) {
+	parsed := make(map[int64]int, len(quotas))
+	for _, quota := range quotas {
+		i := strings.LastIndex(quota, "=")
+		if i < 1 {
+			return nil, fmt.Errorf("invalid quota %s, expected <consumer>=<max workflows>", quota)
+		}
+		maxConcurrent, err := strconv.Atoi(quota[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid quota %s: %w", quota, err)
+		}
+		id, err := lookup(quota[:i])
+		if err != nil {
+			return nil, err
+		}
+		parsed[id] = maxConcurrent
+	}
+	return parsed, nil
+}
+
+func printAgentPools(c *cli.Command, pools ...*woodpecker.AgentPool) error {
+	tmpl, err := template.New("_").Parse(c.String("format") + "\n")
+	if err != nil {
+		return err
+	}
+	for _, pool := range pools {
+		if err := tmpl.Execute(os.Stdout, pool); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Template for agent pool items.
+var tmplAgentPool = `{{ .ID }}	{{ .Name }}	agents: {{ .Agents }} {{ .LabelSelector }}	orgs: {{ .AllowedOrgs }}	repos: {{ .AllowedRepos }}	max: {{ .MaxConcurrent }}{{ with .OrgQuotas }} org quotas: {{ . }}{{ end }}{{ with .RepoQuotas }} repo quotas: {{ . }}{{ end }}`
@@ -0,0 +1,79 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/cli/internal"
+)
+
+var agentUpdateCmd = &cli.Command{
+	Name:      "update",
+	Usage:     "push config to an agent, it is applied without a restart",
+	ArgsUsage: "<agent-id>",
+	Action:    agentUpdate,
+	Flags: []cli.Flag{
+		&cli.IntFlag{
+			Name:  "capacity",
+			Usage: "number of workflows the agent runs in parallel, 0 uses the agent's own config",
+		},
+		&cli.StringSliceFlag{
+			Name:  "label",
+			Usage: "label taking precedence over the custom labels of the agent. Example: gpu=true",
+		},
+		&cli.BoolFlag{
+			Name:  "clear-labels",
+			Usage: "remove the labels pushed to the agent",
+		},
+	},
+}
+
+func agentUpdate(ctx context.Context, c *cli.Command) error {
+	agentID, err := strconv.ParseInt(c.Args().First(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("missing or invalid agent id: %w", err)
+	}
+
+	client, err := internal.NewClient(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	agent, err := client.Agent(agentID)
+	if err != nil {
+		return err
+	}
+
+	if c.IsSet("capacity") {
+		agent.CapacityOverride = int32(c.Int("capacity"))
+	}
+	if c.Bool("clear-labels") {
+		agent.LabelsOverride = nil
+	}
+	if c.IsSet("label") {
+		agent.LabelsOverride = internal.ParseKeyPair(c.StringSlice("label"))
+	}
+
+	if _, err := client.AgentUpdate(agent); err != nil {
+		return err
+	}
+	fmt.Printf("Successfully updated agent %d\n", agentID)
+	return nil
+}
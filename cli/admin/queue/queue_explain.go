@@ -0,0 +1,71 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"context"
+	"errors"
+	"os"
+	"text/template"
+
+	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/cli/common"
+	"go.woodpecker-ci.org/woodpecker/v3/cli/internal"
+)
+
+var queueExplainCmd = &cli.Command{
+	Name:      "explain",
+	Usage:     "explain why a task of the queue does not run yet",
+	ArgsUsage: "<task-id>",
+	Action:    queueExplain,
+	Flags:     []cli.Flag{common.FormatFlag(tmplQueueExplain, false)},
+}
+
+func queueExplain(ctx context.Context, c *cli.Command) error {
+	taskID := c.Args().First()
+	if taskID == "" {
+		return errors.New("missing task id")
+	}
+
+	client, err := internal.NewClient(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	explanation, err := client.QueueExplain(taskID)
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := template.New("_").Parse(c.String("format") + "\n")
+	if err != nil {
+		return err
+	}
+	return tmpl.Execute(os.Stdout, explanation)
+}
+
+// Template for queue explain information.
+var tmplQueueExplain = `Task: {{ .Task.ID }} ({{ .Task.Name }} of pipeline #{{ .Task.PipelineNumber }})
+State: {{ .State }}{{ if .Task.Position }}
+Position: {{ .Task.Position }}{{ end }}{{ if .Task.AgentName }}
+Agent: {{ .Task.AgentName }}{{ end }}
+Reasons:{{ range .Reasons }}
+  - {{ . }}{{ else }} none{{ end }}{{ if .Dependencies }}
+Dependencies:{{ range .Dependencies }}
+  - {{ .Name }} ({{ .ID }}): {{ .State }}{{ end }}{{ end }}
+Agents:{{ range .Agents }}
+  - {{ .AgentName }} ({{ .AgentID }}, {{ .Status }}): {{ if .Matched }}matches{{ else }}does not match{{ end }}{{ range .Reasons }}
+      {{ . }}{{ end }}{{ end }}`
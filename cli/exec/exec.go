@@ -135,7 +135,9 @@ func runExec(ctx context.Context, c *cli.Command, file, repoPath string, singleE
 		backend_local.CLIWorkaroundExecAtDir = repoPath
 	}
 
-	axes, err := matrix.ParseString(string(dat))
+	axes, err := matrix.ParseString(string(dat), matrix.WithLoader(func(path string) ([]byte, error) {
+		return os.ReadFile(filepath.Join(repoPath, path))
+	}))
 	if err != nil {
 		return fmt.Errorf("parse matrix fail")
 	}
@@ -27,6 +27,7 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/cli/common"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/linter"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/utils"
 	"go.woodpecker-ci.org/woodpecker/v3/shared/constant"
 )
 
@@ -54,6 +55,14 @@ var Command = &cli.Command{
 				TrimSpace: true,
 			},
 		},
+		&cli.StringSliceFlag{
+			Sources: cli.EnvVars("WOODPECKER_LINT_SECRETS"),
+			Name:    "secrets",
+			Usage:   "names of the secrets available to the pipeline, used to check `from_secret` references",
+			Config: cli.StringConfig{
+				TrimSpace: true,
+			},
+		},
 		&cli.BoolFlag{
 			Sources: cli.EnvVars("WOODPECKER_LINT_STRICT"),
 			Name:    "strict",
@@ -66,8 +75,8 @@ func lint(ctx context.Context, c *cli.Command) error {
 	return common.RunPipelineFunc(ctx, c, lintFile, lintDir)
 }
 
-func lintDir(ctx context.Context, c *cli.Command, dir string) error {
-	var errorStrings []string
+func lintDir(_ context.Context, c *cli.Command, dir string) error {
+	var files []string
 	if err := filepath.Walk(dir, func(path string, info os.FileInfo, e error) error {
 		if e != nil {
 			return e
@@ -75,12 +84,7 @@ func lintDir(ctx context.Context, c *cli.Command, dir string) error {
 
 		// check if it is a regular file (not dir)
 		if info.Mode().IsRegular() && (strings.HasSuffix(info.Name(), ".yaml") || strings.HasSuffix(info.Name(), ".yml")) {
-			fmt.Println("#", info.Name())
-			if err := lintFile(ctx, c, path); err != nil {
-				errorStrings = append(errorStrings, err.Error())
-			}
-			fmt.Println("")
-			return nil
+			files = append(files, path)
 		}
 
 		return nil
@@ -88,6 +92,27 @@ func lintDir(ctx context.Context, c *cli.Command, dir string) error {
 		return err
 	}
 
+	// collect the dependencies of all workflows to check them while linting each file
+	workflows := make(map[string][]string, len(files))
+	for _, file := range files {
+		var dependsOn []string
+		if buf, err := os.ReadFile(file); err == nil {
+			if parsed, err := yaml.ParseBytes(buf); err == nil {
+				dependsOn = parsed.DependsOn
+			}
+		}
+		workflows[utils.WorkflowName(file)] = dependsOn
+	}
+
+	var errorStrings []string
+	for _, file := range files {
+		fmt.Println("#", path.Base(file))
+		if err := lintConfig(c, file, linter.WithWorkflows(workflows)); err != nil {
+			errorStrings = append(errorStrings, err.Error())
+		}
+		fmt.Println("")
+	}
+
 	if len(errorStrings) != 0 {
 		return fmt.Errorf("ERRORS: %s", strings.Join(errorStrings, "; "))
 	}
@@ -95,12 +120,10 @@ func lintDir(ctx context.Context, c *cli.Command, dir string) error {
 }
 
 func lintFile(_ context.Context, c *cli.Command, file string) error {
-	fi, err := os.Open(file)
-	if err != nil {
-		return err
-	}
-	defer fi.Close()
+	return lintConfig(c, file)
+}
 
+func lintConfig(c *cli.Command, file string, opts ...linter.Option) error {
 	buf, err := os.ReadFile(file)
 	if err != nil {
 		return err
@@ -119,8 +142,7 @@ func lintFile(_ context.Context, c *cli.Command, file string) error {
 		Workflow:  parsedConfig,
 	}
 
-	// TODO: lint multiple files at once to allow checks for sth like "depends_on" to work
-	err = linter.New(
+	opts = append(opts,
 		linter.WithTrusted(linter.TrustedConfiguration{
 			Network:  true,
 			Volumes:  true,
@@ -128,7 +150,12 @@ func lintFile(_ context.Context, c *cli.Command, file string) error {
 		}),
 		linter.PrivilegedPlugins(c.StringSlice("plugins-privileged")),
 		linter.WithTrustedClonePlugins(c.StringSlice("plugins-trusted-clone")),
-	).Lint([]*linter.WorkflowConfig{config})
+	)
+	if c.IsSet("secrets") {
+		opts = append(opts, linter.WithSecrets(c.StringSlice("secrets")))
+	}
+
+	err = linter.New(opts...).Lint([]*linter.WorkflowConfig{config})
 	if err != nil {
 		str, err := FormatLintError(config.File, err, c.Bool("strict"))
 
@@ -0,0 +1,167 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/cli/common"
+	"go.woodpecker-ci.org/woodpecker/v3/cli/internal"
+	"go.woodpecker-ci.org/woodpecker/v3/cli/output"
+	"go.woodpecker-ci.org/woodpecker/v3/woodpecker-go/woodpecker"
+)
+
+var logSearchCmd = &cli.Command{
+	Name:      "search",
+	Usage:     "search the logs of the recent pipelines of a repository",
+	ArgsUsage: "<repo-id|repo-full-name> <query>",
+	Action:    logSearch,
+	Flags: append(common.OutputFlags("text"), []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "regex",
+			Usage: "treat the query as regular expression",
+		},
+		&cli.BoolFlag{
+			Name:    "ignore-case",
+			Aliases: []string{"i"},
+			Usage:   "ignore the case of letters",
+		},
+		&cli.StringFlag{
+			Name:  "branch",
+			Usage: "only search pipelines of this branch",
+		},
+		&cli.StringFlag{
+			Name:  "step",
+			Usage: "only search steps with this name",
+		},
+		&cli.IntFlag{
+			Name:  "limit",
+			Usage: "max number of matches",
+			Value: 100, //nolint:mnd
+		},
+		&cli.IntFlag{
+			Name:  "pipelines",
+			Usage: "number of recent pipelines to search",
+			Value: 10, //nolint:mnd
+		},
+		&cli.StringFlag{
+			Name:  "cursor",
+			Usage: "continue a search that reached the match limit after its last match",
+		},
+		&cli.IntFlag{
+			Name:  "page",
+			Usage: "page of pipelines to search, use to continue with older pipelines",
+			Value: 1,
+		},
+	}...),
+}
+
+func logSearch(ctx context.Context, c *cli.Command) error {
+	client, err := internal.NewClient(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	repoIDOrFullName := c.Args().First()
+	if len(repoIDOrFullName) == 0 {
+		return fmt.Errorf("missing required argument repo-id / repo-full-name")
+	}
+	repoID, err := internal.ParseRepo(client, repoIDOrFullName)
+	if err != nil {
+		return fmt.Errorf("invalid repo '%s': %w ", repoIDOrFullName, err)
+	}
+
+	query := c.Args().Get(1)
+	if len(query) == 0 {
+		return fmt.Errorf("missing required argument query")
+	}
+
+	result, err := client.LogSearch(repoID, woodpecker.LogSearchOptions{
+		ListOptions: woodpecker.ListOptions{
+			Page:    c.Int("page"),
+			PerPage: c.Int("pipelines"),
+		},
+		Query:      query,
+		Regex:      c.Bool("regex"),
+		IgnoreCase: c.Bool("ignore-case"),
+		Branch:     c.String("branch"),
+		Step:       c.String("step"),
+		Limit:      c.Int("limit"),
+		Cursor:     c.String("cursor"),
+	})
+	if err != nil {
+		return err
+	}
+
+	return logSearchOutput(c, result)
+}
+
+func logSearchOutput(c *cli.Command, result *woodpecker.LogSearchResult, fd ...io.Writer) error {
+	outFmt, _ := output.ParseOutputOptions(c.String("output"))
+
+	var out io.Writer
+	out = os.Stdout
+	if len(fd) > 0 {
+		out = fd[0]
+	}
+
+	switch outFmt {
+	case "json":
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(data))
+		return err
+	case "text":
+		fallthrough
+	default:
+		for _, match := range result.Matches {
+			_, _ = fmt.Fprintf(out, "\x1b[33m#%d %s:%d:\x1b[0m %s\n", match.PipelineNumber, match.StepName, match.Line, highlight(match))
+		}
+		if result.Truncated {
+			_, _ = fmt.Fprintf(out, "match limit reached, use --cursor %s to show more matches\n", result.Cursor)
+		} else if result.NextPage > 0 {
+			_, _ = fmt.Fprintf(out, "use --page %d to search older pipelines\n", result.NextPage)
+		}
+	}
+
+	return nil
+}
+
+// highlight marks the matches in the log line.
+func highlight(match *woodpecker.LogMatch) string {
+	var sb strings.Builder
+	last := 0
+	for _, r := range match.Highlights {
+		if r.Start < last || r.End > len(match.Data) {
+			continue
+		}
+		sb.WriteString(match.Data[last:r.Start])
+		sb.WriteString("\x1b[1;31m")
+		sb.WriteString(match.Data[r.Start:r.End])
+		sb.WriteString("\x1b[0m")
+		last = r.End
+	}
+	sb.WriteString(strings.TrimRight(match.Data[last:], "\r\n"))
+	return sb.String()
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/cli/common"
+	"go.woodpecker-ci.org/woodpecker/v3/woodpecker-go/woodpecker"
+)
+
+func TestLogSearchOutput(t *testing.T) {
+	result := &woodpecker.LogSearchResult{
+		Matches: []*woodpecker.LogMatch{
+			{PipelineNumber: 12, StepName: "test", Line: 3, Data: "Error: test failed", Highlights: []woodpecker.LogMatchRange{{Start: 0, End: 5}}},
+		},
+		Truncated: true,
+		Cursor:    "7:3",
+		NextPage:  2,
+	}
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name: "text output",
+			args: []string{"output"},
+			expected: "\x1b[33m#12 test:3:\x1b[0m \x1b[1;31mError\x1b[0m: test failed\n" +
+				"match limit reached, use --cursor 7:3 to show more matches\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			command := &cli.Command{
+				Writer: io.Discard,
+				Name:   "output",
+				Flags:  common.OutputFlags("text"),
+				Action: func(_ context.Context, c *cli.Command) error {
+					var buf bytes.Buffer
+					assert.NoError(t, logSearchOutput(c, result, &buf))
+					assert.Equal(t, tt.expected, buf.String())
+					return nil
+				},
+			}
+
+			_ = command.Run(context.Background(), tt.args)
+		})
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	match := &woodpecker.LogMatch{
+		Data:       "a-b-a\n",
+		Highlights: []woodpecker.LogMatchRange{{Start: 0, End: 1}, {Start: 4, End: 5}, {Start: 3, End: 9}},
+	}
+	assert.Equal(t, "\x1b[1;31ma\x1b[0m-b-\x1b[1;31ma\x1b[0m", highlight(match))
+}
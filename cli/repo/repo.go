@@ -44,6 +44,7 @@ var Command = &cli.Command{
 		repoRepairCmd,
 		secret.Command,
 		repoShowCmd,
+		repoStatsCmd,
 		repoSyncCmd,
 		repoUpdateCmd,
 	},
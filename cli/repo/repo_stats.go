@@ -0,0 +1,187 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/cli/common"
+	"go.woodpecker-ci.org/woodpecker/v3/cli/internal"
+	"go.woodpecker-ci.org/woodpecker/v3/cli/output"
+	"go.woodpecker-ci.org/woodpecker/v3/woodpecker-go/woodpecker"
+)
+
+var repoStatsCmd = &cli.Command{
+	Name:      "stats",
+	Usage:     "show pipeline statistics of a repository",
+	ArgsUsage: "<repo-id|repo-full-name>",
+	Action:    Stats,
+	Flags: append(common.OutputFlags("table"), []cli.Flag{
+		&cli.TimestampFlag{
+			Name:  "after",
+			Usage: "only use pipelines created after this date (RFC3339), defaults to 30 days ago",
+			Config: cli.TimestampConfig{
+				Layouts: []string{
+					time.RFC3339,
+				},
+			},
+		},
+		&cli.TimestampFlag{
+			Name:  "before",
+			Usage: "only use pipelines created before this date (RFC3339), defaults to now",
+			Config: cli.TimestampConfig{
+				Layouts: []string{
+					time.RFC3339,
+				},
+			},
+		},
+		&cli.StringFlag{
+			Name:  "interval",
+			Usage: "duration of a timeline bucket",
+			Value: "24h",
+		},
+		&cli.IntFlag{
+			Name:  "limit",
+			Usage: "limit the number of listed steps and branches",
+			Value: 10,
+		},
+	}...),
+}
+
+func Stats(ctx context.Context, c *cli.Command) error {
+	client, err := internal.NewClient(ctx, c)
+	if err != nil {
+		return err
+	}
+	stats, err := repoStats(c, client)
+	if err != nil {
+		return err
+	}
+	return statsOutput(c, stats)
+}
+
+func repoStats(c *cli.Command, client woodpecker.Client) (*woodpecker.Stats, error) {
+	repoIDOrFullName := c.Args().First()
+	repoID, err := internal.ParseRepo(client, repoIDOrFullName)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.RepoStats(repoID, woodpecker.RepoStatsOptions{
+		After:    c.Timestamp("after"),
+		Before:   c.Timestamp("before"),
+		Interval: c.String("interval"),
+		Limit:    c.Int("limit"),
+	})
+}
+
+func statsOutput(c *cli.Command, stats *woodpecker.Stats, fd ...io.Writer) error {
+	outFmt, _ := output.ParseOutputOptions(c.String("output"))
+	noHeader := c.Bool("output-no-headers")
+
+	var out io.Writer
+	out = os.Stdout
+	if len(fd) > 0 {
+		out = fd[0]
+	}
+
+	switch outFmt {
+	case "json":
+		data, err := json.MarshalIndent(stats, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(data))
+		return err
+	case "table":
+		fallthrough
+	default:
+		_, _ = fmt.Fprintf(out, "Period:       %s - %s\n", formatUnix(stats.After), formatUnix(stats.Before))
+		_, _ = fmt.Fprintf(out, "Pipelines:    %d (%d succeeded, %d failed)\n", stats.Pipelines, stats.Success, stats.Failure)
+		_, _ = fmt.Fprintf(out, "Success rate: %s\n", formatRate(stats.SuccessRate))
+		_, _ = fmt.Fprintf(out, "Duration:     p50 %s, p95 %s\n", formatSeconds(stats.Duration.P50), formatSeconds(stats.Duration.P95))
+		_, _ = fmt.Fprintf(out, "Queue wait:   p50 %s, p95 %s\n", formatSeconds(stats.QueueWait.P50), formatSeconds(stats.QueueWait.P95))
+
+		stepCols := []string{"Name", "Runs", "Failures", "Success_Rate", "P50", "P95", "Flaky"}
+		_, _ = fmt.Fprintln(out, "\nSteps:")
+		if err := writeStatsTable(out, stepCols, stats.Steps, noHeader); err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintln(out, "\nFlaky steps:")
+		if err := writeStatsTable(out, stepCols, stats.FlakySteps, noHeader); err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintln(out, "\nBranches:")
+		if err := writeStatsTable(out, []string{"Branch", "Pipelines", "Success_Rate"}, stats.Branches, noHeader); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeStatsTable[T any](out io.Writer, cols []string, rows []T, noHeader bool) error {
+	table := output.NewTable(out)
+	table.AddFieldFn("SuccessRate", func(obj any) string {
+		switch v := obj.(type) {
+		case *woodpecker.StepStats:
+			return formatRate(v.SuccessRate)
+		case *woodpecker.BranchStats:
+			return formatRate(v.SuccessRate)
+		}
+		return ""
+	})
+	table.AddFieldFn("P50", func(obj any) string {
+		if step, ok := obj.(*woodpecker.StepStats); ok {
+			return formatSeconds(step.Duration.P50)
+		}
+		return ""
+	})
+	table.AddFieldFn("P95", func(obj any) string {
+		if step, ok := obj.(*woodpecker.StepStats); ok {
+			return formatSeconds(step.Duration.P95)
+		}
+		return ""
+	})
+
+	if !noHeader {
+		table.WriteHeader(cols)
+	}
+	for _, row := range rows {
+		if err := table.Write(cols, row); err != nil {
+			return err
+		}
+	}
+	return table.Flush()
+}
+
+func formatRate(rate float64) string {
+	return fmt.Sprintf("%.1f%%", rate*100)
+}
+
+func formatSeconds(seconds int64) string {
+	return (time.Duration(seconds) * time.Second).String()
+}
+
+func formatUnix(ts int64) string {
+	return time.Unix(ts, 0).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,75 @@
+package repo
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/cli/common"
+	"go.woodpecker-ci.org/woodpecker/v3/woodpecker-go/woodpecker"
+)
+
+func TestStatsOutput(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		contains []string
+	}{
+		{
+			name: "table output",
+			args: []string{"output"},
+			contains: []string{
+				"Pipelines:    4 (3 succeeded, 1 failed)\n",
+				"Success rate: 75.0%\n",
+				"Duration:     p50 1m0s, p95 2m30s\n",
+				"NAME  RUNS  FAILURES  SUCCESS RATE  P50  P95  FLAKY\ntest  4     1         75.0%         30s  45s  1\n",
+				"BRANCH  PIPELINES  SUCCESS RATE\nmain    4          75.0%\n",
+			},
+		},
+		{
+			name: "json output",
+			args: []string{"output", "--output", "json"},
+			contains: []string{
+				`"success_rate": 0.75`,
+				`"p95": 150`,
+				`"branch": "main"`,
+			},
+		},
+	}
+
+	step := &woodpecker.StepStats{Name: "test", Runs: 4, Failures: 1, SuccessRate: 0.75, Duration: woodpecker.StatsDuration{P50: 30, P95: 45}, Flaky: 1}
+	stats := &woodpecker.Stats{
+		Pipelines:   4,
+		Success:     3,
+		Failure:     1,
+		SuccessRate: 0.75,
+		Duration:    woodpecker.StatsDuration{P50: 60, P95: 150},
+		Steps:       []*woodpecker.StepStats{step},
+		FlakySteps:  []*woodpecker.StepStats{step},
+		Branches:    []*woodpecker.BranchStats{{Branch: "main", Pipelines: 4, SuccessRate: 0.75}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			command := &cli.Command{
+				Writer: io.Discard,
+				Name:   "output",
+				Flags:  common.OutputFlags("table"),
+				Action: func(_ context.Context, c *cli.Command) error {
+					var buf bytes.Buffer
+					assert.NoError(t, statsOutput(c, stats, &buf))
+					for _, expected := range tt.contains {
+						assert.Contains(t, buf.String(), expected)
+					}
+					return nil
+				},
+			}
+
+			_ = command.Run(context.Background(), tt.args)
+		})
+	}
+}
@@ -17,6 +17,8 @@ package repo
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/urfave/cli/v3"
@@ -55,6 +57,14 @@ var repoUpdateCmd = &cli.Command{
 			Name:  "config",
 			Usage: "repository configuration path. Example: .woodpecker.yml",
 		},
+		&cli.StringSliceFlag{
+			Name:  "queue-priority",
+			Usage: "queue priority of the pipelines of an event, requires admin privileges. Example: push=2",
+		},
+		&cli.DurationFlag{
+			Name:  "agent-affinity-wait",
+			Usage: "how long workflows wait for the agent that last ran them, 0 disables the agent affinity",
+		},
 		&cli.IntFlag{
 			Name:  "pipeline-counter",
 			Usage: "repository starting pipeline number",
@@ -117,6 +127,25 @@ func repoUpdate(ctx context.Context, c *cli.Command) error {
 			patch.Visibility = &visibility
 		}
 	}
+	if c.IsSet("agent-affinity-wait") {
+		v := int64(c.Duration("agent-affinity-wait") / time.Second)
+		patch.AgentAffinityWait = &v
+	}
+	if c.IsSet("queue-priority") {
+		priorities := make(map[string]int)
+		for _, priority := range c.StringSlice("queue-priority") {
+			event, value, ok := strings.Cut(priority, "=")
+			if !ok {
+				return fmt.Errorf("invalid queue priority '%s', expected <event>=<priority>", priority)
+			}
+			v, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("invalid queue priority of event '%s': %w", event, err)
+			}
+			priorities[event] = v
+		}
+		patch.QueuePriorities = &priorities
+	}
 	if c.IsSet("pipeline-counter") && !unsafe {
 		fmt.Printf("Setting the pipeline counter is an unsafe operation that could put your repository in an inconsistent state. Please use --unsafe to proceed")
 	}
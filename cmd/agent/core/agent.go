@@ -23,8 +23,10 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"os/signal"
 	"strings"
 	"sync/atomic"
+	"syscall"
 	"time"
 
 	"github.com/rs/zerolog/log"
@@ -55,7 +57,8 @@ const (
 )
 
 const (
-	shutdownTimeout = time.Second * 5
+	shutdownTimeout    = time.Second * 5
+	drainNotifyTimeout = time.Second * 5
 )
 
 var (
@@ -67,6 +70,14 @@ var (
 func run(ctx context.Context, c *cli.Command, backends []types.Backend) error {
 	log.Info().Str("version", version.String()).Msg("Starting Woodpecker agent")
 
+	// with a drain timeout a termination signal only stops taking new workflows,
+	// the agent keeps running until its workflows are done or the timeout passed
+	drainTimeout := c.Duration("drain-timeout")
+	signalCtx := ctx
+	if drainTimeout > 0 {
+		ctx = context.WithoutCancel(ctx)
+	}
+
 	agentCtx, ctxCancel := context.WithCancelCause(ctx)
 	stopAgentFunc = func(err error) {
 		msg := "shutdown of whole agent"
@@ -90,7 +101,6 @@ func run(ctx context.Context, c *cli.Command, backends []types.Backend) error {
 		hostname, _ = os.Hostname()
 	}
 
-	counter.Polling = c.Int("max-workflows")
 	counter.Running = 0
 
 	if c.Bool("healthcheck") {
@@ -160,7 +170,20 @@ func run(ctx context.Context, c *cli.Command, backends []types.Backend) error {
 	}
 	defer conn.Close()
 
-	client := agent_rpc.NewGrpcClient(ctx, conn)
+	if dir := c.String("log-spool-dir"); dir != "" {
+		logSpool, err = agent_rpc.NewSpool(dir, c.Int64("log-spool-max-size"))
+		if err != nil {
+			return fmt.Errorf("could not open log spool: %w", err)
+		}
+		if records, size := logSpool.Depth(); records > 0 {
+			log.Info().Msgf("replaying %d records (%d bytes) left in the log spool", records, size)
+		}
+	}
+
+	// stop replaying the spool before a retry opens it again
+	clientCtx, clientCancel := context.WithCancel(ctx)
+	defer clientCancel()
+	client := agent_rpc.NewGrpcClient(clientCtx, conn, logSpool)
 	agentConfigPersisted := atomic.Bool{}
 
 	grpcCtx := metadata.NewOutgoingContext(grpcClientCtx, metadata.Pairs("hostname", hostname))
@@ -201,23 +224,38 @@ func run(ctx context.Context, c *cli.Command, backends []types.Backend) error {
 	}
 	log.Debug().Msgf("loaded %s backend engine", backendEngine.Name())
 
-	maxWorkflows := c.Int("max-workflows")
-
 	customLabels := make(map[string]string)
 	if err := stringSliceAddToMap(c.StringSlice("labels"), customLabels); err != nil {
 		return err
 	}
-	if len(customLabels) != 0 {
-		log.Debug().Msgf("custom labels detected: %#v", customLabels)
+	flagSettings := agentSettings{
+		maxWorkflows: c.Int("max-workflows"),
+		customLabels: customLabels,
 	}
 
-	agentConfig.AgentID, err = client.RegisterAgent(grpcCtx, rpc.AgentInfo{ //nolint:contextcheck
-		Version:      version.String(),
-		Backend:      backendEngine.Name(),
-		Platform:     engInfo.Platform,
-		Capacity:     maxWorkflows,
-		CustomLabels: customLabels,
-	})
+	// the config pushed by the server is applied right away, e.g. after a restart
+	pushedConfig, err := client.AgentConfig(grpcCtx) //nolint:contextcheck
+	if err != nil {
+		log.Error().Err(err).Msg("could not get agent config from server")
+	}
+	settings := resolveSettings(flagSettings, agentConfig, pushedConfig)
+	if len(settings.customLabels) != 0 {
+		log.Debug().Msgf("custom labels detected: %#v", settings.customLabels)
+	}
+
+	capabilities := detectCapabilities(backendEngine.Name(), engInfo)
+	agentInfo := func(settings agentSettings) rpc.AgentInfo {
+		return rpc.AgentInfo{
+			Version:      version.String(),
+			Backend:      backendEngine.Name(),
+			Platform:     engInfo.Platform,
+			Capacity:     settings.maxWorkflows,
+			CustomLabels: settings.customLabels,
+			Capabilities: capabilities,
+		}
+	}
+
+	agentConfig.AgentID, err = client.RegisterAgent(grpcCtx, agentInfo(settings)) //nolint:contextcheck
 	if err != nil {
 		return err
 	}
@@ -248,25 +286,30 @@ func run(ctx context.Context, c *cli.Command, backends []types.Backend) error {
 	}
 
 	// set default labels ...
-	labels := make(map[string]string)
-	labels[pipeline.LabelFilterHostname] = hostname
-	labels[pipeline.LabelFilterPlatform] = engInfo.Platform
-	labels[pipeline.LabelFilterBackend] = backendEngine.Name()
-	labels[pipeline.LabelFilterRepo] = "*" // allow all repos by default
-	// ... and let it overwrite by custom ones
-	maps.Copy(labels, customLabels)
-
-	log.Debug().Any("labels", labels).Msgf("agent configured with labels")
-
-	filter := rpc.Filter{
-		Labels: labels,
+	defaultLabels := make(map[string]string)
+	defaultLabels[pipeline.LabelFilterHostname] = hostname
+	defaultLabels[pipeline.LabelFilterPlatform] = engInfo.Platform
+	defaultLabels[pipeline.LabelFilterBackend] = backendEngine.Name()
+	defaultLabels[pipeline.LabelFilterRepo] = "*" // allow all repos by default
+	if hasGPU() {
+		defaultLabels[pipeline.LabelFilterGPU] = "true"
+	}
+	createFilter := func(settings agentSettings) rpc.Filter {
+		// ... and let it overwrite by custom ones
+		labels := maps.Clone(defaultLabels)
+		maps.Copy(labels, settings.customLabels)
+
+		log.Debug().Any("labels", labels).Msgf("agent configured with labels")
+		return rpc.Filter{
+			Labels: labels,
+		}
 	}
 
 	log.Debug().Msgf("agent registered with ID %d", agentConfig.AgentID)
 
 	serviceWaitingGroup.Go(func() error {
 		for {
-			err := client.ReportHealth(grpcCtx)
+			err := client.ReportHealth(grpcCtx, currentDiskFree(engInfo))
 			if err != nil {
 				log.Err(err).Msg("failed to report health")
 				// Check if the error is due to context cancellation
@@ -274,6 +317,15 @@ func run(ctx context.Context, c *cli.Command, backends []types.Backend) error {
 					log.Debug().Msg("terminating health reporting due to context cancellation")
 					return nil
 				}
+			} else if workflowIDs := counter.WorkflowIDs(); len(workflowIDs) != 0 {
+				// keep the running workflows attached to this agent, e.g. after the server restarted
+				canceled, err := client.ReportWorkflows(grpcCtx, workflowIDs)
+				if err != nil {
+					log.Err(err).Msg("failed to report running workflows")
+				}
+				for _, workflowID := range canceled {
+					counter.Cancel(workflowID)
+				}
 			}
 
 			select {
@@ -285,39 +337,124 @@ func run(ctx context.Context, c *cli.Command, backends []types.Backend) error {
 		}
 	})
 
-	// https://go.dev/blog/go1.22 fixed scope for goroutines in loops
-	for i := range maxWorkflows {
+	workers := newWorkerPool(func(filter rpc.Filter, pollCtx context.Context) bool {
+		if agentCtx.Err() != nil || signalCtx.Err() != nil {
+			return false
+		}
+
+		runner := agent.NewRunner(client, filter, hostname, counter, &backendEngine)
+		if drainTimeout > 0 {
+			runner.EnableDrain()
+		}
+		runner.StopPollingWith(pollCtx)
+
+		log.Debug().Msg("polling new steps")
+		if err := runner.Run(agentCtx, shutdownCtx); err != nil {
+			log.Error().Err(err).Msg("runner error, retrying...")
+			// Wait a bit before retrying to avoid hammering the server
+			select {
+			case <-agentCtx.Done():
+				return false
+			case <-time.After(time.Second * 5):
+				// Continue to next iteration
+			}
+		}
+		return true
+	})
+	counter.SetCapacity(settings.maxWorkflows)
+	workers.resize(settings.maxWorkflows, createFilter(settings))
+	serviceWaitingGroup.Go(func() error {
+		workers.wait()
+		return nil
+	})
+
+	// reload the config on SIGHUP and once the server pushed a new one
+	serviceWaitingGroup.Go(func() error {
+		hangup := make(chan os.Signal, 1)
+		signal.Notify(hangup, syscall.SIGHUP)
+		defer signal.Stop(hangup)
+
+		fileConfig := agentConfig
+		current := settings
+		for {
+			select {
+			case <-agentCtx.Done():
+				return nil
+			case <-signalCtx.Done():
+				// a draining agent keeps its config
+				return nil
+			case <-hangup:
+				log.Info().Msg("reloading agent config")
+				fileConfig = readAgentConfig(agentConfigPath)
+			case <-time.After(reportHealthInterval):
+			}
+
+			if config, err := client.AgentConfig(grpcCtx); err != nil {
+				log.Error().Err(err).Msg("could not get agent config from server")
+			} else {
+				pushedConfig = config
+			}
+
+			newSettings := resolveSettings(flagSettings, fileConfig, pushedConfig)
+			if newSettings.equal(current) {
+				continue
+			}
+
+			info := agentInfo(newSettings)
+			info.Reload = true
+			if _, err := client.RegisterAgent(grpcCtx, info); err != nil {
+				log.Error().Err(err).Msg("could not register agent with reloaded config")
+				continue
+			}
+
+			log.Info().Msgf("agent config reloaded, running up to %d workflows in parallel", newSettings.maxWorkflows)
+			current = newSettings
+			counter.SetCapacity(current.maxWorkflows)
+			workers.resize(current.maxWorkflows, createFilter(current))
+		}
+	})
+
+	if drainTimeout > 0 {
 		serviceWaitingGroup.Go(func() error {
-			runner := agent.NewRunner(client, filter, hostname, counter, &backendEngine)
-			log.Debug().Msgf("created new runner %d", i)
+			select {
+			case <-agentCtx.Done():
+				return nil
+			case <-signalCtx.Done():
+			}
 
-			for {
-				if agentCtx.Err() != nil {
-					return nil
+			log.Info().Msgf("draining agent, waiting up to %s for running workflows", drainTimeout)
+			deadline := time.Now().Add(drainTimeout)
+			drainCtx, drainCancel := context.WithDeadline(grpcCtx, deadline)
+			defer drainCancel()
+
+			// an unreachable server must not hold up waiting for the running workflows
+			go func() {
+				notifyCtx, notifyCancel := context.WithTimeout(drainCtx, drainNotifyTimeout)
+				defer notifyCancel()
+				if err := client.Drain(notifyCtx, deadline.Unix()); err != nil {
+					log.Error().Err(err).Msg("failed to report draining to server")
 				}
+			}()
 
-				log.Debug().Msg("polling new steps")
-				if err := runner.Run(agentCtx, shutdownCtx); err != nil {
-					log.Error().Err(err).Msg("runner error, retrying...")
-					// Check if context is canceled
-					if agentCtx.Err() != nil {
-						return nil
-					}
-					// Wait a bit before retrying to avoid hammering the server
-					select {
-					case <-agentCtx.Done():
-						return nil
-					case <-time.After(time.Second * 5):
-						// Continue to next iteration
-					}
-				}
+			drained := make(chan struct{})
+			go func() {
+				workers.wait()
+				close(drained)
+			}()
+			select {
+			case <-drained:
+				log.Info().Msg("agent drained")
+			case <-drainCtx.Done():
+				log.Warn().Msg("drain timeout passed, canceling running workflows")
 			}
+			stopAgentFunc(nil)
+			return nil
 		})
 	}
 
 	log.Info().Msgf(
 		"starting Woodpecker agent with version '%s' and backend '%s' using platform '%s' running up to %d pipelines in parallel",
-		version.String(), backendEngine.Name(), engInfo.Platform, maxWorkflows)
+		version.String(), backendEngine.Name(), engInfo.Platform, settings.maxWorkflows)
 
 	return serviceWaitingGroup.Wait()
 }
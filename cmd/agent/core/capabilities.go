@@ -0,0 +1,77 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"slices"
+	"strings"
+
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/rpc"
+)
+
+// detectCapabilities collects the capabilities of the machine running the workflows of the agent.
+// Host values are only used where the backend runs workflows on the same machine as the agent.
+func detectCapabilities(backendName string, info *types.BackendInfo) rpc.Capabilities {
+	capabilities := rpc.Capabilities{
+		CPUs:     info.CPUs,
+		Memory:   info.Memory,
+		Runtimes: info.Runtimes,
+	}
+
+	if backendName == "local" && capabilities.Memory == 0 {
+		capabilities.Memory = hostMemory()
+	}
+	capabilities.DiskFree = currentDiskFree(info)
+
+	if _, arch, ok := strings.Cut(info.Platform, "/"); ok {
+		capabilities.Architectures = append(capabilities.Architectures, arch)
+	}
+	if backendName == "docker" || backendName == "local" {
+		// architectures emulated by qemu through binfmt_misc
+		for _, arch := range binfmtArchitectures() {
+			if !slices.Contains(capabilities.Architectures, arch) {
+				capabilities.Architectures = append(capabilities.Architectures, arch)
+			}
+		}
+	}
+
+	return capabilities
+}
+
+// currentDiskFree returns the free disk space of the workflow data, 0 if unknown.
+// It is reported with every health check so the server does not schedule on stale values.
+func currentDiskFree(info *types.BackendInfo) int64 {
+	if info.DataDir == "" {
+		return 0
+	}
+	return diskFree(info.DataDir)
+}
+
+// qemuArchitectures maps the names of qemu binfmt handlers to go architectures.
+var qemuArchitectures = map[string]string{
+	"aarch64":     "arm64",
+	"arm":         "arm",
+	"i386":        "386",
+	"loongarch64": "loong64",
+	"mips":        "mips",
+	"mips64":      "mips64",
+	"mips64el":    "mips64le",
+	"mipsel":      "mipsle",
+	"ppc64le":     "ppc64le",
+	"riscv64":     "riscv64",
+	"s390x":       "s390x",
+	"x86_64":      "amd64",
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+const binfmtDir = "/proc/sys/fs/binfmt_misc"
+
+// hostMemory returns the total memory of the machine in bytes.
+func hostMemory() int64 {
+	file, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return 0
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		// MemTotal:       16314768 kB
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 3 && fields[0] == "MemTotal:" && fields[2] == "kB" {
+			kb, err := strconv.ParseInt(fields[1], 10, 64)
+			if err != nil {
+				return 0
+			}
+			return kb << 10
+		}
+	}
+	return 0
+}
+
+// diskFree returns the free disk space available to unprivileged users at path in bytes.
+func diskFree(path string) int64 {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0
+	}
+	return int64(stat.Bavail) * int64(stat.Bsize) //nolint:unconvert // Bsize is no int64 on all architectures
+}
+
+// binfmtArchitectures returns the architectures qemu emulates through enabled binfmt_misc handlers.
+func binfmtArchitectures() []string {
+	handlers, err := filepath.Glob(filepath.Join(binfmtDir, "qemu-*"))
+	if err != nil {
+		return nil
+	}
+
+	var architectures []string
+	for _, handler := range handlers {
+		arch, ok := qemuArchitectures[strings.TrimPrefix(filepath.Base(handler), "qemu-")]
+		if !ok || slices.Contains(architectures, arch) {
+			continue
+		}
+		content, err := os.ReadFile(handler)
+		if err != nil || !strings.HasPrefix(string(content), "enabled") {
+			continue
+		}
+		architectures = append(architectures, arch)
+	}
+	return architectures
+}
+
+// hasGPU reports whether a NVIDIA or AMD GPU is accessible.
+func hasGPU() bool {
+	for _, device := range []string{"/dev/nvidiactl", "/dev/kfd"} {
+		if _, err := os.Stat(device); err == nil {
+			return true
+		}
+	}
+	return false
+}
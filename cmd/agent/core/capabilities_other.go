@@ -0,0 +1,37 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !linux
+
+package core
+
+// hostMemory is only detected on linux.
+func hostMemory() int64 {
+	return 0
+}
+
+// diskFree is only detected on linux.
+func diskFree(string) int64 {
+	return 0
+}
+
+// binfmtArchitectures is only detected on linux.
+func binfmtArchitectures() []string {
+	return nil
+}
+
+// hasGPU is only detected on linux.
+func hasGPU() bool {
+	return false
+}
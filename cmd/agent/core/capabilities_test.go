@@ -0,0 +1,41 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/rpc"
+)
+
+func TestDetectCapabilities(t *testing.T) {
+	capabilities := detectCapabilities("kubernetes", &types.BackendInfo{Platform: "linux/amd64"})
+	assert.Equal(t, rpc.Capabilities{Architectures: []string{"amd64"}}, capabilities)
+
+	capabilities = detectCapabilities("docker", &types.BackendInfo{
+		Platform: "linux/arm64",
+		CPUs:     8,
+		Memory:   16 << 30,
+		Runtimes: []string{"nvidia", "runc"},
+	})
+	assert.Equal(t, 8, capabilities.CPUs)
+	assert.Equal(t, int64(16<<30), capabilities.Memory)
+	assert.Equal(t, []string{"nvidia", "runc"}, capabilities.Runtimes)
+	assert.Equal(t, "arm64", capabilities.Architectures[0])
+	assert.Zero(t, capabilities.DiskFree)
+}
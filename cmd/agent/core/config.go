@@ -26,6 +26,9 @@ import (
 
 type AgentConfig struct {
 	AgentID int64 `json:"agent_id"`
+	// MaxWorkflows and Labels take precedence over the flags and are applied again on reloads
+	MaxWorkflows int               `json:"max_workflows,omitempty"`
+	Labels       map[string]string `json:"labels,omitempty"`
 }
 
 const defaultAgentIDValue = int64(-1)
@@ -40,7 +40,7 @@ func TestReadAgentIDFileExists(t *testing.T) {
 
 	// read existing config
 	actual := readAgentConfig(tmpF.Name())
-	assert.EqualValues(t, AgentConfig{3}, actual)
+	assert.EqualValues(t, AgentConfig{AgentID: 3}, actual)
 
 	// update existing config and check
 	actual.AgentID = 33
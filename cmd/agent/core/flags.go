@@ -77,6 +77,22 @@ var flags = []cli.Flag{
 		Usage:   "agent parallel workflows",
 		Value:   1,
 	},
+	&cli.DurationFlag{
+		Sources: cli.EnvVars("WOODPECKER_DRAIN_TIMEOUT"),
+		Name:    "drain-timeout",
+		Usage:   "how long running workflows may take to finish after a termination signal, 0 cancels them right away",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_LOG_SPOOL_DIR"),
+		Name:    "log-spool-dir",
+		Usage:   "directory to persist logs and step updates in until the server received them, spooling is disabled if empty",
+	},
+	&cli.Int64Flag{
+		Sources: cli.EnvVars("WOODPECKER_LOG_SPOOL_MAX_SIZE"),
+		Name:    "log-spool-max-size",
+		Usage:   "maximum size of the log spool in bytes, logs exceeding it are dropped",
+		Value:   100 * 1024 * 1024, //nolint:mnd
+	},
 	&cli.BoolFlag{
 		Sources: cli.EnvVars("WOODPECKER_HEALTHCHECK"),
 		Name:    "healthcheck",
@@ -25,6 +25,7 @@ import (
 	"github.com/urfave/cli/v3"
 
 	"go.woodpecker-ci.org/woodpecker/v3/agent"
+	agent_rpc "go.woodpecker-ci.org/woodpecker/v3/agent/rpc"
 	"go.woodpecker-ci.org/woodpecker/v3/version"
 )
 
@@ -65,6 +66,10 @@ func handleStats(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 	w.Header().Add("Content-Type", "text/json")
+	if logSpool != nil {
+		records, size := logSpool.Depth()
+		counter.SetSpool(records, size, logSpool.Dropped())
+	}
 	if _, err := counter.WriteTo(w); err != nil {
 		log.Error().Err(err).Msg("handleStats")
 	}
@@ -80,6 +85,9 @@ var counter = &agent.State{
 	Metadata: map[string]agent.Info{},
 }
 
+// Spool of logs and step updates not received by the server yet, nil if disabled.
+var logSpool *agent_rpc.Spool
+
 // handles pinging the endpoint and returns an error if the
 // agent is in an unhealthy state.
 func pinger(ctx context.Context, c *cli.Command) error {
@@ -27,7 +27,7 @@ func TestHealthy(t *testing.T) {
 	s := agent.State{}
 	s.Metadata = map[string]agent.Info{}
 
-	s.Add("1", time.Hour, "octocat/hello-world", "42")
+	s.Add("1", time.Hour, "octocat/hello-world", "42", nil)
 
 	assert.Equal(t, "1", s.Metadata["1"].ID)
 	assert.Equal(t, time.Hour, s.Metadata["1"].Timeout)
@@ -49,3 +49,45 @@ func TestHealthy(t *testing.T) {
 	}
 	assert.False(t, s.Healthy(), "want unhealthy status when timeout+buffer not exceeded, got true")
 }
+
+func TestStateCancel(t *testing.T) {
+	s := agent.State{}
+	s.Metadata = map[string]agent.Info{}
+
+	canceled := false
+	s.Add("1", time.Hour, "octocat/hello-world", "42", func() { canceled = true })
+	assert.Equal(t, []string{"1"}, s.WorkflowIDs())
+
+	s.Cancel("2")
+	assert.False(t, canceled)
+	s.Cancel("1")
+	assert.True(t, canceled)
+
+	s.Done("1")
+	assert.Empty(t, s.WorkflowIDs())
+}
+
+func TestStateSetCapacity(t *testing.T) {
+	s := agent.State{}
+	s.Metadata = map[string]agent.Info{}
+
+	s.SetCapacity(3)
+	for _, id := range []string{"1", "2", "3"} {
+		s.Add(id, time.Hour, "octocat/hello-world", "42", nil)
+	}
+	assert.Equal(t, 0, s.Polling)
+	assert.Equal(t, 3, s.Running)
+
+	// finishing workflows only free polling slots within the reduced capacity
+	s.SetCapacity(2)
+	s.Done("1")
+	assert.Equal(t, 0, s.Polling)
+	s.Done("2")
+	assert.Equal(t, 1, s.Polling)
+	s.Done("3")
+	assert.Equal(t, 2, s.Polling)
+	assert.Equal(t, 0, s.Running)
+
+	s.SetCapacity(4)
+	assert.Equal(t, 4, s.Polling)
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"maps"
+	"sync"
+
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/rpc"
+)
+
+// agentSettings are the settings of an agent which are applied again when it reloads its config.
+type agentSettings struct {
+	maxWorkflows int
+	customLabels map[string]string
+}
+
+// resolveSettings applies the agent config file and the config pushed by the server on top of the flags.
+func resolveSettings(flagSettings agentSettings, conf AgentConfig, pushed *rpc.AgentConfig) agentSettings {
+	settings := agentSettings{
+		maxWorkflows: flagSettings.maxWorkflows,
+		customLabels: make(map[string]string),
+	}
+	maps.Copy(settings.customLabels, flagSettings.customLabels)
+
+	if conf.MaxWorkflows > 0 {
+		settings.maxWorkflows = conf.MaxWorkflows
+	}
+	maps.Copy(settings.customLabels, conf.Labels)
+
+	if pushed != nil {
+		if pushed.Capacity > 0 {
+			settings.maxWorkflows = pushed.Capacity
+		}
+		maps.Copy(settings.customLabels, pushed.Labels)
+	}
+	return settings
+}
+
+func (s agentSettings) equal(other agentSettings) bool {
+	return s.maxWorkflows == other.maxWorkflows && maps.Equal(s.customLabels, other.customLabels)
+}
+
+// workerPool keeps a worker running for each workflow the agent may run at the same time.
+// Resizing it lets surplus workers stop once their workflow is done and idle workers poll with the new filter.
+type workerPool struct {
+	mu      sync.Mutex
+	wg      sync.WaitGroup
+	size    int
+	workers int
+	filter  rpc.Filter
+	// pollCtx is canceled to interrupt the workers waiting for a workflow
+	pollCtx    context.Context
+	pollCancel context.CancelFunc
+	// run polls for a workflow with the filter and runs it, it returns false once the worker has to stop
+	run func(filter rpc.Filter, pollCtx context.Context) bool
+}
+
+func newWorkerPool(run func(filter rpc.Filter, pollCtx context.Context) bool) *workerPool {
+	pollCtx, pollCancel := context.WithCancel(context.Background())
+	return &workerPool{
+		pollCtx:    pollCtx,
+		pollCancel: pollCancel,
+		run:        run,
+	}
+}
+
+// resize sets the number of workers and the filter they poll with.
+func (p *workerPool) resize(size int, filter rpc.Filter) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if size < p.size || !maps.Equal(filter.Labels, p.filter.Labels) {
+		p.pollCancel()
+		p.pollCtx, p.pollCancel = context.WithCancel(context.Background())
+	}
+	p.size = size
+	p.filter = filter
+
+	for ; p.workers < p.size; p.workers++ {
+		p.wg.Add(1)
+		go p.work()
+	}
+}
+
+func (p *workerPool) work() {
+	defer p.wg.Done()
+	for {
+		p.mu.Lock()
+		if p.workers > p.size {
+			p.workers--
+			p.mu.Unlock()
+			return
+		}
+		filter, pollCtx := p.filter, p.pollCtx
+		p.mu.Unlock()
+
+		if !p.run(filter, pollCtx) {
+			p.mu.Lock()
+			p.workers--
+			p.mu.Unlock()
+			return
+		}
+	}
+}
+
+// wait blocks until all workers stopped.
+func (p *workerPool) wait() {
+	p.wg.Wait()
+}
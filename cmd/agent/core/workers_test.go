@@ -0,0 +1,142 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/rpc"
+)
+
+func TestResolveSettings(t *testing.T) {
+	flagSettings := agentSettings{maxWorkflows: 2, customLabels: map[string]string{"location": "europe", "gpu": "false"}}
+
+	settings := resolveSettings(flagSettings, AgentConfig{}, nil)
+	assert.Equal(t, flagSettings, settings)
+
+	settings = resolveSettings(flagSettings, AgentConfig{MaxWorkflows: 4, Labels: map[string]string{"gpu": "true"}}, nil)
+	assert.Equal(t, agentSettings{maxWorkflows: 4, customLabels: map[string]string{"location": "europe", "gpu": "true"}}, settings)
+
+	settings = resolveSettings(flagSettings, AgentConfig{MaxWorkflows: 4}, &rpc.AgentConfig{Capacity: 8, Labels: map[string]string{"location": "asia"}})
+	assert.Equal(t, agentSettings{maxWorkflows: 8, customLabels: map[string]string{"location": "asia", "gpu": "false"}}, settings)
+
+	// the flag labels are not modified
+	assert.Equal(t, "europe", flagSettings.customLabels["location"])
+	assert.True(t, settings.equal(agentSettings{maxWorkflows: 8, customLabels: map[string]string{"location": "asia", "gpu": "false"}}))
+	assert.False(t, settings.equal(flagSettings))
+}
+
+// fakeWorkflows lets tests control the workflows the workers of a pool run.
+type fakeWorkflows struct {
+	sync.Mutex
+	polling map[string]int
+	running int
+	next    chan struct{}
+	done    chan struct{}
+	stop    context.Context
+}
+
+func (f *fakeWorkflows) run(filter rpc.Filter, pollCtx context.Context) bool {
+	label := filter.Labels["label"]
+	f.Lock()
+	f.polling[label]++
+	f.Unlock()
+
+	select {
+	case <-f.stop.Done():
+		f.Lock()
+		f.polling[label]--
+		f.Unlock()
+		return false
+	case <-pollCtx.Done():
+		f.Lock()
+		f.polling[label]--
+		f.Unlock()
+		return true
+	case <-f.next:
+	}
+
+	f.Lock()
+	f.polling[label]--
+	f.running++
+	f.Unlock()
+	<-f.done
+	f.Lock()
+	f.running--
+	f.Unlock()
+	return true
+}
+
+func (f *fakeWorkflows) state() (map[string]int, int) {
+	f.Lock()
+	defer f.Unlock()
+	polling := make(map[string]int)
+	for label, count := range f.polling {
+		if count != 0 {
+			polling[label] = count
+		}
+	}
+	return polling, f.running
+}
+
+func TestWorkerPool(t *testing.T) {
+	stop, cancel := context.WithCancel(t.Context())
+	workflows := &fakeWorkflows{
+		polling: make(map[string]int),
+		next:    make(chan struct{}),
+		done:    make(chan struct{}),
+		stop:    stop,
+	}
+	pool := newWorkerPool(workflows.run)
+
+	assertState := func(polling map[string]int, running int) {
+		t.Helper()
+		assert.Eventually(t, func() bool {
+			gotPolling, gotRunning := workflows.state()
+			return assert.ObjectsAreEqual(polling, gotPolling) && gotRunning == running
+		}, time.Second, time.Millisecond)
+	}
+
+	pool.resize(3, rpc.Filter{Labels: map[string]string{"label": "old"}})
+	assertState(map[string]int{"old": 3}, 0)
+
+	workflows.next <- struct{}{}
+	workflows.next <- struct{}{}
+	assertState(map[string]int{"old": 1}, 2)
+
+	// busy workers finish their workflow, the idle one polls with the new labels
+	pool.resize(3, rpc.Filter{Labels: map[string]string{"label": "new"}})
+	assertState(map[string]int{"new": 1}, 2)
+
+	// shrinking stops surplus workers once their workflow is done
+	pool.resize(1, rpc.Filter{Labels: map[string]string{"label": "new"}})
+	assertState(map[string]int{}, 2)
+	workflows.done <- struct{}{}
+	assertState(map[string]int{}, 1)
+	workflows.done <- struct{}{}
+	assertState(map[string]int{"new": 1}, 0)
+
+	pool.resize(2, rpc.Filter{Labels: map[string]string{"label": "new"}})
+	assertState(map[string]int{"new": 2}, 0)
+
+	cancel()
+	pool.wait()
+	assertState(map[string]int{}, 0)
+}
@@ -32,6 +32,7 @@ func genApp() *cli.Command {
 			Usage:  "ping the server",
 			Action: pinger,
 		},
+		encryptionCmd,
 	}
 	app.Flags = flags
 
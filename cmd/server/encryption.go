@@ -0,0 +1,145 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/encryption"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/encryption/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+	"go.woodpecker-ci.org/woodpecker/v3/shared/logger"
+)
+
+var encryptionCmd = &cli.Command{
+	Name:  "encryption",
+	Usage: "manage the encryption of secrets and registry credentials stored in the database",
+	Commands: []*cli.Command{
+		{
+			Name: "rotate",
+			Usage: "re-encrypt all secrets and registry passwords with a new key. " +
+				"The current key is read from the usual encryption flags, the server must not be running",
+			// there is deliberately no API for this: a running server keeps using the key it was started with
+			// and would write new data with the old key or fail to read the re-encrypted data.
+			Action: encryptionRotate,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Sources: cli.NewValueSourceChain(
+						cli.File(os.Getenv("WOODPECKER_ENCRYPTION_NEW_KEY_FILE")),
+						cli.EnvVar("WOODPECKER_ENCRYPTION_NEW_KEY")),
+					Name:  "new-raw-key",
+					Usage: "raw encryption key to encrypt the data with",
+					Config: cli.StringConfig{
+						TrimSpace: true,
+					},
+				},
+				&cli.StringFlag{
+					Sources: cli.EnvVars("WOODPECKER_ENCRYPTION_NEW_TINK_KEYSET_FILE"),
+					Name:    "new-tink-keyset",
+					Usage:   "Google tink AEAD-compatible keyset file to encrypt the data with",
+				},
+				&cli.BoolFlag{
+					Name:  "decrypt",
+					Usage: "store all data unencrypted, this disables encryption",
+				},
+				&cli.IntFlag{
+					Name:  "batch-size",
+					Usage: "number of records re-encrypted before the progress is saved",
+					Value: 100,
+				},
+				&cli.BoolFlag{
+					Name:  "dry-run",
+					Usage: "only verify that all data can be decrypted with the current key",
+				},
+				&cli.BoolFlag{
+					Name:  "restart",
+					Usage: "ignore the progress of a previous interrupted run",
+				},
+			},
+		},
+	},
+}
+
+func encryptionRotate(ctx context.Context, c *cli.Command) error {
+	if err := logger.SetupGlobalLogger(ctx, c, true); err != nil {
+		return err
+	}
+
+	if !c.Bool("decrypt") && !c.IsSet("new-raw-key") && !c.IsSet("new-tink-keyset") {
+		return errors.New("either a new encryption key or --decrypt is required")
+	}
+
+	_store, err := setupStore(ctx, c)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := _store.Close(); err != nil {
+			log.Error().Err(err).Msg("could not close store")
+		}
+	}()
+
+	from, err := encryptionServiceFromFlags(c, _store, "encryption-raw-key", "encryption-tink-keyset", false)
+	if err != nil {
+		return fmt.Errorf("could not load current encryption key: %w", err)
+	}
+
+	if err := encryption.CheckCurrentKey(_store, from); err != nil {
+		return fmt.Errorf("current encryption key does not match the stored data: %w", err)
+	}
+
+	to, err := encryptionServiceFromFlags(c, _store, "new-raw-key", "new-tink-keyset", c.Bool("decrypt"))
+	if err != nil {
+		return fmt.Errorf("could not load new encryption key: %w", err)
+	}
+
+	return encryption.Reencrypt(ctx, _store, from, to, encryption.ReencryptOptions{
+		BatchSize: c.Int("batch-size"),
+		DryRun:    c.Bool("dry-run"),
+		Restart:   c.Bool("restart"),
+		Progress: func(p encryption.ReencryptProgress) {
+			log.Info().
+				Str("kind", p.Kind).
+				Int("total", p.Total).
+				Int("processed", p.Processed).
+				Int("skipped", p.Skipped).
+				Int("failed", len(p.Failed)).
+				Msg("re-encryption progress")
+		},
+	})
+}
+
+func encryptionServiceFromFlags(c *cli.Command, s store.Store, rawKeyFlag, tinkKeysetFlag string, plaintext bool) (types.EncryptionService, error) {
+	rawKey := c.String(rawKeyFlag)
+	tinkKeyset := c.String(tinkKeysetFlag)
+
+	switch {
+	case rawKey != "" && tinkKeyset != "":
+		return nil, fmt.Errorf("cannot use --%s and --%s at the same time", rawKeyFlag, tinkKeysetFlag)
+	case plaintext && (rawKey != "" || tinkKeyset != ""):
+		return nil, errors.New("cannot decrypt the data and set a new key at the same time")
+	case rawKey != "":
+		return encryption.NewRawKeyService(rawKey, s)
+	case tinkKeyset != "":
+		return encryption.NewTinkService(tinkKeyset, s)
+	}
+	return encryption.NewNoEncryptionService(), nil
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/urfave/cli/v3"
 
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/matrix"
 	host_matcher "go.woodpecker-ci.org/woodpecker/v3/server/services/utils/hostmatcher"
 	"go.woodpecker-ci.org/woodpecker/v3/shared/constant"
 	"go.woodpecker-ci.org/woodpecker/v3/shared/logger"
@@ -197,6 +198,115 @@ var flags = append([]cli.Flag{
 		Usage:   "The maximum time in minutes you can set in the repo settings before a pipeline gets killed",
 		Value:   120,
 	},
+	&cli.IntFlag{
+		Sources: cli.EnvVars("WOODPECKER_MAX_MATRIX_COMBINATIONS"),
+		Name:    "max-matrix-combinations",
+		Usage:   "The maximum number of workflows a matrix can expand to, additional ones are ignored",
+		Value:   matrix.DefaultLimitCombinations,
+	},
+	&cli.IntFlag{
+		Sources: cli.EnvVars("WOODPECKER_QUEUE_MAX_RUNNING_PER_ORG"),
+		Name:    "queue-max-running-per-org",
+		Usage:   "The maximum number of workflows of an org running at the same time, 0 means unlimited",
+	},
+	&cli.IntFlag{
+		Sources: cli.EnvVars("WOODPECKER_QUEUE_MAX_RUNNING_PER_REPO"),
+		Name:    "queue-max-running-per-repo",
+		Usage:   "The maximum number of workflows of a repo running at the same time, 0 means unlimited",
+	},
+	&cli.StringSliceFlag{
+		Sources: cli.EnvVars("WOODPECKER_QUEUE_ORG_WEIGHTS"),
+		Name:    "queue-org-weights",
+		Usage:   "Weights of orgs sharing the agents, in the format <org-id>:<weight>. Orgs without a weight have a weight of 1",
+	},
+	&cli.DurationFlag{
+		Sources: cli.EnvVars("WOODPECKER_QUEUE_RESUME_GRACE_PERIOD"),
+		Name:    "queue-resume-grace-period",
+		Usage:   "How long agents have after a server restart to resume the workflows they were running before these are handed out again",
+		Value:   2 * time.Minute,
+	},
+	&cli.DurationFlag{
+		Sources: cli.EnvVars("WOODPECKER_RETENTION_INTERVAL"),
+		Name:    "retention-interval",
+		Usage:   "How often old pipelines and logs are pruned according to the retention policies, 0 disables pruning",
+		Value:   time.Hour,
+	},
+	&cli.Int64Flag{
+		Sources: cli.EnvVars("WOODPECKER_RETENTION_KEEP_LAST"),
+		Name:    "retention-keep-last",
+		Usage:   "The number of newest pipelines of a repo that are always kept, used if no org or repo policy is set",
+	},
+	&cli.Int64Flag{
+		Sources: cli.EnvVars("WOODPECKER_RETENTION_KEEP_DAYS"),
+		Name:    "retention-keep-days",
+		Usage:   "The number of days pipelines are kept, used if no org or repo policy is set",
+	},
+	&cli.BoolFlag{
+		Sources: cli.EnvVars("WOODPECKER_RETENTION_KEEP_LATEST_PER_BRANCH"),
+		Name:    "retention-keep-latest-per-branch",
+		Usage:   "Always keep the newest pipeline of every branch, used if no org or repo policy is set",
+	},
+	&cli.BoolFlag{
+		Sources: cli.EnvVars("WOODPECKER_RETENTION_KEEP_LATEST_DEPLOYMENT"),
+		Name:    "retention-keep-latest-deployment",
+		Usage:   "Always keep the newest deployment of every target, used if no org or repo policy is set",
+	},
+	&cli.Int64Flag{
+		Sources: cli.EnvVars("WOODPECKER_RETENTION_LOG_DAYS"),
+		Name:    "retention-log-days",
+		Usage:   "The number of days logs are kept, the pipelines stay, used if no org or repo policy is set",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_AUTOSCALER_PROVIDER"),
+		Name:    "autoscaler-provider",
+		Usage:   "The provider deploying agents for pending workflows (command or http), the autoscaler is disabled if not set",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_AUTOSCALER_COMMAND"),
+		Name:    "autoscaler-command",
+		Usage:   "The command run by the command provider with the action deploy or remove as argument",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_AUTOSCALER_ENDPOINT"),
+		Name:    "autoscaler-endpoint",
+		Usage:   "The url of the http provider",
+	},
+	&cli.StringFlag{
+		Sources: cli.NewValueSourceChain(
+			cli.File(os.Getenv("WOODPECKER_AUTOSCALER_TOKEN_FILE")),
+			cli.EnvVar("WOODPECKER_AUTOSCALER_TOKEN")),
+		Name:  "autoscaler-token",
+		Usage: "The bearer token sent to the http provider",
+	},
+	&cli.DurationFlag{
+		Sources: cli.EnvVars("WOODPECKER_AUTOSCALER_INTERVAL"),
+		Name:    "autoscaler-interval",
+		Usage:   "How often the autoscaler checks the queue",
+		Value:   time.Minute,
+	},
+	&cli.IntFlag{
+		Sources: cli.EnvVars("WOODPECKER_AUTOSCALER_MIN_AGENTS"),
+		Name:    "autoscaler-min-agents",
+		Usage:   "The number of agents kept running even if they are idle",
+	},
+	&cli.IntFlag{
+		Sources: cli.EnvVars("WOODPECKER_AUTOSCALER_MAX_AGENTS"),
+		Name:    "autoscaler-max-agents",
+		Usage:   "The maximum number of agents deployed by the autoscaler",
+		Value:   10,
+	},
+	&cli.IntFlag{
+		Sources: cli.EnvVars("WOODPECKER_AUTOSCALER_WORKFLOWS_PER_AGENT"),
+		Name:    "autoscaler-workflows-per-agent",
+		Usage:   "The number of workflows a deployed agent runs in parallel",
+		Value:   1,
+	},
+	&cli.DurationFlag{
+		Sources: cli.EnvVars("WOODPECKER_AUTOSCALER_IDLE_TIMEOUT"),
+		Name:    "autoscaler-idle-timeout",
+		Usage:   "The time after which an agent without work is removed",
+		Value:   10 * time.Minute,
+	},
 	&cli.StringSliceFlag{
 		Sources: cli.EnvVars("WOODPECKER_DEFAULT_WORKFLOW_LABELS"),
 		Name:    "default-workflow-labels",
@@ -343,7 +453,7 @@ var flags = append([]cli.Flag{
 	&cli.StringFlag{
 		Sources: cli.EnvVars("WOODPECKER_LOG_STORE"),
 		Name:    "log-store",
-		Usage:   "log store to use ('database', 'addon' or 'file')",
+		Usage:   "log store to use ('database', 'addon', 'file' or 's3')",
 		Value:   "database",
 	},
 	&cli.StringFlag{
@@ -351,6 +461,57 @@ var flags = append([]cli.Flag{
 		Name:    "log-store-file-path",
 		Usage:   "directory used for file based log storage or addon executable file path",
 	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_LOG_STORE_S3_ENDPOINT"),
+		Name:    "log-store-s3-endpoint",
+		Usage:   "endpoint of the S3 compatible object storage used for the s3 log store",
+		Value:   "s3.amazonaws.com",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_LOG_STORE_S3_BUCKET"),
+		Name:    "log-store-s3-bucket",
+		Usage:   "bucket the s3 log store writes the logs to",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_LOG_STORE_S3_PREFIX"),
+		Name:    "log-store-s3-prefix",
+		Usage:   "prefix of the object keys written by the s3 log store",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_LOG_STORE_S3_REGION"),
+		Name:    "log-store-s3-region",
+		Usage:   "region of the bucket used by the s3 log store",
+	},
+	&cli.StringFlag{
+		Sources: cli.NewValueSourceChain(
+			cli.File(os.Getenv("WOODPECKER_LOG_STORE_S3_ACCESS_KEY_ID_FILE")),
+			cli.EnvVar("WOODPECKER_LOG_STORE_S3_ACCESS_KEY_ID")),
+		Name:  "log-store-s3-access-key-id",
+		Usage: "access key id used by the s3 log store, if not set the AWS credential chain is used",
+		Config: cli.StringConfig{
+			TrimSpace: true,
+		},
+	},
+	&cli.StringFlag{
+		Sources: cli.NewValueSourceChain(
+			cli.File(os.Getenv("WOODPECKER_LOG_STORE_S3_SECRET_ACCESS_KEY_FILE")),
+			cli.EnvVar("WOODPECKER_LOG_STORE_S3_SECRET_ACCESS_KEY")),
+		Name:  "log-store-s3-secret-access-key",
+		Usage: "secret access key used by the s3 log store",
+		Config: cli.StringConfig{
+			TrimSpace: true,
+		},
+	},
+	&cli.BoolFlag{
+		Sources: cli.EnvVars("WOODPECKER_LOG_STORE_S3_INSECURE"),
+		Name:    "log-store-s3-insecure",
+		Usage:   "connect to the object storage without TLS",
+	},
+	&cli.BoolFlag{
+		Sources: cli.EnvVars("WOODPECKER_LOG_STORE_S3_PATH_STYLE"),
+		Name:    "log-store-s3-path-style",
+		Usage:   "use path style bucket urls, required by most MinIO setups",
+	},
 	//
 	// backend options for pipeline compiler
 	//
@@ -455,6 +616,12 @@ var flags = append([]cli.Flag{
 		Name:    "forge-oauth-host",
 		Usage:   "fully qualified public forge url, used if forge url is not a public url. Format: <scheme>://<host>[/<prefix path>]",
 	},
+	&cli.DurationFlag{
+		Sources: cli.EnvVars("WOODPECKER_FORGE_POLL_INTERVAL"),
+		Name:    "forge-poll-interval",
+		Usage:   "How often repositories of forges without webhooks (e.g. git) are polled for changes, 0 disables polling",
+		Value:   time.Minute,
+	},
 	//
 	// Addon
 	//
@@ -463,6 +630,26 @@ var flags = append([]cli.Flag{
 		Name:    "addon-forge",
 		Usage:   "path to forge addon executable",
 	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_ADDON_FORGE_ADDRESS"),
+		Name:    "addon-forge-address",
+		Usage:   "address of a forge addon served over the network via grpc",
+	},
+	&cli.StringFlag{
+		Sources: cli.NewValueSourceChain(
+			cli.File(os.Getenv("WOODPECKER_ADDON_FORGE_TOKEN_FILE")),
+			cli.EnvVar("WOODPECKER_ADDON_FORGE_TOKEN")),
+		Name:  "addon-forge-token",
+		Usage: "token used to authenticate against a forge addon served over the network",
+		Config: cli.StringConfig{
+			TrimSpace: true,
+		},
+	},
+	&cli.BoolFlag{
+		Sources: cli.EnvVars("WOODPECKER_ADDON_FORGE_INSECURE"),
+		Name:    "addon-forge-insecure",
+		Usage:   "connect to a forge addon served over the network without tls, not allowed together with a token",
+	},
 	//
 	// GitHub
 	//
@@ -483,6 +670,34 @@ var flags = append([]cli.Flag{
 		Usage:   "github tokens should only get access to public repos",
 		Value:   false,
 	},
+	&cli.BoolFlag{
+		Sources: cli.EnvVars("WOODPECKER_GITHUB_CHECKS"),
+		Name:    "github-checks",
+		Usage:   "github workflows are reported as check runs of a github app instead of commit statuses",
+		Value:   false,
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_GITHUB_APP_ID"),
+		Name:    "github-app-id",
+		Usage:   "github app id or client id used to create check runs",
+	},
+	&cli.StringFlag{
+		Sources: cli.NewValueSourceChain(
+			cli.File(os.Getenv("WOODPECKER_GITHUB_APP_PRIVATE_KEY_FILE")),
+			cli.EnvVar("WOODPECKER_GITHUB_APP_PRIVATE_KEY")),
+		Name:  "github-app-private-key",
+		Usage: "github app private key used to create check runs",
+	},
+	&cli.StringFlag{
+		Sources: cli.NewValueSourceChain(
+			cli.File(os.Getenv("WOODPECKER_GITHUB_APP_WEBHOOK_SECRET_FILE")),
+			cli.EnvVar("WOODPECKER_GITHUB_APP_WEBHOOK_SECRET")),
+		Name:  "github-app-webhook-secret",
+		Usage: "github app webhook secret used to verify requests to re-run pipelines",
+		Config: cli.StringConfig{
+			TrimSpace: true,
+		},
+	},
 	//
 	// Gitea
 	//
@@ -549,6 +764,59 @@ var flags = append([]cli.Flag{
 		Usage:   "Bitbucket DataCenter/Server oauth2 scope should be configured to include PROJECT_ADMIN configuration.",
 	},
 	//
+	// Git
+	//
+	&cli.BoolFlag{
+		Sources: cli.EnvVars("WOODPECKER_GIT"),
+		Name:    "git",
+		Usage:   "plain git driver is enabled",
+	},
+	&cli.StringSliceFlag{
+		Sources: cli.EnvVars("WOODPECKER_GIT_REPOS"),
+		Name:    "git-repos",
+		Usage:   "clone urls of the repositories tracked by the plain git driver",
+	},
+	&cli.StringFlag{
+		Sources: cli.NewValueSourceChain(
+			cli.File(os.Getenv("WOODPECKER_GIT_USERNAME_FILE")),
+			cli.EnvVar("WOODPECKER_GIT_USERNAME")),
+		Name:  "git-username",
+		Usage: "username used to fetch http(s) clone urls",
+		Config: cli.StringConfig{
+			TrimSpace: true,
+		},
+	},
+	&cli.StringFlag{
+		Sources: cli.NewValueSourceChain(
+			cli.File(os.Getenv("WOODPECKER_GIT_PASSWORD_FILE")),
+			cli.EnvVar("WOODPECKER_GIT_PASSWORD")),
+		Name:  "git-password",
+		Usage: "password or token used to fetch http(s) clone urls",
+		Config: cli.StringConfig{
+			TrimSpace: true,
+		},
+	},
+	&cli.StringFlag{
+		Sources: cli.NewValueSourceChain(
+			cli.File(os.Getenv("WOODPECKER_GIT_SSH_KEY_FILE")),
+			cli.EnvVar("WOODPECKER_GIT_SSH_KEY")),
+		Name:  "git-ssh-key",
+		Usage: "private ssh key used to fetch ssh clone urls",
+	},
+	&cli.StringFlag{
+		Sources: cli.NewValueSourceChain(
+			cli.File(os.Getenv("WOODPECKER_GIT_USERS_FILE")),
+			cli.EnvVar("WOODPECKER_GIT_USERS")),
+		Name:  "git-users",
+		Usage: "users allowed to login in htpasswd format, only bcrypt hashes are supported",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_GIT_MIRROR_PATH"),
+		Name:    "git-mirror-path",
+		Usage:   "directory to store the bare clones of the repositories tracked by the plain git driver",
+		Value:   gitMirrorPathDefaultValue(),
+	},
+	//
 	// development flags
 	//
 	&cli.StringFlag{
@@ -600,6 +868,14 @@ func datasourceDefaultValue() string {
 	return "woodpecker.sqlite"
 }
 
+func gitMirrorPathDefaultValue() string {
+	_, found := os.LookupEnv("WOODPECKER_IN_CONTAINER")
+	if found {
+		return "/var/lib/woodpecker/git"
+	}
+	return "git"
+}
+
 func getFirstNonEmptyEnvVar(envVars ...string) string {
 	for _, envVar := range envVars {
 		val := os.Getenv(envVar)
@@ -18,15 +18,15 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/agents": {
+        "/agent-pools": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Agent pools"
                 ],
-                "summary": "List agents",
+                "summary": "List agent pools",
                 "parameters": [
                     {
                         "type": "string",
@@ -57,21 +57,21 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/Agent"
+                                "$ref": "#/definitions/AgentPool"
                             }
                         }
                     }
                 }
             },
             "post": {
-                "description": "Creates a new agent with a random token",
+                "description": "Agents of a pool only run workflows of the organizations and repositories allowed to use it.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Agent pools"
                 ],
-                "summary": "Create a new agent",
+                "summary": "Create an agent pool",
                 "parameters": [
                     {
                         "type": "string",
@@ -82,12 +82,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "the agent's data (only 'name' and 'no_schedule' are read)",
-                        "name": "agent",
+                        "description": "the agent pool's data",
+                        "name": "pool",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/Agent"
+                            "$ref": "#/definitions/AgentPool"
                         }
                     }
                 ],
@@ -95,21 +95,21 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Agent"
+                            "$ref": "#/definitions/AgentPool"
                         }
                     }
                 }
             }
         },
-        "/agents/{agent_id}": {
+        "/agent-pools/{pool_id}": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Agent pools"
                 ],
-                "summary": "Get an agent",
+                "summary": "Get an agent pool",
                 "parameters": [
                     {
                         "type": "string",
@@ -121,8 +121,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "the agent's id",
-                        "name": "agent_id",
+                        "description": "the agent pool's id",
+                        "name": "pool_id",
                         "in": "path",
                         "required": true
                     }
@@ -131,7 +131,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Agent"
+                            "$ref": "#/definitions/AgentPool"
                         }
                     }
                 }
@@ -141,9 +141,9 @@ const docTemplate = `{
                     "text/plain"
                 ],
                 "tags": [
-                    "Agents"
+                    "Agent pools"
                 ],
-                "summary": "Delete an agent",
+                "summary": "Delete an agent pool",
                 "parameters": [
                     {
                         "type": "string",
@@ -155,15 +155,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "the agent's id",
-                        "name": "agent_id",
+                        "description": "the agent pool's id",
+                        "name": "pool_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             },
@@ -172,9 +172,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Agent pools"
                 ],
-                "summary": "Update an agent",
+                "summary": "Update an agent pool",
                 "parameters": [
                     {
                         "type": "string",
@@ -186,18 +186,18 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "the agent's id",
-                        "name": "agent_id",
+                        "description": "the agent pool's id",
+                        "name": "pool_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "the agent's data",
-                        "name": "agentData",
+                        "description": "the agent pool's data",
+                        "name": "pool",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/Agent"
+                            "$ref": "#/definitions/AgentPoolPatch"
                         }
                     }
                 ],
@@ -205,13 +205,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Agent"
+                            "$ref": "#/definitions/AgentPool"
                         }
                     }
                 }
             }
         },
-        "/agents/{agent_id}/tasks": {
+        "/agents": {
             "get": {
                 "produces": [
                     "application/json"
@@ -219,7 +219,7 @@ const docTemplate = `{
                 "tags": [
                     "Agents"
                 ],
-                "summary": "List agent tasks",
+                "summary": "List agents",
                 "parameters": [
                     {
                         "type": "string",
@@ -231,10 +231,17 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "the agent's id",
-                        "name": "agent_id",
-                        "in": "path",
-                        "required": true
+                        "default": 1,
+                        "description": "for response pagination, page offset number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "for response pagination, max items per page",
+                        "name": "perPage",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -243,74 +250,93 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/Task"
+                                "$ref": "#/definitions/Agent"
                             }
                         }
                     }
                 }
-            }
-        },
-        "/badges/{repo_id}/cc.xml": {
-            "get": {
-                "description": "CCMenu displays the pipeline status of projects on a CI server as an item in the Mac's menu bar.\nMore details on how to install, you can find at http://ccmenu.org/\nThe response format adheres to CCTray v1 Specification, https://cctray.org/v1/",
+            },
+            "post": {
+                "description": "Creates a new agent with a random token",
                 "produces": [
-                    "text/xml"
+                    "application/json"
                 ],
                 "tags": [
-                    "Badges"
+                    "Agents"
                 ],
-                "summary": "Provide pipeline status information to the CCMenu tool",
+                "summary": "Create a new agent",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "the repository id",
-                        "name": "repo_id",
-                        "in": "path",
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "the agent's data (only 'name' and 'no_schedule' are read)",
+                        "name": "agent",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/Agent"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Agent"
+                        }
                     }
                 }
             }
         },
-        "/badges/{repo_id}/status.svg": {
+        "/agents/{agent_id}": {
             "get": {
                 "produces": [
-                    "image/svg+xml"
+                    "application/json"
                 ],
                 "tags": [
-                    "Badges"
+                    "Agents"
                 ],
-                "summary": "Get status of pipeline as SVG badge",
+                "summary": "Get an agent",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
                     {
                         "type": "integer",
-                        "description": "the repository id",
-                        "name": "repo_id",
+                        "description": "the agent's id",
+                        "name": "agent_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Agent"
+                        }
                     }
                 }
-            }
-        },
-        "/debug/pprof": {
-            "get": {
-                "description": "Only available, when server was started with WOODPECKER_LOG_LEVEL=debug",
+            },
+            "delete": {
                 "produces": [
-                    "text/html"
+                    "text/plain"
                 ],
                 "tags": [
-                    "Process profiling and debugging"
+                    "Agents"
                 ],
-                "summary": "List available pprof profiles (HTML)",
+                "summary": "Delete an agent",
                 "parameters": [
                     {
                         "type": "string",
@@ -319,6 +345,13 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the agent's id",
+                        "name": "agent_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -326,18 +359,15 @@ const docTemplate = `{
                         "description": "OK"
                     }
                 }
-            }
-        },
-        "/debug/pprof/block": {
-            "get": {
-                "description": "Only available, when server was started with WOODPECKER_LOG_LEVEL=debug",
+            },
+            "patch": {
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "Process profiling and debugging"
+                    "Agents"
                 ],
-                "summary": "Get pprof stack traces that led to blocking on synchronization primitives",
+                "summary": "Update an agent",
                 "parameters": [
                     {
                         "type": "string",
@@ -346,25 +376,44 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the agent's id",
+                        "name": "agent_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the agent's data",
+                        "name": "agentData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/Agent"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Agent"
+                        }
                     }
                 }
             }
         },
-        "/debug/pprof/cmdline": {
+        "/agents/{agent_id}/drain": {
             "get": {
-                "description": "Only available, when server was started with WOODPECKER_LOG_LEVEL=debug",
+                "description": "Blocks until the draining agent has no running workflows anymore.",
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "Process profiling and debugging"
+                    "Agents"
                 ],
-                "summary": "Get the command line invocation of the current program",
+                "summary": "Wait for an agent to be drained",
                 "parameters": [
                     {
                         "type": "string",
@@ -373,25 +422,39 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the agent's id",
+                        "name": "agent_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the maximum time to wait, e.g. 30m",
+                        "name": "timeout",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Agent"
+                        }
                     }
                 }
-            }
-        },
-        "/debug/pprof/goroutine": {
-            "get": {
-                "description": "Only available, when server was started with WOODPECKER_LOG_LEVEL=debug",
+            },
+            "post": {
+                "description": "The agent finishes its running workflows but gets no new ones until it registers again.",
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "Process profiling and debugging"
+                    "Agents"
                 ],
-                "summary": "Get pprof stack traces of all current goroutines",
+                "summary": "Drain an agent",
                 "parameters": [
                     {
                         "type": "string",
@@ -403,29 +466,29 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "default": 1,
-                        "description": "Use debug=2 as a query parameter to export in the same format as an un-recovered panic",
-                        "name": "debug",
-                        "in": "query"
+                        "description": "the agent's id",
+                        "name": "agent_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Agent"
+                        }
                     }
                 }
-            }
-        },
-        "/debug/pprof/heap": {
-            "get": {
-                "description": "Only available, when server was started with WOODPECKER_LOG_LEVEL=debug",
+            },
+            "delete": {
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "Process profiling and debugging"
+                    "Agents"
                 ],
-                "summary": "Get pprof heap dump, a sampling of memory allocations of live objects",
+                "summary": "Cancel draining an agent",
                 "parameters": [
                     {
                         "type": "string",
@@ -436,30 +499,32 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "default": "",
-                        "description": "You can specify gc=heap to run GC before taking the heap sample",
-                        "name": "gc",
-                        "in": "query"
+                        "type": "integer",
+                        "description": "the agent's id",
+                        "name": "agent_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Agent"
+                        }
                     }
                 }
             }
         },
-        "/debug/pprof/profile": {
+        "/agents/{agent_id}/tasks": {
             "get": {
-                "description": "Only available, when server was started with WOODPECKER_LOG_LEVEL=debug\nAfter you get the profile file, use the go tool pprof command to investigate the profile.",
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "Process profiling and debugging"
+                    "Agents"
                 ],
-                "summary": "Get pprof CPU profile",
+                "summary": "List agent tasks",
                 "parameters": [
                     {
                         "type": "string",
@@ -471,8 +536,248 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "You can specify the duration in the seconds GET parameter.",
-                        "name": "seconds",
+                        "description": "the agent's id",
+                        "name": "agent_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Task"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/badges/{repo_id}/cc.xml": {
+            "get": {
+                "description": "CCMenu displays the pipeline status of projects on a CI server as an item in the Mac's menu bar.\nMore details on how to install, you can find at http://ccmenu.org/\nThe response format adheres to CCTray v1 Specification, https://cctray.org/v1/",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "Badges"
+                ],
+                "summary": "Provide pipeline status information to the CCMenu tool",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/badges/{repo_id}/status.svg": {
+            "get": {
+                "produces": [
+                    "image/svg+xml"
+                ],
+                "tags": [
+                    "Badges"
+                ],
+                "summary": "Get status of pipeline as SVG badge",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/debug/pprof": {
+            "get": {
+                "description": "Only available, when server was started with WOODPECKER_LOG_LEVEL=debug",
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "Process profiling and debugging"
+                ],
+                "summary": "List available pprof profiles (HTML)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/debug/pprof/block": {
+            "get": {
+                "description": "Only available, when server was started with WOODPECKER_LOG_LEVEL=debug",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Process profiling and debugging"
+                ],
+                "summary": "Get pprof stack traces that led to blocking on synchronization primitives",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/debug/pprof/cmdline": {
+            "get": {
+                "description": "Only available, when server was started with WOODPECKER_LOG_LEVEL=debug",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Process profiling and debugging"
+                ],
+                "summary": "Get the command line invocation of the current program",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/debug/pprof/goroutine": {
+            "get": {
+                "description": "Only available, when server was started with WOODPECKER_LOG_LEVEL=debug",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Process profiling and debugging"
+                ],
+                "summary": "Get pprof stack traces of all current goroutines",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Use debug=2 as a query parameter to export in the same format as an un-recovered panic",
+                        "name": "debug",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/debug/pprof/heap": {
+            "get": {
+                "description": "Only available, when server was started with WOODPECKER_LOG_LEVEL=debug",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Process profiling and debugging"
+                ],
+                "summary": "Get pprof heap dump, a sampling of memory allocations of live objects",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "",
+                        "description": "You can specify gc=heap to run GC before taking the heap sample",
+                        "name": "gc",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/debug/pprof/profile": {
+            "get": {
+                "description": "Only available, when server was started with WOODPECKER_LOG_LEVEL=debug\nAfter you get the profile file, use the go tool pprof command to investigate the profile.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Process profiling and debugging"
+                ],
+                "summary": "Get pprof CPU profile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "You can specify the duration in the seconds GET parameter.",
+                        "name": "seconds",
                         "in": "query",
                         "required": true
                     }
@@ -835,23 +1140,57 @@ const docTemplate = `{
                 }
             }
         },
-        "/log-level": {
-            "get": {
-                "description": "Endpoint returns the current logging level. Requires admin rights.",
+        "/hook/forges/{forgeId}": {
+            "post": {
                 "produces": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
                     "System"
                 ],
-                "summary": "Current log level",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "object",
-                            "properties": {
-                                "log-level": {
+                "summary": "Incoming webhook from a forge app to run a pipeline again, e.g. by the re-run button of a GitHub check run",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "the forge's id",
+                        "name": "forgeId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the webhook payload",
+                        "name": "hook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/log-level": {
+            "get": {
+                "description": "Endpoint returns the current logging level. Requires admin rights.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "Current log level",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "log-level": {
                                     "type": "string"
                                 }
                             }
@@ -1096,34 +1435,383 @@ const docTemplate = `{
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "default": 50,
-                        "description": "for response pagination, max items per page",
-                        "name": "perPage",
-                        "in": "query"
+                        "type": "integer",
+                        "default": 50,
+                        "description": "for response pagination, max items per page",
+                        "name": "perPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Agent"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a new agent with a random token, scoped to the specified organization",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Agents"
+                ],
+                "summary": "Create a new organization-scoped agent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the organization's id",
+                        "name": "org_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the agent's data (only 'name' and 'no_schedule' are read)",
+                        "name": "agent",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/Agent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Agent"
+                        }
+                    }
+                }
+            }
+        },
+        "/orgs/{org_id}/agents/{agent_id}": {
+            "delete": {
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Agents"
+                ],
+                "summary": "Delete an organization-scoped agent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the organization's id",
+                        "name": "org_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the agent's id",
+                        "name": "agent_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            },
+            "patch": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Agents"
+                ],
+                "summary": "Update an organization-scoped agent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the organization's id",
+                        "name": "org_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the agent's id",
+                        "name": "agent_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the agent's updated data",
+                        "name": "agent",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/Agent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Agent"
+                        }
+                    }
+                }
+            }
+        },
+        "/orgs/{org_id}/permissions": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Organization permissions"
+                ],
+                "summary": "Get the permissions of the currently authenticated user for the given organization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the organization's id",
+                        "name": "org_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/OrgPerm"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/orgs/{org_id}/registries": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Organization registries"
+                ],
+                "summary": "List organization registries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the org's id",
+                        "name": "org_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "for response pagination, page offset number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "for response pagination, max items per page",
+                        "name": "perPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Registry"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Organization registries"
+                ],
+                "summary": "Create an organization registry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the org's id",
+                        "name": "org_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the new registry",
+                        "name": "registryData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/Registry"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Registry"
+                        }
+                    }
+                }
+            }
+        },
+        "/orgs/{org_id}/registries/{registry}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Organization registries"
+                ],
+                "summary": "Get a organization registry by address",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the org's id",
+                        "name": "org_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the registry's address",
+                        "name": "registry",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Registry"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Organization registries"
+                ],
+                "summary": "Delete an organization registry by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the org's id",
+                        "name": "org_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the registry's name",
+                        "name": "registry",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/Agent"
-                            }
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             },
-            "post": {
-                "description": "Creates a new agent with a random token, scoped to the specified organization",
+            "patch": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Organization registries"
                 ],
-                "summary": "Create a new organization-scoped agent",
+                "summary": "Update an organization registry by name",
                 "parameters": [
                     {
                         "type": "string",
@@ -1134,19 +1822,26 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "the organization's id",
+                        "type": "string",
+                        "description": "the org's id",
                         "name": "org_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "the agent's data (only 'name' and 'no_schedule' are read)",
-                        "name": "agent",
+                        "type": "string",
+                        "description": "the registry's name",
+                        "name": "registry",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the update registry data",
+                        "name": "registryData",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/Agent"
+                            "$ref": "#/definitions/Registry"
                         }
                     }
                 ],
@@ -1154,21 +1849,22 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Agent"
+                            "$ref": "#/definitions/Registry"
                         }
                     }
                 }
             }
         },
-        "/orgs/{org_id}/agents/{agent_id}": {
-            "delete": {
+        "/orgs/{org_id}/retention": {
+            "get": {
+                "description": "Returns the global policy if the organization has no own policy.",
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Orgs"
                 ],
-                "summary": "Delete an organization-scoped agent",
+                "summary": "Get the retention policy of an organization",
                 "parameters": [
                     {
                         "type": "string",
@@ -1179,34 +1875,30 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
+                        "type": "string",
                         "description": "the organization's id",
                         "name": "org_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "the agent's id",
-                        "name": "agent_id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/RetentionPolicy"
+                        }
                     }
                 }
             },
-            "patch": {
+            "delete": {
                 "produces": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
-                    "Agents"
+                    "Orgs"
                 ],
-                "summary": "Update an organization-scoped agent",
+                "summary": "Delete the retention policy of an organization",
                 "parameters": [
                     {
                         "type": "string",
@@ -1217,48 +1909,28 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
+                        "type": "string",
                         "description": "the organization's id",
                         "name": "org_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "the agent's id",
-                        "name": "agent_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "the agent's updated data",
-                        "name": "agent",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/Agent"
-                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/Agent"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
-            }
-        },
-        "/orgs/{org_id}/permissions": {
-            "get": {
+            },
+            "patch": {
+                "description": "Creates the policy of the organization if it has none yet. It is used for all repositories of the organization without an own policy.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Organization permissions"
+                    "Orgs"
                 ],
-                "summary": "Get the permissions of the currently authenticated user for the given organization",
+                "summary": "Update the retention policy of an organization",
                 "parameters": [
                     {
                         "type": "string",
@@ -1274,30 +1946,36 @@ const docTemplate = `{
                         "name": "org_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "the retention policy data",
+                        "name": "policy",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/RetentionPolicyPatch"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/OrgPerm"
-                            }
+                            "$ref": "#/definitions/RetentionPolicy"
                         }
                     }
                 }
             }
         },
-        "/orgs/{org_id}/registries": {
+        "/orgs/{org_id}/secrets": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Organization registries"
+                    "Organization secrets"
                 ],
-                "summary": "List organization registries",
+                "summary": "List organization secrets",
                 "parameters": [
                     {
                         "type": "string",
@@ -1335,7 +2013,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/Registry"
+                                "$ref": "#/definitions/Secret"
                             }
                         }
                     }
@@ -1346,9 +2024,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Organization registries"
+                    "Organization secrets"
                 ],
-                "summary": "Create an organization registry",
+                "summary": "Create an organization secret",
                 "parameters": [
                     {
                         "type": "string",
@@ -1366,12 +2044,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "the new registry",
-                        "name": "registryData",
+                        "description": "the new secret",
+                        "name": "secretData",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/Registry"
+                            "$ref": "#/definitions/Secret"
                         }
                     }
                 ],
@@ -1379,21 +2057,21 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Registry"
+                            "$ref": "#/definitions/Secret"
                         }
                     }
                 }
             }
         },
-        "/orgs/{org_id}/registries/{registry}": {
+        "/orgs/{org_id}/secrets/{secret}": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Organization registries"
+                    "Organization secrets"
                 ],
-                "summary": "Get a organization registry by address",
+                "summary": "Get a organization secret by name",
                 "parameters": [
                     {
                         "type": "string",
@@ -1412,8 +2090,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "the registry's address",
-                        "name": "registry",
+                        "description": "the secret's name",
+                        "name": "secret",
                         "in": "path",
                         "required": true
                     }
@@ -1422,7 +2100,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Registry"
+                            "$ref": "#/definitions/Secret"
                         }
                     }
                 }
@@ -1432,9 +2110,9 @@ const docTemplate = `{
                     "text/plain"
                 ],
                 "tags": [
-                    "Organization registries"
+                    "Organization secrets"
                 ],
-                "summary": "Delete an organization registry by name",
+                "summary": "Delete an organization secret by name",
                 "parameters": [
                     {
                         "type": "string",
@@ -1453,8 +2131,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "the registry's name",
-                        "name": "registry",
+                        "description": "the secret's name",
+                        "name": "secret",
                         "in": "path",
                         "required": true
                     }
@@ -1470,9 +2148,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Organization registries"
+                    "Organization secrets"
                 ],
-                "summary": "Update an organization registry by name",
+                "summary": "Update an organization secret by name",
                 "parameters": [
                     {
                         "type": "string",
@@ -1491,18 +2169,18 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "the registry's name",
-                        "name": "registry",
+                        "description": "the secret's name",
+                        "name": "secret",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "the update registry data",
-                        "name": "registryData",
+                        "description": "the update secret data",
+                        "name": "secretData",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/Registry"
+                            "$ref": "#/definitions/Secret"
                         }
                     }
                 ],
@@ -1510,21 +2188,84 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Registry"
+                            "$ref": "#/definitions/Secret"
                         }
                     }
                 }
             }
         },
-        "/orgs/{org_id}/secrets": {
+        "/orgs/{org_id}/stats": {
             "get": {
+                "description": "Aggregates success rate, durations, queue wait times, step and branch statistics of the pipelines created in the given time window of at most 366 days.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Organization secrets"
+                    "Orgs"
                 ],
-                "summary": "List organization secrets",
+                "summary": "Get pipeline statistics of the repositories of an organization the user can access",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the organization's id",
+                        "name": "org_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "only use pipelines created after this RFC3339 date (default 30 days ago)",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only use pipelines created before this RFC3339 date (default now)",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "24h",
+                        "description": "duration of a timeline bucket",
+                        "name": "interval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "max number of entries in the step, flaky step and branch lists",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Stats"
+                        }
+                    }
+                }
+            }
+        },
+        "/orgs/{org_id}/templates": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Organization config templates"
+                ],
+                "summary": "List organization config templates",
                 "parameters": [
                     {
                         "type": "string",
@@ -1562,7 +2303,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/Secret"
+                                "$ref": "#/definitions/ConfigTemplate"
                             }
                         }
                     }
@@ -1573,9 +2314,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Organization secrets"
+                    "Organization config templates"
                 ],
-                "summary": "Create an organization secret",
+                "summary": "Create an organization config template",
                 "parameters": [
                     {
                         "type": "string",
@@ -1593,12 +2334,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "the new secret",
-                        "name": "secretData",
+                        "description": "the new template",
+                        "name": "template",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/Secret"
+                            "$ref": "#/definitions/ConfigTemplate"
                         }
                     }
                 ],
@@ -1606,21 +2347,21 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Secret"
+                            "$ref": "#/definitions/ConfigTemplate"
                         }
                     }
                 }
             }
         },
-        "/orgs/{org_id}/secrets/{secret}": {
+        "/orgs/{org_id}/templates/{template}": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Organization secrets"
+                    "Organization config templates"
                 ],
-                "summary": "Get a organization secret by name",
+                "summary": "Get an organization config template by name",
                 "parameters": [
                     {
                         "type": "string",
@@ -1639,8 +2380,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "the secret's name",
-                        "name": "secret",
+                        "description": "the template's name",
+                        "name": "template",
                         "in": "path",
                         "required": true
                     }
@@ -1649,7 +2390,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Secret"
+                            "$ref": "#/definitions/ConfigTemplate"
                         }
                     }
                 }
@@ -1659,9 +2400,9 @@ const docTemplate = `{
                     "text/plain"
                 ],
                 "tags": [
-                    "Organization secrets"
+                    "Organization config templates"
                 ],
-                "summary": "Delete an organization secret by name",
+                "summary": "Delete an organization config template by name",
                 "parameters": [
                     {
                         "type": "string",
@@ -1680,8 +2421,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "the secret's name",
-                        "name": "secret",
+                        "description": "the template's name",
+                        "name": "template",
                         "in": "path",
                         "required": true
                     }
@@ -1697,9 +2438,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Organization secrets"
+                    "Organization config templates"
                 ],
-                "summary": "Update an organization secret by name",
+                "summary": "Update an organization config template by name",
                 "parameters": [
                     {
                         "type": "string",
@@ -1718,18 +2459,18 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "the secret's name",
-                        "name": "secret",
+                        "description": "the template's name",
+                        "name": "template",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "the update secret data",
-                        "name": "secretData",
+                        "description": "the update template data",
+                        "name": "templateData",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/Secret"
+                            "$ref": "#/definitions/ConfigTemplate"
                         }
                     }
                 ],
@@ -1737,7 +2478,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Secret"
+                            "$ref": "#/definitions/ConfigTemplate"
                         }
                     }
                 }
@@ -1883,6 +2624,43 @@ const docTemplate = `{
                 }
             }
         },
+        "/queue/tasks/{task_id}/explain": {
+            "get": {
+                "description": "Returns the blocking dependencies of the task, its position in the queue and which agents can take it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pipeline queues"
+                ],
+                "summary": "Explain why a task of the pipeline queue does not run yet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the task's id",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/QueueTaskExplanation"
+                        }
+                    }
+                }
+            }
+        },
         "/registries": {
             "get": {
                 "produces": [
@@ -2695,6 +3473,101 @@ const docTemplate = `{
                 }
             }
         },
+        "/repos/{repo_id}/logs/search": {
+            "get": {
+                "description": "Searches the logs of the pipelines on the given page, newest first, for a substring or regular expression.\nThe search stops once the match limit is reached, use cursor with the same page to continue after the last match\nand next_page to continue with older pipelines.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pipeline logs"
+                ],
+                "summary": "Search the step logs of a repository",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the substring or regular expression to search for",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "treat the query as regular expression",
+                        "name": "regex",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "ignore the case of letters",
+                        "name": "ignore_case",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only search pipelines of this branch",
+                        "name": "branch",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only search steps with this name",
+                        "name": "step",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "max number of matches",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "continue a truncated search after this match",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "for response pagination, page offset number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "number of pipelines to search",
+                        "name": "perPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/LogSearchResult"
+                        }
+                    }
+                }
+            }
+        },
         "/repos/{repo_id}/logs/{number}": {
             "delete": {
                 "produces": [
@@ -3708,6 +4581,118 @@ const docTemplate = `{
                 }
             }
         },
+        "/repos/{repo_id}/retention": {
+            "get": {
+                "description": "Returns the policy used for the repository. If the repository has no own policy, the policy of its organization or the global policy is returned.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Repositories"
+                ],
+                "summary": "Get the retention policy of a repository",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/RetentionPolicy"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Repositories"
+                ],
+                "summary": "Delete the retention policy of a repository",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            },
+            "patch": {
+                "description": "Creates the policy of the repository if it has none yet. It replaces the policy of the organization and the global one.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Repositories"
+                ],
+                "summary": "Update the retention policy of a repository",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the retention policy data",
+                        "name": "policy",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/RetentionPolicyPatch"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/RetentionPolicy"
+                        }
+                    }
+                }
+            }
+        },
         "/repos/{repo_id}/secrets": {
             "get": {
                 "produces": [
@@ -3929,7 +4914,70 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Secret"
+                            "$ref": "#/definitions/Secret"
+                        }
+                    }
+                }
+            }
+        },
+        "/repos/{repo_id}/stats": {
+            "get": {
+                "description": "Aggregates success rate, durations, queue wait times, step and branch statistics of the pipelines created in the given time window of at most 366 days.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Repositories"
+                ],
+                "summary": "Get pipeline statistics of a repository",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "only use pipelines created after this RFC3339 date (default 30 days ago)",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only use pipelines created before this RFC3339 date (default now)",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "24h",
+                        "description": "duration of a timeline bucket",
+                        "name": "interval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "max number of entries in the step, flaky step and branch lists",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Stats"
                         }
                     }
                 }
@@ -4629,9 +5677,16 @@ const docTemplate = `{
                 "backend": {
                     "type": "string"
                 },
+                "capabilities": {
+                    "$ref": "#/definitions/AgentCapabilities"
+                },
                 "capacity": {
                     "type": "integer"
                 },
+                "capacity_override": {
+                    "description": "CapacityOverride and LabelsOverride are pushed to the agent and take precedence over its own capacity and custom labels",
+                    "type": "integer"
+                },
                 "created": {
                     "type": "integer"
                 },
@@ -4641,9 +5696,33 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "drain_deadline": {
+                    "description": "DrainDeadline is the time a draining agent cancels its workflows at, 0 if it waits for them",
+                    "type": "integer"
+                },
+                "drain_eta": {
+                    "type": "integer"
+                },
+                "draining": {
+                    "description": "Draining agents finish their running workflows but get no new ones until they register again",
+                    "type": "boolean"
+                },
                 "id": {
                     "type": "integer"
                 },
+                "labels": {
+                    "description": "Labels the agent last polled for workflows with, including the ones enforced by the server",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "labels_override": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
                 "last_contact": {
                     "type": "integer"
                 },
@@ -4667,6 +5746,17 @@ const docTemplate = `{
                 "platform": {
                     "type": "string"
                 },
+                "running_tasks": {
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "Status, RunningTasks and DrainETA are computed from the queue and not stored",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/AgentStatus"
+                        }
+                    ]
+                },
                 "token": {
                     "type": "string"
                 },
@@ -4678,6 +5768,211 @@ const docTemplate = `{
                 }
             }
         },
+        "AgentCapabilities": {
+            "type": "object",
+            "properties": {
+                "architectures": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "cpus": {
+                    "type": "integer"
+                },
+                "disk_free": {
+                    "type": "integer"
+                },
+                "memory": {
+                    "type": "integer"
+                },
+                "runtimes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "AgentPool": {
+            "type": "object",
+            "properties": {
+                "agents": {
+                    "description": "Agents are the ids of the member agents",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "allowed_orgs": {
+                    "description": "AllowedOrgs and AllowedRepos may run workflows on the pool, everyone may if both are empty",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "allowed_repos": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "created": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "label_selector": {
+                    "description": "LabelSelector adds all agents polling with these labels to the pool",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "max_concurrent": {
+                    "description": "MaxConcurrent limits the workflows each org or repo runs on the pool at the same time, 0 means unlimited",
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "org_quotas": {
+                    "description": "OrgQuotas and RepoQuotas override MaxConcurrent for single consumers, e.g. to not limit the owner of the pool",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "repo_quotas": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "updated": {
+                    "type": "integer"
+                }
+            }
+        },
+        "AgentPoolPatch": {
+            "type": "object",
+            "properties": {
+                "agents": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "allowed_orgs": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "allowed_repos": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "label_selector": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "max_concurrent": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "org_quotas": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "repo_quotas": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "AgentStatus": {
+            "type": "string",
+            "enum": [
+                "idle",
+                "busy",
+                "draining",
+                "drained",
+                "offline"
+            ],
+            "x-enum-comments": {
+                "AgentStatusBusy": "running workflows",
+                "AgentStatusDrained": "draining without running workflows",
+                "AgentStatusDraining": "finishing its running workflows without taking new ones",
+                "AgentStatusIdle": "connected without running workflows",
+                "AgentStatusOffline": "no contact within AgentOfflineTimeout"
+            },
+            "x-enum-descriptions": [
+                "connected without running workflows",
+                "running workflows",
+                "finishing its running workflows without taking new ones",
+                "draining without running workflows",
+                "no contact within AgentOfflineTimeout"
+            ],
+            "x-enum-varnames": [
+                "AgentStatusIdle",
+                "AgentStatusBusy",
+                "AgentStatusDraining",
+                "AgentStatusDrained",
+                "AgentStatusOffline"
+            ]
+        },
+        "BranchStats": {
+            "type": "object",
+            "properties": {
+                "branch": {
+                    "type": "string"
+                },
+                "pipelines": {
+                    "type": "integer"
+                },
+                "success_rate": {
+                    "type": "number"
+                }
+            }
+        },
+        "CommitDirectives": {
+            "type": "object",
+            "properties": {
+                "full": {
+                    "description": "Full directives ignore the path filters of the workflows.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "only": {
+                    "description": "Only directives restrict the pipeline to the listed workflows, e.g. ` + "`" + `[ci only: lint,test]` + "`" + `.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "skip": {
+                    "description": "Skip directives skip the pipeline.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "Config": {
             "type": "object",
             "properties": {
@@ -4695,6 +5990,29 @@ const docTemplate = `{
                 }
             }
         },
+        "ConfigTemplate": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "data": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "org_id": {
+                    "type": "integer"
+                },
+                "updated": {
+                    "type": "integer"
+                }
+            }
+        },
         "Cron": {
             "type": "object",
             "properties": {
@@ -4855,34 +6173,97 @@ const docTemplate = `{
                 "line": {
                     "type": "integer"
                 },
-                "step_id": {
+                "step_id": {
+                    "type": "integer"
+                },
+                "time": {
+                    "type": "integer"
+                },
+                "type": {
+                    "$ref": "#/definitions/LogEntryType"
+                }
+            }
+        },
+        "LogEntryType": {
+            "type": "integer",
+            "enum": [
+                0,
+                1,
+                2,
+                3,
+                4
+            ],
+            "x-enum-varnames": [
+                "LogEntryStdout",
+                "LogEntryStderr",
+                "LogEntryExitCode",
+                "LogEntryMetadata",
+                "LogEntryProgress"
+            ]
+        },
+        "LogMatch": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "string"
+                },
+                "highlights": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/LogMatchRange"
+                    }
+                },
+                "line": {
+                    "type": "integer"
+                },
+                "pipeline_number": {
+                    "type": "integer"
+                },
+                "step_id": {
+                    "type": "integer"
+                },
+                "step_name": {
+                    "type": "string"
+                },
+                "time": {
+                    "type": "integer"
+                }
+            }
+        },
+        "LogMatchRange": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "type": "integer"
+                },
+                "start": {
                     "type": "integer"
+                }
+            }
+        },
+        "LogSearchResult": {
+            "type": "object",
+            "properties": {
+                "cursor": {
+                    "description": "Cursor is the position of the last match if truncated, to continue the search on the same page after it.",
+                    "type": "string"
                 },
-                "time": {
+                "matches": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/LogMatch"
+                    }
+                },
+                "next_page": {
+                    "description": "NextPage is the page containing older pipelines or 0 if there are none.",
                     "type": "integer"
                 },
-                "type": {
-                    "$ref": "#/definitions/LogEntryType"
+                "truncated": {
+                    "description": "Truncated is set if the match limit was reached before all pipelines of the page were searched.",
+                    "type": "boolean"
                 }
             }
         },
-        "LogEntryType": {
-            "type": "integer",
-            "enum": [
-                0,
-                1,
-                2,
-                3,
-                4
-            ],
-            "x-enum-varnames": [
-                "LogEntryStdout",
-                "LogEntryStderr",
-                "LogEntryExitCode",
-                "LogEntryMetadata",
-                "LogEntryProgress"
-            ]
-        },
         "Org": {
             "type": "object",
             "properties": {
@@ -4946,6 +6327,10 @@ const docTemplate = `{
                 "author_email": {
                     "type": "string"
                 },
+                "base_commit": {
+                    "description": "commit before a push or base of a pull request",
+                    "type": "string"
+                },
                 "branch": {
                     "type": "string"
                 },
@@ -4997,6 +6382,9 @@ const docTemplate = `{
                 "is_prerelease": {
                     "type": "boolean"
                 },
+                "logs_pruned": {
+                    "type": "boolean"
+                },
                 "message": {
                     "type": "string"
                 },
@@ -5085,6 +6473,31 @@ const docTemplate = `{
                 }
             }
         },
+        "QueueAgentMatch": {
+            "type": "object",
+            "properties": {
+                "agent_id": {
+                    "type": "integer"
+                },
+                "agent_name": {
+                    "type": "string"
+                },
+                "matched": {
+                    "description": "Matched is set if the labels and capabilities of the agent match the task",
+                    "type": "boolean"
+                },
+                "reasons": {
+                    "description": "Reasons explain why the agent can not take the task",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "$ref": "#/definitions/AgentStatus"
+                }
+            }
+        },
         "QueueInfo": {
             "type": "object",
             "properties": {
@@ -5128,6 +6541,52 @@ const docTemplate = `{
                 }
             }
         },
+        "QueueTaskDependency": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "state": {
+                    "description": "State is pending, waiting_on_deps, running or the status of the finished dependency",
+                    "type": "string"
+                }
+            }
+        },
+        "QueueTaskExplanation": {
+            "type": "object",
+            "properties": {
+                "agents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/QueueAgentMatch"
+                    }
+                },
+                "dependencies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/QueueTaskDependency"
+                    }
+                },
+                "reasons": {
+                    "description": "Reasons summarize why the task does not run yet",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "state": {
+                    "description": "State is pending, waiting_on_deps or running",
+                    "type": "string"
+                },
+                "task": {
+                    "$ref": "#/definitions/model.QueueTask"
+                }
+            }
+        },
         "Registry": {
             "type": "object",
             "properties": {
@@ -5160,6 +6619,10 @@ const docTemplate = `{
                 "active": {
                     "type": "boolean"
                 },
+                "agent_affinity_wait": {
+                    "description": "AgentAffinityWait is the time in seconds workflows wait for the agent that last ran them, 0 disables the affinity",
+                    "type": "integer"
+                },
                 "allow_deploy": {
                     "type": "boolean"
                 },
@@ -5187,6 +6650,9 @@ const docTemplate = `{
                 "clone_url_ssh": {
                     "type": "string"
                 },
+                "commit_directives": {
+                    "$ref": "#/definitions/CommitDirectives"
+                },
                 "config_extension_endpoint": {
                     "type": "string"
                 },
@@ -5233,6 +6699,12 @@ const docTemplate = `{
                 "private": {
                     "type": "boolean"
                 },
+                "queue_priorities": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
                 "require_approval": {
                     "$ref": "#/definitions/model.ApprovalMode"
                 },
@@ -5253,6 +6725,10 @@ const docTemplate = `{
                 "active": {
                     "type": "boolean"
                 },
+                "agent_affinity_wait": {
+                    "description": "AgentAffinityWait is the time in seconds workflows wait for the agent that last ran them, 0 disables the affinity",
+                    "type": "integer"
+                },
                 "allow_deploy": {
                     "type": "boolean"
                 },
@@ -5280,6 +6756,9 @@ const docTemplate = `{
                 "clone_url_ssh": {
                     "type": "string"
                 },
+                "commit_directives": {
+                    "$ref": "#/definitions/CommitDirectives"
+                },
                 "config_extension_endpoint": {
                     "type": "string"
                 },
@@ -5329,6 +6808,12 @@ const docTemplate = `{
                 "private": {
                     "type": "boolean"
                 },
+                "queue_priorities": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
                 "require_approval": {
                     "$ref": "#/definitions/model.ApprovalMode"
                 },
@@ -5346,6 +6831,9 @@ const docTemplate = `{
         "RepoPatch": {
             "type": "object",
             "properties": {
+                "agent_affinity_wait": {
+                    "type": "integer"
+                },
                 "allow_deploy": {
                     "type": "boolean"
                 },
@@ -5364,6 +6852,9 @@ const docTemplate = `{
                         "$ref": "#/definitions/WebhookEvent"
                     }
                 },
+                "commit_directives": {
+                    "$ref": "#/definitions/CommitDirectives"
+                },
                 "config_extension_endpoint": {
                     "type": "string"
                 },
@@ -5376,6 +6867,12 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "queue_priorities": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
                 "require_approval": {
                     "type": "string"
                 },
@@ -5403,6 +6900,52 @@ const docTemplate = `{
                 "VisibilityInternal"
             ]
         },
+        "RetentionPolicy": {
+            "type": "object",
+            "properties": {
+                "keep_days": {
+                    "type": "integer"
+                },
+                "keep_last": {
+                    "type": "integer"
+                },
+                "keep_latest_deployment": {
+                    "type": "boolean"
+                },
+                "keep_latest_per_branch": {
+                    "type": "boolean"
+                },
+                "log_days": {
+                    "type": "integer"
+                },
+                "org_id": {
+                    "type": "integer"
+                },
+                "repo_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "RetentionPolicyPatch": {
+            "type": "object",
+            "properties": {
+                "keep_days": {
+                    "type": "integer"
+                },
+                "keep_last": {
+                    "type": "integer"
+                },
+                "keep_latest_deployment": {
+                    "type": "boolean"
+                },
+                "keep_latest_per_branch": {
+                    "type": "boolean"
+                },
+                "log_days": {
+                    "type": "integer"
+                }
+            }
+        },
         "Secret": {
             "type": "object",
             "properties": {
@@ -5435,6 +6978,87 @@ const docTemplate = `{
                 }
             }
         },
+        "Stats": {
+            "type": "object",
+            "properties": {
+                "after": {
+                    "type": "integer"
+                },
+                "before": {
+                    "type": "integer"
+                },
+                "branches": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/BranchStats"
+                    }
+                },
+                "duration": {
+                    "$ref": "#/definitions/StatsDuration"
+                },
+                "failure": {
+                    "type": "integer"
+                },
+                "flaky_steps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/StepStats"
+                    }
+                },
+                "pipelines": {
+                    "type": "integer"
+                },
+                "queue_wait": {
+                    "$ref": "#/definitions/StatsDuration"
+                },
+                "steps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/StepStats"
+                    }
+                },
+                "success": {
+                    "type": "integer"
+                },
+                "success_rate": {
+                    "type": "number"
+                },
+                "timeline": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/StatsBucket"
+                    }
+                }
+            }
+        },
+        "StatsBucket": {
+            "type": "object",
+            "properties": {
+                "failure": {
+                    "type": "integer"
+                },
+                "pipelines": {
+                    "type": "integer"
+                },
+                "start": {
+                    "type": "integer"
+                },
+                "success": {
+                    "type": "integer"
+                }
+            }
+        },
+        "StatsDuration": {
+            "type": "object",
+            "properties": {
+                "p50": {
+                    "type": "integer"
+                },
+                "p95": {
+                    "type": "integer"
+                }
+            }
+        },
         "StatusValue": {
             "type": "string",
             "enum": [
@@ -5527,6 +7151,30 @@ const docTemplate = `{
                 }
             }
         },
+        "StepStats": {
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "$ref": "#/definitions/StatsDuration"
+                },
+                "failures": {
+                    "type": "integer"
+                },
+                "flaky": {
+                    "description": "Flaky is the number of commits the step both failed and succeeded on.",
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "runs": {
+                    "type": "integer"
+                },
+                "success_rate": {
+                    "type": "number"
+                }
+            }
+        },
         "StepType": {
             "type": "string",
             "enum": [
@@ -5547,6 +7195,10 @@ const docTemplate = `{
         "Task": {
             "type": "object",
             "properties": {
+                "affinity_wait": {
+                    "description": "AffinityWait is the time in seconds the task waits for the agent that last ran its workflow",
+                    "type": "integer"
+                },
                 "agent_id": {
                     "type": "integer"
                 },
@@ -5574,15 +7226,30 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "org_id": {
+                    "type": "integer"
+                },
                 "pid": {
                     "type": "integer"
                 },
                 "pipeline_id": {
                     "type": "integer"
                 },
+                "priority": {
+                    "type": "integer"
+                },
+                "queued": {
+                    "type": "integer"
+                },
                 "repo_id": {
                     "type": "integer"
                 },
+                "requires": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
                 "run_on": {
                     "type": "array",
                     "items": {
@@ -5637,7 +7304,8 @@ const docTemplate = `{
                 "release",
                 "deployment",
                 "cron",
-                "manual"
+                "manual",
+                "merge_group"
             ],
             "x-enum-varnames": [
                 "EventPush",
@@ -5648,7 +7316,8 @@ const docTemplate = `{
                 "EventRelease",
                 "EventDeploy",
                 "EventCron",
-                "EventManual"
+                "EventManual",
+                "EventMergeGroup"
             ]
         },
         "metadata.Author": {
@@ -5680,6 +7349,20 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "compare_changed_files": {
+                    "description": "CompareChangedFiles are the files changed compared to the branches used by path constraints with compare_to.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "ignore_path_filters": {
+                    "description": "IgnorePathFilters disables the path constraints, e.g. if forced by a commit message directive.",
+                    "type": "boolean"
+                },
                 "is_prerelease": {
                     "type": "boolean"
                 },
@@ -5932,6 +7615,7 @@ const docTemplate = `{
                 "forgejo",
                 "bitbucket",
                 "bitbucket-dc",
+                "git",
                 "addon"
             ],
             "x-enum-varnames": [
@@ -5941,12 +7625,17 @@ const docTemplate = `{
                 "ForgeTypeForgejo",
                 "ForgeTypeBitbucket",
                 "ForgeTypeBitbucketDatacenter",
+                "ForgeTypeGit",
                 "ForgeTypeAddon"
             ]
         },
         "model.QueueTask": {
             "type": "object",
             "properties": {
+                "affinity_wait": {
+                    "description": "AffinityWait is the time in seconds the task waits for the agent that last ran its workflow",
+                    "type": "integer"
+                },
                 "agent_id": {
                     "type": "integer"
                 },
@@ -5977,6 +7666,9 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "org_id": {
+                    "type": "integer"
+                },
                 "pid": {
                     "type": "integer"
                 },
@@ -5986,9 +7678,29 @@ const docTemplate = `{
                 "pipeline_number": {
                     "type": "integer"
                 },
+                "position": {
+                    "description": "Position is the effective position of a pending task in the queue, 0 if it is blocked.",
+                    "type": "integer"
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "queued": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "description": "Reason explains the position of a pending task.",
+                    "type": "string"
+                },
                 "repo_id": {
                     "type": "integer"
                 },
+                "requires": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
                 "run_on": {
                     "type": "array",
                     "items": {
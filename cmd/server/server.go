@@ -35,6 +35,8 @@ import (
 
 	"go.woodpecker-ci.org/woodpecker/v3/server"
 	"go.woodpecker-ci.org/woodpecker/v3/server/cron"
+	"go.woodpecker-ci.org/woodpecker/v3/server/poll"
+	"go.woodpecker-ci.org/woodpecker/v3/server/retention"
 	"go.woodpecker-ci.org/woodpecker/v3/server/router"
 	"go.woodpecker-ci.org/woodpecker/v3/server/router/middleware"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store"
@@ -117,6 +119,11 @@ func run(ctx context.Context, c *cli.Command) error {
 		return fmt.Errorf("can't setup globals: %w", err)
 	}
 
+	_autoscaler, err := setupAutoscaler(c, _store, server.Config.Services.Queue)
+	if err != nil {
+		return fmt.Errorf("can't setup autoscaler: %w", err)
+	}
+
 	// wait for all services until one do stops with an error
 	serviceWaitingGroup := errgroup.Group{}
 
@@ -134,6 +141,42 @@ func run(ctx context.Context, c *cli.Command) error {
 		return nil
 	})
 
+	if server.Config.Poll.Interval > 0 {
+		serviceWaitingGroup.Go(func() error {
+			log.Info().Msg("starting poll service ...")
+			if err := poll.Run(ctx, _store, server.Config.Poll.Interval); err != nil {
+				go stopServerFunc(err)
+				return err
+			}
+			log.Info().Msg("poll service stopped")
+			return nil
+		})
+	}
+
+	if server.Config.Retention.Interval > 0 {
+		serviceWaitingGroup.Go(func() error {
+			log.Info().Msg("starting retention service ...")
+			if err := retention.Run(ctx, _store, server.Config.Services.LogStore, server.Config.Retention.Interval, server.Config.Retention.DefaultPolicy); err != nil {
+				go stopServerFunc(err)
+				return err
+			}
+			log.Info().Msg("retention service stopped")
+			return nil
+		})
+	}
+
+	if _autoscaler != nil {
+		serviceWaitingGroup.Go(func() error {
+			log.Info().Msg("starting autoscaler service ...")
+			if err := _autoscaler.Run(ctx); err != nil {
+				go stopServerFunc(err)
+				return err
+			}
+			log.Info().Msg("autoscaler service stopped")
+			return nil
+		})
+	}
+
 	// start the grpc server
 	serviceWaitingGroup.Go(func() error {
 		log.Info().Msg("starting grpc server ...")
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/urfave/cli/v3"
 
 	"go.woodpecker-ci.org/woodpecker/v3/server"
+	"go.woodpecker-ci.org/woodpecker/v3/server/autoscaler"
 	"go.woodpecker-ci.org/woodpecker/v3/server/cache"
 	"go.woodpecker-ci.org/woodpecker/v3/server/forge/setup"
 	"go.woodpecker-ci.org/woodpecker/v3/server/logging"
@@ -40,6 +42,7 @@ import (
 	logService "go.woodpecker-ci.org/woodpecker/v3/server/services/log"
 	"go.woodpecker-ci.org/woodpecker/v3/server/services/log/addon"
 	"go.woodpecker-ci.org/woodpecker/v3/server/services/log/file"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/log/s3"
 	"go.woodpecker-ci.org/woodpecker/v3/server/services/permissions"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store/datastore"
@@ -111,23 +114,91 @@ func checkSqliteFileExist(path string) error {
 	return err
 }
 
-func setupQueue(ctx context.Context, s store.Store) (queue.Queue, error) {
+func setupQueue(ctx context.Context, c *cli.Command, s store.Store) (queue.Queue, error) {
+	orgWeights := make(map[int64]int)
+	for _, orgWeight := range c.StringSlice("queue-org-weights") {
+		orgID, weight, ok := strings.Cut(orgWeight, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid org weight '%s', expected <org-id>:<weight>", orgWeight)
+		}
+		id, err := strconv.ParseInt(orgID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid org id of org weight '%s': %w", orgWeight, err)
+		}
+		orgWeights[id], err = strconv.Atoi(weight)
+		if err != nil || orgWeights[id] < 1 {
+			return nil, fmt.Errorf("invalid weight of org weight '%s', expected a positive number", orgWeight)
+		}
+	}
+
 	return queue.New(ctx, queue.Config{
 		Backend: queue.TypeMemory,
 		Store:   s,
+		Scheduling: queue.SchedulingConfig{
+			MaxRunningPerOrg:  c.Int("queue-max-running-per-org"),
+			MaxRunningPerRepo: c.Int("queue-max-running-per-repo"),
+			OrgWeights:        orgWeights,
+		},
+		ResumeGracePeriod: c.Duration("queue-resume-grace-period"),
 	})
 }
 
+func setupAutoscaler(c *cli.Command, s store.Store, q queue.Queue) (*autoscaler.Autoscaler, error) {
+	var provider autoscaler.Provider
+	switch c.String("autoscaler-provider") {
+	case "":
+		return nil, nil
+	case "command":
+		if c.String("autoscaler-command") == "" {
+			return nil, errors.New("WOODPECKER_AUTOSCALER_COMMAND must be set for the command provider")
+		}
+		provider = autoscaler.NewCommand(c.String("autoscaler-command"))
+	case "http":
+		if c.String("autoscaler-endpoint") == "" {
+			return nil, errors.New("WOODPECKER_AUTOSCALER_ENDPOINT must be set for the http provider")
+		}
+		provider = autoscaler.NewHTTP(c.String("autoscaler-endpoint"), c.String("autoscaler-token"))
+	default:
+		return nil, fmt.Errorf("unknown autoscaler provider: %s", c.String("autoscaler-provider"))
+	}
+
+	if c.Duration("autoscaler-interval") <= 0 {
+		return nil, errors.New("WOODPECKER_AUTOSCALER_INTERVAL must be positive")
+	}
+	if c.Int("autoscaler-max-agents") < c.Int("autoscaler-min-agents") {
+		return nil, errors.New("WOODPECKER_AUTOSCALER_MAX_AGENTS must not be lower than WOODPECKER_AUTOSCALER_MIN_AGENTS")
+	}
+
+	return autoscaler.New(s, q, provider, autoscaler.Config{
+		Interval:          c.Duration("autoscaler-interval"),
+		MinAgents:         c.Int("autoscaler-min-agents"),
+		MaxAgents:         c.Int("autoscaler-max-agents"),
+		WorkflowsPerAgent: c.Int("autoscaler-workflows-per-agent"),
+		IdleTimeout:       c.Duration("autoscaler-idle-timeout"),
+	}), nil
+}
+
 func setupMembershipService(_ context.Context, _store store.Store) cache.MembershipService {
 	return cache.NewMembershipService(_store)
 }
 
-func setupLogStore(c *cli.Command, s store.Store) (logService.Service, error) {
+func setupLogStore(ctx context.Context, c *cli.Command, s store.Store) (logService.Service, error) {
 	switch c.String("log-store") {
 	case "file":
 		return file.NewLogStore(c.String("log-store-file-path"))
 	case "addon":
 		return addon.Load(c.String("log-store-file-path"))
+	case "s3":
+		return s3.NewLogStore(ctx, s3.Config{
+			Endpoint:        c.String("log-store-s3-endpoint"),
+			Bucket:          c.String("log-store-s3-bucket"),
+			Prefix:          c.String("log-store-s3-prefix"),
+			Region:          c.String("log-store-s3-region"),
+			AccessKeyID:     c.String("log-store-s3-access-key-id"),
+			SecretAccessKey: c.String("log-store-s3-secret-access-key"),
+			Insecure:        c.Bool("log-store-s3-insecure"),
+			PathStyle:       c.Bool("log-store-s3-path-style"),
+		})
 	default:
 		return s, nil
 	}
@@ -161,7 +232,7 @@ func setupEvilGlobals(ctx context.Context, c *cli.Command, s store.Store) (err e
 	server.Config.Services.Logs = logging.New()
 	server.Config.Services.Pubsub = pubsub.New()
 	server.Config.Services.Membership = setupMembershipService(ctx, s)
-	server.Config.Services.Queue, err = setupQueue(ctx, s)
+	server.Config.Services.Queue, err = setupQueue(ctx, c, s)
 	if err != nil {
 		return fmt.Errorf("could not setup queue: %w", err)
 	}
@@ -169,7 +240,7 @@ func setupEvilGlobals(ctx context.Context, c *cli.Command, s store.Store) (err e
 	if err != nil {
 		return fmt.Errorf("could not setup service manager: %w", err)
 	}
-	server.Config.Services.LogStore, err = setupLogStore(c, s)
+	server.Config.Services.LogStore, err = setupLogStore(ctx, c, s)
 	if err != nil {
 		return fmt.Errorf("could not setup log store: %w", err)
 	}
@@ -208,6 +279,23 @@ func setupEvilGlobals(ctx context.Context, c *cli.Command, s store.Store) (err e
 	server.Config.Pipeline.DefaultCancelPreviousPipelineEvents = events
 	server.Config.Pipeline.DefaultTimeout = c.Int64("default-pipeline-timeout")
 	server.Config.Pipeline.MaxTimeout = c.Int64("max-pipeline-timeout")
+	server.Config.Pipeline.MaxMatrixCombinations = c.Int("max-matrix-combinations")
+
+	// Poll
+	server.Config.Poll.Interval = c.Duration("forge-poll-interval")
+
+	// Retention
+	server.Config.Retention.Interval = c.Duration("retention-interval")
+	server.Config.Retention.DefaultPolicy = &model.RetentionPolicy{
+		KeepLast:             c.Int64("retention-keep-last"),
+		KeepDays:             c.Int64("retention-keep-days"),
+		KeepLatestPerBranch:  c.Bool("retention-keep-latest-per-branch"),
+		KeepLatestDeployment: c.Bool("retention-keep-latest-deployment"),
+		LogDays:              c.Int64("retention-log-days"),
+	}
+	if err := server.Config.Retention.DefaultPolicy.Validate(); err != nil {
+		return err
+	}
 
 	_labels := c.StringSlice("default-workflow-labels")
 	labels := make(map[string]string, len(_labels))
@@ -79,4 +79,9 @@ func (e *docker) windowsPathPatch(step *types.Step) {
 			step.Environment["CI_WORKSPACE"] = filepath.Join(defaultWindowsDriverLetter, ciWorkspace)
 		}
 	}
+	if annotationsFile, ok := step.Environment[types.EnvStepAnnotationsFile]; ok {
+		if !MustNotAddWindowsLetterPattern.MatchString(annotationsFile) {
+			step.Environment[types.EnvStepAnnotationsFile] = filepath.Join(defaultWindowsDriverLetter, annotationsFile)
+		}
+	}
 }
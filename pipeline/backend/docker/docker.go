@@ -15,11 +15,15 @@
 package docker
 
 import (
+	"archive/tar"
 	"context"
+	"fmt"
 	"io"
+	"maps"
 	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/containerd/errdefs"
@@ -142,9 +146,26 @@ func (e *docker) Load(ctx context.Context) (*backend.BackendInfo, error) {
 
 	return &backend.BackendInfo{
 		Platform: e.info.OSType + "/" + normalizeArchType(e.info.Architecture),
+		CPUs:     e.info.NCPU,
+		Memory:   e.info.MemTotal,
+		Runtimes: slices.Sorted(maps.Keys(e.info.Runtimes)),
+		DataDir:  localDataDir(cl.DaemonHost(), e.info.DockerRootDir),
 	}, nil
 }
 
+// localDataDir returns the data root of the daemon if the agent can access it, empty otherwise.
+// The root is a path on the machine of the daemon, which is neither the machine of the agent
+// for remote daemons nor visible to agents running in a container without mounting it.
+func localDataDir(daemonHost, rootDir string) string {
+	if rootDir == "" || !(strings.HasPrefix(daemonHost, "unix://") || strings.HasPrefix(daemonHost, "npipe://")) {
+		return ""
+	}
+	if info, err := os.Stat(rootDir); err != nil || !info.IsDir() {
+		return ""
+	}
+	return rootDir
+}
+
 func (e *docker) SetupWorkflow(ctx context.Context, conf *backend.Config, taskUUID string) error {
 	log.Trace().Str("taskUUID", taskUUID).Msg("create workflow environment")
 
@@ -300,6 +321,38 @@ func (e *docker) TailStep(ctx context.Context, step *backend.Step, taskUUID stri
 	return rc, nil
 }
 
+// ReadStepAnnotations copies the annotations file out of the exited container of the step.
+func (e *docker) ReadStepAnnotations(ctx context.Context, step *backend.Step, taskUUID string) ([]byte, error) {
+	file := step.Environment[backend.EnvStepAnnotationsFile]
+	if file == "" {
+		return nil, nil
+	}
+	log.Trace().Str("taskUUID", taskUUID).Msgf("read annotations of step %s", step.Name)
+
+	rc, _, err := e.client.CopyFromContainer(ctx, toContainerName(step), file)
+	if errdefs.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	// the content is sent as tar archive
+	tr := tar.NewReader(rc)
+	if _, err := tr.Next(); err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(io.LimitReader(tr, backend.MaxAnnotationsFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > backend.MaxAnnotationsFileSize {
+		return nil, fmt.Errorf("annotations file is larger than %d bytes", backend.MaxAnnotationsFileSize)
+	}
+	return data, nil
+}
+
 func (e *docker) DestroyStep(ctx context.Context, step *backend.Step, taskUUID string) error {
 	log.Trace().Str("taskUUID", taskUUID).Msgf("stop step %s", step.Name)
 
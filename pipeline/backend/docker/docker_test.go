@@ -0,0 +1,32 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalDataDir(t *testing.T) {
+	dir := t.TempDir()
+
+	assert.Equal(t, dir, localDataDir("unix:///var/run/docker.sock", dir))
+	// remote daemons report a directory of another machine
+	assert.Empty(t, localDataDir("tcp://docker:2376", dir))
+	// agents running in a container without the data root mounted
+	assert.Empty(t, localDataDir("unix:///var/run/docker.sock", dir+"/missing"))
+	assert.Empty(t, localDataDir("unix:///var/run/docker.sock", ""))
+}
@@ -27,6 +27,7 @@ var notAllowedEnvVarOverwrites = []string{
 	"HOME",
 	"SHELL",
 	"CI_WORKSPACE",
+	"CI_STEP_ANNOTATIONS_FILE",
 }
 
 const netrcFile = `
@@ -40,6 +40,12 @@ type workflowState struct {
 	pluginGitBinary string
 }
 
+// annotationsFile returns the path the step writes its annotations to, outside the workspace
+// to not end up in the repository.
+func (s *workflowState) annotationsFile(step *types.Step) string {
+	return filepath.Join(s.baseDir, "annotations-"+step.UUID+".json")
+}
+
 type stepState struct {
 	cmd    *exec.Cmd
 	output io.ReadCloser
@@ -88,8 +94,15 @@ func (e *local) Load(ctx context.Context) (*types.BackendInfo, error) {
 
 	e.loadClone()
 
+	dataDir := e.tempDir
+	if dataDir == "" {
+		dataDir = os.TempDir()
+	}
+
 	return &types.BackendInfo{
 		Platform: e.os + "/" + e.arch,
+		CPUs:     runtime.NumCPU(),
+		DataDir:  dataDir,
 	}, nil
 }
 
@@ -158,6 +171,7 @@ func (e *local) StartStep(ctx context.Context, step *types.Step, taskUUID string
 	env = append(env, "HOME="+state.homeDir)
 	env = append(env, "USERPROFILE="+state.homeDir)
 	env = append(env, "CI_WORKSPACE="+state.workspaceDir)
+	env = append(env, types.EnvStepAnnotationsFile+"="+state.annotationsFile(step))
 
 	switch step.Type {
 	case types.StepTypeClone:
@@ -209,6 +223,32 @@ func (e *local) TailStep(_ context.Context, step *types.Step, taskUUID string) (
 	return state.output, nil
 }
 
+// ReadStepAnnotations reads the annotations file of the step from the workflow directory.
+func (e *local) ReadStepAnnotations(_ context.Context, step *types.Step, taskUUID string) ([]byte, error) {
+	state, err := e.getWorkflowState(taskUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(state.annotationsFile(step))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, types.MaxAnnotationsFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > types.MaxAnnotationsFileSize {
+		return nil, fmt.Errorf("annotations file is larger than %d bytes", types.MaxAnnotationsFileSize)
+	}
+	return data, nil
+}
+
 func (e *local) DestroyStep(_ context.Context, step *types.Step, taskUUID string) error {
 	state, err := e.getStepState(taskUUID, step.UUID)
 	if err != nil {
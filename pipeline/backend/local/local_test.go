@@ -266,6 +266,7 @@ func TestRunStep(t *testing.T) {
 				"TEST_VAR=test_value",
 				"HOME=" + state.baseDir + "/home",
 				"CI_WORKSPACE=" + state.baseDir + "/workspace",
+				"CI_STEP_ANNOTATIONS_FILE=" + state.baseDir + "/annotations-step-1.json",
 				"PATH=" + strings.Join(path, ":"),
 			}, gotEnvs)
 
@@ -488,3 +489,25 @@ loop:
 		assert.ErrorIs(t, err, ErrWorkflowStateNotFound)
 	}
 }
+
+func TestReadStepAnnotations(t *testing.T) {
+	backend, _ := New().(*local)
+	backend.tempDir = t.TempDir()
+	ctx := t.Context()
+	taskUUID := "test-annotations"
+	step := &types.Step{UUID: "step-1"}
+
+	require.NoError(t, backend.SetupWorkflow(ctx, &types.Config{}, taskUUID))
+	state, err := backend.getWorkflowState(taskUUID)
+	require.NoError(t, err)
+
+	// steps do not have to write annotations
+	data, err := backend.ReadStepAnnotations(ctx, step, taskUUID)
+	assert.NoError(t, err)
+	assert.Nil(t, data)
+
+	require.NoError(t, os.WriteFile(state.annotationsFile(step), []byte(`[{"path": "main.go"}]`), 0o600))
+	data, err = backend.ReadStepAnnotations(ctx, step, taskUUID)
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"path": "main.go"}]`, string(data))
+}
@@ -0,0 +1,31 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "context"
+
+const (
+	// EnvStepAnnotationsFile is the env var with the path a step can write annotations of its results to.
+	EnvStepAnnotationsFile = "CI_STEP_ANNOTATIONS_FILE"
+	// MaxAnnotationsFileSize is the max size of an annotations file read from a step.
+	MaxAnnotationsFileSize = 1 * 1024 * 1024 // 1mb
+)
+
+// StepAnnotationsReader is implemented by backends which can read the annotations file written by a step.
+type StepAnnotationsReader interface {
+	// ReadStepAnnotations returns the content of the annotations file of the exited step or
+	// nil if the step did not write one. It is called before DestroyStep.
+	ReadStepAnnotations(ctx context.Context, step *Step, taskUUID string) ([]byte, error)
+}
@@ -165,4 +165,10 @@ type Backend interface {
 // BackendInfo represents the reported information of a loaded backend.
 type BackendInfo struct {
 	Platform string
+	// CPUs, Memory and Runtimes of the machine running the workflows, zero if unknown
+	CPUs     int
+	Memory   int64
+	Runtimes []string
+	// DataDir is the directory workflows store their data in, empty if the agent can not access it
+	DataDir string
 }
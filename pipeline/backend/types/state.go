@@ -24,4 +24,6 @@ type State struct {
 	OOMKilled bool `json:"oom_killed"`
 	// Container error
 	Error error
+	// Content of the annotations file written by the step
+	Annotations []byte `json:"-"`
 }
@@ -34,4 +34,5 @@ const (
 	LabelFilterPlatform string = "platform"
 	LabelFilterHostname string = "hostname"
 	LabelFilterBackend  string = "backend"
+	LabelFilterGPU      string = "gpu"
 )
@@ -9,20 +9,26 @@ import (
 )
 
 type LinterErrorData struct {
-	File  string `json:"file"`
-	Field string `json:"field"`
+	File   string `json:"file"`
+	Field  string `json:"field"`
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
 }
 
 type DeprecationErrorData struct {
-	File  string `json:"file"`
-	Field string `json:"field"`
-	Docs  string `json:"docs"`
+	File   string `json:"file"`
+	Field  string `json:"field"`
+	Docs   string `json:"docs"`
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
 }
 
 type BadHabitErrorData struct {
-	File  string `json:"file"`
-	Field string `json:"field"`
-	Docs  string `json:"docs"`
+	File   string `json:"file"`
+	Field  string `json:"field"`
+	Docs   string `json:"docs"`
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
 }
 
 func GetLinterData(e *types.PipelineError) *LinterErrorData {
@@ -25,6 +25,7 @@ const (
 	EventDeploy       = "deployment"
 	EventCron         = "cron"
 	EventManual       = "manual"
+	EventMergeGroup   = "merge_group"
 )
 
 func EventIsPull(event string) bool {
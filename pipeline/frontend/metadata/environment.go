@@ -103,6 +103,9 @@ func (m *Metadata) Environ() map[string]string {
 	if pipeline.Event == EventRelease {
 		setNonEmptyEnvVar(params, "CI_COMMIT_PRERELEASE", strconv.FormatBool(pipeline.Commit.IsPrerelease))
 	}
+	if pipeline.Event == EventMergeGroup {
+		setNonEmptyEnvVar(params, "CI_COMMIT_TARGET_BRANCH", commit.Branch)
+	}
 	if EventIsPull(pipeline.Event) {
 		sourceBranch, targetBranch := getSourceTargetBranches(commit.Refspec)
 		setNonEmptyEnvVar(params, "CI_COMMIT_SOURCE_BRANCH", sourceBranch)
@@ -72,6 +72,10 @@ type (
 		PullRequestLabels    []string `json:"labels,omitempty"`
 		PullRequestMilestone string   `json:"milestone,omitempty"`
 		IsPrerelease         bool     `json:"is_prerelease,omitempty"`
+		// CompareChangedFiles are the files changed compared to the branches used by path constraints with compare_to.
+		CompareChangedFiles map[string][]string `json:"compare_changed_files,omitempty"`
+		// IgnorePathFilters disables the path constraints, e.g. if forced by a commit message directive.
+		IgnorePathFilters bool `json:"ignore_path_filters,omitempty"`
 	}
 
 	// Author defines runtime metadata for a commit author.
@@ -91,6 +91,8 @@ func (c *Compiler) createProcess(container *yaml_types.Container, workflow *yaml
 	maps.Copy(environment, c.env)
 
 	environment["CI_WORKSPACE"] = path.Join(workspaceBase, c.workspacePath)
+	// outside the workspace to not end up in the repository
+	environment[backend_types.EnvStepAnnotationsFile] = path.Join(workspaceBase, "annotations-"+uuid.String()+".json")
 
 	if stepType == backend_types.StepTypeService || container.Detached {
 		detached = true
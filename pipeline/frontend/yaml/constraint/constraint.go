@@ -165,9 +165,13 @@ func (c *Constraint) Match(m metadata.Metadata, global bool, env map[string]stri
 		c.Ref.Match(m.Curr.Commit.Ref) &&
 		c.Instance.Match(m.Sys.Host)
 
-	// changed files filter apply only for pull-request and push events
-	if metadata.EventIsPull(m.Curr.Event) || m.Curr.Event == metadata.EventPush {
-		match = match && c.Path.Match(m.Curr.Commit.ChangedFiles, m.Curr.Commit.Message)
+	// changed files filter apply only for pull-request, push and merge group events
+	if (metadata.EventIsPull(m.Curr.Event) || m.Curr.Event == metadata.EventPush || m.Curr.Event == metadata.EventMergeGroup) && !m.Curr.Commit.IgnorePathFilters {
+		changedFiles := m.Curr.Commit.ChangedFiles
+		if files, ok := m.Curr.Commit.CompareChangedFiles[c.Path.CompareTo]; ok && c.Path.CompareTo != "" {
+			changedFiles = files
+		}
+		match = match && c.Path.Match(changedFiles, m.Curr.Commit.Message)
 	}
 
 	if m.Curr.Event != metadata.EventTag {
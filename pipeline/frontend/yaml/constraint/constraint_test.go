@@ -119,6 +119,42 @@ func TestConstraints(t *testing.T) {
 			with: metadata.Metadata{Curr: metadata.Pipeline{Event: metadata.EventPush}, Sys: metadata.System{Host: "beta.agent.tld"}},
 			want: false,
 		},
+		{
+			desc: "path constraint",
+			conf: "{ path: 'api/**' }",
+			with: metadata.Metadata{Curr: metadata.Pipeline{Event: metadata.EventPush, Commit: metadata.Commit{ChangedFiles: []string{"web/index.html"}}}},
+			want: false,
+		},
+		{
+			desc: "path constraint ignored",
+			conf: "{ path: 'api/**' }",
+			with: metadata.Metadata{Curr: metadata.Pipeline{Event: metadata.EventPush, Commit: metadata.Commit{ChangedFiles: []string{"web/index.html"}, IgnorePathFilters: true}}},
+			want: true,
+		},
+		{
+			desc: "path constraint on merge group",
+			conf: "{ event: merge_group, branch: main, path: 'api/**' }",
+			with: metadata.Metadata{Curr: metadata.Pipeline{Event: metadata.EventMergeGroup, Commit: metadata.Commit{Branch: "main", ChangedFiles: []string{"web/index.html"}}}},
+			want: false,
+		},
+		{
+			desc: "path constraint compared to branch",
+			conf: "{ path: { include: 'api/**', compare_to: main } }",
+			with: metadata.Metadata{Curr: metadata.Pipeline{Event: metadata.EventPush, Commit: metadata.Commit{
+				ChangedFiles:        []string{"web/index.html"},
+				CompareChangedFiles: map[string][]string{"main": {"api/main.go", "web/index.html"}},
+			}}},
+			want: true,
+		},
+		{
+			desc: "path constraint compared to unknown branch falls back to changed files",
+			conf: "{ path: { include: 'api/**', compare_to: develop } }",
+			with: metadata.Metadata{Curr: metadata.Pipeline{Event: metadata.EventPush, Commit: metadata.Commit{
+				ChangedFiles:        []string{"web/index.html"},
+				CompareChangedFiles: map[string][]string{"main": {"api/main.go"}},
+			}}},
+			want: false,
+		},
 		{
 			desc: "filter cron by matching name",
 			conf: "{ event: cron, cron: job1 }",
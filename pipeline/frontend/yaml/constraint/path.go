@@ -31,6 +31,7 @@ type Path struct {
 	Exclude       []string              `yaml:"exclude,omitempty"`
 	IgnoreMessage string                `yaml:"ignore_message,omitempty"`
 	OnEmpty       optional.Option[bool] `yaml:"on_empty,omitempty"`
+	CompareTo     string                `yaml:"compare_to,omitempty"`
 }
 
 // UnmarshalYAML unmarshal the constraint.
@@ -40,6 +41,7 @@ func (c *Path) UnmarshalYAML(value *yaml.Node) error {
 		Exclude       yamlBaseTypes.StringOrSlice `yaml:"exclude"`
 		IgnoreMessage string                      `yaml:"ignore_message"`
 		OnEmpty       optional.Option[bool]       `yaml:"on_empty"`
+		CompareTo     string                      `yaml:"compare_to"`
 	}{}
 
 	var out2 yamlBaseTypes.StringOrSlice
@@ -50,6 +52,7 @@ func (c *Path) UnmarshalYAML(value *yaml.Node) error {
 	c.Exclude = out1.Exclude
 	c.IgnoreMessage = out1.IgnoreMessage
 	c.OnEmpty = out1.OnEmpty
+	c.CompareTo = out1.CompareTo
 	c.Include = append( //nolint:gocritic
 		out1.Include,
 		out2...,
@@ -68,6 +71,7 @@ func (c Path) MarshalYAML() (any, error) {
 	// if only Include is set return simple syntax
 	if len(c.Exclude) == 0 &&
 		len(c.IgnoreMessage) == 0 &&
+		len(c.CompareTo) == 0 &&
 		c.OnEmpty.ValueOrDefault(true) {
 		if len(c.Include) == 0 {
 			return nil, nil
@@ -86,11 +90,13 @@ func (c Path) MarshalYAML() (any, error) {
 		Exclude       yamlBaseTypes.StringOrSlice `yaml:"exclude,omitempty"`
 		IgnoreMessage string                      `yaml:"ignore_message,omitempty"`
 		OnEmpty       optional.Option[bool]       `yaml:"on_empty,omitempty"`
+		CompareTo     string                      `yaml:"compare_to,omitempty"`
 	}{
 		Include:       c.Include,
 		Exclude:       c.Exclude,
 		IgnoreMessage: c.IgnoreMessage,
 		OnEmpty:       c.OnEmpty,
+		CompareTo:     c.CompareTo,
 	}, nil
 }
 
@@ -0,0 +1,414 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package include resolves the include and extends keys of a workflow by merging
+// the referenced workflow fragments into the workflow.
+package include
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	keyInclude    = "include"
+	keyExtends    = "extends"
+	keyParameters = "parameters"
+
+	// maxDepth is the max nesting level of fragments including other fragments.
+	maxDepth = 10
+)
+
+var paramPattern = regexp.MustCompile(`\$\{\{\s*params\.([a-zA-Z_][a-zA-Z0-9_]*)\s*\}\}`)
+
+// Source references a workflow fragment.
+// A fragment is either a file of a repository or a template of the organization.
+type Source struct {
+	// Repo is the full name of the repository, empty for the repository of the including file.
+	Repo string `yaml:"repo,omitempty"`
+	// Ref is the branch, tag or commit to read the file from. It is required for other repositories.
+	Ref string `yaml:"ref,omitempty"`
+	// Path of the file in the repository.
+	Path string `yaml:"path,omitempty"`
+	// Template is the name of a template of the organization.
+	Template string `yaml:"template,omitempty"`
+	// With are the values of the parameters of the fragment.
+	With map[string]any `yaml:"with,omitempty"`
+}
+
+func (s *Source) String() string {
+	switch {
+	case s.Template != "":
+		return "template " + s.Template
+	case s.Repo != "":
+		return fmt.Sprintf("%s@%s:%s", s.Repo, s.Ref, s.Path)
+	}
+	return s.Path
+}
+
+func (s *Source) validate() error {
+	switch {
+	case s.Template != "" && (s.Repo != "" || s.Ref != "" || s.Path != ""):
+		return errors.New("template can not be combined with repo, ref or path")
+	case s.Template != "":
+		return nil
+	case s.Path == "":
+		return errors.New("path or template is required")
+	case s.Repo != "" && s.Ref == "":
+		return fmt.Errorf("ref is required to include files of %s", s.Repo)
+	}
+	return nil
+}
+
+// Loader returns the content of the referenced fragment.
+type Loader func(ctx context.Context, source *Source) ([]byte, error)
+
+// Has returns true if the workflow uses include or extends.
+func Has(data []byte) bool {
+	doc, err := parse(data)
+	if err != nil || doc == nil {
+		return false
+	}
+	_, inc := lookup(doc, keyInclude)
+	_, ext := lookup(doc, keyExtends)
+	return inc != nil || ext != nil
+}
+
+// Resolve merges the fragments referenced by the include and extends keys into the workflow.
+// The workflow is merged on top of the fragment it extends and the fragments it includes, in this order.
+// Mappings are merged recursively, the top level steps and services lists are concatenated and all
+// other values are replaced. Workflows without include and extends are returned unchanged.
+func Resolve(ctx context.Context, data []byte, load Loader) ([]byte, error) {
+	if !Has(data) {
+		return data, nil
+	}
+
+	doc, err := parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &resolver{load: load}
+	result, err := r.resolve(ctx, expand(doc), nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2) //nolint:mnd
+	if err := enc.Encode(result); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type resolver struct {
+	load  Loader
+	stack []string
+}
+
+// resolve merges the fragments referenced by the mapping into it.
+// The parent is the source of the mapping or nil for the workflow itself.
+func (r *resolver) resolve(ctx context.Context, node *yaml.Node, parent *Source, depth int) (*yaml.Node, error) {
+	if node.Kind != yaml.MappingNode {
+		return nil, errors.New("workflow must be a mapping")
+	}
+
+	var sources []*Source
+	if extends := remove(node, keyExtends); extends != nil {
+		source, err := decodeSource(extends)
+		if err != nil {
+			return nil, fmt.Errorf("invalid extends: %w", err)
+		}
+		sources = append(sources, source)
+	}
+	if includes := remove(node, keyInclude); includes != nil {
+		list := []*yaml.Node{includes}
+		if includes.Kind == yaml.SequenceNode {
+			list = includes.Content
+		}
+		for _, include := range list {
+			source, err := decodeSource(include)
+			if err != nil {
+				return nil, fmt.Errorf("invalid include: %w", err)
+			}
+			sources = append(sources, source)
+		}
+	}
+
+	result := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for _, source := range sources {
+		if parent != nil && source.Repo == "" && source.Template == "" {
+			// relative includes of fragments refer to the repository of the fragment
+			source.Repo = parent.Repo
+			source.Ref = parent.Ref
+		}
+
+		fragment, err := r.fragment(ctx, source, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		if err := merge(result, fragment, true); err != nil {
+			return nil, fmt.Errorf("could not merge %s: %w", source, err)
+		}
+	}
+
+	if err := merge(result, node, true); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// fragment loads a fragment, replaces its parameters and resolves its own includes.
+func (r *resolver) fragment(ctx context.Context, source *Source, depth int) (*yaml.Node, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("include of %s exceeds the max depth of %d", source, maxDepth)
+	}
+	key := source.String()
+	for _, s := range r.stack {
+		if s == key {
+			return nil, fmt.Errorf("include cycle: %s -> %s", strings.Join(r.stack, " -> "), key)
+		}
+	}
+	r.stack = append(r.stack, key)
+	defer func() { r.stack = r.stack[:len(r.stack)-1] }()
+
+	data, err := r.load(ctx, source)
+	if err != nil {
+		return nil, fmt.Errorf("could not load %s: %w", source, err)
+	}
+
+	doc, err := parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %s: %w", source, err)
+	}
+	if doc == nil {
+		return &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}, nil
+	}
+	node := expand(doc)
+
+	var declared map[string]any
+	if node.Kind == yaml.MappingNode {
+		if parameters := remove(node, keyParameters); parameters != nil {
+			if err := parameters.Decode(&declared); err != nil {
+				return nil, fmt.Errorf("invalid parameters of %s: %w", source, err)
+			}
+		}
+	}
+	if err := substitute(node, declared, source.With); err != nil {
+		return nil, fmt.Errorf("invalid parameters of %s: %w", source, err)
+	}
+
+	return r.resolve(ctx, node, source, depth)
+}
+
+func decodeSource(node *yaml.Node) (*Source, error) {
+	source := new(Source)
+	if err := node.Decode(source); err != nil {
+		return nil, err
+	}
+	return source, source.validate()
+}
+
+// substitute replaces the parameters in the scalars of the fragment with the given values or their defaults.
+// Values are never parsed as yaml, so they can not add keys or steps to the fragment.
+func substitute(node *yaml.Node, declared, with map[string]any) error {
+	values := make(map[string]any, len(declared))
+	for name, value := range declared {
+		if value != nil {
+			values[name] = value
+		}
+	}
+	for name, value := range with {
+		if _, ok := declared[name]; !ok {
+			return fmt.Errorf("unknown parameter %s", name)
+		}
+		switch value.(type) {
+		case map[string]any, []any:
+			return fmt.Errorf("parameter %s must be a scalar", name)
+		}
+		values[name] = value
+	}
+
+	var errs []error
+	var walk func(node *yaml.Node)
+	walk = func(node *yaml.Node) {
+		if node.Kind != yaml.ScalarNode {
+			for _, child := range node.Content {
+				walk(child)
+			}
+			return
+		}
+		if !paramPattern.MatchString(node.Value) {
+			return
+		}
+
+		// a plain scalar only made of a parameter keeps the type of the value
+		if match := paramPattern.FindStringSubmatchIndex(node.Value); node.Style == 0 &&
+			match[0] == 0 && match[1] == len(node.Value) {
+			if value, ok := values[node.Value[match[2]:match[3]]]; ok {
+				node.Value, node.Tag = fmt.Sprint(value), scalarTag(value)
+				return
+			}
+		}
+
+		node.Value = paramPattern.ReplaceAllStringFunc(node.Value, func(match string) string {
+			name := paramPattern.FindStringSubmatch(match)[1]
+			value, ok := values[name]
+			if !ok {
+				if _, declared := declared[name]; declared {
+					errs = append(errs, fmt.Errorf("missing required parameter %s", name))
+				} else {
+					errs = append(errs, fmt.Errorf("undeclared parameter %s", name))
+				}
+				return match
+			}
+			return fmt.Sprint(value)
+		})
+		node.Tag = "!!str"
+	}
+	walk(node)
+	return errors.Join(errs...)
+}
+
+// scalarTag returns the yaml tag of a parameter value.
+func scalarTag(value any) string {
+	switch value.(type) {
+	case bool:
+		return "!!bool"
+	case int, int64, uint64:
+		return "!!int"
+	case float64:
+		return "!!float"
+	}
+	return "!!str"
+}
+
+func parse(data []byte) (*yaml.Node, error) {
+	doc := new(yaml.Node)
+	if err := yaml.Unmarshal(data, doc); err != nil {
+		return nil, err
+	}
+	if doc.Kind == 0 || len(doc.Content) == 0 {
+		return nil, nil
+	}
+	return doc.Content[0], nil
+}
+
+// expand returns a copy of the node with all aliases and merge keys resolved,
+// so parts of different documents can be combined without dangling anchors.
+func expand(node *yaml.Node) *yaml.Node {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		return expand(node.Content[0])
+	case yaml.AliasNode:
+		return expand(node.Alias)
+	case yaml.MappingNode:
+		out := &yaml.Node{Kind: yaml.MappingNode, Tag: node.Tag, Style: node.Style}
+		var merges []*yaml.Node
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Kind == yaml.ScalarNode && key.ShortTag() == "!!merge" {
+				value = expand(value)
+				if value.Kind == yaml.SequenceNode {
+					merges = append(merges, value.Content...)
+				} else {
+					merges = append(merges, value)
+				}
+				continue
+			}
+			out.Content = append(out.Content, expand(key), expand(value))
+		}
+		// explicit keys take precedence over merged ones, earlier merged mappings over later ones
+		for _, m := range merges {
+			for i := 0; i+1 < len(m.Content); i += 2 {
+				if _, v := lookup(out, m.Content[i].Value); v == nil {
+					out.Content = append(out.Content, m.Content[i], m.Content[i+1])
+				}
+			}
+		}
+		return out
+	case yaml.SequenceNode:
+		out := &yaml.Node{Kind: yaml.SequenceNode, Tag: node.Tag, Style: node.Style}
+		for _, item := range node.Content {
+			out.Content = append(out.Content, expand(item))
+		}
+		return out
+	default:
+		out := *node
+		out.Anchor = ""
+		return &out
+	}
+}
+
+// merge merges src into dst. Both have to be mappings.
+func merge(dst, src *yaml.Node, top bool) error {
+	if src.Kind != yaml.MappingNode {
+		return errors.New("fragment must be a mapping")
+	}
+
+	for i := 0; i+1 < len(src.Content); i += 2 {
+		key, value := src.Content[i], src.Content[i+1]
+		idx, existing := lookup(dst, key.Value)
+		switch {
+		case existing == nil:
+			dst.Content = append(dst.Content, key, value)
+		case existing.Kind == yaml.MappingNode && value.Kind == yaml.MappingNode:
+			if err := merge(existing, value, false); err != nil {
+				return err
+			}
+		case top && (key.Value == "steps" || key.Value == "services"):
+			if existing.Kind != value.Kind {
+				return fmt.Errorf("can not merge %s given as list and as map", key.Value)
+			}
+			existing.Content = append(existing.Content, value.Content...)
+		default:
+			dst.Content[idx+1] = value
+		}
+	}
+	return nil
+}
+
+// lookup returns the index of the key and the value of the key in the mapping.
+func lookup(node *yaml.Node, key string) (int, *yaml.Node) {
+	if node.Kind != yaml.MappingNode {
+		return -1, nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return i, node.Content[i+1]
+		}
+	}
+	return -1, nil
+}
+
+// remove removes the key from the mapping and returns its value.
+func remove(node *yaml.Node, key string) *yaml.Node {
+	idx, value := lookup(node, key)
+	if value == nil {
+		return nil
+	}
+	node.Content = append(node.Content[:idx], node.Content[idx+2:]...)
+	return value
+}
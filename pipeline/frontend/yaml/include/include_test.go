@@ -0,0 +1,283 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package include
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func loader(files map[string]string) Loader {
+	return func(_ context.Context, source *Source) ([]byte, error) {
+		data, ok := files[source.String()]
+		if !ok {
+			return nil, fmt.Errorf("%s not found", source)
+		}
+		return []byte(data), nil
+	}
+}
+
+func TestResolve(t *testing.T) {
+	files := map[string]string{
+		"org/templates@v1:go.yaml": `
+parameters:
+  go_version: "1.24"
+  flags:
+when:
+  event: push
+steps:
+  - name: test
+    image: golang:${{ params.go_version }}
+    commands:
+      - go test ${{ params.flags }} ./...
+`,
+		"org/templates@v1:lint.yaml": `
+include:
+  path: base.yaml
+steps:
+  - name: lint
+    image: golangci/golangci-lint
+`,
+		"org/templates@v1:base.yaml": `
+labels:
+  platform: linux/amd64
+`,
+		"template deploy": `
+variables:
+  - &image alpine
+steps:
+  build:
+    image: *image
+    commands: [make]
+  deploy:
+    image: *image
+    commands: [make deploy]
+`,
+		".woodpecker/shared.yaml": `
+services:
+  - name: db
+    image: postgres
+`,
+		"org/templates@v1:cycle.yaml": `
+include:
+  path: cycle.yaml
+`,
+		"org/templates@v1:step.yaml": `
+parameters:
+  name: test
+  image:
+  pull: false
+steps:
+  - name: ${{ params.name }}
+    image: ${{ params.image }}
+    pull: ${{ params.pull }}
+`,
+	}
+
+	tests := []struct {
+		name     string
+		workflow string
+		expected string
+		err      string
+	}{
+		{
+			name: "without include",
+			workflow: `steps:
+  - name: build
+    image: alpine
+`,
+			expected: `steps:
+  - name: build
+    image: alpine
+`,
+		},
+		{
+			name: "include with parameters",
+			workflow: `
+include:
+  - repo: org/templates
+    ref: v1
+    path: go.yaml
+    with:
+      flags: -race
+  - path: .woodpecker/shared.yaml
+when:
+  branch: main
+steps:
+  - name: build
+    image: alpine
+`,
+			expected: `when:
+  event: push
+  branch: main
+steps:
+  - name: test
+    image: golang:1.24
+    commands:
+      - go test -race ./...
+  - name: build
+    image: alpine
+services:
+  - name: db
+    image: postgres
+`,
+		},
+		{
+			name: "nested relative include",
+			workflow: `
+include:
+  repo: org/templates
+  ref: v1
+  path: lint.yaml
+`,
+			expected: `labels:
+  platform: linux/amd64
+steps:
+  - name: lint
+    image: golangci/golangci-lint
+`,
+		},
+		{
+			name: "extends template",
+			workflow: `
+extends:
+  template: deploy
+steps:
+  deploy:
+    commands: [make release]
+`,
+			expected: `variables:
+  - alpine
+steps:
+  build:
+    image: alpine
+    commands: [make]
+  deploy:
+    image: alpine
+    commands: [make release]
+`,
+		},
+		{
+			name: "parameter values are not parsed as yaml",
+			workflow: `
+include:
+  repo: org/templates
+  ref: v1
+  path: step.yaml
+  with:
+    image: "alpine\n    commands:\n      - cat /secret"
+    pull: true
+`,
+			// the value stays a single string instead of adding commands to the step
+			expected: `steps:
+  - name: test
+    image: |-
+      alpine
+          commands:
+            - cat /secret
+    pull: true
+`,
+		},
+		{
+			name: "missing required parameter",
+			workflow: `
+include:
+  repo: org/templates
+  ref: v1
+  path: go.yaml
+`,
+			err: "missing required parameter flags",
+		},
+		{
+			name: "unknown parameter",
+			workflow: `
+include:
+  repo: org/templates
+  ref: v1
+  path: go.yaml
+  with:
+    flags: -v
+    version: 1
+`,
+			err: "unknown parameter version",
+		},
+		{
+			name: "missing ref",
+			workflow: `
+include:
+  repo: org/templates
+  path: go.yaml
+`,
+			err: "ref is required",
+		},
+		{
+			name: "cycle",
+			workflow: `
+include:
+  repo: org/templates
+  ref: v1
+  path: cycle.yaml
+`,
+			err: "include cycle",
+		},
+		{
+			name: "steps list and map",
+			workflow: `
+extends:
+  template: deploy
+steps:
+  - name: test
+    image: alpine
+`,
+			err: "can not merge steps given as list and as map",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Resolve(t.Context(), []byte(tt.workflow), loader(files))
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(result))
+		})
+	}
+}
+
+func TestExpand(t *testing.T) {
+	doc, err := parse([]byte(`
+defaults: &defaults
+  image: alpine
+  pull: true
+steps:
+  build:
+    <<: *defaults
+    pull: false
+`))
+	assert.NoError(t, err)
+
+	node := expand(doc)
+	_, steps := lookup(node, "steps")
+	_, build := lookup(steps, "build")
+	_, image := lookup(build, "image")
+	_, pull := lookup(build, "pull")
+	assert.Equal(t, "alpine", image.Value)
+	assert.Equal(t, "false", pull.Value)
+}
@@ -16,12 +16,16 @@ package linter
 
 import (
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
 	"codeberg.org/6543/xyaml"
 	"go.uber.org/multierr"
 
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
 	errorTypes "go.woodpecker-ci.org/woodpecker/v3/pipeline/errors/types"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/constraint"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/linter/schema"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/types"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/utils"
@@ -37,6 +41,8 @@ type Linter struct {
 	trusted             TrustedConfiguration
 	privilegedPlugins   *[]string
 	trustedClonePlugins *[]string
+	workflows           map[string][]string
+	secrets             *[]string
 }
 
 type TrustedConfiguration struct {
@@ -75,6 +81,11 @@ func (l *Linter) Lint(configs []*WorkflowConfig) error {
 		}
 	}
 
+	if err := l.lintWorkflowDependencies(configs); err != nil {
+		linterErr = multierr.Append(linterErr, err)
+	}
+
+	setPositions(linterErr, configs)
 	return linterErr
 }
 
@@ -89,6 +100,17 @@ func (l *Linter) lintFile(config *WorkflowConfig) error {
 		linterErr = multierr.Append(linterErr, err)
 	}
 
+	if err := l.lintRequires(config); err != nil {
+		linterErr = multierr.Append(linterErr, err)
+	}
+
+	if err := l.lintStepCycles(config); err != nil {
+		linterErr = multierr.Append(linterErr, err)
+	}
+	if err := l.lintUnreachableSteps(config); err != nil {
+		linterErr = multierr.Append(linterErr, err)
+	}
+
 	if err := l.lintContainers(config, "clone"); err != nil {
 		linterErr = multierr.Append(linterErr, err)
 	}
@@ -168,6 +190,9 @@ func (l *Linter) lintContainers(config *WorkflowConfig, area string) error {
 		if err := l.lintDependsOn(config, container, area); err != nil {
 			linterErr = multierr.Append(linterErr, err)
 		}
+		if err := l.lintSecrets(config, container, area); err != nil {
+			linterErr = multierr.Append(linterErr, err)
+		}
 	}
 
 	return linterErr
@@ -196,6 +221,140 @@ check:
 	return linterErr
 }
 
+func (l *Linter) lintRequires(config *WorkflowConfig) error {
+	var linterErr error
+	for key, value := range config.Workflow.Requires {
+		if _, err := types.ParseRequirement(key, value); err != nil {
+			linterErr = multierr.Append(linterErr, newLinterError(err.Error(), config.File, "requires."+key, false))
+		}
+	}
+	return linterErr
+}
+
+func (l *Linter) lintStepCycles(config *WorkflowConfig) error {
+	steps := make(map[string][]string, len(config.Workflow.Steps.ContainerList))
+	for _, step := range config.Workflow.Steps.ContainerList {
+		steps[step.Name] = step.DependsOn
+	}
+
+	var linterErr error
+	// report each cycle once instead of once for every step in it
+	inCycle := make(map[string]struct{})
+	for _, step := range config.Workflow.Steps.ContainerList {
+		if _, ok := inCycle[step.Name]; ok {
+			continue
+		}
+		if cycle := dependencyCycle(steps, step.Name); cycle != nil {
+			for _, name := range cycle {
+				inCycle[name] = struct{}{}
+			}
+			linterErr = multierr.Append(linterErr,
+				newLinterError(
+					fmt.Sprintf("Dependency cycle detected: %s", strings.Join(cycle, " -> ")),
+					config.File, fmt.Sprintf("steps.%s.depends_on", step.Name), false,
+				),
+			)
+		}
+	}
+	return linterErr
+}
+
+func (l *Linter) lintUnreachableSteps(config *WorkflowConfig) error {
+	workflowEvents := eventFilter(config.Workflow.When)
+	if workflowEvents == nil {
+		return nil
+	}
+
+	var linterErr error
+	for _, step := range config.Workflow.Steps.ContainerList {
+		stepEvents := eventFilter(step.When)
+		if stepEvents == nil {
+			continue
+		}
+		if !slices.ContainsFunc(stepEvents, func(event string) bool { return slices.Contains(workflowEvents, event) }) {
+			linterErr = multierr.Append(linterErr,
+				newLinterError(
+					"Step can never run as none of its events match the event filter of the workflow",
+					config.File, fmt.Sprintf("steps.%s.when", step.Name), true,
+				),
+			)
+		}
+	}
+	return linterErr
+}
+
+// lintWorkflowDependencies checks the dependencies between workflows. As the
+// workflows of other files are unknown if a single config is linted, it is
+// skipped unless the workflows have been added using WithWorkflows.
+func (l *Linter) lintWorkflowDependencies(configs []*WorkflowConfig) error {
+	workflows := maps.Clone(l.workflows)
+	if workflows == nil {
+		if len(configs) < 2 {
+			return nil
+		}
+		workflows = make(map[string][]string, len(configs))
+	}
+	for _, config := range configs {
+		workflows[utils.WorkflowName(config.File)] = config.Workflow.DependsOn
+	}
+
+	var linterErr error
+	inCycle := make(map[string]struct{})
+	for _, config := range configs {
+		for _, dep := range config.Workflow.DependsOn {
+			if _, ok := workflows[dep]; !ok {
+				linterErr = multierr.Append(linterErr,
+					newLinterError(
+						fmt.Sprintf("Workflow `%s` does not exist", dep),
+						config.File, "depends_on", true,
+					),
+				)
+			}
+		}
+
+		if _, ok := inCycle[utils.WorkflowName(config.File)]; ok {
+			continue
+		}
+		if cycle := dependencyCycle(workflows, utils.WorkflowName(config.File)); cycle != nil {
+			for _, name := range cycle {
+				inCycle[name] = struct{}{}
+			}
+			linterErr = multierr.Append(linterErr,
+				newLinterError(
+					fmt.Sprintf("Dependency cycle detected: %s", strings.Join(cycle, " -> ")),
+					config.File, "depends_on", false,
+				),
+			)
+		}
+	}
+	return linterErr
+}
+
+func (l *Linter) lintSecrets(config *WorkflowConfig, c *types.Container, area string) error {
+	if l.secrets == nil {
+		return nil
+	}
+
+	var linterErr error
+	for _, field := range []struct {
+		name   string
+		values map[string]any
+	}{{"environment", c.Environment}, {"settings", c.Settings}} {
+		for _, secret := range secretReferences(field.values) {
+			if slices.ContainsFunc(*l.secrets, func(name string) bool { return strings.EqualFold(name, secret) }) {
+				continue
+			}
+			linterErr = multierr.Append(linterErr,
+				newLinterError(
+					fmt.Sprintf("Secret `%s` does not exist", secret),
+					config.File, fmt.Sprintf("%s.%s.%s", area, c.Name, field.name), true,
+				),
+			)
+		}
+	}
+	return linterErr
+}
+
 func (l *Linter) lintImage(config *WorkflowConfig, c *types.Container, area string) error {
 	if len(c.Image) == 0 {
 		return newLinterError("Invalid or missing image", config.File, fmt.Sprintf("%s.%s", area, c.Name), false)
@@ -373,3 +532,61 @@ func (l *Linter) lintBadHabits(config *WorkflowConfig) (err error) {
 
 	return err
 }
+
+// dependencyCycle returns the path of a dependency cycle leading back to start or nil if there is none.
+func dependencyCycle(dependencies map[string][]string, start string) []string {
+	visited := make(map[string]struct{})
+	var visit func(name string, path []string) []string
+	visit = func(name string, path []string) []string {
+		for _, dep := range dependencies[name] {
+			if dep == start {
+				return append(slices.Clone(path), dep)
+			}
+			if _, ok := visited[dep]; ok {
+				continue
+			}
+			visited[dep] = struct{}{}
+			if cycle := visit(dep, append(path, dep)); cycle != nil {
+				return cycle
+			}
+		}
+		return nil
+	}
+	return visit(start, []string{start})
+}
+
+// eventFilter returns the events the constraints are limited to or nil if
+// any of the constraints matches all events.
+func eventFilter(when constraint.When) []string {
+	if len(when.Constraints) == 0 {
+		return nil
+	}
+
+	var events []string
+	for _, c := range when.Constraints {
+		if len(c.Event) == 0 {
+			return nil
+		}
+		events = append(events, c.Event...)
+	}
+	return events
+}
+
+// secretReferences returns the names of all secrets referenced using `from_secret`.
+func secretReferences(v any) []string {
+	var secrets []string
+	switch v := v.(type) {
+	case map[string]any:
+		if name, ok := v["from_secret"].(string); ok {
+			return []string{name}
+		}
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			secrets = append(secrets, secretReferences(v[key])...)
+		}
+	case []any:
+		for _, item := range v {
+			secrets = append(secrets, secretReferences(item)...)
+		}
+	}
+	return secrets
+}
@@ -15,11 +15,13 @@
 package linter_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/errors/types"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/linter"
 )
@@ -189,6 +191,22 @@ func TestLintErrors(t *testing.T) {
 			from: "steps: { build: { image: golang }, publish: { image: golang, depends_on: [ binary ] } }",
 			want: "One or more of the specified dependencies do not exist",
 		},
+		{
+			from: "steps: { build: { image: golang, depends_on: [ test ] }, test: { image: golang, depends_on: [ build ] } }",
+			want: "Dependency cycle detected: build -> test -> build",
+		},
+		{
+			from: "steps: { build: { image: golang, depends_on: [ build ] } }",
+			want: "Dependency cycle detected: build -> build",
+		},
+		{
+			from: "{when: { event: push }, steps: { build: { image: golang }, release: { image: golang, when: { event: tag } } } }",
+			want: "Step can never run as none of its events match the event filter of the workflow",
+		},
+		{
+			from: "{requires: { memory: 'lots' }, steps: { build: { image: golang } } }",
+			want: `requirement memory has invalid value "lots"`,
+		},
 	}
 
 	for _, test := range testdata {
@@ -251,3 +269,117 @@ func TestBadHabits(t *testing.T) {
 		assert.True(t, found, "Expected error %q, got %q", test.want, lerrors)
 	}
 }
+
+func TestLintWorkflowDependencies(t *testing.T) {
+	parse := func(file, data string) *linter.WorkflowConfig {
+		conf, err := yaml.ParseString(data)
+		assert.NoError(t, err)
+		return &linter.WorkflowConfig{File: file, RawConfig: data, Workflow: conf}
+	}
+	messages := func(err error) (messages []string) {
+		for _, lerr := range errors.GetPipelineErrors(err) {
+			if lerr.Type == types.PipelineErrorTypeLinter && errors.GetLinterData(lerr).Field == "depends_on" {
+				messages = append(messages, lerr.Message)
+			}
+		}
+		return messages
+	}
+
+	build := parse("build.yaml", "steps: { build: { image: golang } }")
+	deploy := parse("deploy.yaml", "{depends_on: [ build, test ], steps: { deploy: { image: golang } } }")
+
+	// workflows of other files are unknown
+	assert.Empty(t, messages(linter.New().Lint([]*linter.WorkflowConfig{deploy})))
+
+	assert.Equal(t, []string{"Workflow `test` does not exist"}, messages(linter.New().Lint([]*linter.WorkflowConfig{build, deploy})))
+
+	assert.Empty(t, messages(linter.New(linter.WithWorkflows(map[string][]string{
+		"build":  nil,
+		"test":   nil,
+		"deploy": {"build", "test"},
+	})).Lint([]*linter.WorkflowConfig{deploy})))
+
+	assert.Equal(t, []string{"Dependency cycle detected: deploy -> test -> deploy"}, messages(linter.New(linter.WithWorkflows(map[string][]string{
+		"build":  nil,
+		"test":   {"deploy"},
+		"deploy": {"build", "test"},
+	})).Lint([]*linter.WorkflowConfig{deploy})))
+
+	// a cycle is reported once, not for every workflow in it
+	test := parse("test.yaml", "{depends_on: [ deploy ], steps: { test: { image: golang } } }")
+	assert.Equal(t, []string{"Dependency cycle detected: deploy -> test -> deploy"}, messages(linter.New().Lint([]*linter.WorkflowConfig{build, deploy, test})))
+}
+
+func TestLintStepCycles(t *testing.T) {
+	data := "steps: { build: { image: golang, depends_on: [ test ] }, test: { image: golang, depends_on: [ build ] }, lint: { image: golang, depends_on: [ lint ] } }"
+	conf, err := yaml.ParseString(data)
+	assert.NoError(t, err)
+
+	var messages []string
+	for _, lerr := range errors.GetPipelineErrors(linter.New().Lint([]*linter.WorkflowConfig{{File: "test.yml", RawConfig: data, Workflow: conf}})) {
+		if strings.HasPrefix(lerr.Message, "Dependency cycle detected") {
+			messages = append(messages, lerr.Message)
+		}
+	}
+	assert.ElementsMatch(t, []string{
+		"Dependency cycle detected: build -> test -> build",
+		"Dependency cycle detected: lint -> lint",
+	}, messages)
+}
+
+func TestLintSecrets(t *testing.T) {
+	data := `
+when:
+  event: push
+
+steps:
+  build:
+    image: golang
+    environment:
+      TOKEN:
+        from_secret: token
+      PASSWORD:
+        from_secret: Password
+  publish:
+    image: woodpeckerci/plugin-kaniko
+    settings:
+      auth:
+        - username: woodpecker
+          password:
+            from_secret: registry_password
+`
+	conf, err := yaml.ParseString(data)
+	assert.NoError(t, err)
+	configs := []*linter.WorkflowConfig{{File: "build.yaml", RawConfig: data, Workflow: conf}}
+
+	// secrets are unknown
+	assert.NoError(t, linter.New().Lint(configs))
+
+	lerrors := errors.GetPipelineErrors(linter.New(linter.WithSecrets([]string{"token", "password"})).Lint(configs))
+	if assert.Len(t, lerrors, 1) {
+		assert.Equal(t, "Secret `registry_password` does not exist", lerrors[0].Message)
+		assert.Equal(t, "steps.publish.settings", errors.GetLinterData(lerrors[0]).Field)
+		assert.True(t, lerrors[0].IsWarning)
+	}
+}
+
+func TestLintPositions(t *testing.T) {
+	data := `when:
+  event: push
+
+steps:
+  - name: build
+    image: golang
+  - name: release
+    image: golang
+    when:
+      event: tag
+`
+	conf, err := yaml.ParseString(data)
+	assert.NoError(t, err)
+
+	lerrs := errors.GetPipelineErrors(linter.New().Lint([]*linter.WorkflowConfig{{File: "test.yml", RawConfig: data, Workflow: conf}}))
+	if assert.Len(t, lerrs, 1) {
+		assert.Equal(t, &errors.LinterErrorData{File: "test.yml", Field: "steps.release.when", Line: 9, Column: 5}, errors.GetLinterData(lerrs[0]))
+	}
+}
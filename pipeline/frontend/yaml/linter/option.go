@@ -37,3 +37,18 @@ func WithTrustedClonePlugins(plugins []string) Option {
 		linter.trustedClonePlugins = &plugins
 	}
 }
+
+// WithWorkflows adds the dependencies of all workflows of the pipeline by
+// workflow name, used to check workflow dependencies if workflows are linted individually.
+func WithWorkflows(workflows map[string][]string) Option {
+	return func(linter *Linter) {
+		linter.workflows = workflows
+	}
+}
+
+// WithSecrets adds the names of the secrets available to the pipeline.
+func WithSecrets(secrets []string) Option {
+	return func(linter *Linter) {
+		linter.secrets = &secrets
+	}
+}
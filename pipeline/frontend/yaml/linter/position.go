@@ -0,0 +1,122 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linter
+
+import (
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
+)
+
+// setPositions sets the line and column of the fields the errors refer to in their config file.
+func setPositions(err error, configs []*WorkflowConfig) {
+	if err == nil {
+		return
+	}
+
+	roots := make(map[string]*yaml.Node, len(configs))
+	for _, config := range configs {
+		root := new(yaml.Node)
+		if yaml.Unmarshal([]byte(config.RawConfig), root) == nil {
+			roots[config.File] = root
+		}
+	}
+
+	for _, pipelineErr := range errors.GetPipelineErrors(err) {
+		switch data := pipelineErr.Data.(type) {
+		case *errors.LinterErrorData:
+			data.Line, data.Column = fieldPosition(roots[data.File], data.Field)
+		case errors.DeprecationErrorData:
+			data.Line, data.Column = fieldPosition(roots[data.File], data.Field)
+			pipelineErr.Data = data
+		case errors.BadHabitErrorData:
+			data.Line, data.Column = fieldPosition(roots[data.File], data.Field)
+			pipelineErr.Data = data
+		}
+	}
+}
+
+// fieldPosition returns the position of a field like `steps.build.when[0]` in the config. If the
+// field does not exist, the position of the closest parent is returned.
+func fieldPosition(root *yaml.Node, field string) (line, column int) {
+	if root == nil {
+		return 0, 0
+	}
+
+	node := root
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	line, column = node.Line, node.Column
+
+	for part := range strings.SplitSeq(field, ".") {
+		// schema errors of the top level
+		if part == "" || part == "(root)" {
+			continue
+		}
+
+		name, index, hasIndex := strings.Cut(strings.TrimSuffix(part, "]"), "[")
+		child, position := childNode(node, name)
+		if child == nil {
+			break
+		}
+		node = child
+		line, column = position.Line, position.Column
+
+		if hasIndex {
+			child, position = childNode(node, index)
+			if child == nil {
+				break
+			}
+			node = child
+			line, column = position.Line, position.Column
+		}
+	}
+	return line, column
+}
+
+// childNode returns the value of the key in a mapping or the item of a sequence by its index
+// or name together with the node marking its position.
+func childNode(node *yaml.Node, name string) (child, position *yaml.Node) {
+	if node.Kind == yaml.AliasNode && node.Alias != nil {
+		node = node.Alias
+	}
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == name {
+				return node.Content[i+1], node.Content[i]
+			}
+		}
+	case yaml.SequenceNode:
+		if index, err := strconv.Atoi(name); err == nil {
+			if index >= 0 && index < len(node.Content) {
+				return node.Content[index], node.Content[index]
+			}
+			return nil, nil
+		}
+		// steps in the list syntax are referred to by their name
+		for _, item := range node.Content {
+			if value, _ := childNode(item, "name"); value != nil && value.Value == name {
+				return item, item
+			}
+		}
+	}
+	return nil, nil
+}
@@ -76,6 +76,22 @@ func TestSchema(t *testing.T) {
 			name:     "Labels",
 			testFile: ".woodpecker/test-labels.yaml",
 		},
+		{
+			name:     "Include",
+			testFile: ".woodpecker/test-include.yaml",
+		},
+		{
+			name:     "Extends",
+			testFile: ".woodpecker/test-extends.yaml",
+		},
+		{
+			name:     "Report",
+			testFile: ".woodpecker/test-report.yaml",
+		},
+		{
+			name:     "Requires",
+			testFile: ".woodpecker/test-requires.yaml",
+		},
 		{
 			name:     "Map and Sequence Merge", // https://woodpecker-ci.org/docs/next/usage/advanced-yaml-syntax
 			testFile: ".woodpecker/test-merge-map-and-sequence.yaml",
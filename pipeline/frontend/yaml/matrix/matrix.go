@@ -15,16 +15,24 @@
 package matrix
 
 import (
+	"errors"
+	"fmt"
 	"strings"
 
 	"codeberg.org/6543/xyaml"
+	"gopkg.in/yaml.v3"
 
 	errorTypes "go.woodpecker-ci.org/woodpecker/v3/pipeline/errors/types"
 )
 
 const (
 	limitTags = 10
-	limitAxis = 25
+	// DefaultLimitCombinations is the default max number of combinations a matrix expands to.
+	DefaultLimitCombinations = 27
+
+	keyFile    = "file"
+	keyInclude = "include"
+	keyExclude = "exclude"
 )
 
 // Matrix represents the pipeline matrix.
@@ -43,31 +51,163 @@ func (a Axis) String() string {
 	return strings.Join(envs, " ")
 }
 
+// matches returns true if the axis contains all entries of the filter.
+func (a Axis) matches(filter Axis) bool {
+	if len(filter) == 0 {
+		return false
+	}
+	for k, v := range filter {
+		if a[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Loader returns the content of a matrix file of the repository.
+type Loader func(path string) ([]byte, error)
+
+// Option configures the matrix parser.
+type Option func(*options)
+
+type options struct {
+	loader            Loader
+	limitCombinations int
+}
+
+// WithLoader sets the loader used to read matrix files.
+// Without loader, matrix files are not supported.
+func WithLoader(loader Loader) Option {
+	return func(o *options) {
+		o.loader = loader
+	}
+}
+
+// WithLimitCombinations sets the max number of combinations a matrix expands to.
+// Additional combinations are ignored.
+func WithLimitCombinations(limit int) Option {
+	return func(o *options) {
+		if limit > 0 {
+			o.limitCombinations = limit
+		}
+	}
+}
+
+// definition is a parsed matrix.
+type definition struct {
+	file    string
+	axes    Matrix
+	include []Axis
+	exclude []Axis
+}
+
 // Parse parses the Yaml matrix definition.
-func Parse(data []byte) ([]Axis, error) {
-	axis, err := parseList(data)
-	if err == nil && len(axis) != 0 {
-		return axis, nil
+func Parse(data []byte, opts ...Option) ([]Axis, error) {
+	o := &options{limitCombinations: DefaultLimitCombinations}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	raw := struct {
+		Matrix map[string]yaml.Node
+	}{}
+	if err := xyaml.Unmarshal(data, &raw); err != nil {
+		return nil, compilerError(err)
 	}
 
-	matrix, err := parse(data)
+	def, err := parseDefinition(raw.Matrix)
 	if err != nil {
-		return nil, err
+		return nil, compilerError(err)
 	}
 
-	if len(matrix) == 0 {
-		return []Axis{}, nil
+	if def.file != "" {
+		if err := def.load(o.loader); err != nil {
+			return nil, compilerError(err)
+		}
 	}
 
-	return calc(matrix), nil
+	return def.calc(o.limitCombinations), nil
 }
 
 // ParseString parses the Yaml string matrix definition.
-func ParseString(data string) ([]Axis, error) {
-	return Parse([]byte(data))
+func ParseString(data string, opts ...Option) ([]Axis, error) {
+	return Parse([]byte(data), opts...)
+}
+
+func parseDefinition(raw map[string]yaml.Node) (*definition, error) {
+	def := &definition{axes: Matrix{}}
+	for key, node := range raw {
+		var err error
+		switch {
+		case key == keyInclude:
+			err = node.Decode(&def.include)
+		case key == keyExclude:
+			err = node.Decode(&def.exclude)
+		case key == keyFile && node.Kind == yaml.ScalarNode:
+			// a list named file is a regular axis
+			err = node.Decode(&def.file)
+		default:
+			var values []string
+			err = node.Decode(&values)
+			if len(values) != 0 {
+				def.axes[key] = values
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid matrix entry %s: %w", key, err)
+		}
+	}
+	return def, nil
 }
 
-func calc(matrix Matrix) []Axis {
+// load merges the matrix file into the definition. Axes of the file replace
+// axes with the same name, include and exclude entries are appended.
+func (d *definition) load(loader Loader) error {
+	if loader == nil {
+		return errors.New("matrix files are not supported")
+	}
+
+	data, err := loader(d.file)
+	if err != nil {
+		return fmt.Errorf("could not load matrix file %s: %w", d.file, err)
+	}
+
+	raw := map[string]yaml.Node{}
+	if err := xyaml.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("could not parse matrix file %s: %w", d.file, err)
+	}
+	file, err := parseDefinition(raw)
+	if err != nil {
+		return fmt.Errorf("could not parse matrix file %s: %w", d.file, err)
+	}
+	if file.file != "" {
+		return fmt.Errorf("matrix file %s can not reference another file", d.file)
+	}
+
+	for k, v := range file.axes {
+		d.axes[k] = v
+	}
+	d.include = append(d.include, file.include...)
+	d.exclude = append(d.exclude, file.exclude...)
+	return nil
+}
+
+// calc returns the permutations of the axes without the excluded ones, followed by the included axes.
+func (d *definition) calc(limit int) []Axis {
+	axisList := make([]Axis, 0)
+	if len(d.axes) != 0 {
+		axisList = calc(d.axes, d.exclude, limit)
+	}
+	axisList = append(axisList, d.include...)
+
+	// enforce a maximum number of combinations that should be calculated.
+	if len(axisList) > limit {
+		axisList = axisList[:limit]
+	}
+	return axisList
+}
+
+func calc(matrix Matrix, exclude []Axis, limit int) []Axis {
 	// calculate number of permutations and extract the list of tags
 	// (ie go_version, redis_version, etc)
 	var perm int
@@ -84,7 +224,7 @@ func calc(matrix Matrix) []Axis {
 	var axisList []Axis
 
 	// for each axis calculate the unique set of values that should be used.
-	for p := 0; p < perm; p++ {
+	for p := 0; p < perm && len(axisList) < limit; p++ {
 		axis := map[string]string{}
 		decrease := perm
 		for i, tag := range tags {
@@ -99,37 +239,26 @@ func calc(matrix Matrix) []Axis {
 			}
 		}
 
+		if isExcluded(axis, exclude) {
+			continue
+		}
+
 		// append to the list of axis.
 		axisList = append(axisList, axis)
-
-		// enforce a maximum number of axis that should be calculated.
-		if p > limitAxis {
-			break
-		}
 	}
 
 	return axisList
 }
 
-func parse(raw []byte) (Matrix, error) {
-	data := struct {
-		Matrix map[string][]string
-	}{}
-	if err := xyaml.Unmarshal(raw, &data); err != nil {
-		return nil, &errorTypes.PipelineError{Message: err.Error(), Type: errorTypes.PipelineErrorTypeCompiler}
+func isExcluded(axis Axis, exclude []Axis) bool {
+	for _, filter := range exclude {
+		if axis.matches(filter) {
+			return true
+		}
 	}
-	return data.Matrix, nil
+	return false
 }
 
-func parseList(raw []byte) ([]Axis, error) {
-	data := struct {
-		Matrix struct {
-			Include []Axis
-		}
-	}{}
-
-	if err := xyaml.Unmarshal(raw, &data); err != nil {
-		return nil, &errorTypes.PipelineError{Message: err.Error(), Type: errorTypes.PipelineErrorTypeCompiler}
-	}
-	return data.Matrix.Include, nil
+func compilerError(err error) error {
+	return &errorTypes.PipelineError{Message: err.Error(), Type: errorTypes.PipelineErrorTypeCompiler}
 }
@@ -15,6 +15,7 @@
 package matrix
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -47,6 +48,78 @@ func TestMatrixIncluded(t *testing.T) {
 	assert.Equal(t, "3.4", axis[1]["python_version"])
 }
 
+func TestMatrixExclude(t *testing.T) {
+	axis, err := ParseString(`
+matrix:
+  go_version: [1.24, 1.25]
+  os: [linux, windows]
+  exclude:
+    - go_version: 1.24
+      os: windows
+  include:
+    - go_version: tip
+      os: linux
+`)
+	assert.NoError(t, err)
+	assert.Len(t, axis, 4)
+	assert.NotContains(t, axis, Axis{"go_version": "1.24", "os": "windows"})
+	assert.Contains(t, axis, Axis{"go_version": "1.25", "os": "windows"})
+	assert.Equal(t, Axis{"go_version": "tip", "os": "linux"}, axis[3])
+}
+
+func TestMatrixLimit(t *testing.T) {
+	axis, err := ParseString(fakeMatrix, WithLimitCombinations(5))
+	assert.NoError(t, err)
+	assert.Len(t, axis, 5)
+
+	axis, err = ParseString(`
+matrix:
+  version: [1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30]
+`)
+	assert.NoError(t, err)
+	assert.Len(t, axis, DefaultLimitCombinations)
+}
+
+func TestMatrixFile(t *testing.T) {
+	files := map[string]string{
+		"matrix.json": `{"service": ["api", "web", "worker"], "exclude": [{"service": "worker", "go_version": "1.24"}]}`,
+		"nested.yaml": "file: matrix.json",
+	}
+	loader := func(path string) ([]byte, error) {
+		data, ok := files[path]
+		if !ok {
+			return nil, errors.New("file not found")
+		}
+		return []byte(data), nil
+	}
+
+	axis, err := ParseString(`
+matrix:
+  file: matrix.json
+  go_version: [1.24, 1.25]
+  include:
+    - service: docs
+`, WithLoader(loader))
+	assert.NoError(t, err)
+	assert.Len(t, axis, 6)
+	assert.NotContains(t, axis, Axis{"service": "worker", "go_version": "1.24"})
+	assert.Equal(t, Axis{"service": "docs"}, axis[5])
+
+	_, err = ParseString("matrix:\n  file: matrix.json\n")
+	assert.ErrorContains(t, err, "matrix files are not supported")
+
+	_, err = ParseString("matrix:\n  file: missing.json\n", WithLoader(loader))
+	assert.ErrorContains(t, err, "could not load matrix file missing.json")
+
+	_, err = ParseString("matrix:\n  file: nested.yaml\n", WithLoader(loader))
+	assert.ErrorContains(t, err, "can not reference another file")
+
+	// a list named file is an axis
+	axis, err = ParseString("matrix:\n  file: [a.txt, b.txt]\n")
+	assert.NoError(t, err)
+	assert.Len(t, axis, 2)
+}
+
 var fakeMatrix = `
 matrix:
   go_version:
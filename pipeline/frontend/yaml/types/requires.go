@@ -0,0 +1,110 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Keys of the requires section of a workflow.
+const (
+	RequireCPUs    = "cpus"
+	RequireMemory  = "memory"
+	RequireDisk    = "disk"
+	RequireArch    = "arch"
+	RequireRuntime = "runtime"
+)
+
+// Requirement is a condition on a capability of an agent, e.g. `memory: ">=16G"`.
+type Requirement struct {
+	Key string
+	// Op is the comparison of numeric requirements: >=, >, <=, < or ==
+	Op string
+	// Size is the value of numeric requirements with units already applied
+	Size int64
+	// Value is the value of arch and runtime requirements
+	Value string
+}
+
+var sizeUnits = map[string]int64{
+	"":  1,
+	"K": 1 << 10,
+	"M": 1 << 20,
+	"G": 1 << 30,
+	"T": 1 << 40,
+}
+
+// ParseRequirement parses the value of a requirement from the requires section of a workflow.
+// Numeric values are compared with >= unless prefixed by another operator and may use
+// the binary units K, M, G and T, optionally followed by i or B.
+func ParseRequirement(key, value string) (*Requirement, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, fmt.Errorf("requirement %s has no value", key)
+	}
+
+	switch key {
+	case RequireArch, RequireRuntime:
+		return &Requirement{Key: key, Value: value}, nil
+	case RequireCPUs, RequireMemory, RequireDisk:
+	default:
+		return nil, fmt.Errorf("unknown requirement %s", key)
+	}
+
+	op := ">="
+	for _, o := range []string{">=", "<=", "==", ">", "<", "="} {
+		if strings.HasPrefix(value, o) {
+			op = o
+			value = strings.TrimSpace(value[len(o):])
+			break
+		}
+	}
+	if op == "=" {
+		op = "=="
+	}
+
+	number := strings.TrimRight(strings.ToUpper(value), "IB")
+	unit := ""
+	if len(number) > 0 {
+		if _, ok := sizeUnits[number[len(number)-1:]]; ok {
+			unit = number[len(number)-1:]
+			number = number[:len(number)-1]
+		}
+	}
+	size, err := strconv.ParseFloat(strings.TrimSpace(number), 64)
+	if err != nil || size < 0 {
+		return nil, fmt.Errorf("requirement %s has invalid value %q", key, value)
+	}
+
+	return &Requirement{Key: key, Op: op, Size: int64(size * float64(sizeUnits[unit]))}, nil
+}
+
+// MatchSize reports whether the size of a capability meets a numeric requirement.
+func (r *Requirement) MatchSize(size int64) bool {
+	switch r.Op {
+	case ">":
+		return size > r.Size
+	case "<=":
+		return size <= r.Size
+	case "<":
+		return size < r.Size
+	case "==":
+		return size == r.Size
+	default:
+		return size >= r.Size
+	}
+}
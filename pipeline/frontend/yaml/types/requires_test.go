@@ -0,0 +1,60 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRequirement(t *testing.T) {
+	testdata := []struct {
+		key   string
+		value string
+		want  *Requirement
+	}{
+		{key: RequireCPUs, value: "8", want: &Requirement{Key: RequireCPUs, Op: ">=", Size: 8}},
+		{key: RequireCPUs, value: "< 4", want: &Requirement{Key: RequireCPUs, Op: "<", Size: 4}},
+		{key: RequireMemory, value: ">=16G", want: &Requirement{Key: RequireMemory, Op: ">=", Size: 16 << 30}},
+		{key: RequireMemory, value: ">512Mi", want: &Requirement{Key: RequireMemory, Op: ">", Size: 512 << 20}},
+		{key: RequireDisk, value: "=1.5TB", want: &Requirement{Key: RequireDisk, Op: "==", Size: 3 << 39}},
+		{key: RequireArch, value: "arm64", want: &Requirement{Key: RequireArch, Value: "arm64"}},
+		{key: RequireRuntime, value: "nvidia", want: &Requirement{Key: RequireRuntime, Value: "nvidia"}},
+	}
+
+	for _, test := range testdata {
+		got, err := ParseRequirement(test.key, test.value)
+		require.NoError(t, err, test.value)
+		assert.Equal(t, test.want, got, test.value)
+	}
+
+	for _, value := range []string{"", "lots", ">=G", "-4"} {
+		_, err := ParseRequirement(RequireMemory, value)
+		assert.Error(t, err, value)
+	}
+
+	_, err := ParseRequirement("gpus", "1")
+	assert.Error(t, err)
+}
+
+func TestRequirementMatchSize(t *testing.T) {
+	assert.True(t, (&Requirement{Op: ">=", Size: 8}).MatchSize(8))
+	assert.False(t, (&Requirement{Op: ">", Size: 8}).MatchSize(8))
+	assert.True(t, (&Requirement{Op: "<=", Size: 8}).MatchSize(4))
+	assert.False(t, (&Requirement{Op: "<", Size: 8}).MatchSize(8))
+	assert.True(t, (&Requirement{Op: "==", Size: 8}).MatchSize(8))
+}
@@ -29,7 +29,15 @@ type (
 		Labels    map[string]string `yaml:"labels,omitempty"`
 		DependsOn []string          `yaml:"depends_on,omitempty"`
 		RunsOn    []string          `yaml:"runs_on,omitempty"`
+		Requires  map[string]string `yaml:"requires,omitempty"`
 		SkipClone bool              `yaml:"skip_clone"`
+		Report    Report            `yaml:"report,omitempty"`
+	}
+
+	// Report defines the summary of the pipeline posted on pull requests.
+	Report struct {
+		Comment bool     `yaml:"comment,omitempty"`
+		Logs    []string `yaml:"logs,omitempty"`
 	}
 
 	// Workspace defines a pipeline workspace.
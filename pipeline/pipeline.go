@@ -289,6 +289,16 @@ func (r *Runtime) exec(step *backend.Step) (*backend.State, error) {
 		return nil, err
 	}
 
+	// the annotations file has to be read before the step is destroyed
+	if reader, ok := r.engine.(backend.StepAnnotationsReader); ok {
+		annotations, err := reader.ReadStepAnnotations(r.ctx, step, r.taskUUID)
+		if err != nil {
+			logger := r.MakeLogger()
+			logger.Warn().Err(err).Str("step", step.Name).Msg("could not read annotations file of step")
+		}
+		waitState.Annotations = annotations
+	}
+
 	if err := r.engine.DestroyStep(r.ctx, step, r.taskUUID); err != nil {
 		return nil, err
 	}
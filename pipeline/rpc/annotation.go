@@ -0,0 +1,58 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"encoding/json"
+	"slices"
+)
+
+// Levels of annotations.
+const (
+	AnnotationFailure = "failure"
+	AnnotationWarning = "warning"
+	AnnotationNotice  = "notice"
+)
+
+// MaxStepAnnotations is the max number of annotations of a step.
+const MaxStepAnnotations = 50
+
+// ParseAnnotations parses the annotations file written by a step, a json array of annotations.
+// Annotations without a file, line or message are dropped.
+func ParseAnnotations(data []byte) ([]*Annotation, error) {
+	var annotations []*Annotation
+	if err := json.Unmarshal(data, &annotations); err != nil {
+		return nil, err
+	}
+
+	annotations = slices.DeleteFunc(annotations, func(annotation *Annotation) bool {
+		return annotation == nil || annotation.Path == "" || annotation.StartLine < 1 || annotation.Message == ""
+	})
+	for _, annotation := range annotations {
+		if annotation.EndLine < annotation.StartLine {
+			annotation.EndLine = annotation.StartLine
+		}
+		switch annotation.Level {
+		case AnnotationFailure, AnnotationWarning, AnnotationNotice:
+		default:
+			annotation.Level = AnnotationNotice
+		}
+	}
+
+	if len(annotations) > MaxStepAnnotations {
+		annotations = annotations[:MaxStepAnnotations]
+	}
+	return annotations, nil
+}
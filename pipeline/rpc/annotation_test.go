@@ -0,0 +1,38 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAnnotations(t *testing.T) {
+	annotations, err := ParseAnnotations([]byte(`[
+		{"path": "main.go", "start_line": 3, "level": "failure", "title": "vet", "message": "unreachable code"},
+		{"path": "main_test.go", "start_line": 5, "end_line": 7, "start_column": 2, "level": "debug", "message": "slow test"},
+		{"path": "main.go", "message": "no line"},
+		null
+	]`))
+	assert.NoError(t, err)
+	assert.Equal(t, []*Annotation{
+		{Path: "main.go", StartLine: 3, EndLine: 3, Level: AnnotationFailure, Title: "vet", Message: "unreachable code"},
+		{Path: "main_test.go", StartLine: 5, EndLine: 7, StartColumn: 2, Level: AnnotationNotice, Message: "slow test"},
+	}, annotations)
+
+	_, err = ParseAnnotations([]byte("::error file=main.go::message"))
+	assert.Error(t, err)
+}
@@ -38,6 +38,68 @@ func (_m *MockPeer) EXPECT() *MockPeer_Expecter {
 	return &MockPeer_Expecter{mock: &_m.Mock}
 }
 
+// AgentConfig provides a mock function for the type MockPeer
+func (_mock *MockPeer) AgentConfig(c context.Context) (*rpc.AgentConfig, error) {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AgentConfig")
+	}
+
+	var r0 *rpc.AgentConfig
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*rpc.AgentConfig, error)); ok {
+		return returnFunc(c)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *rpc.AgentConfig); ok {
+		r0 = returnFunc(c)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rpc.AgentConfig)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(c)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPeer_AgentConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AgentConfig'
+type MockPeer_AgentConfig_Call struct {
+	*mock.Call
+}
+
+// AgentConfig is a helper method to define mock.On call
+//   - c context.Context
+func (_e *MockPeer_Expecter) AgentConfig(c interface{}) *MockPeer_AgentConfig_Call {
+	return &MockPeer_AgentConfig_Call{Call: _e.mock.On("AgentConfig", c)}
+}
+
+func (_c *MockPeer_AgentConfig_Call) Run(run func(c context.Context)) *MockPeer_AgentConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPeer_AgentConfig_Call) Return(agentConfig *rpc.AgentConfig, err error) *MockPeer_AgentConfig_Call {
+	_c.Call.Return(agentConfig, err)
+	return _c
+}
+
+func (_c *MockPeer_AgentConfig_Call) RunAndReturn(run func(c context.Context) (*rpc.AgentConfig, error)) *MockPeer_AgentConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Done provides a mock function for the type MockPeer
 func (_mock *MockPeer) Done(c context.Context, workflowID string, state rpc.WorkflowState) error {
 	ret := _mock.Called(c, workflowID, state)
@@ -101,6 +163,63 @@ func (_c *MockPeer_Done_Call) RunAndReturn(run func(c context.Context, workflowI
 	return _c
 }
 
+// Drain provides a mock function for the type MockPeer
+func (_mock *MockPeer) Drain(c context.Context, deadline int64) error {
+	ret := _mock.Called(c, deadline)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Drain")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(c, deadline)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPeer_Drain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Drain'
+type MockPeer_Drain_Call struct {
+	*mock.Call
+}
+
+// Drain is a helper method to define mock.On call
+//   - c context.Context
+//   - deadline int64
+func (_e *MockPeer_Expecter) Drain(c interface{}, deadline interface{}) *MockPeer_Drain_Call {
+	return &MockPeer_Drain_Call{Call: _e.mock.On("Drain", c, deadline)}
+}
+
+func (_c *MockPeer_Drain_Call) Run(run func(c context.Context, deadline int64)) *MockPeer_Drain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPeer_Drain_Call) Return(err error) *MockPeer_Drain_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPeer_Drain_Call) RunAndReturn(run func(c context.Context, deadline int64) error) *MockPeer_Drain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // EnqueueLog provides a mock function for the type MockPeer
 func (_mock *MockPeer) EnqueueLog(logEntry *rpc.LogEntry) {
 	_mock.Called(logEntry)
@@ -396,16 +515,16 @@ func (_c *MockPeer_RegisterAgent_Call) RunAndReturn(run func(ctx context.Context
 }
 
 // ReportHealth provides a mock function for the type MockPeer
-func (_mock *MockPeer) ReportHealth(c context.Context) error {
-	ret := _mock.Called(c)
+func (_mock *MockPeer) ReportHealth(c context.Context, diskFree int64) error {
+	ret := _mock.Called(c, diskFree)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ReportHealth")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
-		r0 = returnFunc(c)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(c, diskFree)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -419,18 +538,24 @@ type MockPeer_ReportHealth_Call struct {
 
 // ReportHealth is a helper method to define mock.On call
 //   - c context.Context
-func (_e *MockPeer_Expecter) ReportHealth(c interface{}) *MockPeer_ReportHealth_Call {
-	return &MockPeer_ReportHealth_Call{Call: _e.mock.On("ReportHealth", c)}
+//   - diskFree int64
+func (_e *MockPeer_Expecter) ReportHealth(c interface{}, diskFree interface{}) *MockPeer_ReportHealth_Call {
+	return &MockPeer_ReportHealth_Call{Call: _e.mock.On("ReportHealth", c, diskFree)}
 }
 
-func (_c *MockPeer_ReportHealth_Call) Run(run func(c context.Context)) *MockPeer_ReportHealth_Call {
+func (_c *MockPeer_ReportHealth_Call) Run(run func(c context.Context, diskFree int64)) *MockPeer_ReportHealth_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -441,7 +566,75 @@ func (_c *MockPeer_ReportHealth_Call) Return(err error) *MockPeer_ReportHealth_C
 	return _c
 }
 
-func (_c *MockPeer_ReportHealth_Call) RunAndReturn(run func(c context.Context) error) *MockPeer_ReportHealth_Call {
+func (_c *MockPeer_ReportHealth_Call) RunAndReturn(run func(c context.Context, diskFree int64) error) *MockPeer_ReportHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReportWorkflows provides a mock function for the type MockPeer
+func (_mock *MockPeer) ReportWorkflows(c context.Context, workflowIDs []string) ([]string, error) {
+	ret := _mock.Called(c, workflowIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportWorkflows")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]string, error)); ok {
+		return returnFunc(c, workflowIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []string); ok {
+		r0 = returnFunc(c, workflowIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(c, workflowIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPeer_ReportWorkflows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportWorkflows'
+type MockPeer_ReportWorkflows_Call struct {
+	*mock.Call
+}
+
+// ReportWorkflows is a helper method to define mock.On call
+//   - c context.Context
+//   - workflowIDs []string
+func (_e *MockPeer_Expecter) ReportWorkflows(c interface{}, workflowIDs interface{}) *MockPeer_ReportWorkflows_Call {
+	return &MockPeer_ReportWorkflows_Call{Call: _e.mock.On("ReportWorkflows", c, workflowIDs)}
+}
+
+func (_c *MockPeer_ReportWorkflows_Call) Run(run func(c context.Context, workflowIDs []string)) *MockPeer_ReportWorkflows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPeer_ReportWorkflows_Call) Return(canceled []string, err error) *MockPeer_ReportWorkflows_Call {
+	_c.Call.Return(canceled, err)
+	return _c
+}
+
+func (_c *MockPeer_ReportWorkflows_Call) RunAndReturn(run func(c context.Context, workflowIDs []string) ([]string, error)) *MockPeer_ReportWorkflows_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -35,6 +35,20 @@ type (
 		Exited   bool   `json:"exited"`
 		ExitCode int    `json:"exit_code"`
 		Error    string `json:"error"`
+		// Annotations of the results of the exited step, e.g. from tests or linters.
+		Annotations []*Annotation `json:"annotations,omitempty"`
+	}
+
+	// Annotation marks a position in a file of the repository with a message.
+	Annotation struct {
+		Path        string `json:"path"`
+		StartLine   int    `json:"start_line"`
+		EndLine     int    `json:"end_line,omitempty"`
+		StartColumn int    `json:"start_column,omitempty"`
+		EndColumn   int    `json:"end_column,omitempty"`
+		Level       string `json:"level"`
+		Title       string `json:"title,omitempty"`
+		Message     string `json:"message"`
 	}
 
 	// WorkflowState defines the workflow state.
@@ -63,6 +77,24 @@ type (
 		Backend      string            `json:"backend"`
 		Capacity     int               `json:"capacity"`
 		CustomLabels map[string]string `json:"custom_labels"`
+		Capabilities Capabilities      `json:"capabilities"`
+		// Reload is set if the agent registers again after reloading its config
+		Reload bool `json:"reload"`
+	}
+
+	// AgentConfig is the config an admin pushed to an agent, it overrides the agent's own config.
+	AgentConfig struct {
+		Capacity int               `json:"capacity"` // 0 keeps the capacity of the agent
+		Labels   map[string]string `json:"labels"`
+	}
+
+	// Capabilities of the machine running the workflows of an agent, zero values are unknown.
+	Capabilities struct {
+		CPUs          int      `json:"cpus"`
+		Memory        int64    `json:"memory"`
+		DiskFree      int64    `json:"disk_free"`
+		Architectures []string `json:"architectures"`
+		Runtimes      []string `json:"runtimes"`
 	}
 )
 
@@ -98,6 +130,18 @@ type Peer interface {
 	// UnregisterAgent unregister our agent from the server
 	UnregisterAgent(ctx context.Context) error
 
-	// ReportHealth reports health status of the agent to the server
-	ReportHealth(c context.Context) error
+	// ReportHealth reports health status of the agent to the server,
+	// diskFree refreshes the free disk space of the capabilities (0 if unknown)
+	ReportHealth(c context.Context, diskFree int64) error
+
+	// Drain reports that the agent stops taking new workflows and shuts down
+	// once its running workflows are done or at the given unix deadline
+	Drain(c context.Context, deadline int64) error
+
+	// ReportWorkflows reports the workflows the agent is still running, so the server
+	// keeps them attached to the agent after a restart, and returns the ones to cancel
+	ReportWorkflows(c context.Context, workflowIDs []string) ([]string, error)
+
+	// AgentConfig returns the config pushed to the agent by the server
+	AgentConfig(c context.Context) (*AgentConfig, error)
 }
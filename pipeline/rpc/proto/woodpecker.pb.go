@@ -44,6 +44,7 @@ type StepState struct {
 	Exited        bool                   `protobuf:"varint,4,opt,name=exited,proto3" json:"exited,omitempty"`
 	ExitCode      int32                  `protobuf:"varint,5,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
 	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	Annotations   []*Annotation          `protobuf:"bytes,7,rep,name=annotations,proto3" json:"annotations,omitempty"` // annotations of the results of the exited step
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -120,6 +121,113 @@ func (x *StepState) GetError() string {
 	return ""
 }
 
+func (x *StepState) GetAnnotations() []*Annotation {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+type Annotation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	StartLine     int32                  `protobuf:"varint,2,opt,name=start_line,json=startLine,proto3" json:"start_line,omitempty"`
+	EndLine       int32                  `protobuf:"varint,3,opt,name=end_line,json=endLine,proto3" json:"end_line,omitempty"`
+	StartColumn   int32                  `protobuf:"varint,4,opt,name=start_column,json=startColumn,proto3" json:"start_column,omitempty"`
+	EndColumn     int32                  `protobuf:"varint,5,opt,name=end_column,json=endColumn,proto3" json:"end_column,omitempty"`
+	Level         string                 `protobuf:"bytes,6,opt,name=level,proto3" json:"level,omitempty"` // failure, warning or notice
+	Title         string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	Message       string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Annotation) Reset() {
+	*x = Annotation{}
+	mi := &file_woodpecker_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Annotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Annotation) ProtoMessage() {}
+
+func (x *Annotation) ProtoReflect() protoreflect.Message {
+	mi := &file_woodpecker_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Annotation.ProtoReflect.Descriptor instead.
+func (*Annotation) Descriptor() ([]byte, []int) {
+	return file_woodpecker_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Annotation) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Annotation) GetStartLine() int32 {
+	if x != nil {
+		return x.StartLine
+	}
+	return 0
+}
+
+func (x *Annotation) GetEndLine() int32 {
+	if x != nil {
+		return x.EndLine
+	}
+	return 0
+}
+
+func (x *Annotation) GetStartColumn() int32 {
+	if x != nil {
+		return x.StartColumn
+	}
+	return 0
+}
+
+func (x *Annotation) GetEndColumn() int32 {
+	if x != nil {
+		return x.EndColumn
+	}
+	return 0
+}
+
+func (x *Annotation) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *Annotation) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Annotation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type WorkflowState struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Started       int64                  `protobuf:"varint,4,opt,name=started,proto3" json:"started,omitempty"`
@@ -131,7 +239,7 @@ type WorkflowState struct {
 
 func (x *WorkflowState) Reset() {
 	*x = WorkflowState{}
-	mi := &file_woodpecker_proto_msgTypes[1]
+	mi := &file_woodpecker_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -143,7 +251,7 @@ func (x *WorkflowState) String() string {
 func (*WorkflowState) ProtoMessage() {}
 
 func (x *WorkflowState) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[1]
+	mi := &file_woodpecker_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -156,7 +264,7 @@ func (x *WorkflowState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkflowState.ProtoReflect.Descriptor instead.
 func (*WorkflowState) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{1}
+	return file_woodpecker_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *WorkflowState) GetStarted() int64 {
@@ -193,7 +301,7 @@ type LogEntry struct {
 
 func (x *LogEntry) Reset() {
 	*x = LogEntry{}
-	mi := &file_woodpecker_proto_msgTypes[2]
+	mi := &file_woodpecker_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -205,7 +313,7 @@ func (x *LogEntry) String() string {
 func (*LogEntry) ProtoMessage() {}
 
 func (x *LogEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[2]
+	mi := &file_woodpecker_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -218,7 +326,7 @@ func (x *LogEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{2}
+	return file_woodpecker_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *LogEntry) GetStepUuid() string {
@@ -265,7 +373,7 @@ type Filter struct {
 
 func (x *Filter) Reset() {
 	*x = Filter{}
-	mi := &file_woodpecker_proto_msgTypes[3]
+	mi := &file_woodpecker_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -277,7 +385,7 @@ func (x *Filter) String() string {
 func (*Filter) ProtoMessage() {}
 
 func (x *Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[3]
+	mi := &file_woodpecker_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -290,7 +398,7 @@ func (x *Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Filter.ProtoReflect.Descriptor instead.
 func (*Filter) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{3}
+	return file_woodpecker_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Filter) GetLabels() map[string]string {
@@ -311,7 +419,7 @@ type Workflow struct {
 
 func (x *Workflow) Reset() {
 	*x = Workflow{}
-	mi := &file_woodpecker_proto_msgTypes[4]
+	mi := &file_woodpecker_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -323,7 +431,7 @@ func (x *Workflow) String() string {
 func (*Workflow) ProtoMessage() {}
 
 func (x *Workflow) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[4]
+	mi := &file_woodpecker_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -336,7 +444,7 @@ func (x *Workflow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Workflow.ProtoReflect.Descriptor instead.
 func (*Workflow) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{4}
+	return file_woodpecker_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Workflow) GetId() string {
@@ -369,7 +477,7 @@ type NextRequest struct {
 
 func (x *NextRequest) Reset() {
 	*x = NextRequest{}
-	mi := &file_woodpecker_proto_msgTypes[5]
+	mi := &file_woodpecker_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -381,7 +489,7 @@ func (x *NextRequest) String() string {
 func (*NextRequest) ProtoMessage() {}
 
 func (x *NextRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[5]
+	mi := &file_woodpecker_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -394,7 +502,7 @@ func (x *NextRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NextRequest.ProtoReflect.Descriptor instead.
 func (*NextRequest) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{5}
+	return file_woodpecker_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *NextRequest) GetFilter() *Filter {
@@ -414,7 +522,7 @@ type InitRequest struct {
 
 func (x *InitRequest) Reset() {
 	*x = InitRequest{}
-	mi := &file_woodpecker_proto_msgTypes[6]
+	mi := &file_woodpecker_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -426,7 +534,7 @@ func (x *InitRequest) String() string {
 func (*InitRequest) ProtoMessage() {}
 
 func (x *InitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[6]
+	mi := &file_woodpecker_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -439,7 +547,7 @@ func (x *InitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitRequest.ProtoReflect.Descriptor instead.
 func (*InitRequest) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{6}
+	return file_woodpecker_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *InitRequest) GetId() string {
@@ -465,7 +573,7 @@ type WaitRequest struct {
 
 func (x *WaitRequest) Reset() {
 	*x = WaitRequest{}
-	mi := &file_woodpecker_proto_msgTypes[7]
+	mi := &file_woodpecker_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -477,7 +585,7 @@ func (x *WaitRequest) String() string {
 func (*WaitRequest) ProtoMessage() {}
 
 func (x *WaitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[7]
+	mi := &file_woodpecker_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -490,7 +598,7 @@ func (x *WaitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WaitRequest.ProtoReflect.Descriptor instead.
 func (*WaitRequest) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{7}
+	return file_woodpecker_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *WaitRequest) GetId() string {
@@ -510,7 +618,7 @@ type DoneRequest struct {
 
 func (x *DoneRequest) Reset() {
 	*x = DoneRequest{}
-	mi := &file_woodpecker_proto_msgTypes[8]
+	mi := &file_woodpecker_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -522,7 +630,7 @@ func (x *DoneRequest) String() string {
 func (*DoneRequest) ProtoMessage() {}
 
 func (x *DoneRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[8]
+	mi := &file_woodpecker_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -535,7 +643,7 @@ func (x *DoneRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DoneRequest.ProtoReflect.Descriptor instead.
 func (*DoneRequest) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{8}
+	return file_woodpecker_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DoneRequest) GetId() string {
@@ -561,7 +669,7 @@ type ExtendRequest struct {
 
 func (x *ExtendRequest) Reset() {
 	*x = ExtendRequest{}
-	mi := &file_woodpecker_proto_msgTypes[9]
+	mi := &file_woodpecker_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -573,7 +681,7 @@ func (x *ExtendRequest) String() string {
 func (*ExtendRequest) ProtoMessage() {}
 
 func (x *ExtendRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[9]
+	mi := &file_woodpecker_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +694,7 @@ func (x *ExtendRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtendRequest.ProtoReflect.Descriptor instead.
 func (*ExtendRequest) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{9}
+	return file_woodpecker_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ExtendRequest) GetId() string {
@@ -606,7 +714,7 @@ type UpdateRequest struct {
 
 func (x *UpdateRequest) Reset() {
 	*x = UpdateRequest{}
-	mi := &file_woodpecker_proto_msgTypes[10]
+	mi := &file_woodpecker_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -618,7 +726,7 @@ func (x *UpdateRequest) String() string {
 func (*UpdateRequest) ProtoMessage() {}
 
 func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[10]
+	mi := &file_woodpecker_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -631,7 +739,7 @@ func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
 func (*UpdateRequest) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{10}
+	return file_woodpecker_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateRequest) GetId() string {
@@ -657,7 +765,7 @@ type LogRequest struct {
 
 func (x *LogRequest) Reset() {
 	*x = LogRequest{}
-	mi := &file_woodpecker_proto_msgTypes[11]
+	mi := &file_woodpecker_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -669,7 +777,7 @@ func (x *LogRequest) String() string {
 func (*LogRequest) ProtoMessage() {}
 
 func (x *LogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[11]
+	mi := &file_woodpecker_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -682,7 +790,7 @@ func (x *LogRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogRequest.ProtoReflect.Descriptor instead.
 func (*LogRequest) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{11}
+	return file_woodpecker_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *LogRequest) GetLogEntries() []*LogEntry {
@@ -700,7 +808,7 @@ type Empty struct {
 
 func (x *Empty) Reset() {
 	*x = Empty{}
-	mi := &file_woodpecker_proto_msgTypes[12]
+	mi := &file_woodpecker_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -712,7 +820,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[12]
+	mi := &file_woodpecker_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,19 +833,20 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{12}
+	return file_woodpecker_proto_rawDescGZIP(), []int{13}
 }
 
 type ReportHealthRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DiskFree      int64                  `protobuf:"varint,2,opt,name=disk_free,json=diskFree,proto3" json:"disk_free,omitempty"` // free disk space of the workflow data in bytes, 0 if unknown
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ReportHealthRequest) Reset() {
 	*x = ReportHealthRequest{}
-	mi := &file_woodpecker_proto_msgTypes[13]
+	mi := &file_woodpecker_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -749,7 +858,7 @@ func (x *ReportHealthRequest) String() string {
 func (*ReportHealthRequest) ProtoMessage() {}
 
 func (x *ReportHealthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[13]
+	mi := &file_woodpecker_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -762,7 +871,7 @@ func (x *ReportHealthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportHealthRequest.ProtoReflect.Descriptor instead.
 func (*ReportHealthRequest) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{13}
+	return file_woodpecker_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ReportHealthRequest) GetStatus() string {
@@ -772,6 +881,101 @@ func (x *ReportHealthRequest) GetStatus() string {
 	return ""
 }
 
+func (x *ReportHealthRequest) GetDiskFree() int64 {
+	if x != nil {
+		return x.DiskFree
+	}
+	return 0
+}
+
+type DrainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deadline      int64                  `protobuf:"varint,1,opt,name=deadline,proto3" json:"deadline,omitempty"` // unix timestamp the agent cancels its workflows at, 0 if it waits for them
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrainRequest) Reset() {
+	*x = DrainRequest{}
+	mi := &file_woodpecker_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainRequest) ProtoMessage() {}
+
+func (x *DrainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_woodpecker_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainRequest.ProtoReflect.Descriptor instead.
+func (*DrainRequest) Descriptor() ([]byte, []int) {
+	return file_woodpecker_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DrainRequest) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+type ReportWorkflowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowIds   []string               `protobuf:"bytes,1,rep,name=workflow_ids,json=workflowIds,proto3" json:"workflow_ids,omitempty"` // workflows the agent is still running
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportWorkflowsRequest) Reset() {
+	*x = ReportWorkflowsRequest{}
+	mi := &file_woodpecker_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportWorkflowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportWorkflowsRequest) ProtoMessage() {}
+
+func (x *ReportWorkflowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_woodpecker_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportWorkflowsRequest.ProtoReflect.Descriptor instead.
+func (*ReportWorkflowsRequest) Descriptor() ([]byte, []int) {
+	return file_woodpecker_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReportWorkflowsRequest) GetWorkflowIds() []string {
+	if x != nil {
+		return x.WorkflowIds
+	}
+	return nil
+}
+
 type AgentInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Platform      string                 `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
@@ -779,13 +983,15 @@ type AgentInfo struct {
 	Backend       string                 `protobuf:"bytes,3,opt,name=backend,proto3" json:"backend,omitempty"`
 	Version       string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
 	CustomLabels  map[string]string      `protobuf:"bytes,5,rep,name=customLabels,proto3" json:"customLabels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Capabilities  *Capabilities          `protobuf:"bytes,6,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	Reload        bool                   `protobuf:"varint,7,opt,name=reload,proto3" json:"reload,omitempty"` // registered again after a config reload, keeps the drain of the agent
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AgentInfo) Reset() {
 	*x = AgentInfo{}
-	mi := &file_woodpecker_proto_msgTypes[14]
+	mi := &file_woodpecker_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -797,7 +1003,7 @@ func (x *AgentInfo) String() string {
 func (*AgentInfo) ProtoMessage() {}
 
 func (x *AgentInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[14]
+	mi := &file_woodpecker_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -810,7 +1016,7 @@ func (x *AgentInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentInfo.ProtoReflect.Descriptor instead.
 func (*AgentInfo) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{14}
+	return file_woodpecker_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *AgentInfo) GetPlatform() string {
@@ -848,6 +1054,96 @@ func (x *AgentInfo) GetCustomLabels() map[string]string {
 	return nil
 }
 
+func (x *AgentInfo) GetCapabilities() *Capabilities {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *AgentInfo) GetReload() bool {
+	if x != nil {
+		return x.Reload
+	}
+	return false
+}
+
+type Capabilities struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cpus          int32                  `protobuf:"varint,1,opt,name=cpus,proto3" json:"cpus,omitempty"`
+	Memory        int64                  `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	DiskFree      int64                  `protobuf:"varint,3,opt,name=disk_free,json=diskFree,proto3" json:"disk_free,omitempty"`
+	Architectures []string               `protobuf:"bytes,4,rep,name=architectures,proto3" json:"architectures,omitempty"`
+	Runtimes      []string               `protobuf:"bytes,5,rep,name=runtimes,proto3" json:"runtimes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Capabilities) Reset() {
+	*x = Capabilities{}
+	mi := &file_woodpecker_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Capabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Capabilities) ProtoMessage() {}
+
+func (x *Capabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_woodpecker_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Capabilities.ProtoReflect.Descriptor instead.
+func (*Capabilities) Descriptor() ([]byte, []int) {
+	return file_woodpecker_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Capabilities) GetCpus() int32 {
+	if x != nil {
+		return x.Cpus
+	}
+	return 0
+}
+
+func (x *Capabilities) GetMemory() int64 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *Capabilities) GetDiskFree() int64 {
+	if x != nil {
+		return x.DiskFree
+	}
+	return 0
+}
+
+func (x *Capabilities) GetArchitectures() []string {
+	if x != nil {
+		return x.Architectures
+	}
+	return nil
+}
+
+func (x *Capabilities) GetRuntimes() []string {
+	if x != nil {
+		return x.Runtimes
+	}
+	return nil
+}
+
 type RegisterAgentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Info          *AgentInfo             `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
@@ -857,7 +1153,7 @@ type RegisterAgentRequest struct {
 
 func (x *RegisterAgentRequest) Reset() {
 	*x = RegisterAgentRequest{}
-	mi := &file_woodpecker_proto_msgTypes[15]
+	mi := &file_woodpecker_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -869,7 +1165,7 @@ func (x *RegisterAgentRequest) String() string {
 func (*RegisterAgentRequest) ProtoMessage() {}
 
 func (x *RegisterAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[15]
+	mi := &file_woodpecker_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -882,7 +1178,7 @@ func (x *RegisterAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterAgentRequest.ProtoReflect.Descriptor instead.
 func (*RegisterAgentRequest) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{15}
+	return file_woodpecker_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *RegisterAgentRequest) GetInfo() *AgentInfo {
@@ -902,7 +1198,7 @@ type VersionResponse struct {
 
 func (x *VersionResponse) Reset() {
 	*x = VersionResponse{}
-	mi := &file_woodpecker_proto_msgTypes[16]
+	mi := &file_woodpecker_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -914,7 +1210,7 @@ func (x *VersionResponse) String() string {
 func (*VersionResponse) ProtoMessage() {}
 
 func (x *VersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[16]
+	mi := &file_woodpecker_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -927,7 +1223,7 @@ func (x *VersionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
 func (*VersionResponse) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{16}
+	return file_woodpecker_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *VersionResponse) GetGrpcVersion() int32 {
@@ -953,7 +1249,7 @@ type NextResponse struct {
 
 func (x *NextResponse) Reset() {
 	*x = NextResponse{}
-	mi := &file_woodpecker_proto_msgTypes[17]
+	mi := &file_woodpecker_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -965,7 +1261,7 @@ func (x *NextResponse) String() string {
 func (*NextResponse) ProtoMessage() {}
 
 func (x *NextResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[17]
+	mi := &file_woodpecker_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -978,7 +1274,7 @@ func (x *NextResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NextResponse.ProtoReflect.Descriptor instead.
 func (*NextResponse) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{17}
+	return file_woodpecker_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *NextResponse) GetWorkflow() *Workflow {
@@ -997,7 +1293,7 @@ type RegisterAgentResponse struct {
 
 func (x *RegisterAgentResponse) Reset() {
 	*x = RegisterAgentResponse{}
-	mi := &file_woodpecker_proto_msgTypes[18]
+	mi := &file_woodpecker_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1009,7 +1305,7 @@ func (x *RegisterAgentResponse) String() string {
 func (*RegisterAgentResponse) ProtoMessage() {}
 
 func (x *RegisterAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[18]
+	mi := &file_woodpecker_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1022,7 +1318,7 @@ func (x *RegisterAgentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterAgentResponse.ProtoReflect.Descriptor instead.
 func (*RegisterAgentResponse) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{18}
+	return file_woodpecker_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *RegisterAgentResponse) GetAgentId() int64 {
@@ -1032,6 +1328,102 @@ func (x *RegisterAgentResponse) GetAgentId() int64 {
 	return 0
 }
 
+type ReportWorkflowsResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CanceledWorkflowIds []string               `protobuf:"bytes,1,rep,name=canceled_workflow_ids,json=canceledWorkflowIds,proto3" json:"canceled_workflow_ids,omitempty"` // workflows the agent has to cancel
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ReportWorkflowsResponse) Reset() {
+	*x = ReportWorkflowsResponse{}
+	mi := &file_woodpecker_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportWorkflowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportWorkflowsResponse) ProtoMessage() {}
+
+func (x *ReportWorkflowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_woodpecker_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportWorkflowsResponse.ProtoReflect.Descriptor instead.
+func (*ReportWorkflowsResponse) Descriptor() ([]byte, []int) {
+	return file_woodpecker_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReportWorkflowsResponse) GetCanceledWorkflowIds() []string {
+	if x != nil {
+		return x.CanceledWorkflowIds
+	}
+	return nil
+}
+
+type AgentConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Capacity      int32                  `protobuf:"varint,1,opt,name=capacity,proto3" json:"capacity,omitempty"`                                                                      // overrides the capacity of the agent if set
+	Labels        map[string]string      `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // overrides custom labels of the agent
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgentConfigResponse) Reset() {
+	*x = AgentConfigResponse{}
+	mi := &file_woodpecker_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentConfigResponse) ProtoMessage() {}
+
+func (x *AgentConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_woodpecker_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentConfigResponse.ProtoReflect.Descriptor instead.
+func (*AgentConfigResponse) Descriptor() ([]byte, []int) {
+	return file_woodpecker_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AgentConfigResponse) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *AgentConfigResponse) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
 type AuthRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AgentToken    string                 `protobuf:"bytes,1,opt,name=agent_token,json=agentToken,proto3" json:"agent_token,omitempty"`
@@ -1042,7 +1434,7 @@ type AuthRequest struct {
 
 func (x *AuthRequest) Reset() {
 	*x = AuthRequest{}
-	mi := &file_woodpecker_proto_msgTypes[19]
+	mi := &file_woodpecker_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1054,7 +1446,7 @@ func (x *AuthRequest) String() string {
 func (*AuthRequest) ProtoMessage() {}
 
 func (x *AuthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[19]
+	mi := &file_woodpecker_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1067,7 +1459,7 @@ func (x *AuthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthRequest.ProtoReflect.Descriptor instead.
 func (*AuthRequest) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{19}
+	return file_woodpecker_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *AuthRequest) GetAgentToken() string {
@@ -1095,7 +1487,7 @@ type AuthResponse struct {
 
 func (x *AuthResponse) Reset() {
 	*x = AuthResponse{}
-	mi := &file_woodpecker_proto_msgTypes[20]
+	mi := &file_woodpecker_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1107,7 +1499,7 @@ func (x *AuthResponse) String() string {
 func (*AuthResponse) ProtoMessage() {}
 
 func (x *AuthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[20]
+	mi := &file_woodpecker_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1120,7 +1512,7 @@ func (x *AuthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthResponse.ProtoReflect.Descriptor instead.
 func (*AuthResponse) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{20}
+	return file_woodpecker_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *AuthResponse) GetStatus() string {
@@ -1148,14 +1540,27 @@ var File_woodpecker_proto protoreflect.FileDescriptor
 
 const file_woodpecker_proto_rawDesc = "" +
 	"\n" +
-	"\x10woodpecker.proto\x12\x05proto\"\xa9\x01\n" +
+	"\x10woodpecker.proto\x12\x05proto\"\xde\x01\n" +
 	"\tStepState\x12\x1b\n" +
 	"\tstep_uuid\x18\x01 \x01(\tR\bstepUuid\x12\x18\n" +
 	"\astarted\x18\x02 \x01(\x03R\astarted\x12\x1a\n" +
 	"\bfinished\x18\x03 \x01(\x03R\bfinished\x12\x16\n" +
 	"\x06exited\x18\x04 \x01(\bR\x06exited\x12\x1b\n" +
 	"\texit_code\x18\x05 \x01(\x05R\bexitCode\x12\x14\n" +
-	"\x05error\x18\x06 \x01(\tR\x05error\"[\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x123\n" +
+	"\vannotations\x18\a \x03(\v2\x11.proto.AnnotationR\vannotations\"\xe2\x01\n" +
+	"\n" +
+	"Annotation\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1d\n" +
+	"\n" +
+	"start_line\x18\x02 \x01(\x05R\tstartLine\x12\x19\n" +
+	"\bend_line\x18\x03 \x01(\x05R\aendLine\x12!\n" +
+	"\fstart_column\x18\x04 \x01(\x05R\vstartColumn\x12\x1d\n" +
+	"\n" +
+	"end_column\x18\x05 \x01(\x05R\tendColumn\x12\x14\n" +
+	"\x05level\x18\x06 \x01(\tR\x05level\x12\x14\n" +
+	"\x05title\x18\a \x01(\tR\x05title\x12\x18\n" +
+	"\amessage\x18\b \x01(\tR\amessage\"[\n" +
 	"\rWorkflowState\x12\x18\n" +
 	"\astarted\x18\x04 \x01(\x03R\astarted\x12\x1a\n" +
 	"\bfinished\x18\x05 \x01(\x03R\bfinished\x12\x14\n" +
@@ -1195,18 +1600,31 @@ const file_woodpecker_proto_rawDesc = "" +
 	"\n" +
 	"logEntries\x18\x01 \x03(\v2\x0f.proto.LogEntryR\n" +
 	"logEntries\"\a\n" +
-	"\x05Empty\"-\n" +
+	"\x05Empty\"J\n" +
 	"\x13ReportHealthRequest\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\"\x80\x02\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1b\n" +
+	"\tdisk_free\x18\x02 \x01(\x03R\bdiskFree\"*\n" +
+	"\fDrainRequest\x12\x1a\n" +
+	"\bdeadline\x18\x01 \x01(\x03R\bdeadline\";\n" +
+	"\x16ReportWorkflowsRequest\x12!\n" +
+	"\fworkflow_ids\x18\x01 \x03(\tR\vworkflowIds\"\xd1\x02\n" +
 	"\tAgentInfo\x12\x1a\n" +
 	"\bplatform\x18\x01 \x01(\tR\bplatform\x12\x1a\n" +
 	"\bcapacity\x18\x02 \x01(\x05R\bcapacity\x12\x18\n" +
 	"\abackend\x18\x03 \x01(\tR\abackend\x12\x18\n" +
 	"\aversion\x18\x04 \x01(\tR\aversion\x12F\n" +
-	"\fcustomLabels\x18\x05 \x03(\v2\".proto.AgentInfo.CustomLabelsEntryR\fcustomLabels\x1a?\n" +
+	"\fcustomLabels\x18\x05 \x03(\v2\".proto.AgentInfo.CustomLabelsEntryR\fcustomLabels\x127\n" +
+	"\fcapabilities\x18\x06 \x01(\v2\x13.proto.CapabilitiesR\fcapabilities\x12\x16\n" +
+	"\x06reload\x18\a \x01(\bR\x06reload\x1a?\n" +
 	"\x11CustomLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"<\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x99\x01\n" +
+	"\fCapabilities\x12\x12\n" +
+	"\x04cpus\x18\x01 \x01(\x05R\x04cpus\x12\x16\n" +
+	"\x06memory\x18\x02 \x01(\x03R\x06memory\x12\x1b\n" +
+	"\tdisk_free\x18\x03 \x01(\x03R\bdiskFree\x12$\n" +
+	"\rarchitectures\x18\x04 \x03(\tR\rarchitectures\x12\x1a\n" +
+	"\bruntimes\x18\x05 \x03(\tR\bruntimes\"<\n" +
 	"\x14RegisterAgentRequest\x12$\n" +
 	"\x04info\x18\x01 \x01(\v2\x10.proto.AgentInfoR\x04info\"[\n" +
 	"\x0fVersionResponse\x12!\n" +
@@ -1215,7 +1633,15 @@ const file_woodpecker_proto_rawDesc = "" +
 	"\fNextResponse\x12+\n" +
 	"\bworkflow\x18\x01 \x01(\v2\x0f.proto.WorkflowR\bworkflow\"2\n" +
 	"\x15RegisterAgentResponse\x12\x19\n" +
-	"\bagent_id\x18\x01 \x01(\x03R\aagentId\"I\n" +
+	"\bagent_id\x18\x01 \x01(\x03R\aagentId\"M\n" +
+	"\x17ReportWorkflowsResponse\x122\n" +
+	"\x15canceled_workflow_ids\x18\x01 \x03(\tR\x13canceledWorkflowIds\"\xac\x01\n" +
+	"\x13AgentConfigResponse\x12\x1a\n" +
+	"\bcapacity\x18\x01 \x01(\x05R\bcapacity\x12>\n" +
+	"\x06labels\x18\x02 \x03(\v2&.proto.AgentConfigResponse.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"I\n" +
 	"\vAuthRequest\x12\x1f\n" +
 	"\vagent_token\x18\x01 \x01(\tR\n" +
 	"agentToken\x12\x19\n" +
@@ -1223,7 +1649,7 @@ const file_woodpecker_proto_rawDesc = "" +
 	"\fAuthResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x19\n" +
 	"\bagent_id\x18\x02 \x01(\x03R\aagentId\x12!\n" +
-	"\faccess_token\x18\x03 \x01(\tR\vaccessToken2\xbb\x04\n" +
+	"\faccess_token\x18\x03 \x01(\tR\vaccessToken2\xf8\x05\n" +
 	"\n" +
 	"Woodpecker\x121\n" +
 	"\aVersion\x12\f.proto.Empty\x1a\x16.proto.VersionResponse\"\x00\x121\n" +
@@ -1236,7 +1662,10 @@ const file_woodpecker_proto_rawDesc = "" +
 	"\x03Log\x12\x11.proto.LogRequest\x1a\f.proto.Empty\"\x00\x12L\n" +
 	"\rRegisterAgent\x12\x1b.proto.RegisterAgentRequest\x1a\x1c.proto.RegisterAgentResponse\"\x00\x12/\n" +
 	"\x0fUnregisterAgent\x12\f.proto.Empty\x1a\f.proto.Empty\"\x00\x12:\n" +
-	"\fReportHealth\x12\x1a.proto.ReportHealthRequest\x1a\f.proto.Empty\"\x002C\n" +
+	"\fReportHealth\x12\x1a.proto.ReportHealthRequest\x1a\f.proto.Empty\"\x00\x12,\n" +
+	"\x05Drain\x12\x13.proto.DrainRequest\x1a\f.proto.Empty\"\x00\x12R\n" +
+	"\x0fReportWorkflows\x12\x1d.proto.ReportWorkflowsRequest\x1a\x1e.proto.ReportWorkflowsResponse\"\x00\x129\n" +
+	"\vAgentConfig\x12\f.proto.Empty\x1a\x1a.proto.AgentConfigResponse\"\x002C\n" +
 	"\x0eWoodpeckerAuth\x121\n" +
 	"\x04Auth\x12\x12.proto.AuthRequest\x1a\x13.proto.AuthResponse\"\x00B7Z5go.woodpecker-ci.org/woodpecker/v3/pipeline/rpc/protob\x06proto3"
 
@@ -1252,71 +1681,87 @@ func file_woodpecker_proto_rawDescGZIP() []byte {
 	return file_woodpecker_proto_rawDescData
 }
 
-var file_woodpecker_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_woodpecker_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_woodpecker_proto_goTypes = []any{
-	(*StepState)(nil),             // 0: proto.StepState
-	(*WorkflowState)(nil),         // 1: proto.WorkflowState
-	(*LogEntry)(nil),              // 2: proto.LogEntry
-	(*Filter)(nil),                // 3: proto.Filter
-	(*Workflow)(nil),              // 4: proto.Workflow
-	(*NextRequest)(nil),           // 5: proto.NextRequest
-	(*InitRequest)(nil),           // 6: proto.InitRequest
-	(*WaitRequest)(nil),           // 7: proto.WaitRequest
-	(*DoneRequest)(nil),           // 8: proto.DoneRequest
-	(*ExtendRequest)(nil),         // 9: proto.ExtendRequest
-	(*UpdateRequest)(nil),         // 10: proto.UpdateRequest
-	(*LogRequest)(nil),            // 11: proto.LogRequest
-	(*Empty)(nil),                 // 12: proto.Empty
-	(*ReportHealthRequest)(nil),   // 13: proto.ReportHealthRequest
-	(*AgentInfo)(nil),             // 14: proto.AgentInfo
-	(*RegisterAgentRequest)(nil),  // 15: proto.RegisterAgentRequest
-	(*VersionResponse)(nil),       // 16: proto.VersionResponse
-	(*NextResponse)(nil),          // 17: proto.NextResponse
-	(*RegisterAgentResponse)(nil), // 18: proto.RegisterAgentResponse
-	(*AuthRequest)(nil),           // 19: proto.AuthRequest
-	(*AuthResponse)(nil),          // 20: proto.AuthResponse
-	nil,                           // 21: proto.Filter.LabelsEntry
-	nil,                           // 22: proto.AgentInfo.CustomLabelsEntry
+	(*StepState)(nil),               // 0: proto.StepState
+	(*Annotation)(nil),              // 1: proto.Annotation
+	(*WorkflowState)(nil),           // 2: proto.WorkflowState
+	(*LogEntry)(nil),                // 3: proto.LogEntry
+	(*Filter)(nil),                  // 4: proto.Filter
+	(*Workflow)(nil),                // 5: proto.Workflow
+	(*NextRequest)(nil),             // 6: proto.NextRequest
+	(*InitRequest)(nil),             // 7: proto.InitRequest
+	(*WaitRequest)(nil),             // 8: proto.WaitRequest
+	(*DoneRequest)(nil),             // 9: proto.DoneRequest
+	(*ExtendRequest)(nil),           // 10: proto.ExtendRequest
+	(*UpdateRequest)(nil),           // 11: proto.UpdateRequest
+	(*LogRequest)(nil),              // 12: proto.LogRequest
+	(*Empty)(nil),                   // 13: proto.Empty
+	(*ReportHealthRequest)(nil),     // 14: proto.ReportHealthRequest
+	(*DrainRequest)(nil),            // 15: proto.DrainRequest
+	(*ReportWorkflowsRequest)(nil),  // 16: proto.ReportWorkflowsRequest
+	(*AgentInfo)(nil),               // 17: proto.AgentInfo
+	(*Capabilities)(nil),            // 18: proto.Capabilities
+	(*RegisterAgentRequest)(nil),    // 19: proto.RegisterAgentRequest
+	(*VersionResponse)(nil),         // 20: proto.VersionResponse
+	(*NextResponse)(nil),            // 21: proto.NextResponse
+	(*RegisterAgentResponse)(nil),   // 22: proto.RegisterAgentResponse
+	(*ReportWorkflowsResponse)(nil), // 23: proto.ReportWorkflowsResponse
+	(*AgentConfigResponse)(nil),     // 24: proto.AgentConfigResponse
+	(*AuthRequest)(nil),             // 25: proto.AuthRequest
+	(*AuthResponse)(nil),            // 26: proto.AuthResponse
+	nil,                             // 27: proto.Filter.LabelsEntry
+	nil,                             // 28: proto.AgentInfo.CustomLabelsEntry
+	nil,                             // 29: proto.AgentConfigResponse.LabelsEntry
 }
 var file_woodpecker_proto_depIdxs = []int32{
-	21, // 0: proto.Filter.labels:type_name -> proto.Filter.LabelsEntry
-	3,  // 1: proto.NextRequest.filter:type_name -> proto.Filter
-	1,  // 2: proto.InitRequest.state:type_name -> proto.WorkflowState
-	1,  // 3: proto.DoneRequest.state:type_name -> proto.WorkflowState
-	0,  // 4: proto.UpdateRequest.state:type_name -> proto.StepState
-	2,  // 5: proto.LogRequest.logEntries:type_name -> proto.LogEntry
-	22, // 6: proto.AgentInfo.customLabels:type_name -> proto.AgentInfo.CustomLabelsEntry
-	14, // 7: proto.RegisterAgentRequest.info:type_name -> proto.AgentInfo
-	4,  // 8: proto.NextResponse.workflow:type_name -> proto.Workflow
-	12, // 9: proto.Woodpecker.Version:input_type -> proto.Empty
-	5,  // 10: proto.Woodpecker.Next:input_type -> proto.NextRequest
-	6,  // 11: proto.Woodpecker.Init:input_type -> proto.InitRequest
-	7,  // 12: proto.Woodpecker.Wait:input_type -> proto.WaitRequest
-	8,  // 13: proto.Woodpecker.Done:input_type -> proto.DoneRequest
-	9,  // 14: proto.Woodpecker.Extend:input_type -> proto.ExtendRequest
-	10, // 15: proto.Woodpecker.Update:input_type -> proto.UpdateRequest
-	11, // 16: proto.Woodpecker.Log:input_type -> proto.LogRequest
-	15, // 17: proto.Woodpecker.RegisterAgent:input_type -> proto.RegisterAgentRequest
-	12, // 18: proto.Woodpecker.UnregisterAgent:input_type -> proto.Empty
-	13, // 19: proto.Woodpecker.ReportHealth:input_type -> proto.ReportHealthRequest
-	19, // 20: proto.WoodpeckerAuth.Auth:input_type -> proto.AuthRequest
-	16, // 21: proto.Woodpecker.Version:output_type -> proto.VersionResponse
-	17, // 22: proto.Woodpecker.Next:output_type -> proto.NextResponse
-	12, // 23: proto.Woodpecker.Init:output_type -> proto.Empty
-	12, // 24: proto.Woodpecker.Wait:output_type -> proto.Empty
-	12, // 25: proto.Woodpecker.Done:output_type -> proto.Empty
-	12, // 26: proto.Woodpecker.Extend:output_type -> proto.Empty
-	12, // 27: proto.Woodpecker.Update:output_type -> proto.Empty
-	12, // 28: proto.Woodpecker.Log:output_type -> proto.Empty
-	18, // 29: proto.Woodpecker.RegisterAgent:output_type -> proto.RegisterAgentResponse
-	12, // 30: proto.Woodpecker.UnregisterAgent:output_type -> proto.Empty
-	12, // 31: proto.Woodpecker.ReportHealth:output_type -> proto.Empty
-	20, // 32: proto.WoodpeckerAuth.Auth:output_type -> proto.AuthResponse
-	21, // [21:33] is the sub-list for method output_type
-	9,  // [9:21] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	1,  // 0: proto.StepState.annotations:type_name -> proto.Annotation
+	27, // 1: proto.Filter.labels:type_name -> proto.Filter.LabelsEntry
+	4,  // 2: proto.NextRequest.filter:type_name -> proto.Filter
+	2,  // 3: proto.InitRequest.state:type_name -> proto.WorkflowState
+	2,  // 4: proto.DoneRequest.state:type_name -> proto.WorkflowState
+	0,  // 5: proto.UpdateRequest.state:type_name -> proto.StepState
+	3,  // 6: proto.LogRequest.logEntries:type_name -> proto.LogEntry
+	28, // 7: proto.AgentInfo.customLabels:type_name -> proto.AgentInfo.CustomLabelsEntry
+	18, // 8: proto.AgentInfo.capabilities:type_name -> proto.Capabilities
+	17, // 9: proto.RegisterAgentRequest.info:type_name -> proto.AgentInfo
+	5,  // 10: proto.NextResponse.workflow:type_name -> proto.Workflow
+	29, // 11: proto.AgentConfigResponse.labels:type_name -> proto.AgentConfigResponse.LabelsEntry
+	13, // 12: proto.Woodpecker.Version:input_type -> proto.Empty
+	6,  // 13: proto.Woodpecker.Next:input_type -> proto.NextRequest
+	7,  // 14: proto.Woodpecker.Init:input_type -> proto.InitRequest
+	8,  // 15: proto.Woodpecker.Wait:input_type -> proto.WaitRequest
+	9,  // 16: proto.Woodpecker.Done:input_type -> proto.DoneRequest
+	10, // 17: proto.Woodpecker.Extend:input_type -> proto.ExtendRequest
+	11, // 18: proto.Woodpecker.Update:input_type -> proto.UpdateRequest
+	12, // 19: proto.Woodpecker.Log:input_type -> proto.LogRequest
+	19, // 20: proto.Woodpecker.RegisterAgent:input_type -> proto.RegisterAgentRequest
+	13, // 21: proto.Woodpecker.UnregisterAgent:input_type -> proto.Empty
+	14, // 22: proto.Woodpecker.ReportHealth:input_type -> proto.ReportHealthRequest
+	15, // 23: proto.Woodpecker.Drain:input_type -> proto.DrainRequest
+	16, // 24: proto.Woodpecker.ReportWorkflows:input_type -> proto.ReportWorkflowsRequest
+	13, // 25: proto.Woodpecker.AgentConfig:input_type -> proto.Empty
+	25, // 26: proto.WoodpeckerAuth.Auth:input_type -> proto.AuthRequest
+	20, // 27: proto.Woodpecker.Version:output_type -> proto.VersionResponse
+	21, // 28: proto.Woodpecker.Next:output_type -> proto.NextResponse
+	13, // 29: proto.Woodpecker.Init:output_type -> proto.Empty
+	13, // 30: proto.Woodpecker.Wait:output_type -> proto.Empty
+	13, // 31: proto.Woodpecker.Done:output_type -> proto.Empty
+	13, // 32: proto.Woodpecker.Extend:output_type -> proto.Empty
+	13, // 33: proto.Woodpecker.Update:output_type -> proto.Empty
+	13, // 34: proto.Woodpecker.Log:output_type -> proto.Empty
+	22, // 35: proto.Woodpecker.RegisterAgent:output_type -> proto.RegisterAgentResponse
+	13, // 36: proto.Woodpecker.UnregisterAgent:output_type -> proto.Empty
+	13, // 37: proto.Woodpecker.ReportHealth:output_type -> proto.Empty
+	13, // 38: proto.Woodpecker.Drain:output_type -> proto.Empty
+	23, // 39: proto.Woodpecker.ReportWorkflows:output_type -> proto.ReportWorkflowsResponse
+	24, // 40: proto.Woodpecker.AgentConfig:output_type -> proto.AgentConfigResponse
+	26, // 41: proto.WoodpeckerAuth.Auth:output_type -> proto.AuthResponse
+	27, // [27:42] is the sub-list for method output_type
+	12, // [12:27] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_woodpecker_proto_init() }
@@ -1330,7 +1775,7 @@ func file_woodpecker_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_woodpecker_proto_rawDesc), len(file_woodpecker_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   23,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
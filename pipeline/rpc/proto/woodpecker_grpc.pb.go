@@ -45,6 +45,9 @@ const (
 	Woodpecker_RegisterAgent_FullMethodName   = "/proto.Woodpecker/RegisterAgent"
 	Woodpecker_UnregisterAgent_FullMethodName = "/proto.Woodpecker/UnregisterAgent"
 	Woodpecker_ReportHealth_FullMethodName    = "/proto.Woodpecker/ReportHealth"
+	Woodpecker_Drain_FullMethodName           = "/proto.Woodpecker/Drain"
+	Woodpecker_ReportWorkflows_FullMethodName = "/proto.Woodpecker/ReportWorkflows"
+	Woodpecker_AgentConfig_FullMethodName     = "/proto.Woodpecker/AgentConfig"
 )
 
 // WoodpeckerClient is the client API for Woodpecker service.
@@ -64,6 +67,9 @@ type WoodpeckerClient interface {
 	RegisterAgent(ctx context.Context, in *RegisterAgentRequest, opts ...grpc.CallOption) (*RegisterAgentResponse, error)
 	UnregisterAgent(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
 	ReportHealth(ctx context.Context, in *ReportHealthRequest, opts ...grpc.CallOption) (*Empty, error)
+	Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*Empty, error)
+	ReportWorkflows(ctx context.Context, in *ReportWorkflowsRequest, opts ...grpc.CallOption) (*ReportWorkflowsResponse, error)
+	AgentConfig(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AgentConfigResponse, error)
 }
 
 type woodpeckerClient struct {
@@ -184,6 +190,36 @@ func (c *woodpeckerClient) ReportHealth(ctx context.Context, in *ReportHealthReq
 	return out, nil
 }
 
+func (c *woodpeckerClient) Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Woodpecker_Drain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *woodpeckerClient) ReportWorkflows(ctx context.Context, in *ReportWorkflowsRequest, opts ...grpc.CallOption) (*ReportWorkflowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportWorkflowsResponse)
+	err := c.cc.Invoke(ctx, Woodpecker_ReportWorkflows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *woodpeckerClient) AgentConfig(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AgentConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgentConfigResponse)
+	err := c.cc.Invoke(ctx, Woodpecker_AgentConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WoodpeckerServer is the server API for Woodpecker service.
 // All implementations must embed UnimplementedWoodpeckerServer
 // for forward compatibility.
@@ -201,6 +237,9 @@ type WoodpeckerServer interface {
 	RegisterAgent(context.Context, *RegisterAgentRequest) (*RegisterAgentResponse, error)
 	UnregisterAgent(context.Context, *Empty) (*Empty, error)
 	ReportHealth(context.Context, *ReportHealthRequest) (*Empty, error)
+	Drain(context.Context, *DrainRequest) (*Empty, error)
+	ReportWorkflows(context.Context, *ReportWorkflowsRequest) (*ReportWorkflowsResponse, error)
+	AgentConfig(context.Context, *Empty) (*AgentConfigResponse, error)
 	mustEmbedUnimplementedWoodpeckerServer()
 }
 
@@ -244,6 +283,15 @@ func (UnimplementedWoodpeckerServer) UnregisterAgent(context.Context, *Empty) (*
 func (UnimplementedWoodpeckerServer) ReportHealth(context.Context, *ReportHealthRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportHealth not implemented")
 }
+func (UnimplementedWoodpeckerServer) Drain(context.Context, *DrainRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Drain not implemented")
+}
+func (UnimplementedWoodpeckerServer) ReportWorkflows(context.Context, *ReportWorkflowsRequest) (*ReportWorkflowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportWorkflows not implemented")
+}
+func (UnimplementedWoodpeckerServer) AgentConfig(context.Context, *Empty) (*AgentConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AgentConfig not implemented")
+}
 func (UnimplementedWoodpeckerServer) mustEmbedUnimplementedWoodpeckerServer() {}
 func (UnimplementedWoodpeckerServer) testEmbeddedByValue()                    {}
 
@@ -463,6 +511,60 @@ func _Woodpecker_ReportHealth_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Woodpecker_Drain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WoodpeckerServer).Drain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Woodpecker_Drain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WoodpeckerServer).Drain(ctx, req.(*DrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Woodpecker_ReportWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportWorkflowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WoodpeckerServer).ReportWorkflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Woodpecker_ReportWorkflows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WoodpeckerServer).ReportWorkflows(ctx, req.(*ReportWorkflowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Woodpecker_AgentConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WoodpeckerServer).AgentConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Woodpecker_AgentConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WoodpeckerServer).AgentConfig(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Woodpecker_ServiceDesc is the grpc.ServiceDesc for Woodpecker service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -514,6 +616,18 @@ var Woodpecker_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReportHealth",
 			Handler:    _Woodpecker_ReportHealth_Handler,
 		},
+		{
+			MethodName: "Drain",
+			Handler:    _Woodpecker_Drain_Handler,
+		},
+		{
+			MethodName: "ReportWorkflows",
+			Handler:    _Woodpecker_ReportWorkflows_Handler,
+		},
+		{
+			MethodName: "AgentConfig",
+			Handler:    _Woodpecker_AgentConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "woodpecker.proto",
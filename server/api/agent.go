@@ -15,8 +15,11 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
@@ -46,6 +49,7 @@ func GetAgents(c *gin.Context) {
 		c.String(http.StatusInternalServerError, "Error getting agent list. %s", err)
 		return
 	}
+	setAgentStatus(c, agents...)
 	c.JSON(http.StatusOK, agents)
 }
 
@@ -70,6 +74,7 @@ func GetAgent(c *gin.Context) {
 		handleDBError(c, err)
 		return
 	}
+	setAgentStatus(c, agent)
 	c.JSON(http.StatusOK, agent)
 }
 
@@ -138,12 +143,20 @@ func PatchAgent(c *gin.Context) {
 		return
 	}
 
+	if in.CapacityOverride < 0 {
+		c.String(http.StatusUnprocessableEntity, "Capacity override can not be negative")
+		return
+	}
+
 	// Update allowed fields
 	agent.Name = in.Name
 	agent.NoSchedule = in.NoSchedule
 	if agent.NoSchedule {
 		server.Config.Services.Queue.KickAgentWorkers(agent.ID)
 	}
+	// the agent reloads its config with the overrides
+	agent.CapacityOverride = in.CapacityOverride
+	agent.LabelsOverride = in.LabelsOverride
 
 	err = _store.AgentUpdate(agent)
 	if err != nil {
@@ -154,6 +167,120 @@ func PatchAgent(c *gin.Context) {
 	c.JSON(http.StatusOK, agent)
 }
 
+// PostAgentDrain
+//
+//	@Summary		Drain an agent
+//	@Description	The agent finishes its running workflows but gets no new ones until it registers again.
+//	@Router			/agents/{agent_id}/drain [post]
+//	@Produce		json
+//	@Success		200	{object}	Agent
+//	@Tags			Agents
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			agent_id		path	int		true	"the agent's id"
+func PostAgentDrain(c *gin.Context) {
+	setAgentDraining(c, true)
+}
+
+// DeleteAgentDrain
+//
+//	@Summary	Cancel draining an agent
+//	@Router		/agents/{agent_id}/drain [delete]
+//	@Produce	json
+//	@Success	200	{object}	Agent
+//	@Tags		Agents
+//	@Param		Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param		agent_id		path	int		true	"the agent's id"
+func DeleteAgentDrain(c *gin.Context) {
+	setAgentDraining(c, false)
+}
+
+func setAgentDraining(c *gin.Context, draining bool) {
+	_store := store.FromContext(c)
+
+	agentID, err := strconv.ParseInt(c.Param("agent_id"), 10, 64)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	agent, err := _store.AgentFind(agentID)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+
+	agent.Draining = draining
+	agent.DrainDeadline = 0
+	if err := _store.AgentUpdate(agent); err != nil {
+		c.String(http.StatusInternalServerError, "Error updating agent. %s", err)
+		return
+	}
+	if draining {
+		server.Config.Services.Queue.KickAgentWorkers(agent.ID)
+	}
+
+	setAgentStatus(c, agent)
+	c.JSON(http.StatusOK, agent)
+}
+
+// GetAgentDrain
+//
+//	@Summary		Wait for an agent to be drained
+//	@Description	Blocks until the draining agent has no running workflows anymore.
+//	@Router			/agents/{agent_id}/drain [get]
+//	@Produce		json
+//	@Success		200	{object}	Agent
+//	@Tags			Agents
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			agent_id		path	int		true	"the agent's id"
+//	@Param			timeout			query	string	false	"the maximum time to wait, e.g. 30m"
+func GetAgentDrain(c *gin.Context) {
+	_store := store.FromContext(c)
+
+	agentID, err := strconv.ParseInt(c.Param("agent_id"), 10, 64)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if t := c.Query("timeout"); t != "" {
+		timeout, err := time.ParseDuration(t)
+		if err != nil {
+			c.String(http.StatusBadRequest, "Invalid timeout. %s", err)
+			return
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		agent, err := _store.AgentFind(agentID)
+		if err != nil {
+			handleDBError(c, err)
+			return
+		}
+		if !agent.Draining && !agent.NoSchedule {
+			c.String(http.StatusConflict, "Agent is not draining")
+			return
+		}
+
+		setAgentStatus(c, agent)
+		if agent.RunningTasks == 0 {
+			c.JSON(http.StatusOK, agent)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			c.String(http.StatusRequestTimeout, "Agent is still running %d workflows", agent.RunningTasks)
+			return
+		case <-time.After(drainPollInterval):
+		}
+	}
+}
+
 // PostAgent
 //
 //	@Summary		Create a new agent
@@ -299,6 +426,7 @@ func GetOrgAgents(c *gin.Context) {
 		c.String(http.StatusInternalServerError, "Error getting agent list. %s", err)
 		return
 	}
+	setAgentStatus(c, agents...)
 
 	c.JSON(http.StatusOK, agents)
 }
@@ -406,3 +534,54 @@ func DeleteOrgAgent(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+// drainPollInterval is how often the running workflows of a draining agent are checked.
+const drainPollInterval = time.Second
+
+// setAgentStatus computes the status of the agents from the running workflows of the queue.
+func setAgentStatus(c *gin.Context, agents ...*model.Agent) {
+	running := make(map[int64][]*model.Task)
+	for _, task := range server.Config.Services.Queue.Info(c).Running {
+		running[task.AgentID] = append(running[task.AgentID], task)
+	}
+
+	now := time.Now()
+	for _, agent := range agents {
+		tasks := running[agent.ID]
+		agent.RunningTasks = len(tasks)
+		agent.Status = agent.GetStatus(len(tasks), now)
+		if agent.Status == model.AgentStatusDraining {
+			agent.DrainETA = drainETA(store.FromContext(c), agent, tasks)
+		}
+	}
+}
+
+// drainETA estimates when a draining agent is done. As the duration of a workflow
+// is unknown it uses the time its timeout cancels it at the latest.
+func drainETA(_store store.Store, agent *model.Agent, tasks []*model.Task) int64 {
+	var eta int64
+	for _, task := range tasks {
+		workflowID, err := strconv.ParseInt(task.ID, 10, 64)
+		if err != nil {
+			continue
+		}
+		workflow, err := _store.WorkflowLoad(workflowID)
+		if err != nil || workflow.Started == 0 {
+			continue
+		}
+
+		var data struct {
+			Timeout int64 `json:"timeout"`
+		}
+		timeout := time.Hour
+		if err := json.Unmarshal(task.Data, &data); err == nil && data.Timeout > 0 {
+			timeout = time.Duration(data.Timeout) * time.Minute
+		}
+		eta = max(eta, time.Unix(workflow.Started, 0).Add(timeout).Unix())
+	}
+
+	if agent.DrainDeadline > 0 && (eta == 0 || agent.DrainDeadline < eta) {
+		eta = agent.DrainDeadline
+	}
+	return eta
+}
@@ -0,0 +1,209 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/router/middleware/session"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+)
+
+// GetAgentPools
+//
+//	@Summary	List agent pools
+//	@Router		/agent-pools [get]
+//	@Produce	json
+//	@Success	200	{array}	AgentPool
+//	@Tags		Agent pools
+//	@Param		Authorization	header	string	true	"Insert your personal access token"				default(Bearer <personal access token>)
+//	@Param		page			query	int		false	"for response pagination, page offset number"	default(1)
+//	@Param		perPage			query	int		false	"for response pagination, max items per page"	default(50)
+func GetAgentPools(c *gin.Context) {
+	pools, err := store.FromContext(c).AgentPoolList(session.Pagination(c))
+	if err != nil {
+		c.String(http.StatusInternalServerError, "Error getting agent pool list. %s", err)
+		return
+	}
+	c.JSON(http.StatusOK, pools)
+}
+
+// GetAgentPool
+//
+//	@Summary	Get an agent pool
+//	@Router		/agent-pools/{pool_id} [get]
+//	@Produce	json
+//	@Success	200	{object}	AgentPool
+//	@Tags		Agent pools
+//	@Param		Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param		pool_id			path	int		true	"the agent pool's id"
+func GetAgentPool(c *gin.Context) {
+	poolID, err := strconv.ParseInt(c.Param("pool_id"), 10, 64)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	pool, err := store.FromContext(c).AgentPoolFind(poolID)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, pool)
+}
+
+// PostAgentPool
+//
+//	@Summary		Create an agent pool
+//	@Description	Agents of a pool only run workflows of the organizations and repositories allowed to use it.
+//	@Router			/agent-pools [post]
+//	@Produce		json
+//	@Success		200	{object}	AgentPool
+//	@Tags			Agent pools
+//	@Param			Authorization	header	string		true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			pool			body	AgentPool	true	"the agent pool's data"
+func PostAgentPool(c *gin.Context) {
+	_store := store.FromContext(c)
+
+	in := new(model.AgentPool)
+	if err := c.Bind(in); err != nil {
+		c.String(http.StatusBadRequest, "Error parsing agent pool. %s", err)
+		return
+	}
+	pool := &model.AgentPool{
+		Name:          in.Name,
+		Agents:        in.Agents,
+		LabelSelector: in.LabelSelector,
+		AllowedOrgs:   in.AllowedOrgs,
+		AllowedRepos:  in.AllowedRepos,
+		MaxConcurrent: in.MaxConcurrent,
+	}
+	if err := pool.Validate(); err != nil {
+		c.String(http.StatusUnprocessableEntity, "Error inserting agent pool. %s", err)
+		return
+	}
+
+	if err := _store.AgentPoolCreate(pool); err != nil {
+		c.String(http.StatusInternalServerError, "Error inserting agent pool %q. %s", in.Name, err)
+		return
+	}
+
+	kickAgentPoolWorkers(_store, pool)
+	c.JSON(http.StatusOK, pool)
+}
+
+// PatchAgentPool
+//
+//	@Summary	Update an agent pool
+//	@Router		/agent-pools/{pool_id} [patch]
+//	@Produce	json
+//	@Success	200	{object}	AgentPool
+//	@Tags		Agent pools
+//	@Param		Authorization	header	string			true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param		pool_id			path	int				true	"the agent pool's id"
+//	@Param		pool			body	AgentPoolPatch	true	"the agent pool's data"
+func PatchAgentPool(c *gin.Context) {
+	_store := store.FromContext(c)
+
+	in := new(model.AgentPoolPatch)
+	if err := c.Bind(in); err != nil {
+		c.String(http.StatusBadRequest, "Error parsing agent pool. %s", err)
+		return
+	}
+
+	poolID, err := strconv.ParseInt(c.Param("pool_id"), 10, 64)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	pool, err := _store.AgentPoolFind(poolID)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+	before := *pool
+
+	pool.Apply(in)
+	if err := pool.Validate(); err != nil {
+		c.String(http.StatusUnprocessableEntity, "Error updating agent pool. %s", err)
+		return
+	}
+
+	if err := _store.AgentPoolUpdate(pool); err != nil {
+		c.String(http.StatusInternalServerError, "Error updating agent pool %q. %s", pool.Name, err)
+		return
+	}
+
+	kickAgentPoolWorkers(_store, &before, pool)
+	c.JSON(http.StatusOK, pool)
+}
+
+// DeleteAgentPool
+//
+//	@Summary	Delete an agent pool
+//	@Router		/agent-pools/{pool_id} [delete]
+//	@Produce	plain
+//	@Success	204
+//	@Tags		Agent pools
+//	@Param		Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param		pool_id			path	int		true	"the agent pool's id"
+func DeleteAgentPool(c *gin.Context) {
+	_store := store.FromContext(c)
+
+	poolID, err := strconv.ParseInt(c.Param("pool_id"), 10, 64)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	pool, err := _store.AgentPoolFind(poolID)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+
+	if err := _store.AgentPoolDelete(pool); err != nil {
+		handleDBError(c, err)
+		return
+	}
+
+	kickAgentPoolWorkers(_store, pool)
+	c.Status(http.StatusNoContent)
+}
+
+// kickAgentPoolWorkers lets the member agents of the pools poll again to apply the changed pools.
+func kickAgentPoolWorkers(_store store.Store, pools ...*model.AgentPool) {
+	agents, err := _store.AgentList(&model.ListOptions{All: true})
+	if err != nil {
+		log.Error().Err(err).Msg("could not list agents to apply agent pool changes")
+		return
+	}
+
+	for _, agent := range agents {
+		for _, pool := range pools {
+			if pool.HasAgent(agent.ID, agent.Labels) {
+				server.Config.Services.Queue.KickAgentWorkers(agent.ID)
+				break
+			}
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -50,6 +51,10 @@ func TestGetAgents(t *testing.T) {
 		mockStore := store_mocks.NewMockStore(t)
 		mockStore.On("AgentList", mock.Anything).Return(agents, nil)
 
+		mockQueue := queue_mocks.NewMockQueue(t)
+		mockQueue.On("Info", mock.Anything).Return(queue.InfoT{})
+		server.Config.Services.Queue = mockQueue
+
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
 		c.Set("store", mockStore)
@@ -74,6 +79,10 @@ func TestGetAgent(t *testing.T) {
 		mockStore := store_mocks.NewMockStore(t)
 		mockStore.On("AgentFind", int64(1)).Return(fakeAgent, nil)
 
+		mockQueue := queue_mocks.NewMockQueue(t)
+		mockQueue.On("Info", mock.Anything).Return(queue.InfoT{})
+		server.Config.Services.Queue = mockQueue
+
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
 		c.Set("store", mockStore)
@@ -148,6 +157,47 @@ func TestPatchAgent(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, "updated-agent", response.Name)
 	})
+
+	t.Run("should push config overrides", func(t *testing.T) {
+		mockStore := store_mocks.NewMockStore(t)
+		mockStore.On("AgentFind", int64(2)).Return(&model.Agent{ID: 2, Name: "busy", Capacity: 1}, nil)
+		mockStore.On("AgentUpdate", mock.AnythingOfType("*model.Agent")).Return(nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Set("store", mockStore)
+		c.Params = gin.Params{{Key: "agent_id", Value: "2"}}
+		c.Request, _ = http.NewRequest(http.MethodPatch, "/", strings.NewReader(`{"name":"busy","capacity_override":4,"labels_override":{"gpu":"true"}}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		PatchAgent(c)
+		c.Writer.WriteHeaderNow()
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response model.Agent
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, int32(1), response.Capacity)
+		assert.Equal(t, int32(4), response.CapacityOverride)
+		assert.Equal(t, map[string]string{"gpu": "true"}, response.LabelsOverride)
+	})
+
+	t.Run("should reject negative capacity override", func(t *testing.T) {
+		mockStore := store_mocks.NewMockStore(t)
+		mockStore.On("AgentFind", int64(2)).Return(&model.Agent{ID: 2, Name: "busy", Capacity: 1}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Set("store", mockStore)
+		c.Params = gin.Params{{Key: "agent_id", Value: "2"}}
+		c.Request, _ = http.NewRequest(http.MethodPatch, "/", strings.NewReader(`{"name":"busy","capacity_override":-1}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		PatchAgent(c)
+		c.Writer.WriteHeaderNow()
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		mockStore.AssertNotCalled(t, "AgentUpdate", mock.Anything)
+	})
 }
 
 func TestPostAgent(t *testing.T) {
@@ -239,6 +289,111 @@ func TestDeleteAgent(t *testing.T) {
 	})
 }
 
+func TestPostAgentDrain(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("should drain agent", func(t *testing.T) {
+		agent := &model.Agent{ID: 1, Name: "test-agent", LastContact: time.Now().Unix()}
+
+		mockStore := store_mocks.NewMockStore(t)
+		mockStore.On("AgentFind", int64(1)).Return(agent, nil)
+		mockStore.On("AgentUpdate", mock.AnythingOfType("*model.Agent")).Return(nil)
+		mockStore.On("WorkflowLoad", int64(5)).Return(&model.Workflow{ID: 5, Started: 1000}, nil)
+
+		mockQueue := queue_mocks.NewMockQueue(t)
+		mockQueue.On("KickAgentWorkers", int64(1)).Return()
+		mockQueue.On("Info", mock.Anything).Return(queue.InfoT{
+			Running: []*model.Task{{ID: "5", AgentID: 1, Data: []byte(`{"timeout":10}`)}},
+		})
+		server.Config.Services.Queue = mockQueue
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Set("store", mockStore)
+		c.Params = gin.Params{{Key: "agent_id", Value: "1"}}
+
+		PostAgentDrain(c)
+		c.Writer.WriteHeaderNow()
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockQueue.AssertCalled(t, "KickAgentWorkers", int64(1))
+
+		var response model.Agent
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, response.Draining)
+		assert.Equal(t, model.AgentStatusDraining, response.Status)
+		assert.Equal(t, 1, response.RunningTasks)
+		assert.EqualValues(t, 1000+10*60, response.DrainETA)
+	})
+}
+
+func TestGetAgentDrain(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("should return drained agent", func(t *testing.T) {
+		mockStore := store_mocks.NewMockStore(t)
+		mockStore.On("AgentFind", int64(1)).Return(&model.Agent{ID: 1, Draining: true, LastContact: time.Now().Unix()}, nil)
+
+		mockQueue := queue_mocks.NewMockQueue(t)
+		mockQueue.On("Info", mock.Anything).Return(queue.InfoT{})
+		server.Config.Services.Queue = mockQueue
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+		c.Set("store", mockStore)
+		c.Params = gin.Params{{Key: "agent_id", Value: "1"}}
+
+		GetAgentDrain(c)
+		c.Writer.WriteHeaderNow()
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response model.Agent
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, model.AgentStatusDrained, response.Status)
+	})
+
+	t.Run("should time out while agent is running workflows", func(t *testing.T) {
+		mockStore := store_mocks.NewMockStore(t)
+		mockStore.On("AgentFind", int64(1)).Return(&model.Agent{ID: 1, Draining: true}, nil)
+		mockStore.On("WorkflowLoad", int64(5)).Return(&model.Workflow{ID: 5}, nil)
+
+		mockQueue := queue_mocks.NewMockQueue(t)
+		mockQueue.On("Info", mock.Anything).Return(queue.InfoT{
+			Running: []*model.Task{{ID: "5", AgentID: 1}},
+		})
+		server.Config.Services.Queue = mockQueue
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/?timeout=10ms", nil)
+		c.Set("store", mockStore)
+		c.Params = gin.Params{{Key: "agent_id", Value: "1"}}
+
+		GetAgentDrain(c)
+		c.Writer.WriteHeaderNow()
+
+		assert.Equal(t, http.StatusRequestTimeout, w.Code)
+	})
+
+	t.Run("should fail for agent not draining", func(t *testing.T) {
+		mockStore := store_mocks.NewMockStore(t)
+		mockStore.On("AgentFind", int64(1)).Return(&model.Agent{ID: 1}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+		c.Set("store", mockStore)
+		c.Params = gin.Params{{Key: "agent_id", Value: "1"}}
+
+		GetAgentDrain(c)
+		c.Writer.WriteHeaderNow()
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
 func TestPostOrgAgent(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -43,14 +43,15 @@ func GetForges(c *gin.Context) {
 	}
 
 	user := session.User(c)
-	if user != nil && user.Admin {
-		c.JSON(http.StatusOK, forges)
-		return
-	}
+	isAdmin := user != nil && user.Admin
 
 	// copy forges data without sensitive information
 	for i, forge := range forges {
-		forges[i] = forge.PublicCopy()
+		if isAdmin {
+			forges[i] = forge.AdminCopy()
+		} else {
+			forges[i] = forge.PublicCopy()
+		}
 	}
 
 	c.JSON(http.StatusOK, forges)
@@ -80,7 +81,7 @@ func GetForge(c *gin.Context) {
 
 	user := session.User(c)
 	if user != nil && user.Admin {
-		c.JSON(http.StatusOK, forge)
+		c.JSON(http.StatusOK, forge.AdminCopy())
 	} else {
 		c.JSON(http.StatusOK, forge.PublicCopy())
 	}
@@ -122,6 +123,8 @@ func PatchForge(c *gin.Context) {
 	forge.OAuthClientID = in.OAuthClientID
 	forge.OAuthHost = in.OAuthHost
 	forge.SkipVerify = in.SkipVerify
+	// credentials are not returned by the API, so they are only updated if set
+	in.KeepSecretOptions(forge)
 	forge.AdditionalOptions = in.AdditionalOptions
 	if in.OAuthClientSecret != "" {
 		forge.OAuthClientSecret = in.OAuthClientSecret
@@ -133,7 +136,7 @@ func PatchForge(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, forge)
+	c.JSON(http.StatusOK, forge.AdminCopy())
 }
 
 // PostForge
@@ -167,7 +170,7 @@ func PostForge(c *gin.Context) {
 		c.String(http.StatusInternalServerError, err.Error())
 		return
 	}
-	c.JSON(http.StatusOK, forge)
+	c.JSON(http.StatusOK, forge.AdminCopy())
 }
 
 // DeleteForge
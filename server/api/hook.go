@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog/log"
@@ -28,6 +30,7 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server"
 	"go.woodpecker-ci.org/woodpecker/v3/server/forge"
 	"go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/grpc"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 	"go.woodpecker-ci.org/woodpecker/v3/server/pipeline"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store"
@@ -56,6 +59,11 @@ func GetQueueInfo(c *gin.Context) {
 		c.String(http.StatusInternalServerError, err.Error())
 		return
 	}
+	for i := range pendingWithAgents {
+		schedule := info.Schedule[pendingWithAgents[i].ID]
+		pendingWithAgents[i].Position = schedule.Position
+		pendingWithAgents[i].Reason = schedule.Reason
+	}
 
 	waitingWithAgents, err := processQueueTasks(_store, info.WaitingOnDeps, agentNameMap)
 	if err != nil {
@@ -191,6 +199,150 @@ func BlockTilQueueHasRunningItem(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+// GetQueueTaskExplanation
+//
+//	@Summary		Explain why a task of the pipeline queue does not run yet
+//	@Description	Returns the blocking dependencies of the task, its position in the queue and which agents can take it
+//	@Router			/queue/tasks/{task_id}/explain [get]
+//	@Produce		json
+//	@Success		200	{object}	QueueTaskExplanation
+//	@Tags			Pipeline queues
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			task_id			path	string	true	"the task's id"
+func GetQueueTaskExplanation(c *gin.Context) {
+	_store := store.FromContext(c)
+	info := server.Config.Services.Queue.Info(c)
+
+	states := make(map[string]string)
+	tasks := make(map[string]*model.Task)
+	for state, list := range map[string][]*model.Task{
+		"pending":         info.Pending,
+		"waiting_on_deps": info.WaitingOnDeps,
+		"running":         info.Running,
+	} {
+		for _, task := range list {
+			states[task.ID] = state
+			tasks[task.ID] = task
+		}
+	}
+
+	task, ok := tasks[c.Param("task_id")]
+	if !ok {
+		c.String(http.StatusNotFound, "Task not found in queue")
+		return
+	}
+
+	queueTasks, err := processQueueTasks(_store, []*model.Task{task}, make(map[int64]string))
+	if err != nil {
+		c.String(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	explanation := model.QueueTaskExplanation{
+		Task:         queueTasks[0],
+		State:        states[task.ID],
+		Reasons:      []string{},
+		Dependencies: []model.QueueTaskDependency{},
+		Agents:       []model.QueueAgentMatch{},
+	}
+	if explanation.State == "running" {
+		explanation.Reasons = append(explanation.Reasons, fmt.Sprintf("task is running on agent %s", explanation.Task.AgentName))
+		c.JSON(http.StatusOK, explanation)
+		return
+	}
+
+	schedule := info.Schedule[task.ID]
+	explanation.Task.Position = schedule.Position
+	explanation.Task.Reason = schedule.Reason
+
+	if info.Paused {
+		explanation.Reasons = append(explanation.Reasons, "queue is paused")
+	}
+
+	var blocking []string
+	for _, dep := range task.Dependencies {
+		dependency := model.QueueTaskDependency{ID: dep, State: states[dep]}
+		if depTask, ok := tasks[dep]; ok {
+			dependency.Name = depTask.Name
+			blocking = append(blocking, depTask.Name)
+		} else {
+			dependency.State = string(task.DepStatus[dep])
+		}
+		explanation.Dependencies = append(explanation.Dependencies, dependency)
+	}
+	if len(blocking) > 0 {
+		explanation.Reasons = append(explanation.Reasons, fmt.Sprintf("waiting on dependencies: %s", strings.Join(blocking, ", ")))
+	}
+
+	if explanation.State == "pending" && schedule.Position == 0 && schedule.Reason != "" {
+		explanation.Reasons = append(explanation.Reasons, fmt.Sprintf("blocked: %s", schedule.Reason))
+	}
+
+	agents, err := _store.AgentList(&model.ListOptions{All: true})
+	if err != nil {
+		c.String(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	pools, err := _store.AgentPoolList(&model.ListOptions{All: true})
+	if err != nil {
+		c.String(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	running := make(map[int64]int)
+	for _, task := range info.Running {
+		running[task.AgentID]++
+	}
+
+	var matched, available int
+	now := time.Now()
+	for _, agent := range agents {
+		match := model.QueueAgentMatch{
+			AgentID:   agent.ID,
+			AgentName: agent.Name,
+			Status:    agent.GetStatus(running[agent.ID], now),
+		}
+
+		if agent.Labels == nil {
+			match.Reasons = []string{"agent did not poll for workflows yet"}
+		} else {
+			match.Reasons = grpc.ExplainMismatch(agent.Labels, agent.Capabilities, task)
+			match.Reasons = append(match.Reasons, grpc.ExplainPools(pools, agents, agent, task, info.Running)...)
+			match.Matched = len(match.Reasons) == 0
+		}
+		if match.Matched {
+			matched++
+		}
+
+		switch {
+		case match.Status == model.AgentStatusOffline:
+			match.Reasons = append(match.Reasons, "agent is offline")
+		case agent.NoSchedule:
+			match.Reasons = append(match.Reasons, "agent is paused")
+		case agent.Draining:
+			match.Reasons = append(match.Reasons, "agent is draining")
+		case running[agent.ID] >= int(agent.Capacity):
+			match.Reasons = append(match.Reasons, fmt.Sprintf("agent is busy running %d of %d workflows", running[agent.ID], agent.Capacity))
+		case match.Matched:
+			available++
+		}
+
+		explanation.Agents = append(explanation.Agents, match)
+	}
+
+	switch {
+	case matched == 0:
+		explanation.Reasons = append(explanation.Reasons, "no agent matches the labels and requirements of the task")
+	case available == 0:
+		explanation.Reasons = append(explanation.Reasons, "all matching agents are offline, paused, draining or busy")
+	case strings.Contains(schedule.Reason, "waiting for agent"):
+		explanation.Reasons = append(explanation.Reasons, schedule.Reason)
+	}
+
+	c.JSON(http.StatusOK, explanation)
+}
+
 // PostHook
 //
 //	@Summary	Incoming webhook from forge
@@ -345,6 +497,91 @@ func PostHook(c *gin.Context) {
 	}
 }
 
+// PostForgeHook
+//
+//	@Summary	Incoming webhook from a forge app to run a pipeline again, e.g. by the re-run button of a GitHub check run
+//	@Router		/hook/forges/{forgeId} [post]
+//	@Produce	plain
+//	@Success	200
+//	@Tags		System
+//	@Param		forgeId	path	int		true	"the forge's id"
+//	@Param		hook	body	object	true	"the webhook payload"
+func PostForgeHook(c *gin.Context) {
+	_store := store.FromContext(c)
+
+	forgeID, err := strconv.ParseInt(c.Param("forgeId"), 10, 64)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	_forge, err := server.Config.Services.Manager.ForgeByID(forgeID)
+	if err != nil {
+		log.Error().Err(err).Msgf("Cannot get forge with id: %d", forgeID)
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	rerunner, ok := forge.As[forge.Rerunner](_forge, forge.CapabilityRerun)
+	if !ok {
+		c.String(http.StatusNotFound, "forge does not support to run pipelines again")
+		return
+	}
+
+	repoFromForge, number, err := rerunner.RerunHook(c, c.Request)
+	if err != nil {
+		if errors.Is(err, &types.ErrIgnoreEvent{}) {
+			msg := fmt.Sprintf("forge driver: %s", err)
+			log.Debug().Err(err).Msg(msg)
+			c.String(http.StatusOK, msg)
+			return
+		}
+
+		msg := "failure to parse hook"
+		log.Debug().Err(err).Msg(msg)
+		c.String(http.StatusBadRequest, msg)
+		return
+	}
+
+	repo, err := _store.GetRepoForgeID(forgeID, repoFromForge.ForgeRemoteID)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+
+	if !repo.IsActive {
+		log.Debug().Msgf("ignoring hook: repo %s is inactive", repo.FullName)
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	if repo.UserID == 0 {
+		log.Warn().Msgf("ignoring hook. repo %s has no owner.", repo.FullName)
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	user, err := _store.GetUser(repo.UserID)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+	forge.Refresh(c, _forge, _store, user)
+
+	pl, err := _store.GetPipelineNumber(repo, number)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+
+	newPipeline, err := pipeline.Restart(c, _store, pl, user, repo, nil)
+	if err != nil {
+		handlePipelineErr(c, err)
+	} else {
+		c.JSON(http.StatusOK, newPipeline)
+	}
+}
+
 func getRepoFromToken(store store.Store, t *token.Token) (*model.Repo, error) {
 	if t.Get("repo-forge-remote-id") != "" {
 		forgeID, err := strconv.ParseInt(t.Get("forge-id"), 10, 64)
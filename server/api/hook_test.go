@@ -1,11 +1,13 @@
 package api_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -15,6 +17,8 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server/api"
 	forge_mocks "go.woodpecker-ci.org/woodpecker/v3/server/forge/mocks"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/queue"
+	queue_mocks "go.woodpecker-ci.org/woodpecker/v3/server/queue/mocks"
 	config_service_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/config/mocks"
 	services_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/mocks"
 	"go.woodpecker-ci.org/woodpecker/v3/server/services/permissions"
@@ -94,3 +98,70 @@ func TestHook(t *testing.T) {
 	assert.Equal(t, http.StatusNoContent, c.Writer.Status())
 	assert.Equal(t, "true", w.Header().Get("Pipeline-Filtered"))
 }
+
+func TestGetQueueTaskExplanation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	build := &model.Task{ID: "1", Name: "build", Labels: map[string]string{"repo": "owner/name"}}
+	deploy := &model.Task{
+		ID:           "2",
+		Name:         "deploy",
+		Labels:       map[string]string{"repo": "owner/name", "platform": "linux/arm64"},
+		Dependencies: []string{"1"},
+		DepStatus:    map[string]model.StatusValue{},
+	}
+
+	mockQueue := queue_mocks.NewMockQueue(t)
+	mockQueue.On("Info", mock.Anything).Return(queue.InfoT{
+		Pending:       []*model.Task{build},
+		WaitingOnDeps: []*model.Task{deploy},
+		Schedule:      map[string]queue.TaskSchedule{"1": {Position: 1, Reason: "position 1"}},
+	})
+	server.Config.Services.Queue = mockQueue
+
+	now := time.Now().Unix()
+	mockStore := store_mocks.NewMockStore(t)
+	mockStore.On("AgentPoolList", mock.Anything).Return([]*model.AgentPool{}, nil)
+	mockStore.On("AgentList", mock.Anything).Return([]*model.Agent{
+		{ID: 1, Name: "amd64", Capacity: 1, LastContact: now, Labels: map[string]string{"platform": "linux/amd64", "repo": "*"}},
+		{ID: 2, Name: "arm64", Capacity: 1, LastContact: now, NoSchedule: true, Labels: map[string]string{"platform": "linux/arm64", "repo": "*"}},
+		{ID: 3, Name: "new"},
+	}, nil)
+
+	t.Run("should explain waiting task", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Set("store", mockStore)
+		c.Params = gin.Params{{Key: "task_id", Value: "2"}}
+
+		api.GetQueueTaskExplanation(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var explanation model.QueueTaskExplanation
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &explanation))
+		assert.Equal(t, "waiting_on_deps", explanation.State)
+		assert.Equal(t, []model.QueueTaskDependency{{ID: "1", Name: "build", State: "pending"}}, explanation.Dependencies)
+		assert.Equal(t, []string{
+			"waiting on dependencies: build",
+			"all matching agents are offline, paused, draining or busy",
+		}, explanation.Reasons)
+		if assert.Len(t, explanation.Agents, 3) {
+			assert.False(t, explanation.Agents[0].Matched)
+			assert.Equal(t, []string{"agent has label platform=linux/amd64, workflow requires platform=linux/arm64"}, explanation.Agents[0].Reasons)
+			assert.True(t, explanation.Agents[1].Matched)
+			assert.Equal(t, []string{"agent is paused"}, explanation.Agents[1].Reasons)
+			assert.Equal(t, []string{"agent did not poll for workflows yet", "agent is offline"}, explanation.Agents[2].Reasons)
+		}
+	})
+
+	t.Run("should return not found for unknown task", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Set("store", mockStore)
+		c.Params = gin.Params{{Key: "task_id", Value: "3"}}
+
+		api.GetQueueTaskExplanation(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
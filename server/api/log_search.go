@@ -0,0 +1,161 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/router/middleware/session"
+	log_service "go.woodpecker-ci.org/woodpecker/v3/server/services/log"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+)
+
+const (
+	defaultLogSearchPipelines = 10
+	defaultLogSearchLimit     = 100
+	maxLogSearchLimit         = 1000
+)
+
+// SearchRepoLogs
+//
+//	@Summary		Search the step logs of a repository
+//	@Description	Searches the logs of the pipelines on the given page, newest first, for a substring or regular expression.
+//	@Description	The search stops once the match limit is reached, use cursor with the same page to continue after the last match
+//	@Description	and next_page to continue with older pipelines.
+//	@Router			/repos/{repo_id}/logs/search [get]
+//	@Produce		json
+//	@Success		200	{object}	LogSearchResult
+//	@Tags			Pipeline logs
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			repo_id			path	int		true	"the repository id"
+//	@Param			query			query	string	true	"the substring or regular expression to search for"
+//	@Param			regex			query	bool	false	"treat the query as regular expression"
+//	@Param			ignore_case		query	bool	false	"ignore the case of letters"
+//	@Param			branch			query	string	false	"only search pipelines of this branch"
+//	@Param			step			query	string	false	"only search steps with this name"
+//	@Param			limit			query	int		false	"max number of matches"	default(100)
+//	@Param			cursor			query	string	false	"continue a truncated search after this match"
+//	@Param			page			query	int		false	"for response pagination, page offset number"	default(1)
+//	@Param			perPage			query	int		false	"number of pipelines to search"					default(10)
+func SearchRepoLogs(c *gin.Context) {
+	_store := store.FromContext(c)
+	repo := session.Repo(c)
+
+	opts := log_service.SearchOptions{
+		Query:      c.Query("query"),
+		Regex:      c.Query("regex") == "true",
+		IgnoreCase: c.Query("ignore_case") == "true",
+		Limit:      defaultLogSearchLimit,
+	}
+	if limit := c.Query("limit"); limit != "" {
+		l, err := strconv.Atoi(limit)
+		if err != nil || l < 1 {
+			c.String(http.StatusBadRequest, "Error parsing limit. %s", limit)
+			return
+		}
+		opts.Limit = min(l, maxLogSearchLimit)
+	}
+	if cursor := c.Query("cursor"); cursor != "" {
+		after, err := log_service.ParseSearchCursor(cursor)
+		if err != nil {
+			c.String(http.StatusBadRequest, "Error parsing cursor. %s", err)
+			return
+		}
+		opts.After = after
+	}
+	if _, err := log_service.CompileQuery(opts); err != nil {
+		c.String(http.StatusBadRequest, "Error parsing search query. %s", err)
+		return
+	}
+
+	listOpts := session.Pagination(c)
+	if c.Query("perPage") == "" {
+		listOpts.PerPage = defaultLogSearchPipelines
+	}
+
+	pipelines, err := _store.GetPipelineList(repo, listOpts, &model.PipelineFilter{Branch: c.Query("branch")})
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	result := &model.LogSearchResult{Matches: []*model.LogMatch{}}
+	if len(pipelines) == listOpts.PerPage {
+		result.NextPage = listOpts.Page + 1
+	}
+
+	stepName := c.Query("step")
+	for _, pipeline := range pipelines {
+		if len(result.Matches) >= opts.Limit {
+			result.Truncated = true
+			break
+		}
+
+		steps, err := _store.StepList(pipeline)
+		if err != nil {
+			_ = c.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+		if stepName != "" {
+			filtered := make([]*model.Step, 0, len(steps))
+			for _, step := range steps {
+				if step.Name == stepName {
+					filtered = append(filtered, step)
+				}
+			}
+			steps = filtered
+		}
+
+		// skip the pipelines before the one of the cursor
+		if opts.After != nil && !slices.ContainsFunc(steps, func(step *model.Step) bool { return step.ID == opts.After.StepID }) {
+			continue
+		}
+
+		pipelineOpts := opts
+		pipelineOpts.Limit = opts.Limit - len(result.Matches)
+		matches, truncated, err := log_service.Search(server.Config.Services.LogStore, steps, pipelineOpts)
+		if err != nil {
+			_ = c.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+		for _, match := range matches {
+			match.PipelineNumber = pipeline.Number
+		}
+		result.Matches = append(result.Matches, matches...)
+		opts.After = nil
+
+		if truncated {
+			result.Truncated = true
+			break
+		}
+	}
+
+	if opts.After != nil {
+		c.String(http.StatusBadRequest, "Error parsing cursor. Step %d is not part of the searched pipelines", opts.After.StepID)
+		return
+	}
+	if result.Truncated && len(result.Matches) > 0 {
+		last := result.Matches[len(result.Matches)-1]
+		result.Cursor = (&log_service.SearchCursor{StepID: last.StepID, Line: last.Line}).String()
+	}
+
+	c.JSON(http.StatusOK, result)
+}
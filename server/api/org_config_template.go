@@ -0,0 +1,172 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/router/middleware/session"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+)
+
+// GetOrgConfigTemplate
+//
+//	@Summary	Get an organization config template by name
+//	@Router		/orgs/{org_id}/templates/{template} [get]
+//	@Produce	json
+//	@Success	200	{object}	ConfigTemplate
+//	@Tags		Organization config templates
+//	@Param		Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param		org_id			path	string	true	"the org's id"
+//	@Param		template		path	string	true	"the template's name"
+func GetOrgConfigTemplate(c *gin.Context) {
+	org := session.Org(c)
+
+	template, err := store.FromContext(c).ConfigTemplateFind(org.ID, c.Param("template"))
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, template)
+}
+
+// GetOrgConfigTemplateList
+//
+//	@Summary	List organization config templates
+//	@Router		/orgs/{org_id}/templates [get]
+//	@Produce	json
+//	@Success	200	{array}	ConfigTemplate
+//	@Tags		Organization config templates
+//	@Param		Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param		org_id			path	string	true	"the org's id"
+//	@Param		page			query	int		false	"for response pagination, page offset number"	default(1)
+//	@Param		perPage			query	int		false	"for response pagination, max items per page"	default(50)
+func GetOrgConfigTemplateList(c *gin.Context) {
+	org := session.Org(c)
+
+	list, err := store.FromContext(c).ConfigTemplateList(org.ID, session.Pagination(c))
+	if err != nil {
+		c.String(http.StatusInternalServerError, "Error getting template list for %q. %s", org.ID, err)
+		return
+	}
+	c.JSON(http.StatusOK, list)
+}
+
+// PostOrgConfigTemplate
+//
+//	@Summary	Create an organization config template
+//	@Router		/orgs/{org_id}/templates [post]
+//	@Produce	json
+//	@Success	200	{object}	ConfigTemplate
+//	@Tags		Organization config templates
+//	@Param		Authorization	header	string			true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param		org_id			path	string			true	"the org's id"
+//	@Param		template		body	ConfigTemplate	true	"the new template"
+func PostOrgConfigTemplate(c *gin.Context) {
+	org := session.Org(c)
+
+	in := new(model.ConfigTemplate)
+	if err := c.Bind(in); err != nil {
+		c.String(http.StatusBadRequest, "Error parsing org %q template. %s", org.ID, err)
+		return
+	}
+	template := &model.ConfigTemplate{
+		OrgID: org.ID,
+		Name:  in.Name,
+		Data:  in.Data,
+	}
+	if err := template.Validate(); err != nil {
+		c.String(http.StatusUnprocessableEntity, "Error inserting org %q template. %s", org.ID, err)
+		return
+	}
+
+	if err := store.FromContext(c).ConfigTemplateCreate(template); err != nil {
+		c.String(http.StatusInternalServerError, "Error inserting org %q template %q. %s", org.ID, in.Name, err)
+		return
+	}
+	c.JSON(http.StatusOK, template)
+}
+
+// PatchOrgConfigTemplate
+//
+//	@Summary	Update an organization config template by name
+//	@Router		/orgs/{org_id}/templates/{template} [patch]
+//	@Produce	json
+//	@Success	200	{object}	ConfigTemplate
+//	@Tags		Organization config templates
+//	@Param		Authorization	header	string			true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param		org_id			path	string			true	"the org's id"
+//	@Param		template		path	string			true	"the template's name"
+//	@Param		templateData	body	ConfigTemplate	true	"the update template data"
+func PatchOrgConfigTemplate(c *gin.Context) {
+	org := session.Org(c)
+	_store := store.FromContext(c)
+
+	in := new(model.ConfigTemplate)
+	if err := c.Bind(in); err != nil {
+		c.String(http.StatusBadRequest, "Error parsing template. %s", err)
+		return
+	}
+
+	template, err := _store.ConfigTemplateFind(org.ID, c.Param("template"))
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+	if in.Data != "" {
+		template.Data = in.Data
+	}
+
+	if err := template.Validate(); err != nil {
+		c.String(http.StatusUnprocessableEntity, "Error updating org %q template. %s", org.ID, err)
+		return
+	}
+
+	if err := _store.ConfigTemplateUpdate(template); err != nil {
+		c.String(http.StatusInternalServerError, "Error updating org %q template %q. %s", org.ID, template.Name, err)
+		return
+	}
+	c.JSON(http.StatusOK, template)
+}
+
+// DeleteOrgConfigTemplate
+//
+//	@Summary	Delete an organization config template by name
+//	@Router		/orgs/{org_id}/templates/{template} [delete]
+//	@Produce	plain
+//	@Success	204
+//	@Tags		Organization config templates
+//	@Param		Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param		org_id			path	string	true	"the org's id"
+//	@Param		template		path	string	true	"the template's name"
+func DeleteOrgConfigTemplate(c *gin.Context) {
+	org := session.Org(c)
+	_store := store.FromContext(c)
+
+	template, err := _store.ConfigTemplateFind(org.ID, c.Param("template"))
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+
+	if err := _store.ConfigTemplateDelete(template); err != nil {
+		handleDBError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
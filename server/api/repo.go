@@ -227,6 +227,15 @@ func PatchRepo(c *gin.Context) {
 		return
 	}
 
+	if in.AgentAffinityWait != nil && *in.AgentAffinityWait < 0 {
+		c.String(http.StatusBadRequest, "Agent affinity wait must not be negative")
+		return
+	}
+	if in.AgentAffinityWait != nil && *in.AgentAffinityWait > server.Config.Pipeline.MaxTimeout*60 && !user.Admin {
+		c.String(http.StatusForbidden, fmt.Sprintf("Agent affinity wait is not allowed to be higher than max timeout (%d min)", server.Config.Pipeline.MaxTimeout))
+		return
+	}
+
 	if in.Trusted != nil {
 		if (*in.Trusted.Network != repo.Trusted.Network || *in.Trusted.Volumes != repo.Trusted.Volumes || *in.Trusted.Security != repo.Trusted.Security) && !user.Admin {
 			log.Trace().Msgf("user '%s' wants to change trusted without being an instance admin", user.Login)
@@ -287,6 +296,26 @@ func PatchRepo(c *gin.Context) {
 	if in.ConfigExtensionEndpoint != nil {
 		repo.ConfigExtensionEndpoint = *in.ConfigExtensionEndpoint
 	}
+	if in.CommitDirectives != nil {
+		repo.CommitDirectives = in.CommitDirectives
+	}
+	if in.AgentAffinityWait != nil {
+		repo.AgentAffinityWait = *in.AgentAffinityWait
+	}
+	if in.QueuePriorities != nil {
+		if !user.Admin {
+			log.Trace().Msgf("user '%s' wants to change queue priorities without being an instance admin", user.Login)
+			c.String(http.StatusForbidden, "Insufficient privileges")
+			return
+		}
+		for event := range *in.QueuePriorities {
+			if err := event.Validate(); err != nil {
+				c.String(http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		repo.QueuePriorities = *in.QueuePriorities
+	}
 
 	err := _store.UpdateRepo(repo)
 	if err != nil {
@@ -0,0 +1,206 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/retention"
+	"go.woodpecker-ci.org/woodpecker/v3/server/router/middleware/session"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+// GetRepoRetention
+//
+//	@Summary		Get the retention policy of a repository
+//	@Description	Returns the policy used for the repository. If the repository has no own policy, the policy of its organization or the global policy is returned.
+//	@Router			/repos/{repo_id}/retention [get]
+//	@Produce		json
+//	@Success		200	{object}	RetentionPolicy
+//	@Tags			Repositories
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			repo_id			path	int		true	"the repository id"
+func GetRepoRetention(c *gin.Context) {
+	repo := session.Repo(c)
+	_store := store.FromContext(c)
+
+	repoPolicy, err := findRetentionPolicy(_store, 0, repo.ID)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	orgPolicy, err := findRetentionPolicy(_store, repo.OrgID, 0)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, retention.Resolve(globalRetentionPolicy(), orgPolicy, repoPolicy))
+}
+
+// PatchRepoRetention
+//
+//	@Summary		Update the retention policy of a repository
+//	@Description	Creates the policy of the repository if it has none yet. It replaces the policy of the organization and the global one.
+//	@Router			/repos/{repo_id}/retention [patch]
+//	@Produce		json
+//	@Success		200	{object}	RetentionPolicy
+//	@Tags			Repositories
+//	@Param			Authorization	header	string					true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			repo_id			path	int						true	"the repository id"
+//	@Param			policy			body	RetentionPolicyPatch	true	"the retention policy data"
+func PatchRepoRetention(c *gin.Context) {
+	repo := session.Repo(c)
+	patchRetentionPolicy(c, 0, repo.ID)
+}
+
+// DeleteRepoRetention
+//
+//	@Summary	Delete the retention policy of a repository
+//	@Router		/repos/{repo_id}/retention [delete]
+//	@Produce	plain
+//	@Success	204
+//	@Tags		Repositories
+//	@Param		Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param		repo_id			path	int		true	"the repository id"
+func DeleteRepoRetention(c *gin.Context) {
+	repo := session.Repo(c)
+	deleteRetentionPolicy(c, 0, repo.ID)
+}
+
+// GetOrgRetention
+//
+//	@Summary		Get the retention policy of an organization
+//	@Description	Returns the global policy if the organization has no own policy.
+//	@Router			/orgs/{org_id}/retention [get]
+//	@Produce		json
+//	@Success		200	{object}	RetentionPolicy
+//	@Tags			Orgs
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			org_id			path	string	true	"the organization's id"
+func GetOrgRetention(c *gin.Context) {
+	org := session.Org(c)
+
+	orgPolicy, err := findRetentionPolicy(store.FromContext(c), org.ID, 0)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, retention.Resolve(globalRetentionPolicy(), orgPolicy, nil))
+}
+
+// PatchOrgRetention
+//
+//	@Summary		Update the retention policy of an organization
+//	@Description	Creates the policy of the organization if it has none yet. It is used for all repositories of the organization without an own policy.
+//	@Router			/orgs/{org_id}/retention [patch]
+//	@Produce		json
+//	@Success		200	{object}	RetentionPolicy
+//	@Tags			Orgs
+//	@Param			Authorization	header	string					true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			org_id			path	string					true	"the organization's id"
+//	@Param			policy			body	RetentionPolicyPatch	true	"the retention policy data"
+func PatchOrgRetention(c *gin.Context) {
+	org := session.Org(c)
+	patchRetentionPolicy(c, org.ID, 0)
+}
+
+// DeleteOrgRetention
+//
+//	@Summary	Delete the retention policy of an organization
+//	@Router		/orgs/{org_id}/retention [delete]
+//	@Produce	plain
+//	@Success	204
+//	@Tags		Orgs
+//	@Param		Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param		org_id			path	string	true	"the organization's id"
+func DeleteOrgRetention(c *gin.Context) {
+	org := session.Org(c)
+	deleteRetentionPolicy(c, org.ID, 0)
+}
+
+func patchRetentionPolicy(c *gin.Context, orgID, repoID int64) {
+	_store := store.FromContext(c)
+
+	in := new(model.RetentionPolicyPatch)
+	if err := c.Bind(in); err != nil {
+		c.String(http.StatusBadRequest, "Error parsing retention policy. %s", err)
+		return
+	}
+
+	policy, err := findRetentionPolicy(_store, orgID, repoID)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if policy == nil {
+		policy = &model.RetentionPolicy{OrgID: orgID, RepoID: repoID}
+	}
+
+	policy.Apply(in)
+	if err := policy.Validate(); err != nil {
+		c.String(http.StatusUnprocessableEntity, "Error updating retention policy. %s", err)
+		return
+	}
+
+	if err := _store.RetentionPolicySave(policy); err != nil {
+		c.String(http.StatusInternalServerError, "Error updating retention policy. %s", err)
+		return
+	}
+	c.JSON(http.StatusOK, policy)
+}
+
+func deleteRetentionPolicy(c *gin.Context, orgID, repoID int64) {
+	_store := store.FromContext(c)
+
+	policy, err := _store.RetentionPolicyFind(orgID, repoID)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+
+	if err := _store.RetentionPolicyDelete(policy); err != nil {
+		c.String(http.StatusInternalServerError, "Error deleting retention policy. %s", err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// findRetentionPolicy returns the policy of the org or repo or nil if there is none.
+func findRetentionPolicy(_store store.Store, orgID, repoID int64) (*model.RetentionPolicy, error) {
+	if orgID == 0 && repoID == 0 {
+		return nil, nil
+	}
+
+	policy, err := _store.RetentionPolicyFind(orgID, repoID)
+	if errors.Is(err, types.RecordNotExist) {
+		return nil, nil
+	}
+	return policy, err
+}
+
+func globalRetentionPolicy() *model.RetentionPolicy {
+	if server.Config.Retention.DefaultPolicy == nil {
+		return &model.RetentionPolicy{}
+	}
+	return server.Config.Retention.DefaultPolicy
+}
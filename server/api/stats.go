@@ -0,0 +1,204 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/router/middleware/session"
+	"go.woodpecker-ci.org/woodpecker/v3/server/stats"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+const (
+	defaultStatsWindow   = 30 * 24 * time.Hour
+	defaultStatsInterval = 24 * time.Hour
+	defaultStatsLimit    = 10
+	maxStatsWindow       = 366 * 24 * time.Hour
+	maxStatsBuckets      = 1000
+)
+
+// GetRepoStats
+//
+//	@Summary		Get pipeline statistics of a repository
+//	@Description	Aggregates success rate, durations, queue wait times, step and branch statistics of the pipelines created in the given time window of at most 366 days.
+//	@Router			/repos/{repo_id}/stats [get]
+//	@Produce		json
+//	@Success		200	{object}	Stats
+//	@Tags			Repositories
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			repo_id			path	int		true	"the repository id"
+//	@Param			after			query	string	false	"only use pipelines created after this RFC3339 date (default 30 days ago)"
+//	@Param			before			query	string	false	"only use pipelines created before this RFC3339 date (default now)"
+//	@Param			interval		query	string	false	"duration of a timeline bucket"	default(24h)
+//	@Param			limit			query	int		false	"max number of entries in the step, flaky step and branch lists"	default(10)
+func GetRepoStats(c *gin.Context) {
+	repo := session.Repo(c)
+	writeStats(c, []int64{repo.ID})
+}
+
+// GetOrgStats
+//
+//	@Summary		Get pipeline statistics of the repositories of an organization the user can access
+//	@Description	Aggregates success rate, durations, queue wait times, step and branch statistics of the pipelines created in the given time window of at most 366 days.
+//	@Router			/orgs/{org_id}/stats [get]
+//	@Produce		json
+//	@Success		200	{object}	Stats
+//	@Tags			Orgs
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			org_id			path	string	true	"the organization's id"
+//	@Param			after			query	string	false	"only use pipelines created after this RFC3339 date (default 30 days ago)"
+//	@Param			before			query	string	false	"only use pipelines created before this RFC3339 date (default now)"
+//	@Param			interval		query	string	false	"duration of a timeline bucket"	default(24h)
+//	@Param			limit			query	int		false	"max number of entries in the step, flaky step and branch lists"	default(10)
+func GetOrgStats(c *gin.Context) {
+	_store := store.FromContext(c)
+	org := session.Org(c)
+	user := session.User(c)
+
+	repos, err := _store.OrgRepoList(org, &model.ListOptions{All: true})
+	if err != nil {
+		c.String(http.StatusInternalServerError, "Error getting repos for organization %s. %s", org.Name, err)
+		return
+	}
+
+	// only aggregate the repos the user may read
+	repoIDs := make([]int64, 0, len(repos))
+	for _, repo := range repos {
+		readable, err := repoReadable(_store, user, repo)
+		if err != nil {
+			c.String(http.StatusInternalServerError, "Error getting permission for repo %s. %s", repo.FullName, err)
+			return
+		}
+		if readable {
+			repoIDs = append(repoIDs, repo.ID)
+		}
+	}
+	writeStats(c, repoIDs)
+}
+
+// repoReadable checks whether the user has read access to the repo like the permission
+// middleware of the repo endpoints does, based on the stored permissions of the user.
+func repoReadable(_store store.Store, user *model.User, repo *model.Repo) (bool, error) {
+	if repo.Visibility == model.VisibilityPublic {
+		return true, nil
+	}
+	if user == nil {
+		return false, nil
+	}
+	if user.Admin || repo.Visibility == model.VisibilityInternal {
+		return true, nil
+	}
+
+	perm, err := _store.PermFind(user, repo)
+	if errors.Is(err, types.RecordNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return perm.Pull, nil
+}
+
+func writeStats(c *gin.Context, repoIDs []int64) {
+	opts, err := parseStatsOptions(c)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	if len(repoIDs) == 0 {
+		c.JSON(http.StatusOK, stats.Compute(&model.StatsAggregates{}, opts))
+		return
+	}
+
+	_store := store.FromContext(c)
+	aggregates, err := _store.StatsAggregate(&model.StatsFilter{
+		RepoIDs:  repoIDs,
+		After:    opts.After,
+		Before:   opts.Before,
+		Interval: opts.Interval,
+	})
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, stats.Compute(aggregates, opts))
+}
+
+func parseStatsOptions(c *gin.Context) (stats.Options, error) {
+	now := time.Now()
+	opts := stats.Options{
+		After:    now.Add(-defaultStatsWindow).Unix(),
+		Before:   now.Unix(),
+		Interval: int64(defaultStatsInterval.Seconds()),
+		Limit:    defaultStatsLimit,
+	}
+
+	if after := c.Query("after"); after != "" {
+		afterDt, err := time.Parse(time.RFC3339, after)
+		if err != nil {
+			return opts, err
+		}
+		opts.After = afterDt.Unix()
+	}
+
+	if before := c.Query("before"); before != "" {
+		beforeDt, err := time.Parse(time.RFC3339, before)
+		if err != nil {
+			return opts, err
+		}
+		opts.Before = beforeDt.Unix()
+	}
+
+	if opts.Before <= opts.After {
+		return opts, errors.New("before has to be later than after")
+	}
+
+	if opts.Before-opts.After > int64(maxStatsWindow.Seconds()) {
+		return opts, fmt.Errorf("the time window can be at most %d days", int(maxStatsWindow.Hours()/24))
+	}
+
+	if interval := c.Query("interval"); interval != "" {
+		d, err := time.ParseDuration(interval)
+		if err != nil {
+			return opts, err
+		}
+		opts.Interval = int64(d.Seconds())
+	}
+
+	if opts.Interval <= 0 || (opts.Before-opts.After)/opts.Interval > maxStatsBuckets {
+		return opts, fmt.Errorf("interval has to be positive and result in at most %d buckets", maxStatsBuckets)
+	}
+
+	if limit := c.Query("limit"); limit != "" {
+		l, err := strconv.Atoi(limit)
+		if err != nil {
+			return opts, err
+		}
+		opts.Limit = l
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+func TestGetOrgStats(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	org := &model.Org{ID: 1, Name: "org"}
+	user := &model.User{ID: 1, Login: "member"}
+	public := &model.Repo{ID: 1, FullName: "org/public", Visibility: model.VisibilityPublic}
+	internal := &model.Repo{ID: 2, FullName: "org/internal", Visibility: model.VisibilityInternal}
+	readable := &model.Repo{ID: 3, FullName: "org/readable", Visibility: model.VisibilityPrivate}
+	forbidden := &model.Repo{ID: 4, FullName: "org/forbidden", Visibility: model.VisibilityPrivate}
+	unknown := &model.Repo{ID: 5, FullName: "org/unknown", Visibility: model.VisibilityPrivate}
+
+	mockStore := store_mocks.NewMockStore(t)
+	mockStore.On("OrgRepoList", org, mock.Anything).Return([]*model.Repo{public, internal, readable, forbidden, unknown}, nil)
+	mockStore.On("PermFind", user, readable).Return(&model.Perm{Pull: true}, nil)
+	mockStore.On("PermFind", user, forbidden).Return(&model.Perm{}, nil)
+	mockStore.On("PermFind", user, unknown).Return(nil, types.RecordNotExist)
+	onlyReadable := mock.MatchedBy(func(filter *model.StatsFilter) bool {
+		return assert.ObjectsAreEqual([]int64{1, 2, 3}, filter.RepoIDs)
+	})
+	mockStore.On("StatsAggregate", onlyReadable).Return(&model.StatsAggregates{}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/orgs/1/stats", nil)
+	c.Set("store", mockStore)
+	c.Set("org", org)
+	c.Set("user", user)
+
+	GetOrgStats(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestParseStatsOptions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name  string
+		query string
+		err   string
+	}{
+		{name: "default", query: ""},
+		{name: "year", query: "?after=2025-01-01T00:00:00Z&before=2026-01-01T00:00:00Z&interval=168h"},
+		{name: "window too long", query: "?after=2024-01-01T00:00:00Z&before=2026-01-01T00:00:00Z&interval=168h", err: "the time window can be at most 366 days"},
+		{name: "too many buckets", query: "?interval=1m", err: "interval has to be positive and result in at most 1000 buckets"},
+		{name: "before after", query: "?after=2026-01-02T00:00:00Z&before=2026-01-01T00:00:00Z", err: "before has to be later than after"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodGet, "/api/repos/1/stats"+tt.query, nil)
+
+			_, err := parseStatsOptions(c)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
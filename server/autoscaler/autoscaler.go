@@ -0,0 +1,418 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoscaler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	pipelineConsts "go.woodpecker-ci.org/woodpecker/v3/pipeline"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/grpc"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/queue"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+)
+
+// AgentNamePrefix is the name prefix of the agents managed by the autoscaler.
+const AgentNamePrefix = "autoscaler-"
+
+// Config holds the configuration of the autoscaler.
+type Config struct {
+	// Interval is how often the queue is checked.
+	Interval time.Duration
+	// MinAgents is the number of agents kept even if they are idle.
+	MinAgents int
+	// MaxAgents is the maximum number of agents deployed.
+	MaxAgents int
+	// WorkflowsPerAgent is the number of workflows a deployed agent runs in parallel.
+	WorkflowsPerAgent int
+	// IdleTimeout is the time after which an agent without work is removed.
+	IdleTimeout time.Duration
+}
+
+// Autoscaler deploys agents for the pending workflows of the queue and removes idle agents again.
+type Autoscaler struct {
+	store    store.Store
+	queue    queue.Queue
+	provider Provider
+	config   Config
+
+	// deployedFor remembers the demand each agent was deployed for
+	deployedFor map[int64]string
+	// unsatisfiable are demands the deployed agents did not meet the requirements of
+	unsatisfiable map[string]bool
+}
+
+// New returns an autoscaler deploying agents with the given provider.
+func New(store store.Store, queue queue.Queue, provider Provider, config Config) *Autoscaler {
+	if config.WorkflowsPerAgent < 1 {
+		config.WorkflowsPerAgent = 1
+	}
+	return &Autoscaler{
+		store:    store,
+		queue:    queue,
+		provider: provider,
+		config:   config,
+
+		deployedFor:   make(map[int64]string),
+		unsatisfiable: make(map[string]bool),
+	}
+}
+
+// Run scales the agents in the configured interval.
+func (a *Autoscaler) Run(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(a.config.Interval):
+			log.Trace().Msg("autoscaler: reconcile agents")
+			if err := a.Reconcile(ctx, time.Now()); err != nil {
+				log.Error().Err(err).Msg("autoscaler run failed")
+			}
+		}
+	}
+}
+
+// Reconcile removes idle agents and deploys agents for the pending workflows once.
+func (a *Autoscaler) Reconcile(ctx context.Context, now time.Time) error {
+	agents, err := a.store.AgentList(&model.ListOptions{All: true})
+	if err != nil {
+		return fmt.Errorf("could not load agents: %w", err)
+	}
+	pools, err := a.store.AgentPoolList(&model.ListOptions{All: true})
+	if err != nil {
+		return fmt.Errorf("could not load agent pools: %w", err)
+	}
+	info := a.queue.Info(ctx)
+
+	busy := make(map[int64]bool)
+	for _, task := range info.Running {
+		busy[task.AgentID] = true
+	}
+
+	managed := make([]*model.Agent, 0, len(agents))
+	exists := make(map[int64]bool)
+	for _, agent := range agents {
+		if isManaged(agent) {
+			managed = append(managed, agent)
+			exists[agent.ID] = true
+		}
+	}
+	for id := range a.deployedFor {
+		if !exists[id] {
+			delete(a.deployedFor, id)
+		}
+	}
+
+	var errs []error
+	active := make([]*model.Agent, 0, len(managed))
+	for i, agent := range managed {
+		remaining := len(managed) - i + len(active)
+		if busy[agent.ID] || (!agent.NoSchedule && (remaining <= a.config.MinAgents || !a.idle(agent, now))) {
+			active = append(active, agent)
+			continue
+		}
+
+		removed, err := a.remove(ctx, agent)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not remove agent %s: %w", agent.Name, err))
+		}
+		if !removed {
+			active = append(active, agent)
+		}
+	}
+
+	if info.Paused {
+		return errors.Join(errs...)
+	}
+
+	demands := pendingDemand(info, pools, agents, now)
+	for key := range a.unsatisfiable {
+		if _, ok := demands[key]; !ok {
+			delete(a.unsatisfiable, key)
+		}
+	}
+
+	// agents which did not connect yet will take the pending workflows they were deployed for
+	starting := make(map[string]int)
+	for _, agent := range active {
+		if agent.NoSchedule {
+			continue
+		}
+		key, ok := a.deployedFor[agent.ID]
+		if !ok {
+			key = demandKey(agent.CustomLabels, nil)
+		}
+		if agent.LastContact == 0 {
+			starting[key]++
+			continue
+		}
+
+		// the provider can not deploy agents meeting the requirements, deploying more would only churn
+		if d, ok := demands[key]; ok && !a.unsatisfiable[key] && (!grpc.RequirementsMet(d.requires, agent.Capabilities) || !hasLabels(agent.Labels, d.selects)) {
+			log.Warn().Msgf("autoscaler: agent %s does not meet the requirements of the workflows it was deployed for (%s), deploying no more agents for them", agent.Name, key)
+			a.unsatisfiable[key] = true
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(demands)) {
+		if a.unsatisfiable[key] {
+			continue
+		}
+		d := demands[key]
+		needed := (d.workflows+a.config.WorkflowsPerAgent-1)/a.config.WorkflowsPerAgent - starting[key]
+		for ; needed > 0 && len(active) < a.config.MaxAgents; needed-- {
+			agent, err := a.deploy(ctx, key, d.labels, d.requires)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("could not deploy agent for %s: %w", key, err))
+				break
+			}
+			active = append(active, agent)
+		}
+	}
+
+	for len(active) < a.config.MinAgents {
+		agent, err := a.deploy(ctx, demandKey(nil, nil), nil, nil)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not deploy agent: %w", err))
+			break
+		}
+		active = append(active, agent)
+	}
+
+	return errors.Join(errs...)
+}
+
+// idle reports if the agent did no work within the idle timeout. Agents
+// which never connected count as idle once the timeout passed since their creation.
+func (a *Autoscaler) idle(agent *model.Agent, now time.Time) bool {
+	lastActivity := max(agent.LastWork, agent.Created)
+	return now.Sub(time.Unix(lastActivity, 0)) > a.config.IdleTimeout
+}
+
+func (a *Autoscaler) deploy(ctx context.Context, key string, labels, requires map[string]string) (*model.Agent, error) {
+	agent := &model.Agent{
+		Name:         AgentNamePrefix + strings.ToLower(model.GenerateNewAgentToken()[:8]),
+		OwnerID:      model.IDNotSet,
+		OrgID:        model.IDNotSet,
+		Token:        model.GenerateNewAgentToken(),
+		CustomLabels: labels,
+	}
+	if err := a.store.AgentCreate(agent); err != nil {
+		return nil, err
+	}
+
+	if err := a.provider.Deploy(ctx, agent, requires); err != nil {
+		if err := a.store.AgentDelete(agent); err != nil {
+			log.Error().Err(err).Msgf("could not delete agent %s after failed deployment", agent.Name)
+		}
+		return nil, err
+	}
+
+	a.deployedFor[agent.ID] = key
+	log.Info().Str("labels", formatLabels(labels)).Str("requires", formatLabels(requires)).Msgf("autoscaler deployed agent %s", agent.Name)
+	return agent, nil
+}
+
+// remove drains the agent and removes it if it did not get any work in the meantime.
+func (a *Autoscaler) remove(ctx context.Context, agent *model.Agent) (bool, error) {
+	if !agent.NoSchedule {
+		agent.NoSchedule = true
+		if err := a.store.AgentUpdate(agent); err != nil {
+			return false, err
+		}
+	}
+	a.queue.KickAgentWorkers(agent.ID)
+
+	for _, task := range a.queue.Info(ctx).Running {
+		if task.AgentID == agent.ID {
+			// the agent is removed once its workflows are done
+			return false, nil
+		}
+	}
+
+	if err := a.provider.Remove(ctx, agent); err != nil {
+		return false, err
+	}
+	if err := a.store.AgentDelete(agent); err != nil {
+		return true, err
+	}
+
+	log.Info().Msgf("autoscaler removed agent %s", agent.Name)
+	return true, nil
+}
+
+func isManaged(agent *model.Agent) bool {
+	return agent.IsSystemAgent() && strings.HasPrefix(agent.Name, AgentNamePrefix)
+}
+
+type demand struct {
+	labels   map[string]string
+	requires map[string]string
+	// selects are the labels the agent sets itself which the workflows select
+	selects   map[string]string
+	workflows int
+}
+
+// pendingDemand groups the pending workflows by the labels and capabilities an agent needs to run them.
+// Workflows blocked by a queue quota are ignored as new agents would not run them,
+// just like workflows only pools not allowing them would take on the agent and
+// workflows connected agents have free capacity for.
+func pendingDemand(info queue.InfoT, pools []*model.AgentPool, agents []*model.Agent, now time.Time) map[string]*demand {
+	free := freeCapacity(info, agents, now)
+
+	demands := make(map[string]*demand)
+	for _, task := range info.Pending {
+		if schedule, ok := info.Schedule[task.ID]; ok && schedule.Position == 0 {
+			continue
+		}
+		if takeFreeCapacity(free, pools, agents, task, info.Running) {
+			continue
+		}
+
+		labels, selects := agentLabels(task.Labels)
+		if _, ok := selects[pipelineConsts.LabelFilterHostname]; ok {
+			// a new agent never runs on the selected host
+			continue
+		}
+		if !poolsAllow(pools, labels, task) {
+			continue
+		}
+
+		requires := task.Requires
+		if platform, ok := selects[pipelineConsts.LabelFilterPlatform]; ok && requires[types.RequireArch] == "" {
+			// the provider picks the machine by its architecture, the agent sets the platform itself
+			if _, arch, ok := strings.Cut(platform, "/"); ok && arch != "" {
+				requires = maps.Clone(requires)
+				if requires == nil {
+					requires = make(map[string]string)
+				}
+				requires[types.RequireArch] = arch
+			}
+		}
+
+		key := demandKey(labels, requires)
+		if len(selects) != 0 {
+			key += " selects " + formatLabels(selects)
+		}
+		if _, ok := demands[key]; !ok {
+			demands[key] = &demand{labels: labels, requires: requires, selects: selects}
+		}
+		demands[key].workflows++
+	}
+	return demands
+}
+
+// freeCapacity returns the number of workflows the connected agents could run in addition to their running ones.
+func freeCapacity(info queue.InfoT, agents []*model.Agent, now time.Time) map[*model.Agent]int {
+	running := make(map[int64]int)
+	for _, task := range info.Running {
+		running[task.AgentID]++
+	}
+
+	free := make(map[*model.Agent]int)
+	for _, agent := range agents {
+		if agent.NoSchedule || agent.Draining || agent.GetStatus(running[agent.ID], now) == model.AgentStatusOffline {
+			continue
+		}
+		if n := int(agent.Capacity) - running[agent.ID]; n > 0 {
+			free[agent] = n
+		}
+	}
+	return free
+}
+
+// takeFreeCapacity reserves a free slot of a connected agent able to run the task.
+func takeFreeCapacity(free map[*model.Agent]int, pools []*model.AgentPool, agents []*model.Agent, task *model.Task, running []*model.Task) bool {
+	for agent, n := range free {
+		if n == 0 || len(grpc.ExplainMismatch(agent.Labels, agent.Capabilities, task)) != 0 ||
+			len(grpc.ExplainPools(pools, agents, agent, task, running)) != 0 {
+			continue
+		}
+		free[agent]--
+		return true
+	}
+	return false
+}
+
+// demandKey identifies the agents deployed with the given custom labels for the given requirements.
+func demandKey(labels, requires map[string]string) string {
+	key := formatLabels(labels)
+	if len(requires) != 0 {
+		key += " requires " + formatLabels(requires)
+	}
+	return key
+}
+
+// poolsAllow checks whether an agent deployed with the labels could run the task,
+// the agent would poll with the task labels as well so label selectors of pools match on both.
+// Agents not being member of any pool run tasks of everyone.
+func poolsAllow(pools []*model.AgentPool, labels map[string]string, task *model.Task) bool {
+	agentLabels := maps.Clone(task.Labels)
+	maps.Copy(agentLabels, labels)
+
+	member := false
+	for _, pool := range pools {
+		if !pool.HasAgent(model.IDNotSet, agentLabels) {
+			continue
+		}
+		if _, ok := pool.Consumer(task); ok {
+			return true
+		}
+		member = true
+	}
+	return !member
+}
+
+// agentLabels returns the custom labels an agent needs to match the task labels.
+// Labels set by the server are left out, the labels the agent sets itself are
+// returned as selects as custom labels would only override their real values.
+func agentLabels(taskLabels map[string]string) (labels, selects map[string]string) {
+	labels = make(map[string]string)
+	for k, v := range taskLabels {
+		switch {
+		case v == "" || strings.HasPrefix(k, pipelineConsts.InternalLabelPrefix) ||
+			k == pipelineConsts.LabelFilterOrg || k == pipelineConsts.LabelFilterRepo:
+			continue
+		case k == pipelineConsts.LabelFilterPlatform || k == pipelineConsts.LabelFilterBackend || k == pipelineConsts.LabelFilterHostname:
+			if selects == nil {
+				selects = make(map[string]string)
+			}
+			selects[k] = v
+		default:
+			labels[k] = v
+		}
+	}
+	return labels, selects
+}
+
+// hasLabels checks whether the agent polls with all the given labels.
+func hasLabels(agentLabels, labels map[string]string) bool {
+	for k, v := range labels {
+		if agentLabels[k] != v {
+			return false
+		}
+	}
+	return true
+}
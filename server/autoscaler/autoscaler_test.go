@@ -0,0 +1,231 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoscaler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/queue"
+	queue_mocks "go.woodpecker-ci.org/woodpecker/v3/server/queue/mocks"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+)
+
+type fakeProvider struct {
+	deployed []map[string]string
+	requires []map[string]string
+	removed  []string
+}
+
+func (p *fakeProvider) Deploy(_ context.Context, agent *model.Agent, requires map[string]string) error {
+	p.deployed = append(p.deployed, agent.CustomLabels)
+	p.requires = append(p.requires, requires)
+	return nil
+}
+
+func (p *fakeProvider) Remove(_ context.Context, agent *model.Agent) error {
+	p.removed = append(p.removed, agent.Name)
+	return nil
+}
+
+func TestReconcileScaleUp(t *testing.T) {
+	now := time.Unix(10000, 0)
+	_store := store_mocks.NewMockStore(t)
+	_queue := queue_mocks.NewMockQueue(t)
+	provider := &fakeProvider{}
+
+	starting := &model.Agent{ID: 1, Name: AgentNamePrefix + "a", OwnerID: model.IDNotSet, Created: now.Unix(), CustomLabels: map[string]string{"gpu": "true"}}
+	_store.On("AgentPoolList", mock.Anything).Return([]*model.AgentPool{}, nil)
+	_store.On("AgentList", mock.Anything).Return([]*model.Agent{starting, {ID: 2, Name: "static", OwnerID: 1}}, nil)
+	_store.On("AgentCreate", mock.Anything).Return(nil)
+	_queue.On("Info", mock.Anything).Return(queue.InfoT{
+		Pending: []*model.Task{
+			{ID: "1", Labels: map[string]string{"platform": "linux/amd64", "repo": "octocat/hello-world", "org-id": "1"}},
+			{ID: "2", Labels: map[string]string{"platform": "linux/amd64", "woodpecker-ci.org/repo-id": "1"}},
+			{ID: "3", Labels: map[string]string{"platform": "linux/amd64"}},
+			{ID: "4", Labels: map[string]string{"gpu": "true"}},
+			{ID: "5", Labels: map[string]string{"gpu": "true"}},
+			{ID: "6", Labels: map[string]string{"platform": "linux/arm64"}},
+		},
+		Schedule: map[string]queue.TaskSchedule{"6": {Position: 0, Reason: "org quota reached"}},
+	})
+
+	autoscaler := New(_store, _queue, provider, Config{MaxAgents: 10, WorkflowsPerAgent: 2, IdleTimeout: time.Minute})
+	assert.NoError(t, autoscaler.Reconcile(t.Context(), now))
+	assert.Equal(t, []map[string]string{{}, {}}, provider.deployed)
+	assert.Equal(t, []map[string]string{{"arch": "amd64"}, {"arch": "amd64"}}, provider.requires)
+	assert.Empty(t, provider.removed)
+}
+
+func TestReconcileFreeCapacity(t *testing.T) {
+	now := time.Unix(10000, 0)
+	_store := store_mocks.NewMockStore(t)
+	_queue := queue_mocks.NewMockQueue(t)
+	provider := &fakeProvider{}
+
+	idle := &model.Agent{ID: 1, Name: "idle", OwnerID: model.IDNotSet, Capacity: 2, LastContact: now.Unix(), Labels: map[string]string{"platform": "linux/amd64"}}
+	arm := &model.Agent{ID: 2, Name: "arm", OwnerID: model.IDNotSet, Capacity: 2, LastContact: now.Unix(), Labels: map[string]string{"platform": "linux/arm64"}}
+	offline := &model.Agent{ID: 3, Name: "offline", OwnerID: model.IDNotSet, Capacity: 2, Labels: map[string]string{"platform": "linux/amd64"}}
+	_store.On("AgentPoolList", mock.Anything).Return([]*model.AgentPool{}, nil)
+	_store.On("AgentList", mock.Anything).Return([]*model.Agent{idle, arm, offline}, nil)
+	_store.On("AgentCreate", mock.Anything).Return(nil)
+	_queue.On("Info", mock.Anything).Return(queue.InfoT{
+		Pending: []*model.Task{
+			{ID: "1", Labels: map[string]string{"platform": "linux/amd64"}},
+			{ID: "2", Labels: map[string]string{"platform": "linux/amd64"}},
+			{ID: "3", Labels: map[string]string{"platform": "linux/amd64"}},
+			{ID: "4", Labels: map[string]string{"platform": "linux/amd64", "hostname": "builder"}},
+		},
+		Running: []*model.Task{{ID: "5", AgentID: idle.ID}},
+	})
+
+	autoscaler := New(_store, _queue, provider, Config{MaxAgents: 10, WorkflowsPerAgent: 1, IdleTimeout: time.Minute})
+	assert.NoError(t, autoscaler.Reconcile(t.Context(), now))
+	assert.Equal(t, []map[string]string{{"arch": "amd64"}, {"arch": "amd64"}}, provider.requires)
+}
+
+func TestReconcileMaxAgents(t *testing.T) {
+	_store := store_mocks.NewMockStore(t)
+	_queue := queue_mocks.NewMockQueue(t)
+	provider := &fakeProvider{}
+
+	_store.On("AgentPoolList", mock.Anything).Return([]*model.AgentPool{}, nil)
+	_store.On("AgentList", mock.Anything).Return([]*model.Agent{}, nil)
+	_store.On("AgentCreate", mock.Anything).Return(nil)
+	_queue.On("Info", mock.Anything).Return(queue.InfoT{
+		Pending: []*model.Task{{ID: "1"}, {ID: "2"}, {ID: "3"}},
+	})
+
+	autoscaler := New(_store, _queue, provider, Config{MaxAgents: 2})
+	assert.NoError(t, autoscaler.Reconcile(t.Context(), time.Now()))
+	assert.Len(t, provider.deployed, 2)
+}
+
+func TestReconcileScaleDown(t *testing.T) {
+	now := time.Unix(10000, 0)
+	_store := store_mocks.NewMockStore(t)
+	_queue := queue_mocks.NewMockQueue(t)
+	provider := &fakeProvider{}
+
+	idle := &model.Agent{ID: 1, Name: AgentNamePrefix + "idle", OwnerID: model.IDNotSet, LastContact: now.Unix(), LastWork: now.Unix() - 600}
+	busy := &model.Agent{ID: 2, Name: AgentNamePrefix + "busy", OwnerID: model.IDNotSet, LastContact: now.Unix(), LastWork: now.Unix() - 600}
+	recent := &model.Agent{ID: 3, Name: AgentNamePrefix + "recent", OwnerID: model.IDNotSet, LastContact: now.Unix(), LastWork: now.Unix() - 10}
+	static := &model.Agent{ID: 4, Name: "static", OwnerID: model.IDNotSet, LastWork: now.Unix() - 600}
+	drained := &model.Agent{ID: 5, Name: AgentNamePrefix + "drained", OwnerID: model.IDNotSet, NoSchedule: true, LastWork: now.Unix()}
+
+	_store.On("AgentPoolList", mock.Anything).Return([]*model.AgentPool{}, nil)
+	_store.On("AgentList", mock.Anything).Return([]*model.Agent{idle, busy, recent, static, drained}, nil)
+	_store.On("AgentUpdate", idle).Return(nil).Once()
+	_store.On("AgentDelete", idle).Return(nil).Once()
+	_store.On("AgentDelete", drained).Return(nil).Once()
+	_queue.On("Info", mock.Anything).Return(queue.InfoT{Running: []*model.Task{{ID: "1", AgentID: busy.ID}}})
+	_queue.On("KickAgentWorkers", idle.ID).Once()
+	_queue.On("KickAgentWorkers", drained.ID).Once()
+
+	autoscaler := New(_store, _queue, provider, Config{MaxAgents: 10, IdleTimeout: 5 * time.Minute})
+	assert.NoError(t, autoscaler.Reconcile(t.Context(), now))
+	assert.True(t, idle.NoSchedule)
+	assert.Equal(t, []string{idle.Name, drained.Name}, provider.removed)
+	assert.Empty(t, provider.deployed)
+}
+
+func TestReconcileMinAgents(t *testing.T) {
+	now := time.Unix(10000, 0)
+	_store := store_mocks.NewMockStore(t)
+	_queue := queue_mocks.NewMockQueue(t)
+	provider := &fakeProvider{}
+
+	idle := &model.Agent{ID: 1, Name: AgentNamePrefix + "idle", OwnerID: model.IDNotSet, LastContact: now.Unix(), LastWork: now.Unix() - 600}
+	_store.On("AgentPoolList", mock.Anything).Return([]*model.AgentPool{}, nil)
+	_store.On("AgentList", mock.Anything).Return([]*model.Agent{idle}, nil)
+	_store.On("AgentCreate", mock.Anything).Return(nil)
+	_queue.On("Info", mock.Anything).Return(queue.InfoT{})
+
+	autoscaler := New(_store, _queue, provider, Config{MinAgents: 2, MaxAgents: 10, IdleTimeout: 5 * time.Minute})
+	assert.NoError(t, autoscaler.Reconcile(t.Context(), now))
+	assert.Empty(t, provider.removed)
+	assert.Len(t, provider.deployed, 1)
+}
+
+func TestReconcileRequirements(t *testing.T) {
+	now := time.Unix(10000, 0)
+	_store := store_mocks.NewMockStore(t)
+	_queue := queue_mocks.NewMockQueue(t)
+	provider := &fakeProvider{}
+
+	var agents []*model.Agent
+	_store.On("AgentPoolList", mock.Anything).Return([]*model.AgentPool{}, nil)
+	_store.On("AgentList", mock.Anything).Return(func(*model.ListOptions) ([]*model.Agent, error) {
+		return agents, nil
+	})
+	_store.On("AgentCreate", mock.Anything).Run(func(args mock.Arguments) {
+		agent := args.Get(0).(*model.Agent)
+		agent.ID = int64(len(agents) + 1)
+		agent.Created = now.Unix()
+		agents = append(agents, agent)
+	}).Return(nil)
+	_queue.On("Info", mock.Anything).Return(queue.InfoT{
+		Pending: []*model.Task{
+			{ID: "1", Labels: map[string]string{"platform": "linux/amd64"}},
+			{ID: "2", Labels: map[string]string{"platform": "linux/amd64"}, Requires: map[string]string{"cpus": ">=64"}},
+		},
+	})
+
+	autoscaler := New(_store, _queue, provider, Config{MaxAgents: 10, IdleTimeout: time.Minute})
+	assert.NoError(t, autoscaler.Reconcile(t.Context(), now))
+	assert.Equal(t, []map[string]string{{"arch": "amd64"}, {"arch": "amd64", "cpus": ">=64"}}, provider.requires)
+
+	// the agents did not take the workflows yet
+	assert.NoError(t, autoscaler.Reconcile(t.Context(), now))
+	assert.Len(t, provider.deployed, 2)
+
+	// the agent deployed for the requirements connected without meeting them
+	agents[1].LastContact = now.Unix()
+	agents[1].Capabilities = model.AgentCapabilities{CPUs: 4}
+	assert.NoError(t, autoscaler.Reconcile(t.Context(), now))
+	assert.Len(t, provider.deployed, 2)
+}
+
+func TestReconcileAgentPools(t *testing.T) {
+	_store := store_mocks.NewMockStore(t)
+	_queue := queue_mocks.NewMockQueue(t)
+	provider := &fakeProvider{}
+
+	_store.On("AgentPoolList", mock.Anything).Return([]*model.AgentPool{
+		{Name: "gpu", LabelSelector: map[string]string{"gpu": "true"}, AllowedRepos: []int64{1}},
+		{Name: "other", Agents: []int64{5}, AllowedRepos: []int64{3}},
+	}, nil)
+	_store.On("AgentList", mock.Anything).Return([]*model.Agent{}, nil)
+	_store.On("AgentCreate", mock.Anything).Return(nil)
+	_queue.On("Info", mock.Anything).Return(queue.InfoT{
+		Pending: []*model.Task{
+			{ID: "1", RepoID: 1, Labels: map[string]string{"gpu": "true"}},
+			{ID: "2", RepoID: 2, Labels: map[string]string{"gpu": "true"}},
+			{ID: "3", RepoID: 2, Labels: map[string]string{"platform": "linux/amd64"}},
+		},
+	})
+
+	autoscaler := New(_store, _queue, provider, Config{MaxAgents: 10})
+	assert.NoError(t, autoscaler.Reconcile(t.Context(), time.Now()))
+	assert.Equal(t, []map[string]string{
+		{},
+		{"gpu": "true"},
+	}, provider.deployed)
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoscaler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/shared/httputil"
+)
+
+// Provider starts and stops the machines running the agents of the autoscaler.
+type Provider interface {
+	// Deploy starts a new agent connecting with the token and custom labels of the given agent
+	// on a machine meeting the requires section of the workflows it is deployed for.
+	Deploy(ctx context.Context, agent *model.Agent, requires map[string]string) error
+	// Remove stops the given agent and frees its resources.
+	Remove(ctx context.Context, agent *model.Agent) error
+}
+
+// Command is a provider running a local command with the action ("deploy" or "remove")
+// as argument and the agent passed as WOODPECKER_AGENT_* environment variables.
+type Command struct {
+	path string
+}
+
+// NewCommand returns a provider running the command at the given path.
+func NewCommand(path string) *Command {
+	return &Command{path: path}
+}
+
+func (c *Command) Deploy(ctx context.Context, agent *model.Agent, requires map[string]string) error {
+	return c.run(ctx, "deploy", agent, requires)
+}
+
+func (c *Command) Remove(ctx context.Context, agent *model.Agent) error {
+	return c.run(ctx, "remove", agent, nil)
+}
+
+func (c *Command) run(ctx context.Context, action string, agent *model.Agent, requires map[string]string) error {
+	cmd := exec.CommandContext(ctx, c.path, action)
+	cmd.Env = append(os.Environ(),
+		"WOODPECKER_AGENT_ID="+strconv.FormatInt(agent.ID, 10),
+		"WOODPECKER_AGENT_NAME="+agent.Name,
+		"WOODPECKER_AGENT_SECRET="+agent.Token,
+		"WOODPECKER_AGENT_LABELS="+formatLabels(agent.CustomLabels),
+		"WOODPECKER_AGENT_REQUIRES="+formatLabels(requires),
+	)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s %s failed: %w: %s", c.path, action, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// HTTP is a provider calling a generic http endpoint. Agents are deployed with
+// POST {endpoint}/agents and removed with DELETE {endpoint}/agents/{name}.
+type HTTP struct {
+	endpoint string
+	token    string
+	client   *http.Client
+}
+
+// NewHTTP returns a provider calling the given endpoint, authenticated with the bearer token if set.
+func NewHTTP(endpoint, token string) *HTTP {
+	return &HTTP{
+		endpoint: strings.TrimRight(endpoint, "/"),
+		token:    token,
+		client: &http.Client{
+			Timeout:   time.Minute,
+			Transport: httputil.NewUserAgentRoundTripper(http.DefaultTransport, "server-autoscaler"),
+		},
+	}
+}
+
+type httpAgent struct {
+	ID       int64             `json:"id"`
+	Name     string            `json:"name"`
+	Token    string            `json:"token"`
+	Labels   map[string]string `json:"labels"`
+	Requires map[string]string `json:"requires"`
+}
+
+func (h *HTTP) Deploy(ctx context.Context, agent *model.Agent, requires map[string]string) error {
+	body, err := json.Marshal(&httpAgent{ID: agent.ID, Name: agent.Name, Token: agent.Token, Labels: agent.CustomLabels, Requires: requires})
+	if err != nil {
+		return err
+	}
+	return h.send(ctx, http.MethodPost, h.endpoint+"/agents", bytes.NewReader(body))
+}
+
+func (h *HTTP) Remove(ctx context.Context, agent *model.Agent) error {
+	return h.send(ctx, http.MethodDelete, h.endpoint+"/agents/"+url.PathEscape(agent.Name), nil)
+}
+
+func (h *HTTP) send(ctx context.Context, method, uri string, body io.Reader) error {
+	req, err := http.NewRequestWithContext(ctx, method, uri, body)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if h.token != "" {
+		req.Header.Set("Authorization", "Bearer "+h.token)
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// a removed agent that is already gone is fine
+	if method == http.MethodDelete && resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024)) //nolint:mnd
+		return fmt.Errorf("%s %s failed with status %d: %s", method, uri, resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// formatLabels formats labels the way WOODPECKER_AGENT_LABELS expects them, requirements are formatted alike.
+func formatLabels(labels map[string]string) string {
+	l := make([]string, 0, len(labels))
+	for k, v := range labels {
+		l = append(l, k+"="+v)
+	}
+	sort.Strings(l)
+	return strings.Join(l, ",")
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoscaler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func TestCommand(t *testing.T) {
+	dir := t.TempDir()
+	script := filepath.Join(dir, "scale.sh")
+	require.NoError(t, os.WriteFile(script, []byte(`#!/bin/sh
+[ "$WOODPECKER_AGENT_NAME" = "fail" ] && echo "no capacity" && exit 1
+echo "$1 $WOODPECKER_AGENT_NAME $WOODPECKER_AGENT_SECRET $WOODPECKER_AGENT_LABELS $WOODPECKER_AGENT_REQUIRES" > `+filepath.Join(dir, "out")+`
+`), 0o755))
+
+	provider := NewCommand(script)
+	agent := &model.Agent{Name: "autoscaler-1", Token: "secret", CustomLabels: map[string]string{"b": "2", "a": "1"}}
+
+	assert.NoError(t, provider.Deploy(t.Context(), agent, map[string]string{"cpus": ">=8", "arch": "arm64"}))
+	out, err := os.ReadFile(filepath.Join(dir, "out"))
+	require.NoError(t, err)
+	assert.Equal(t, "deploy autoscaler-1 secret a=1,b=2 arch=arm64,cpus=>=8\n", string(out))
+
+	assert.NoError(t, provider.Remove(t.Context(), agent))
+	out, err = os.ReadFile(filepath.Join(dir, "out"))
+	require.NoError(t, err)
+	assert.Equal(t, "remove autoscaler-1 secret a=1,b=2 \n", string(out))
+
+	assert.ErrorContains(t, provider.Deploy(t.Context(), &model.Agent{Name: "fail"}, nil), "no capacity")
+}
+
+func TestHTTP(t *testing.T) {
+	var deployed httpAgent
+	var removed string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/scale/agents":
+			_ = json.NewDecoder(r.Body).Decode(&deployed)
+		case r.Method == http.MethodDelete && r.URL.Path == "/scale/agents/gone":
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodDelete:
+			removed = r.URL.Path
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	provider := NewHTTP(server.URL+"/scale/", "token")
+	agent := &model.Agent{ID: 1, Name: "autoscaler-1", Token: "secret", CustomLabels: map[string]string{"gpu": "true"}}
+
+	assert.NoError(t, provider.Deploy(t.Context(), agent, map[string]string{"memory": ">=16G"}))
+	assert.Equal(t, httpAgent{ID: 1, Name: "autoscaler-1", Token: "secret", Labels: map[string]string{"gpu": "true"}, Requires: map[string]string{"memory": ">=16G"}}, deployed)
+
+	assert.NoError(t, provider.Remove(t.Context(), agent))
+	assert.Equal(t, "/scale/agents/autoscaler-1", removed)
+
+	assert.NoError(t, provider.Remove(t.Context(), &model.Agent{Name: "gone"}))
+
+	err := NewHTTP(server.URL, "wrong").Deploy(t.Context(), agent, nil)
+	assert.ErrorContains(t, err, "failed with status 401")
+}
@@ -77,12 +77,20 @@ var Config = struct {
 		PrivilegedPlugins                   []string
 		DefaultTimeout                      int64
 		MaxTimeout                          int64
+		MaxMatrixCombinations               int
 		Proxy                               struct {
 			No    string
 			HTTP  string
 			HTTPS string
 		}
 	}
+	Retention struct {
+		Interval      time.Duration
+		DefaultPolicy *model.RetentionPolicy
+	}
+	Poll struct {
+		Interval time.Duration
+	}
 	Permissions struct {
 		Open            bool
 		Admins          *permissions.Admins
@@ -34,13 +34,14 @@ import (
 // make sure RPC implements forge.Forge.
 var _ forge.Forge = new(RPC)
 
+// Load starts the forge addon executable. Addons supporting the gRPC protocol
+// use it, older addons fall back to net/rpc.
 func Load(file string) (forge.Forge, error) {
 	client := plugin.NewClient(&plugin.ClientConfig{
-		HandshakeConfig: HandshakeConfig,
-		Plugins: map[string]plugin.Plugin{
-			pluginKey: &Plugin{},
-		},
-		Cmd: exec.Command(file),
+		HandshakeConfig:  HandshakeConfig,
+		VersionedPlugins: pluginSets(nil),
+		AllowedProtocols: []plugin.Protocol{plugin.ProtocolNetRPC, plugin.ProtocolGRPC},
+		Cmd:              exec.Command(file),
 		Logger: &logger.AddonClientLogger{
 			Logger: log.With().Str("addon", file).Logger(),
 		},
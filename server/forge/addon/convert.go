@@ -0,0 +1,467 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addon
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+
+	pipeline_errors "go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
+	errorTypes "go.woodpecker-ci.org/woodpecker/v3/pipeline/errors/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/addon/proto"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func userToProto(u *model.User) *proto.User {
+	if u == nil {
+		return nil
+	}
+	return &proto.User{
+		Id:            u.ID,
+		ForgeId:       u.ForgeID,
+		ForgeRemoteId: string(u.ForgeRemoteID),
+		Login:         u.Login,
+		AccessToken:   u.AccessToken,
+		RefreshToken:  u.RefreshToken,
+		Expiry:        u.Expiry,
+		Email:         u.Email,
+		Avatar:        u.Avatar,
+		Admin:         u.Admin,
+		Hash:          u.Hash,
+		OrgId:         u.OrgID,
+	}
+}
+
+func userFromProto(u *proto.User) *model.User {
+	if u == nil {
+		return nil
+	}
+	return &model.User{
+		ID:            u.GetId(),
+		ForgeID:       u.GetForgeId(),
+		ForgeRemoteID: model.ForgeRemoteID(u.GetForgeRemoteId()),
+		Login:         u.GetLogin(),
+		AccessToken:   u.GetAccessToken(),
+		RefreshToken:  u.GetRefreshToken(),
+		Expiry:        u.GetExpiry(),
+		Email:         u.GetEmail(),
+		Avatar:        u.GetAvatar(),
+		Admin:         u.GetAdmin(),
+		Hash:          u.GetHash(),
+		OrgID:         u.GetOrgId(),
+	}
+}
+
+func repoToProto(r *model.Repo) *proto.Repo {
+	if r == nil {
+		return nil
+	}
+	repo := &proto.Repo{
+		Id:            r.ID,
+		UserId:        r.UserID,
+		ForgeId:       r.ForgeID,
+		ForgeRemoteId: string(r.ForgeRemoteID),
+		OrgId:         r.OrgID,
+		Owner:         r.Owner,
+		Name:          r.Name,
+		FullName:      r.FullName,
+		Avatar:        r.Avatar,
+		ForgeUrl:      r.ForgeURL,
+		Clone:         r.Clone,
+		CloneSsh:      r.CloneSSH,
+		Branch:        r.Branch,
+		PrEnabled:     r.PREnabled,
+		Visibility:    string(r.Visibility),
+		Private:       r.IsSCMPrivate,
+		Active:        r.IsActive,
+		Config:        r.Config,
+		Hash:          r.Hash,
+	}
+	if r.Perm != nil {
+		repo.Perm = &proto.Perm{
+			Pull:    r.Perm.Pull,
+			Push:    r.Perm.Push,
+			Admin:   r.Perm.Admin,
+			Synced:  r.Perm.Synced,
+			Created: r.Perm.Created,
+			Updated: r.Perm.Updated,
+		}
+	}
+	return repo
+}
+
+func repoFromProto(r *proto.Repo) *model.Repo {
+	if r == nil {
+		return nil
+	}
+	repo := &model.Repo{
+		ID:            r.GetId(),
+		UserID:        r.GetUserId(),
+		ForgeID:       r.GetForgeId(),
+		ForgeRemoteID: model.ForgeRemoteID(r.GetForgeRemoteId()),
+		OrgID:         r.GetOrgId(),
+		Owner:         r.GetOwner(),
+		Name:          r.GetName(),
+		FullName:      r.GetFullName(),
+		Avatar:        r.GetAvatar(),
+		ForgeURL:      r.GetForgeUrl(),
+		Clone:         r.GetClone(),
+		CloneSSH:      r.GetCloneSsh(),
+		Branch:        r.GetBranch(),
+		PREnabled:     r.GetPrEnabled(),
+		Visibility:    model.RepoVisibility(r.GetVisibility()),
+		IsSCMPrivate:  r.GetPrivate(),
+		IsActive:      r.GetActive(),
+		Config:        r.GetConfig(),
+		Hash:          r.GetHash(),
+	}
+	if perm := r.GetPerm(); perm != nil {
+		repo.Perm = &model.Perm{
+			Pull:    perm.GetPull(),
+			Push:    perm.GetPush(),
+			Admin:   perm.GetAdmin(),
+			Synced:  perm.GetSynced(),
+			Created: perm.GetCreated(),
+			Updated: perm.GetUpdated(),
+		}
+	}
+	return repo
+}
+
+func pipelineErrorToProto(e *errorTypes.PipelineError) *proto.PipelineError {
+	err := &proto.PipelineError{
+		Type:      string(e.Type),
+		Message:   e.Message,
+		IsWarning: e.IsWarning,
+	}
+	switch data := e.Data.(type) {
+	case *pipeline_errors.LinterErrorData:
+		err.File, err.Field, err.Line, err.Column = data.File, data.Field, int32(data.Line), int32(data.Column)
+	case *pipeline_errors.DeprecationErrorData:
+		err.File, err.Field, err.Line, err.Column, err.Docs = data.File, data.Field, int32(data.Line), int32(data.Column), data.Docs
+	case pipeline_errors.DeprecationErrorData:
+		err.File, err.Field, err.Line, err.Column, err.Docs = data.File, data.Field, int32(data.Line), int32(data.Column), data.Docs
+	case *pipeline_errors.BadHabitErrorData:
+		err.File, err.Field, err.Line, err.Column, err.Docs = data.File, data.Field, int32(data.Line), int32(data.Column), data.Docs
+	case pipeline_errors.BadHabitErrorData:
+		err.File, err.Field, err.Line, err.Column, err.Docs = data.File, data.Field, int32(data.Line), int32(data.Column), data.Docs
+	}
+	return err
+}
+
+func pipelineErrorFromProto(e *proto.PipelineError) *errorTypes.PipelineError {
+	err := &errorTypes.PipelineError{
+		Type:      errorTypes.PipelineErrorType(e.GetType()),
+		Message:   e.GetMessage(),
+		IsWarning: e.GetIsWarning(),
+	}
+	if e.GetFile() == "" && e.GetField() == "" {
+		return err
+	}
+	switch err.Type {
+	case errorTypes.PipelineErrorTypeLinter:
+		err.Data = &pipeline_errors.LinterErrorData{File: e.GetFile(), Field: e.GetField(), Line: int(e.GetLine()), Column: int(e.GetColumn())}
+	case errorTypes.PipelineErrorTypeDeprecation:
+		err.Data = &pipeline_errors.DeprecationErrorData{File: e.GetFile(), Field: e.GetField(), Docs: e.GetDocs(), Line: int(e.GetLine()), Column: int(e.GetColumn())}
+	case errorTypes.PipelineErrorTypeBadHabit:
+		err.Data = &pipeline_errors.BadHabitErrorData{File: e.GetFile(), Field: e.GetField(), Docs: e.GetDocs(), Line: int(e.GetLine()), Column: int(e.GetColumn())}
+	}
+	return err
+}
+
+func pipelineToProto(p *model.Pipeline) *proto.Pipeline {
+	if p == nil {
+		return nil
+	}
+	pipeline := &proto.Pipeline{
+		Id:                  p.ID,
+		Number:              p.Number,
+		Author:              p.Author,
+		Parent:              p.Parent,
+		Event:               string(p.Event),
+		EventReason:         p.EventReason,
+		Status:              string(p.Status),
+		Created:             p.Created,
+		Updated:             p.Updated,
+		Started:             p.Started,
+		Finished:            p.Finished,
+		DeployTo:            p.DeployTo,
+		DeployTask:          p.DeployTask,
+		Commit:              p.Commit,
+		BaseCommit:          p.BaseCommit,
+		Branch:              p.Branch,
+		Ref:                 p.Ref,
+		Refspec:             p.Refspec,
+		Title:               p.Title,
+		Message:             p.Message,
+		Timestamp:           p.Timestamp,
+		Sender:              p.Sender,
+		Avatar:              p.Avatar,
+		Email:               p.Email,
+		ForgeUrl:            p.ForgeURL,
+		Reviewer:            p.Reviewer,
+		Reviewed:            p.Reviewed,
+		ChangedFiles:        p.ChangedFiles,
+		AdditionalVariables: p.AdditionalVariables,
+		PrLabels:            p.PullRequestLabels,
+		PrMilestone:         p.PullRequestMilestone,
+		IsPrerelease:        p.IsPrerelease,
+		FromFork:            p.FromFork,
+	}
+	for _, err := range p.Errors {
+		pipeline.Errors = append(pipeline.Errors, pipelineErrorToProto(err))
+	}
+	for _, workflow := range p.Workflows {
+		pipeline.Workflows = append(pipeline.Workflows, workflowToProto(workflow))
+	}
+	return pipeline
+}
+
+func pipelineFromProto(p *proto.Pipeline) *model.Pipeline {
+	if p == nil {
+		return nil
+	}
+	pipeline := &model.Pipeline{
+		ID:                   p.GetId(),
+		Number:               p.GetNumber(),
+		Author:               p.GetAuthor(),
+		Parent:               p.GetParent(),
+		Event:                model.WebhookEvent(p.GetEvent()),
+		EventReason:          p.GetEventReason(),
+		Status:               model.StatusValue(p.GetStatus()),
+		Created:              p.GetCreated(),
+		Updated:              p.GetUpdated(),
+		Started:              p.GetStarted(),
+		Finished:             p.GetFinished(),
+		DeployTo:             p.GetDeployTo(),
+		DeployTask:           p.GetDeployTask(),
+		Commit:               p.GetCommit(),
+		BaseCommit:           p.GetBaseCommit(),
+		Branch:               p.GetBranch(),
+		Ref:                  p.GetRef(),
+		Refspec:              p.GetRefspec(),
+		Title:                p.GetTitle(),
+		Message:              p.GetMessage(),
+		Timestamp:            p.GetTimestamp(),
+		Sender:               p.GetSender(),
+		Avatar:               p.GetAvatar(),
+		Email:                p.GetEmail(),
+		ForgeURL:             p.GetForgeUrl(),
+		Reviewer:             p.GetReviewer(),
+		Reviewed:             p.GetReviewed(),
+		ChangedFiles:         p.GetChangedFiles(),
+		AdditionalVariables:  p.GetAdditionalVariables(),
+		PullRequestLabels:    p.GetPrLabels(),
+		PullRequestMilestone: p.GetPrMilestone(),
+		IsPrerelease:         p.GetIsPrerelease(),
+		FromFork:             p.GetFromFork(),
+	}
+	for _, err := range p.GetErrors() {
+		pipeline.Errors = append(pipeline.Errors, pipelineErrorFromProto(err))
+	}
+	for _, workflow := range p.GetWorkflows() {
+		pipeline.Workflows = append(pipeline.Workflows, workflowFromProto(workflow))
+	}
+	return pipeline
+}
+
+func workflowToProto(w *model.Workflow) *proto.Workflow {
+	if w == nil {
+		return nil
+	}
+	workflow := &proto.Workflow{
+		Id:         w.ID,
+		PipelineId: w.PipelineID,
+		Pid:        int32(w.PID),
+		Name:       w.Name,
+		State:      string(w.State),
+		Error:      w.Error,
+		Started:    w.Started,
+		Finished:   w.Finished,
+		AgentId:    w.AgentID,
+		Platform:   w.Platform,
+		Environ:    w.Environ,
+		AxisId:     int32(w.AxisID),
+	}
+	for _, step := range w.Children {
+		workflow.Children = append(workflow.Children, stepToProto(step))
+	}
+	return workflow
+}
+
+func workflowFromProto(w *proto.Workflow) *model.Workflow {
+	if w == nil {
+		return nil
+	}
+	workflow := &model.Workflow{
+		ID:         w.GetId(),
+		PipelineID: w.GetPipelineId(),
+		PID:        int(w.GetPid()),
+		Name:       w.GetName(),
+		State:      model.StatusValue(w.GetState()),
+		Error:      w.GetError(),
+		Started:    w.GetStarted(),
+		Finished:   w.GetFinished(),
+		AgentID:    w.GetAgentId(),
+		Platform:   w.GetPlatform(),
+		Environ:    w.GetEnviron(),
+		AxisID:     int(w.GetAxisId()),
+	}
+	for _, step := range w.GetChildren() {
+		workflow.Children = append(workflow.Children, stepFromProto(step))
+	}
+	return workflow
+}
+
+func stepToProto(s *model.Step) *proto.Step {
+	step := &proto.Step{
+		Id:         s.ID,
+		Uuid:       s.UUID,
+		PipelineId: s.PipelineID,
+		Pid:        int32(s.PID),
+		Ppid:       int32(s.PPID),
+		Name:       s.Name,
+		State:      string(s.State),
+		Error:      s.Error,
+		Failure:    s.Failure,
+		ExitCode:   int32(s.ExitCode),
+		Started:    s.Started,
+		Finished:   s.Finished,
+		Type:       string(s.Type),
+	}
+	for _, a := range s.Annotations {
+		step.Annotations = append(step.Annotations, &proto.StepAnnotation{
+			Path:        a.Path,
+			StartLine:   int32(a.StartLine),
+			EndLine:     int32(a.EndLine),
+			StartColumn: int32(a.StartColumn),
+			EndColumn:   int32(a.EndColumn),
+			Level:       a.Level,
+			Title:       a.Title,
+			Message:     a.Message,
+		})
+	}
+	return step
+}
+
+func stepFromProto(s *proto.Step) *model.Step {
+	step := &model.Step{
+		ID:         s.GetId(),
+		UUID:       s.GetUuid(),
+		PipelineID: s.GetPipelineId(),
+		PID:        int(s.GetPid()),
+		PPID:       int(s.GetPpid()),
+		Name:       s.GetName(),
+		State:      model.StatusValue(s.GetState()),
+		Error:      s.GetError(),
+		Failure:    s.GetFailure(),
+		ExitCode:   int(s.GetExitCode()),
+		Started:    s.GetStarted(),
+		Finished:   s.GetFinished(),
+		Type:       model.StepType(s.GetType()),
+	}
+	for _, a := range s.GetAnnotations() {
+		step.Annotations = append(step.Annotations, &model.StepAnnotation{
+			Path:        a.GetPath(),
+			StartLine:   int(a.GetStartLine()),
+			EndLine:     int(a.GetEndLine()),
+			StartColumn: int(a.GetStartColumn()),
+			EndColumn:   int(a.GetEndColumn()),
+			Level:       a.GetLevel(),
+			Title:       a.GetTitle(),
+			Message:     a.GetMessage(),
+		})
+	}
+	return step
+}
+
+func listOptionsToProto(p *model.ListOptions) *proto.ListOptions {
+	if p == nil {
+		return nil
+	}
+	return &proto.ListOptions{
+		All:     p.All,
+		Page:    int32(p.Page),
+		PerPage: int32(p.PerPage),
+	}
+}
+
+func listOptionsFromProto(p *proto.ListOptions) *model.ListOptions {
+	if p == nil {
+		return nil
+	}
+	return &model.ListOptions{
+		All:     p.GetAll(),
+		Page:    int(p.GetPage()),
+		PerPage: int(p.GetPerPage()),
+	}
+}
+
+func valuesToProto(values map[string][]string) map[string]*proto.Values {
+	if values == nil {
+		return nil
+	}
+	m := make(map[string]*proto.Values, len(values))
+	for key, v := range values {
+		m[key] = &proto.Values{Values: v}
+	}
+	return m
+}
+
+func valuesFromProto(values map[string]*proto.Values) map[string][]string {
+	if values == nil {
+		return nil
+	}
+	m := make(map[string][]string, len(values))
+	for key, v := range values {
+		m[key] = v.GetValues()
+	}
+	return m
+}
+
+func hookRequestToProto(r *http.Request) (*proto.HookRequest, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.HookRequest{
+		Method: r.Method,
+		Url:    r.URL.String(),
+		Header: valuesToProto(r.Header),
+		Form:   valuesToProto(r.Form),
+		Body:   body,
+	}, nil
+}
+
+func hookRequestFromProto(ctx context.Context, r *proto.HookRequest) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, r.GetMethod(), r.GetUrl(), bytes.NewBuffer(r.GetBody()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header = valuesFromProto(r.GetHeader())
+	req.Form = valuesFromProto(r.GetForm())
+	return req, nil
+}
+
+func fileMetasFromProto(files []*proto.FileMeta) []*types.FileMeta {
+	var metas []*types.FileMeta
+	for _, file := range files {
+		metas = append(metas, &types.FileMeta{Name: file.GetName(), Data: file.GetData()})
+	}
+	return metas
+}
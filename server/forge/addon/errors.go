@@ -0,0 +1,86 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addon
+
+import (
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+)
+
+const (
+	errorDomain       = "woodpecker-ci.org"
+	reasonIgnoreEvent = "IGNORE_EVENT"
+)
+
+// toStatus converts an error of the forge to a gRPC status error keeping the
+// error types the server relies on.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var ignoreEvent *types.ErrIgnoreEvent
+	switch {
+	case errors.As(err, &ignoreEvent):
+		st, detailsErr := status.New(codes.FailedPrecondition, err.Error()).WithDetails(&errdetails.ErrorInfo{
+			Reason: reasonIgnoreEvent,
+			Domain: errorDomain,
+			Metadata: map[string]string{
+				"event":  ignoreEvent.Event,
+				"reason": ignoreEvent.Reason,
+			},
+		})
+		if detailsErr != nil {
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return st.Err()
+	case errors.Is(err, types.ErrNotImplemented):
+		return status.Error(codes.Unimplemented, err.Error())
+	default:
+		return status.Error(codes.Unknown, err.Error())
+	}
+}
+
+// fromStatus converts a gRPC status error returned by the addon back to the error of the forge.
+func fromStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch st.Code() {
+	case codes.FailedPrecondition:
+		for _, detail := range st.Details() {
+			if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetDomain() == errorDomain && info.GetReason() == reasonIgnoreEvent {
+				return &types.ErrIgnoreEvent{
+					Event:  info.GetMetadata()["event"],
+					Reason: info.GetMetadata()["reason"],
+				}
+			}
+		}
+	case codes.Unimplemented:
+		return types.ErrNotImplemented
+	}
+	return errors.New(st.Message())
+}
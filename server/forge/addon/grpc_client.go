@@ -0,0 +1,444 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addon
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc"
+	grpc_credentials "google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/addon/proto"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// make sure GRPC implements forge.Forge and all optional forge interfaces.
+var (
+	_ forge.Forge                = new(GRPC)
+	_ forge.CapabilityChecker    = new(GRPC)
+	_ forge.Refresher            = new(GRPC)
+	_ forge.ChangedFilesLister   = new(GRPC)
+	_ forge.PullRequestCommenter = new(GRPC)
+	_ forge.StepStatusReporter   = new(GRPC)
+	_ forge.Rerunner             = new(GRPC)
+)
+
+const handshakeTimeout = 30 * time.Second
+
+// GRPC is a forge addon using the gRPC protocol.
+type GRPC struct {
+	client       proto.ForgeClient
+	name         string
+	url          string
+	capabilities map[forge.Capability]bool
+}
+
+// ConnectOpts are the options to connect to a forge addon over the network.
+type ConnectOpts struct {
+	// Token is sent to authenticate at the addon, only over TLS connections.
+	Token string
+	// Insecure connects to the addon without TLS.
+	Insecure bool
+	// SkipVerify skips the verification of the TLS certificate of the addon.
+	SkipVerify bool
+}
+
+// Connect connects to a long-running forge addon serving the gRPC protocol at address.
+func Connect(address string, opts ConnectOpts) (*GRPC, error) {
+	if opts.Insecure && opts.Token != "" {
+		return nil, fmt.Errorf("refusing to send the token to the forge addon over an insecure connection")
+	}
+
+	transport := grpc.WithTransportCredentials(grpc_credentials.NewTLS(&tls.Config{InsecureSkipVerify: opts.SkipVerify}))
+	if opts.Insecure {
+		transport = grpc.WithTransportCredentials(insecure.NewCredentials())
+	}
+
+	dialOpts := []grpc.DialOption{transport}
+	if opts.Token != "" {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(tokenCredentials(opts.Token)))
+	}
+
+	conn, err := grpc.NewClient(address, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newGRPC(conn)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return client, nil
+}
+
+// tokenCredentials authenticates at the addon by the token, gRPC refuses to send it
+// over connections without transport security.
+type tokenCredentials string
+
+func (t tokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{tokenKey: string(t)}, nil
+}
+
+func (tokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
+
+// newGRPC creates the client and negotiates the protocol version and capabilities with the addon.
+func newGRPC(conn grpc.ClientConnInterface) (*GRPC, error) {
+	client := proto.NewForgeClient(conn)
+
+	capabilities := make([]string, 0, len(forge.Capabilities))
+	for _, capability := range forge.Capabilities {
+		capabilities = append(capabilities, string(capability))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), handshakeTimeout)
+	defer cancel()
+	resp, err := client.Handshake(ctx, &proto.HandshakeRequest{
+		Version:      proto.Version,
+		Capabilities: capabilities,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("handshake with forge addon failed: %w", fromStatus(err))
+	}
+	if resp.GetVersion() < proto.MinVersion {
+		return nil, fmt.Errorf("forge addon uses protocol version %d, but at least version %d is required", resp.GetVersion(), proto.MinVersion)
+	}
+
+	g := &GRPC{
+		client:       client,
+		name:         resp.GetName(),
+		url:          resp.GetUrl(),
+		capabilities: make(map[forge.Capability]bool, len(resp.GetCapabilities())),
+	}
+	for _, capability := range resp.GetCapabilities() {
+		g.capabilities[forge.Capability(capability)] = true
+	}
+	return g, nil
+}
+
+func (g *GRPC) Supports(capability forge.Capability) bool {
+	return g.capabilities[capability]
+}
+
+func (g *GRPC) Name() string {
+	return g.name
+}
+
+func (g *GRPC) URL() string {
+	return g.url
+}
+
+func (g *GRPC) Login(ctx context.Context, r *types.OAuthRequest) (*model.User, string, error) {
+	resp, err := g.client.Login(ctx, &proto.LoginRequest{
+		Code:     r.Code,
+		State:    r.State,
+		Username: r.Username,
+		Password: r.Password,
+	})
+	if err != nil {
+		return nil, "", fromStatus(err)
+	}
+	return userFromProto(resp.GetUser()), resp.GetRedirectUrl(), nil
+}
+
+func (g *GRPC) Auth(ctx context.Context, token, secret string) (string, error) {
+	resp, err := g.client.Auth(ctx, &proto.AuthRequest{
+		Token:  token,
+		Secret: secret,
+	})
+	if err != nil {
+		return "", fromStatus(err)
+	}
+	return resp.GetLogin(), nil
+}
+
+func (g *GRPC) Teams(ctx context.Context, u *model.User, p *model.ListOptions) ([]*model.Team, error) {
+	resp, err := g.client.Teams(ctx, &proto.TeamsRequest{
+		User:        userToProto(u),
+		ListOptions: listOptionsToProto(p),
+	})
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	var teams []*model.Team
+	for _, team := range resp.GetTeams() {
+		teams = append(teams, &model.Team{Login: team.GetLogin(), Avatar: team.GetAvatar()})
+	}
+	return teams, nil
+}
+
+func (g *GRPC) Repo(ctx context.Context, u *model.User, remoteID model.ForgeRemoteID, owner, name string) (*model.Repo, error) {
+	resp, err := g.client.Repo(ctx, &proto.RepoRequest{
+		User:     userToProto(u),
+		RemoteId: string(remoteID),
+		Owner:    owner,
+		Name:     name,
+	})
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	return repoFromProto(resp.GetRepo()), nil
+}
+
+func (g *GRPC) Repos(ctx context.Context, u *model.User, p *model.ListOptions) ([]*model.Repo, error) {
+	resp, err := g.client.Repos(ctx, &proto.ReposRequest{
+		User:        userToProto(u),
+		ListOptions: listOptionsToProto(p),
+	})
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	var repos []*model.Repo
+	for _, repo := range resp.GetRepos() {
+		repos = append(repos, repoFromProto(repo))
+	}
+	return repos, nil
+}
+
+func (g *GRPC) File(ctx context.Context, u *model.User, r *model.Repo, b *model.Pipeline, f string) ([]byte, error) {
+	resp, err := g.client.File(ctx, &proto.FileRequest{
+		User:     userToProto(u),
+		Repo:     repoToProto(r),
+		Pipeline: pipelineToProto(b),
+		Path:     f,
+	})
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	return resp.GetData(), nil
+}
+
+func (g *GRPC) Dir(ctx context.Context, u *model.User, r *model.Repo, b *model.Pipeline, f string) ([]*types.FileMeta, error) {
+	resp, err := g.client.Dir(ctx, &proto.FileRequest{
+		User:     userToProto(u),
+		Repo:     repoToProto(r),
+		Pipeline: pipelineToProto(b),
+		Path:     f,
+	})
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	return fileMetasFromProto(resp.GetFiles()), nil
+}
+
+func (g *GRPC) Status(ctx context.Context, u *model.User, r *model.Repo, b *model.Pipeline, p *model.Workflow) error {
+	_, err := g.client.Status(ctx, &proto.StatusRequest{
+		User:     userToProto(u),
+		Repo:     repoToProto(r),
+		Pipeline: pipelineToProto(b),
+		Workflow: workflowToProto(p),
+	})
+	return fromStatus(err)
+}
+
+func (g *GRPC) Netrc(u *model.User, r *model.Repo) (*model.Netrc, error) {
+	resp, err := g.client.Netrc(context.Background(), &proto.NetrcRequest{
+		User: userToProto(u),
+		Repo: repoToProto(r),
+	})
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	netrc := resp.GetNetrc()
+	if netrc == nil {
+		return nil, nil
+	}
+	return &model.Netrc{
+		Machine:  netrc.GetMachine(),
+		Login:    netrc.GetLogin(),
+		Password: netrc.GetPassword(),
+		Type:     model.ForgeType(netrc.GetType()),
+	}, nil
+}
+
+func (g *GRPC) Activate(ctx context.Context, u *model.User, r *model.Repo, link string) error {
+	_, err := g.client.Activate(ctx, &proto.ActivateRequest{
+		User: userToProto(u),
+		Repo: repoToProto(r),
+		Link: link,
+	})
+	return fromStatus(err)
+}
+
+func (g *GRPC) Deactivate(ctx context.Context, u *model.User, r *model.Repo, link string) error {
+	_, err := g.client.Deactivate(ctx, &proto.ActivateRequest{
+		User: userToProto(u),
+		Repo: repoToProto(r),
+		Link: link,
+	})
+	return fromStatus(err)
+}
+
+func (g *GRPC) Branches(ctx context.Context, u *model.User, r *model.Repo, p *model.ListOptions) ([]string, error) {
+	resp, err := g.client.Branches(ctx, &proto.BranchesRequest{
+		User:        userToProto(u),
+		Repo:        repoToProto(r),
+		ListOptions: listOptionsToProto(p),
+	})
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	return resp.GetBranches(), nil
+}
+
+func (g *GRPC) BranchHead(ctx context.Context, u *model.User, r *model.Repo, branch string) (*model.Commit, error) {
+	resp, err := g.client.BranchHead(ctx, &proto.BranchHeadRequest{
+		User:   userToProto(u),
+		Repo:   repoToProto(r),
+		Branch: branch,
+	})
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	commit := resp.GetCommit()
+	if commit == nil {
+		return nil, nil
+	}
+	return &model.Commit{SHA: commit.GetSha(), ForgeURL: commit.GetForgeUrl()}, nil
+}
+
+func (g *GRPC) PullRequests(ctx context.Context, u *model.User, r *model.Repo, p *model.ListOptions) ([]*model.PullRequest, error) {
+	resp, err := g.client.PullRequests(ctx, &proto.BranchesRequest{
+		User:        userToProto(u),
+		Repo:        repoToProto(r),
+		ListOptions: listOptionsToProto(p),
+	})
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	var pullRequests []*model.PullRequest
+	for _, pr := range resp.GetPullRequests() {
+		pullRequests = append(pullRequests, &model.PullRequest{
+			Index: model.ForgeRemoteID(pr.GetIndex()),
+			Title: pr.GetTitle(),
+		})
+	}
+	return pullRequests, nil
+}
+
+func (g *GRPC) Hook(ctx context.Context, r *http.Request) (*model.Repo, *model.Pipeline, error) {
+	req, err := hookRequestToProto(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := g.client.Hook(ctx, req)
+	if err != nil {
+		return nil, nil, fromStatus(err)
+	}
+	return repoFromProto(resp.GetRepo()), pipelineFromProto(resp.GetPipeline()), nil
+}
+
+func (g *GRPC) OrgMembership(ctx context.Context, u *model.User, org string) (*model.OrgPerm, error) {
+	resp, err := g.client.OrgMembership(ctx, &proto.OrgRequest{
+		User: userToProto(u),
+		Org:  org,
+	})
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	perm := resp.GetPerm()
+	if perm == nil {
+		return nil, nil
+	}
+	return &model.OrgPerm{Member: perm.GetMember(), Admin: perm.GetAdmin()}, nil
+}
+
+func (g *GRPC) Org(ctx context.Context, u *model.User, org string) (*model.Org, error) {
+	resp, err := g.client.Org(ctx, &proto.OrgRequest{
+		User: userToProto(u),
+		Org:  org,
+	})
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	o := resp.GetOrg()
+	if o == nil {
+		return nil, nil
+	}
+	return &model.Org{
+		ID:      o.GetId(),
+		ForgeID: o.GetForgeId(),
+		Name:    o.GetName(),
+		IsUser:  o.GetIsUser(),
+		Private: o.GetPrivate(),
+	}, nil
+}
+
+func (g *GRPC) Refresh(ctx context.Context, u *model.User) (bool, error) {
+	resp, err := g.client.Refresh(ctx, &proto.RefreshRequest{
+		User: userToProto(u),
+	})
+	if err != nil {
+		return false, fromStatus(err)
+	}
+	if resp.GetUpdated() && resp.GetUser() != nil {
+		*u = *userFromProto(resp.GetUser())
+	}
+	return resp.GetUpdated(), nil
+}
+
+func (g *GRPC) ChangedFiles(ctx context.Context, u *model.User, r *model.Repo, base, head string) ([]string, error) {
+	resp, err := g.client.ChangedFiles(ctx, &proto.ChangedFilesRequest{
+		User: userToProto(u),
+		Repo: repoToProto(r),
+		Base: base,
+		Head: head,
+	})
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	return resp.GetFiles(), nil
+}
+
+func (g *GRPC) PullRequestComment(ctx context.Context, u *model.User, r *model.Repo, p *model.Pipeline, body string) error {
+	_, err := g.client.PullRequestComment(ctx, &proto.PullRequestCommentRequest{
+		User:     userToProto(u),
+		Repo:     repoToProto(r),
+		Pipeline: pipelineToProto(p),
+		Body:     body,
+	})
+	return fromStatus(err)
+}
+
+func (g *GRPC) StepStatus(ctx context.Context, u *model.User, r *model.Repo, p *model.Pipeline, w *model.Workflow) error {
+	_, err := g.client.StepStatus(ctx, &proto.StatusRequest{
+		User:     userToProto(u),
+		Repo:     repoToProto(r),
+		Pipeline: pipelineToProto(p),
+		Workflow: workflowToProto(w),
+	})
+	return fromStatus(err)
+}
+
+func (g *GRPC) RerunHook(ctx context.Context, r *http.Request) (*model.Repo, int64, error) {
+	req, err := hookRequestToProto(r)
+	if err != nil {
+		return nil, 0, err
+	}
+	resp, err := g.client.RerunHook(ctx, req)
+	if err != nil {
+		return nil, 0, fromStatus(err)
+	}
+	return repoFromProto(resp.GetRepo()), resp.GetNumber(), nil
+}
@@ -0,0 +1,376 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addon
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpc_credentials "google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/addon/proto"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// tokenKey is the metadata key of the token used to authenticate at network addons.
+const tokenKey = "token"
+
+// ServeOpts are the options to serve a forge addon over the network.
+type ServeOpts struct {
+	// Token the server has to send to authenticate, if not empty.
+	Token string
+	// TLSConfig enables TLS for the connections, the server only sends the token over TLS.
+	TLSConfig *tls.Config
+}
+
+// ServeGRPC serves the forge by the gRPC protocol on the listener, e.g. as long-running
+// forge adapter the Woodpecker server connects to over the network.
+func ServeGRPC(lis net.Listener, impl forge.Forge, opts ServeOpts) error {
+	var serverOpts []grpc.ServerOption
+	if opts.TLSConfig != nil {
+		serverOpts = append(serverOpts, grpc.Creds(grpc_credentials.NewTLS(opts.TLSConfig)))
+	}
+	if opts.Token != "" {
+		serverOpts = append(serverOpts, grpc.UnaryInterceptor(tokenAuthorizer(opts.Token)))
+	}
+
+	server := grpc.NewServer(serverOpts...)
+	proto.RegisterForgeServer(server, &GRPCServer{Impl: impl})
+	return server.Serve(lis)
+}
+
+func tokenAuthorizer(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get(tokenKey)
+		if len(values) != 1 || subtle.ConstantTimeCompare([]byte(values[0]), []byte(token)) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "invalid token")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// GRPCServer serves a forge by the gRPC protocol.
+type GRPCServer struct {
+	proto.UnimplementedForgeServer
+	Impl forge.Forge
+}
+
+func (s *GRPCServer) Handshake(_ context.Context, req *proto.HandshakeRequest) (*proto.HandshakeResponse, error) {
+	if req.GetVersion() < proto.MinVersion {
+		return nil, status.Errorf(codes.FailedPrecondition, "server uses protocol version %d, but at least version %d is required", req.GetVersion(), proto.MinVersion)
+	}
+
+	var capabilities []string
+	for _, capability := range req.GetCapabilities() {
+		if s.supports(forge.Capability(capability)) {
+			capabilities = append(capabilities, capability)
+		}
+	}
+
+	return &proto.HandshakeResponse{
+		Version:      proto.Version,
+		Name:         s.Impl.Name(),
+		Url:          s.Impl.URL(),
+		Capabilities: capabilities,
+	}, nil
+}
+
+// supports returns true if the forge implements the optional interface of the capability.
+func (s *GRPCServer) supports(capability forge.Capability) bool {
+	var ok bool
+	switch capability {
+	case forge.CapabilityRefresh:
+		_, ok = forge.As[forge.Refresher](s.Impl, capability)
+	case forge.CapabilityChangedFiles:
+		_, ok = forge.As[forge.ChangedFilesLister](s.Impl, capability)
+	case forge.CapabilityPullRequestComment:
+		_, ok = forge.As[forge.PullRequestCommenter](s.Impl, capability)
+	case forge.CapabilityStepStatus:
+		_, ok = forge.As[forge.StepStatusReporter](s.Impl, capability)
+	case forge.CapabilityRerun:
+		_, ok = forge.As[forge.Rerunner](s.Impl, capability)
+	}
+	return ok
+}
+
+func (s *GRPCServer) Login(ctx context.Context, req *proto.LoginRequest) (*proto.LoginResponse, error) {
+	user, redirectURL, err := s.Impl.Login(ctx, &types.OAuthRequest{
+		Code:     req.GetCode(),
+		State:    req.GetState(),
+		Username: req.GetUsername(),
+		Password: req.GetPassword(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.LoginResponse{
+		User:        userToProto(user),
+		RedirectUrl: redirectURL,
+	}, nil
+}
+
+func (s *GRPCServer) Auth(ctx context.Context, req *proto.AuthRequest) (*proto.AuthResponse, error) {
+	login, err := s.Impl.Auth(ctx, req.GetToken(), req.GetSecret())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.AuthResponse{Login: login}, nil
+}
+
+func (s *GRPCServer) Teams(ctx context.Context, req *proto.TeamsRequest) (*proto.TeamsResponse, error) {
+	teams, err := s.Impl.Teams(ctx, userFromProto(req.GetUser()), listOptionsFromProto(req.GetListOptions()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &proto.TeamsResponse{}
+	for _, team := range teams {
+		resp.Teams = append(resp.Teams, &proto.Team{Login: team.Login, Avatar: team.Avatar})
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) Repo(ctx context.Context, req *proto.RepoRequest) (*proto.RepoResponse, error) {
+	repo, err := s.Impl.Repo(ctx, userFromProto(req.GetUser()), model.ForgeRemoteID(req.GetRemoteId()), req.GetOwner(), req.GetName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.RepoResponse{Repo: repoToProto(repo)}, nil
+}
+
+func (s *GRPCServer) Repos(ctx context.Context, req *proto.ReposRequest) (*proto.ReposResponse, error) {
+	repos, err := s.Impl.Repos(ctx, userFromProto(req.GetUser()), listOptionsFromProto(req.GetListOptions()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &proto.ReposResponse{}
+	for _, repo := range repos {
+		resp.Repos = append(resp.Repos, repoToProto(repo))
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) File(ctx context.Context, req *proto.FileRequest) (*proto.FileResponse, error) {
+	data, err := s.Impl.File(ctx, userFromProto(req.GetUser()), repoFromProto(req.GetRepo()), pipelineFromProto(req.GetPipeline()), req.GetPath())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.FileResponse{Data: data}, nil
+}
+
+func (s *GRPCServer) Dir(ctx context.Context, req *proto.FileRequest) (*proto.DirResponse, error) {
+	files, err := s.Impl.Dir(ctx, userFromProto(req.GetUser()), repoFromProto(req.GetRepo()), pipelineFromProto(req.GetPipeline()), req.GetPath())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &proto.DirResponse{}
+	for _, file := range files {
+		resp.Files = append(resp.Files, &proto.FileMeta{Name: file.Name, Data: file.Data})
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) Status(ctx context.Context, req *proto.StatusRequest) (*proto.Empty, error) {
+	err := s.Impl.Status(ctx, userFromProto(req.GetUser()), repoFromProto(req.GetRepo()), pipelineFromProto(req.GetPipeline()), workflowFromProto(req.GetWorkflow()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.Empty{}, nil
+}
+
+func (s *GRPCServer) Netrc(_ context.Context, req *proto.NetrcRequest) (*proto.NetrcResponse, error) {
+	netrc, err := s.Impl.Netrc(userFromProto(req.GetUser()), repoFromProto(req.GetRepo()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &proto.NetrcResponse{}
+	if netrc != nil {
+		resp.Netrc = &proto.Netrc{
+			Machine:  netrc.Machine,
+			Login:    netrc.Login,
+			Password: netrc.Password,
+			Type:     string(netrc.Type),
+		}
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) Activate(ctx context.Context, req *proto.ActivateRequest) (*proto.Empty, error) {
+	if err := s.Impl.Activate(ctx, userFromProto(req.GetUser()), repoFromProto(req.GetRepo()), req.GetLink()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.Empty{}, nil
+}
+
+func (s *GRPCServer) Deactivate(ctx context.Context, req *proto.ActivateRequest) (*proto.Empty, error) {
+	if err := s.Impl.Deactivate(ctx, userFromProto(req.GetUser()), repoFromProto(req.GetRepo()), req.GetLink()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.Empty{}, nil
+}
+
+func (s *GRPCServer) Branches(ctx context.Context, req *proto.BranchesRequest) (*proto.BranchesResponse, error) {
+	branches, err := s.Impl.Branches(ctx, userFromProto(req.GetUser()), repoFromProto(req.GetRepo()), listOptionsFromProto(req.GetListOptions()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.BranchesResponse{Branches: branches}, nil
+}
+
+func (s *GRPCServer) BranchHead(ctx context.Context, req *proto.BranchHeadRequest) (*proto.BranchHeadResponse, error) {
+	commit, err := s.Impl.BranchHead(ctx, userFromProto(req.GetUser()), repoFromProto(req.GetRepo()), req.GetBranch())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &proto.BranchHeadResponse{}
+	if commit != nil {
+		resp.Commit = &proto.Commit{Sha: commit.SHA, ForgeUrl: commit.ForgeURL}
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) PullRequests(ctx context.Context, req *proto.BranchesRequest) (*proto.PullRequestsResponse, error) {
+	pullRequests, err := s.Impl.PullRequests(ctx, userFromProto(req.GetUser()), repoFromProto(req.GetRepo()), listOptionsFromProto(req.GetListOptions()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &proto.PullRequestsResponse{}
+	for _, pr := range pullRequests {
+		resp.PullRequests = append(resp.PullRequests, &proto.PullRequest{Index: string(pr.Index), Title: pr.Title})
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) Hook(ctx context.Context, req *proto.HookRequest) (*proto.HookResponse, error) {
+	r, err := hookRequestFromProto(ctx, req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	repo, pipeline, err := s.Impl.Hook(ctx, r)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.HookResponse{
+		Repo:     repoToProto(repo),
+		Pipeline: pipelineToProto(pipeline),
+	}, nil
+}
+
+func (s *GRPCServer) OrgMembership(ctx context.Context, req *proto.OrgRequest) (*proto.OrgMembershipResponse, error) {
+	perm, err := s.Impl.OrgMembership(ctx, userFromProto(req.GetUser()), req.GetOrg())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &proto.OrgMembershipResponse{}
+	if perm != nil {
+		resp.Perm = &proto.OrgPerm{Member: perm.Member, Admin: perm.Admin}
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) Org(ctx context.Context, req *proto.OrgRequest) (*proto.OrgResponse, error) {
+	org, err := s.Impl.Org(ctx, userFromProto(req.GetUser()), req.GetOrg())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &proto.OrgResponse{}
+	if org != nil {
+		resp.Org = &proto.Org{
+			Id:      org.ID,
+			ForgeId: org.ForgeID,
+			Name:    org.Name,
+			IsUser:  org.IsUser,
+			Private: org.Private,
+		}
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) Refresh(ctx context.Context, req *proto.RefreshRequest) (*proto.RefreshResponse, error) {
+	refresher, ok := forge.As[forge.Refresher](s.Impl, forge.CapabilityRefresh)
+	if !ok {
+		return s.UnimplementedForgeServer.Refresh(ctx, req)
+	}
+	user := userFromProto(req.GetUser())
+	updated, err := refresher.Refresh(ctx, user)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.RefreshResponse{
+		User:    userToProto(user),
+		Updated: updated,
+	}, nil
+}
+
+func (s *GRPCServer) ChangedFiles(ctx context.Context, req *proto.ChangedFilesRequest) (*proto.ChangedFilesResponse, error) {
+	lister, ok := forge.As[forge.ChangedFilesLister](s.Impl, forge.CapabilityChangedFiles)
+	if !ok {
+		return s.UnimplementedForgeServer.ChangedFiles(ctx, req)
+	}
+	files, err := lister.ChangedFiles(ctx, userFromProto(req.GetUser()), repoFromProto(req.GetRepo()), req.GetBase(), req.GetHead())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.ChangedFilesResponse{Files: files}, nil
+}
+
+func (s *GRPCServer) PullRequestComment(ctx context.Context, req *proto.PullRequestCommentRequest) (*proto.Empty, error) {
+	commenter, ok := forge.As[forge.PullRequestCommenter](s.Impl, forge.CapabilityPullRequestComment)
+	if !ok {
+		return s.UnimplementedForgeServer.PullRequestComment(ctx, req)
+	}
+	if err := commenter.PullRequestComment(ctx, userFromProto(req.GetUser()), repoFromProto(req.GetRepo()), pipelineFromProto(req.GetPipeline()), req.GetBody()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.Empty{}, nil
+}
+
+func (s *GRPCServer) StepStatus(ctx context.Context, req *proto.StatusRequest) (*proto.Empty, error) {
+	reporter, ok := forge.As[forge.StepStatusReporter](s.Impl, forge.CapabilityStepStatus)
+	if !ok {
+		return s.UnimplementedForgeServer.StepStatus(ctx, req)
+	}
+	if err := reporter.StepStatus(ctx, userFromProto(req.GetUser()), repoFromProto(req.GetRepo()), pipelineFromProto(req.GetPipeline()), workflowFromProto(req.GetWorkflow())); err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.Empty{}, nil
+}
+
+func (s *GRPCServer) RerunHook(ctx context.Context, req *proto.HookRequest) (*proto.RerunHookResponse, error) {
+	rerunner, ok := forge.As[forge.Rerunner](s.Impl, forge.CapabilityRerun)
+	if !ok {
+		return s.UnimplementedForgeServer.RerunHook(ctx, req)
+	}
+	r, err := hookRequestFromProto(ctx, req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	repo, number, err := rerunner.RerunHook(ctx, r)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.RerunHookResponse{
+		Repo:   repoToProto(repo),
+		Number: number,
+	}, nil
+}
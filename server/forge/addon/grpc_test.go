@@ -0,0 +1,230 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addon
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	pipeline_errors "go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
+	errorTypes "go.woodpecker-ci.org/woodpecker/v3/pipeline/errors/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge"
+	forge_mocks "go.woodpecker-ci.org/woodpecker/v3/server/forge/mocks"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+type refresherForge struct {
+	*forge_mocks.MockForge
+	*forge_mocks.MockRefresher
+}
+
+type stepStatusForge struct {
+	*forge_mocks.MockForge
+	workflow *model.Workflow
+}
+
+func (f *stepStatusForge) StepStatus(_ context.Context, _ *model.User, _ *model.Repo, _ *model.Pipeline, w *model.Workflow) error {
+	f.workflow = w
+	return nil
+}
+
+func serve(t *testing.T, impl forge.Forge, opts ServeOpts) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	go func() {
+		_ = ServeGRPC(lis, impl, opts)
+	}()
+	t.Cleanup(func() { _ = lis.Close() })
+	return lis.Addr().String()
+}
+
+func TestGRPCHandshake(t *testing.T) {
+	mockForge := forge_mocks.NewMockForge(t)
+	mockForge.On("Name").Return("addon")
+	mockForge.On("URL").Return("https://forge.example.com")
+	address := serve(t, mockForge, ServeOpts{})
+
+	client, err := Connect(address, ConnectOpts{Insecure: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "addon", client.Name())
+	assert.Equal(t, "https://forge.example.com", client.URL())
+	for _, capability := range forge.Capabilities {
+		assert.False(t, client.Supports(capability), capability)
+	}
+	_, ok := forge.As[forge.Refresher](client, forge.CapabilityRefresh)
+	assert.False(t, ok)
+}
+
+func TestGRPCCapabilities(t *testing.T) {
+	impl := &refresherForge{
+		MockForge:     forge_mocks.NewMockForge(t),
+		MockRefresher: forge_mocks.NewMockRefresher(t),
+	}
+	impl.MockForge.On("Name").Return("addon")
+	impl.MockForge.On("URL").Return("")
+	impl.MockRefresher.On("Refresh", mock.Anything, mock.Anything).Return(true, nil)
+	address := serve(t, impl, ServeOpts{})
+
+	client, err := Connect(address, ConnectOpts{Insecure: true})
+	assert.NoError(t, err)
+	assert.True(t, client.Supports(forge.CapabilityRefresh))
+	assert.False(t, client.Supports(forge.CapabilityChangedFiles))
+
+	refresher, ok := forge.As[forge.Refresher](client, forge.CapabilityRefresh)
+	if assert.True(t, ok) {
+		user := &model.User{Login: "octocat"}
+		updated, err := refresher.Refresh(t.Context(), user)
+		assert.NoError(t, err)
+		assert.True(t, updated)
+	}
+
+	_, err = client.ChangedFiles(t.Context(), &model.User{}, &model.Repo{}, "main", "6dcb09b5")
+	assert.ErrorIs(t, err, types.ErrNotImplemented)
+}
+
+func TestGRPCIgnoreEvent(t *testing.T) {
+	mockForge := forge_mocks.NewMockForge(t)
+	mockForge.On("Name").Return("addon")
+	mockForge.On("URL").Return("")
+	mockForge.On("Hook", mock.Anything, mock.Anything).Return(nil, nil, &types.ErrIgnoreEvent{Event: "push", Reason: "no commits"})
+	address := serve(t, mockForge, ServeOpts{})
+
+	client, err := Connect(address, ConnectOpts{Insecure: true})
+	assert.NoError(t, err)
+
+	_, _, err = client.Hook(t.Context(), httptest.NewRequest("POST", "/api/hook", strings.NewReader("{}")))
+	assert.ErrorIs(t, err, &types.ErrIgnoreEvent{})
+	assert.EqualError(t, err, "explicit ignored event 'push', reason: no commits")
+}
+
+// selfSignedTLSConfig returns a tls config with a self-signed certificate for 127.0.0.1.
+func selfSignedTLSConfig(t *testing.T) *tls.Config {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	return &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{cert}, PrivateKey: key}}}
+}
+
+func TestGRPCToken(t *testing.T) {
+	mockForge := forge_mocks.NewMockForge(t)
+	mockForge.On("Name").Return("addon")
+	mockForge.On("URL").Return("")
+	address := serve(t, mockForge, ServeOpts{Token: "secret", TLSConfig: selfSignedTLSConfig(t)})
+
+	_, err := Connect(address, ConnectOpts{Token: "invalid", SkipVerify: true})
+	assert.ErrorContains(t, err, "invalid token")
+
+	_, err = Connect(address, ConnectOpts{Token: "secret", SkipVerify: true})
+	assert.NoError(t, err)
+
+	// the token is never sent without tls
+	_, err = Connect(address, ConnectOpts{Token: "secret", Insecure: true})
+	assert.ErrorContains(t, err, "insecure connection")
+}
+
+func TestGRPCHook(t *testing.T) {
+	repo := &model.Repo{
+		ForgeRemoteID: "1",
+		Owner:         "octocat",
+		Name:          "hello-world",
+		FullName:      "octocat/hello-world",
+		Visibility:    model.VisibilityPublic,
+		Hash:          "hash",
+		Perm:          &model.Perm{Pull: true, Push: true},
+	}
+	pipeline := &model.Pipeline{
+		Event:               model.EventPull,
+		Commit:              "6dcb09b5",
+		Branch:              "main",
+		AdditionalVariables: map[string]string{"KEY": "value"},
+		Errors: []*errorTypes.PipelineError{{
+			Type:    errorTypes.PipelineErrorTypeLinter,
+			Message: "invalid step",
+			Data:    &pipeline_errors.LinterErrorData{File: "ci", Field: "steps.build", Line: 3, Column: 5},
+		}},
+	}
+
+	mockForge := forge_mocks.NewMockForge(t)
+	mockForge.On("Name").Return("addon")
+	mockForge.On("URL").Return("")
+	mockForge.On("Hook", mock.Anything, mock.MatchedBy(func(r *http.Request) bool {
+		body, _ := io.ReadAll(r.Body)
+		return r.Method == http.MethodPost && r.Header.Get("X-Event") == "push" && string(body) == "{}"
+	})).Return(repo, pipeline, nil)
+	address := serve(t, mockForge, ServeOpts{})
+
+	client, err := Connect(address, ConnectOpts{Insecure: true})
+	assert.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/hook", strings.NewReader("{}"))
+	req.Header.Set("X-Event", "push")
+	gotRepo, gotPipeline, err := client.Hook(t.Context(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, repo, gotRepo)
+	assert.Equal(t, pipeline, gotPipeline)
+}
+
+func TestGRPCStepStatus(t *testing.T) {
+	workflow := &model.Workflow{
+		ID:    1,
+		Name:  "build",
+		State: model.StatusFailure,
+		Children: []*model.Step{{
+			Name:     "test",
+			State:    model.StatusFailure,
+			ExitCode: 1,
+			Annotations: []*model.StepAnnotation{{
+				Path:      "main.go",
+				StartLine: 12,
+				EndLine:   12,
+				Level:     "failure",
+				Message:   "unused variable x",
+			}},
+		}},
+	}
+
+	impl := &stepStatusForge{MockForge: forge_mocks.NewMockForge(t)}
+	impl.MockForge.On("Name").Return("addon")
+	impl.MockForge.On("URL").Return("")
+	address := serve(t, impl, ServeOpts{})
+
+	client, err := Connect(address, ConnectOpts{Insecure: true})
+	assert.NoError(t, err)
+	assert.NoError(t, client.StepStatus(t.Context(), &model.User{}, &model.Repo{}, &model.Pipeline{}, workflow))
+	assert.Equal(t, workflow, impl.workflow)
+}
@@ -15,15 +15,25 @@
 package addon
 
 import (
+	"context"
 	"net/rpc"
 
 	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
 
 	"go.woodpecker-ci.org/woodpecker/v3/server/forge"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/addon/proto"
 )
 
 const pluginKey = "forge"
 
+const (
+	// netRPCProtocolVersion is the plugin protocol version of addons using net/rpc.
+	netRPCProtocolVersion = 1
+	// grpcProtocolVersion is the plugin protocol version of addons using gRPC.
+	grpcProtocolVersion = 2
+)
+
 var HandshakeConfig = plugin.HandshakeConfig{
 	ProtocolVersion:  1,
 	MagicCookieKey:   "WOODPECKER_FORGE_ADDON_PLUGIN",
@@ -41,3 +51,27 @@ func (p *Plugin) Server(*plugin.MuxBroker) (any, error) {
 func (*Plugin) Client(_ *plugin.MuxBroker, c *rpc.Client) (any, error) {
 	return &RPC{client: c}, nil
 }
+
+// GRPCPlugin is the forge addon plugin using the gRPC protocol.
+type GRPCPlugin struct {
+	plugin.NetRPCUnsupportedPlugin
+	Impl forge.Forge
+}
+
+func (p *GRPCPlugin) GRPCServer(_ *plugin.GRPCBroker, s *grpc.Server) error {
+	proto.RegisterForgeServer(s, &GRPCServer{Impl: p.Impl})
+	return nil
+}
+
+func (*GRPCPlugin) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, c *grpc.ClientConn) (any, error) {
+	return newGRPC(c)
+}
+
+// pluginSets returns the plugins of all supported plugin protocol versions,
+// the newest version supported by both the server and the addon is used.
+func pluginSets(impl forge.Forge) map[int]plugin.PluginSet {
+	return map[int]plugin.PluginSet{
+		netRPCProtocolVersion: {pluginKey: &Plugin{Impl: impl}},
+		grpcProtocolVersion:   {pluginKey: &GRPCPlugin{Impl: impl}},
+	}
+}
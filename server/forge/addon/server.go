@@ -26,12 +26,13 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
 )
 
+// Serve serves the forge as addon started by the Woodpecker server.
+// The gRPC protocol is used if the server supports it, net/rpc otherwise.
 func Serve(impl forge.Forge) {
 	plugin.Serve(&plugin.ServeConfig{
-		HandshakeConfig: HandshakeConfig,
-		Plugins: map[string]plugin.Plugin{
-			pluginKey: &Plugin{Impl: impl},
-		},
+		HandshakeConfig:  HandshakeConfig,
+		VersionedPlugins: pluginSets(impl),
+		GRPCServer:       plugin.DefaultGRPCServer,
 	})
 }
 
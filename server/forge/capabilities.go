@@ -0,0 +1,60 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forge
+
+// Capability is the name of an optional forge interface.
+type Capability string
+
+const (
+	CapabilityRefresh            Capability = "refresh"
+	CapabilityChangedFiles       Capability = "changed_files"
+	CapabilityPullRequestComment Capability = "pull_request_comment"
+	CapabilityStepStatus         Capability = "step_status"
+	CapabilityRerun              Capability = "rerun"
+	CapabilityPoll               Capability = "poll"
+)
+
+// Capabilities are all optional forge interfaces.
+var Capabilities = []Capability{
+	CapabilityRefresh,
+	CapabilityChangedFiles,
+	CapabilityPullRequestComment,
+	CapabilityStepStatus,
+	CapabilityRerun,
+	CapabilityPoll,
+}
+
+// CapabilityChecker is an optional interface for forges that only know at runtime
+// which of the optional interfaces they implement are supported.
+//
+// Implementations: Addon (capabilities are negotiated with the addon).
+type CapabilityChecker interface {
+	// Supports returns true if the optional interface of the capability is supported.
+	Supports(capability Capability) bool
+}
+
+// As returns the forge as optional interface T if it implements it and,
+// for forges implementing CapabilityChecker, supports the capability.
+func As[T any](forge Forge, capability Capability) (T, bool) {
+	t, ok := forge.(T)
+	if !ok {
+		return t, false
+	}
+	if checker, ok := forge.(CapabilityChecker); ok && !checker.Supports(capability) {
+		var zero T
+		return zero, false
+	}
+	return t, true
+}
@@ -0,0 +1,35 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forge
+
+import (
+	"context"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// ChangedFilesLister is an optional interface for forges able to compare two commits.
+//
+// It is used to complete the changed files of pipelines if the webhook payload
+// does not contain all of them (e.g. force-pushes or pushes with many commits)
+// and to evaluate path constraints with compare_to.
+//
+// Implementations: GitHub, Gitea, Forgejo, GitLab, Git.
+type ChangedFilesLister interface {
+	// ChangedFiles returns the files changed by head since it diverged from base.
+	// Base and head are commit shas, branches or tags.
+	// Renamed files should be returned with their old and new path.
+	ChangedFiles(ctx context.Context, u *model.User, r *model.Repo, base, head string) ([]string, error)
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forge
+
+import (
+	"context"
+	"net/http"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// StepStatusReporter is an optional interface for forges showing the progress of
+// the steps of a workflow, e.g. in a check run.
+//
+// Implementations: GitHub (if check runs are enabled).
+type StepStatusReporter interface {
+	// StepStatus is called whenever a step of the workflow finished.
+	// The workflow contains all of its steps.
+	StepStatus(ctx context.Context, u *model.User, r *model.Repo, p *model.Pipeline, w *model.Workflow) error
+}
+
+// Rerunner is an optional interface for forges allowing to run a pipeline again
+// from their UI, e.g. by the re-run button of a check run.
+//
+// Implementations: GitHub (if check runs are enabled).
+type Rerunner interface {
+	// RerunHook parses the webhook sent by the forge to run a pipeline again.
+	// It returns the repo and the number of the pipeline to restart.
+	RerunHook(ctx context.Context, r *http.Request) (*model.Repo, int64, error)
+}
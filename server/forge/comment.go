@@ -0,0 +1,37 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forge
+
+import (
+	"context"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// PullRequestCommentMarker is part of the body of the pull request comment
+// created by Woodpecker, so it can be found and updated by later pipelines.
+const PullRequestCommentMarker = "<!-- woodpecker-ci:report -->"
+
+// PullRequestCommenter is an optional interface for forges able to comment on pull requests.
+//
+// It is used to post the summary of pipelines on the pull request they ran for.
+//
+// Implementations: GitHub, Gitea, Forgejo, GitLab.
+type PullRequestCommenter interface {
+	// PullRequestComment creates the comment on the pull request of the pipeline or
+	// updates the existing one containing the PullRequestCommentMarker.
+	// The body is markdown and contains the marker.
+	PullRequestComment(ctx context.Context, u *model.User, r *model.Repo, p *model.Pipeline, body string) error
+}
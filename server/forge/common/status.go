@@ -27,7 +27,8 @@ import (
 
 func GetPipelineStatusContext(repo *model.Repo, pipeline *model.Pipeline, workflow *model.Workflow) string {
 	event := string(pipeline.Event)
-	if pipeline.Event == model.EventPull {
+	// merge groups have to report the same checks as the pull requests they contain
+	if pipeline.Event == model.EventPull || pipeline.Event == model.EventMergeGroup {
 		event = "pr"
 	}
 
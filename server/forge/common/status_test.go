@@ -40,6 +40,8 @@ func TestGetPipelineStatusContext(t *testing.T) {
 	server.Config.Server.StatusContext = "ci/woodpecker"
 	server.Config.Server.StatusContextFormat = "{{ .context }}/{{ .event }}/{{ .workflow }}"
 	assert.EqualValues(t, "ci/woodpecker/pr/lint", GetPipelineStatusContext(repo, pipeline, workflow))
+	pipeline.Event = model.EventMergeGroup
+	assert.EqualValues(t, "ci/woodpecker/pr/lint", GetPipelineStatusContext(repo, pipeline, workflow))
 	pipeline.Event = model.EventPush
 	assert.EqualValues(t, "ci/woodpecker/push/lint", GetPipelineStatusContext(repo, pipeline, workflow))
 
@@ -90,6 +90,7 @@ func pipelineFromPush(hook *pushHook) *model.Pipeline {
 	return &model.Pipeline{
 		Event:        model.EventPush,
 		Commit:       hook.After,
+		BaseCommit:   hook.Before,
 		Ref:          hook.Ref,
 		ForgeURL:     link,
 		Branch:       strings.TrimPrefix(hook.Ref, "refs/heads/"),
@@ -104,6 +105,12 @@ func pipelineFromPush(hook *pushHook) *model.Pipeline {
 }
 
 func getChangedFilesFromPushHook(hook *pushHook) []string {
+	// the payload only contains a limited number of commits, leave it to the
+	// server to compare the commits if some are missing
+	if hook.TotalCommits > len(hook.Commits) {
+		return nil
+	}
+
 	// assume a capacity of 4 changed files per commit
 	files := make([]string, 0, len(hook.Commits)*4)
 	for _, c := range hook.Commits {
@@ -163,17 +170,18 @@ func pipelineFromPullRequest(hook *pullRequestHook) *model.Pipeline {
 	}
 
 	pipeline := &model.Pipeline{
-		Event:    event,
-		Commit:   hook.PullRequest.Head.Sha,
-		ForgeURL: hook.PullRequest.HTMLURL,
-		Ref:      fmt.Sprintf("refs/pull/%d/head", hook.Number),
-		Branch:   hook.PullRequest.Base.Ref,
-		Message:  hook.PullRequest.Title,
-		Author:   hook.PullRequest.Poster.UserName,
-		Avatar:   avatar,
-		Sender:   hook.Sender.UserName,
-		Email:    hook.Sender.Email,
-		Title:    hook.PullRequest.Title,
+		Event:      event,
+		Commit:     hook.PullRequest.Head.Sha,
+		BaseCommit: hook.PullRequest.Base.Sha,
+		ForgeURL:   hook.PullRequest.HTMLURL,
+		Ref:        fmt.Sprintf("refs/pull/%d/head", hook.Number),
+		Branch:     hook.PullRequest.Base.Ref,
+		Message:    hook.PullRequest.Title,
+		Author:     hook.PullRequest.Poster.UserName,
+		Avatar:     avatar,
+		Sender:     hook.Sender.UserName,
+		Email:      hook.Sender.Email,
+		Title:      hook.PullRequest.Title,
 		Refspec: fmt.Sprintf("%s:%s",
 			hook.PullRequest.Head.Ref,
 			hook.PullRequest.Base.Ref,
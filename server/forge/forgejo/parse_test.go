@@ -66,6 +66,7 @@ func TestForgejoParser(t *testing.T) {
 				Author:       "6543",
 				Event:        "push",
 				Commit:       "28c3613ae62640216bea5e7dc71aa65356e4298b",
+				BaseCommit:   "0000000000000000000000000000000000000000",
 				Branch:       "fdsafdsa",
 				Ref:          "refs/heads/fdsafdsa",
 				Message:      "Delete '.woodpecker/.check.yml'\n",
@@ -100,6 +101,7 @@ func TestForgejoParser(t *testing.T) {
 				Author:       "gordon",
 				Event:        "push",
 				Commit:       "ef98532add3b2feb7a137426bba1248724367df5",
+				BaseCommit:   "4b2626259b5a97b6b4eab5e6cca66adb986b672b",
 				Branch:       "main",
 				Ref:          "refs/heads/main",
 				Message:      "bump\n",
@@ -134,6 +136,7 @@ func TestForgejoParser(t *testing.T) {
 				Author:       "test-user",
 				Event:        "push",
 				Commit:       "29be01c073851cf0db0c6a466e396b725a670453",
+				BaseCommit:   "6efcf5b7c98f3e7a491675164b7a2e7acac27941",
 				Branch:       "main",
 				Ref:          "refs/heads/main",
 				Message:      "add some text\n",
@@ -202,6 +205,7 @@ func TestForgejoParser(t *testing.T) {
 				Author:            "gordon",
 				Event:             "pull_request",
 				Commit:            "0d1a26e67d8f5eaf1f6ba5c57fc3c7d91ac0fd1c",
+				BaseCommit:        "9353195a19e45482665306e466c832c46560532d",
 				Branch:            "main",
 				Ref:               "refs/pull/1/head",
 				Refspec:           "feature/changes:main",
@@ -240,6 +244,7 @@ func TestForgejoParser(t *testing.T) {
 				Author:            "6543",
 				Event:             "pull_request",
 				Commit:            "36b5813240a9d2daa29b05046d56a53e18f39a3e",
+				BaseCommit:        "67012991d6c69b1c58378346fca366b864d8d1a1",
 				Branch:            "main",
 				Ref:               "refs/pull/1/head",
 				Refspec:           "6543-patch-1:main",
@@ -275,18 +280,19 @@ func TestForgejoParser(t *testing.T) {
 				},
 			},
 			pipe: &model.Pipeline{
-				Author:   "test",
-				Event:    "pull_request",
-				Commit:   "788ed8d02d3b7fcfcf6386dbcbca696aa1d4dc25",
-				Branch:   "main",
-				Ref:      "refs/pull/2/head",
-				Refspec:  "test-patch-1:main",
-				Title:    "New Pull",
-				Message:  "New Pull",
-				Sender:   "test",
-				Avatar:   "http://127.0.0.1:3000/avatars/dd46a756faad4727fb679320751f6dea",
-				Email:    "test@noreply.localhost",
-				ForgeURL: "http://127.0.0.1:3000/Test-CI/multi-line-secrets/pulls/2",
+				Author:     "test",
+				Event:      "pull_request",
+				Commit:     "788ed8d02d3b7fcfcf6386dbcbca696aa1d4dc25",
+				BaseCommit: "29be01c073851cf0db0c6a466e396b725a670453",
+				Branch:     "main",
+				Ref:        "refs/pull/2/head",
+				Refspec:    "test-patch-1:main",
+				Title:      "New Pull",
+				Message:    "New Pull",
+				Sender:     "test",
+				Avatar:     "http://127.0.0.1:3000/avatars/dd46a756faad4727fb679320751f6dea",
+				Email:      "test@noreply.localhost",
+				ForgeURL:   "http://127.0.0.1:3000/Test-CI/multi-line-secrets/pulls/2",
 				PullRequestLabels: []string{
 					"Kind/Bug",
 					"Kind/Security",
@@ -319,6 +325,7 @@ func TestForgejoParser(t *testing.T) {
 				Event:             "pull_request_metadata",
 				EventReason:       []string{"edited"},
 				Commit:            "d555a5dd07f4d0148a58d4686ec381502ae6a2d4",
+				BaseCommit:        "068aee163ffd44eef28a7f9ebd43e2c01774f0fa",
 				Branch:            "main",
 				Ref:               "refs/pull/1/head",
 				Refspec:           "anbraten-patch-1:main",
@@ -356,6 +363,7 @@ func TestForgejoParser(t *testing.T) {
 				Author:            "anbraten",
 				Event:             "pull_request_closed",
 				Commit:            "d555a5dd07f4d0148a58d4686ec381502ae6a2d4",
+				BaseCommit:        "068aee163ffd44eef28a7f9ebd43e2c01774f0fa",
 				Branch:            "main",
 				Ref:               "refs/pull/1/head",
 				Refspec:           "anbraten-patch-1:main",
@@ -393,6 +401,7 @@ func TestForgejoParser(t *testing.T) {
 				Author:            "anbraten",
 				Event:             "pull_request_closed",
 				Commit:            "d555a5dd07f4d0148a58d4686ec381502ae6a2d4",
+				BaseCommit:        "f2440f050054df0f8ecabcace648f1683509064c",
 				Branch:            "main",
 				Ref:               "refs/pull/1/head",
 				Refspec:           "anbraten-patch-1:main",
@@ -465,6 +474,7 @@ func TestForgejoParser(t *testing.T) {
 				Event:             "pull_request_metadata",
 				EventReason:       []string{"assigned"},
 				Commit:            "36b5813240a9d2daa29b05046d56a53e18f39a3e",
+				BaseCommit:        "67012991d6c69b1c58378346fca366b864d8d1a1",
 				Branch:            "main",
 				Ref:               "refs/pull/1/head",
 				Refspec:           "6543-patch-1:main",
@@ -504,6 +514,7 @@ func TestForgejoParser(t *testing.T) {
 				Event:                "pull_request_metadata",
 				EventReason:          []string{"milestoned"},
 				Commit:               "36b5813240a9d2daa29b05046d56a53e18f39a3e",
+				BaseCommit:           "67012991d6c69b1c58378346fca366b864d8d1a1",
 				Branch:               "main",
 				Ref:                  "refs/pull/1/head",
 				Refspec:              "6543-patch-1:main",
@@ -544,6 +555,7 @@ func TestForgejoParser(t *testing.T) {
 				Event:                "pull_request_metadata",
 				EventReason:          []string{"label_updated"},
 				Commit:               "36b5813240a9d2daa29b05046d56a53e18f39a3e",
+				BaseCommit:           "67012991d6c69b1c58378346fca366b864d8d1a1",
 				Branch:               "main",
 				Ref:                  "refs/pull/1/head",
 				Refspec:              "6543-patch-1:main",
@@ -584,6 +596,7 @@ func TestForgejoParser(t *testing.T) {
 				Event:                "pull_request_metadata",
 				EventReason:          []string{"unassigned"},
 				Commit:               "36b5813240a9d2daa29b05046d56a53e18f39a3e",
+				BaseCommit:           "67012991d6c69b1c58378346fca366b864d8d1a1",
 				Branch:               "main",
 				Ref:                  "refs/pull/1/head",
 				Refspec:              "6543-patch-1:main",
@@ -624,6 +637,7 @@ func TestForgejoParser(t *testing.T) {
 				Event:                "pull_request_metadata",
 				EventReason:          []string{"milestoned"},
 				Commit:               "36b5813240a9d2daa29b05046d56a53e18f39a3e",
+				BaseCommit:           "67012991d6c69b1c58378346fca366b864d8d1a1",
 				Branch:               "main",
 				Ref:                  "refs/pull/1/head",
 				Refspec:              "6543-patch-1:main",
@@ -664,6 +678,7 @@ func TestForgejoParser(t *testing.T) {
 				Event:                "pull_request_metadata",
 				EventReason:          []string{"label_updated"},
 				Commit:               "36b5813240a9d2daa29b05046d56a53e18f39a3e",
+				BaseCommit:           "67012991d6c69b1c58378346fca366b864d8d1a1",
 				Branch:               "main",
 				Ref:                  "refs/pull/1/head",
 				Refspec:              "6543-patch-1:main",
@@ -704,6 +719,7 @@ func TestForgejoParser(t *testing.T) {
 				Event:                "pull_request_metadata",
 				EventReason:          []string{"label_cleared"},
 				Commit:               "36b5813240a9d2daa29b05046d56a53e18f39a3e",
+				BaseCommit:           "67012991d6c69b1c58378346fca366b864d8d1a1",
 				Branch:               "main",
 				Ref:                  "refs/pull/1/head",
 				Refspec:              "6543-patch-1:main",
@@ -744,6 +760,7 @@ func TestForgejoParser(t *testing.T) {
 				Event:             "pull_request_metadata",
 				EventReason:       []string{"demilestoned"},
 				Commit:            "36b5813240a9d2daa29b05046d56a53e18f39a3e",
+				BaseCommit:        "67012991d6c69b1c58378346fca366b864d8d1a1",
 				Branch:            "main",
 				Ref:               "refs/pull/1/head",
 				Refspec:           "6543-patch-1:main",
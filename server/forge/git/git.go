@@ -0,0 +1,495 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge"
+	forge_types "go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// Opts defines configuration options.
+type Opts struct {
+	URL        string   // Optional url of the git server.
+	Repos      []string // Clone urls of the repositories.
+	Username   string   // Username for http(s) clone urls.
+	Password   string   // Password or token for http(s) clone urls.
+	SSHKey     string   // Private ssh key for ssh clone urls.
+	Users      string   // Users allowed to login in htpasswd format (bcrypt only).
+	MirrorPath string   // Directory for the bare clones of the repositories.
+}
+
+type client struct {
+	id          int64
+	url         string
+	repos       []string
+	username    string
+	password    string
+	sshKeyFile  string
+	users       map[string][]byte
+	mirrorPath  string
+	mirrorLocks sync.Map
+}
+
+var (
+	_ forge.Poller             = new(client)
+	_ forge.ChangedFilesLister = new(client)
+)
+
+// New returns a Forge implementation that tracks repositories of plain git servers
+// by their clone url. Changes are detected by polling and users log in with
+// credentials managed by Woodpecker.
+func New(id int64, opts Opts) (forge.Forge, error) {
+	users, err := parseUsers(opts.Users)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(users) == 0:
+		return nil, errors.New("must have at least one user")
+	case opts.MirrorPath == "":
+		return nil, errors.New("must have a mirror path")
+	}
+
+	c := &client{
+		id:         id,
+		url:        opts.URL,
+		username:   opts.Username,
+		password:   opts.Password,
+		users:      users,
+		mirrorPath: opts.MirrorPath,
+	}
+	for _, repo := range opts.Repos {
+		if repo = strings.TrimSpace(repo); repo != "" {
+			c.repos = append(c.repos, repo)
+		}
+	}
+
+	if err := os.MkdirAll(c.mirrorPath, 0o700); err != nil {
+		return nil, fmt.Errorf("could not create mirror path: %w", err)
+	}
+	if opts.SSHKey != "" {
+		c.sshKeyFile = filepath.Join(c.mirrorPath, "ssh_key")
+		// ssh requires a trailing newline after the key
+		if err := os.WriteFile(c.sshKeyFile, []byte(strings.TrimSpace(opts.SSHKey)+"\n"), 0o600); err != nil {
+			return nil, fmt.Errorf("could not write ssh key: %w", err)
+		}
+	}
+
+	return c, nil
+}
+
+// Name returns the string name of this driver.
+func (c *client) Name() string {
+	return "git"
+}
+
+// URL returns the root url of a configured forge.
+func (c *client) URL() string {
+	return c.url
+}
+
+// gitEnv returns the environment of git commands to authenticate at the remotes.
+func (c *client) gitEnv() []string {
+	env := []string{"GIT_TERMINAL_PROMPT=0"}
+	if c.username != "" || c.password != "" {
+		auth := base64.StdEncoding.EncodeToString([]byte(c.username + ":" + c.password))
+		env = append(env,
+			"GIT_CONFIG_COUNT=1",
+			"GIT_CONFIG_KEY_0=http.extraHeader",
+			"GIT_CONFIG_VALUE_0=Authorization: Basic "+auth,
+		)
+	}
+	if c.sshKeyFile != "" {
+		env = append(env, fmt.Sprintf("GIT_SSH_COMMAND=ssh -i '%s' -o IdentitiesOnly=yes -o StrictHostKeyChecking=accept-new -o UserKnownHostsFile='%s'",
+			c.sshKeyFile, filepath.Join(c.mirrorPath, "known_hosts")))
+	}
+	return env
+}
+
+// Login authenticates the user by the username and password submitted by the login form.
+func (c *client) Login(_ context.Context, req *forge_types.OAuthRequest) (*model.User, string, error) {
+	redirectURL := server.Config.Server.RootPath + "/login"
+	if req.Username == "" {
+		return nil, redirectURL, nil
+	}
+
+	if err := c.authenticate(req.Username, req.Password); err != nil {
+		return nil, redirectURL, err
+	}
+
+	return &model.User{
+		Login:         req.Username,
+		ForgeRemoteID: model.ForgeRemoteID(req.Username),
+	}, redirectURL, nil
+}
+
+// Auth is not supported as users are authenticated by Woodpecker.
+func (c *client) Auth(_ context.Context, _, _ string) (string, error) {
+	return "", forge_types.ErrNotImplemented
+}
+
+// Teams is not supported by plain git servers.
+func (c *client) Teams(_ context.Context, _ *model.User, _ *model.ListOptions) ([]*model.Team, error) {
+	return nil, nil
+}
+
+// Repo returns the configured repository with the clone url as remote id or the full name.
+func (c *client) Repo(ctx context.Context, _ *model.User, remoteID model.ForgeRemoteID, owner, name string) (*model.Repo, error) {
+	for _, cloneURL := range c.repos {
+		repo, err := repoFromURL(cloneURL)
+		if err != nil {
+			return nil, err
+		}
+		if (remoteID.IsValid() && repo.ForgeRemoteID == remoteID) || (!remoteID.IsValid() && repo.Owner == owner && repo.Name == name) {
+			repo.Branch, err = c.mirror(cloneURL).remoteDefaultBranch(ctx)
+			return repo, err
+		}
+	}
+	return nil, fmt.Errorf("repository %s/%s is not configured", owner, name)
+}
+
+// Repos returns all configured repositories.
+func (c *client) Repos(ctx context.Context, _ *model.User, p *model.ListOptions) ([]*model.Repo, error) {
+	repos := make([]*model.Repo, 0, len(c.repos))
+	for _, cloneURL := range c.repos {
+		repo, err := repoFromURL(cloneURL)
+		if err != nil {
+			return nil, err
+		}
+		// avoid requests to all remotes, the default branch is updated by Repo
+		if m := c.mirror(cloneURL); m.exists() {
+			repo.Branch, _ = m.defaultBranch(ctx)
+		}
+		repos = append(repos, repo)
+	}
+	return model.ApplyPagination(p, repos), nil
+}
+
+// File fetches the file from the mirror of the repository.
+func (c *client) File(ctx context.Context, _ *model.User, r *model.Repo, b *model.Pipeline, f string) ([]byte, error) {
+	data, err := c.mirror(string(r.ForgeRemoteID)).file(ctx, b.Commit, f)
+	if errors.Is(err, errNotFound) {
+		return nil, &forge_types.ErrConfigNotFound{Configs: []string{f}}
+	}
+	return data, err
+}
+
+// Dir fetches the files of the directory from the mirror of the repository.
+func (c *client) Dir(ctx context.Context, _ *model.User, r *model.Repo, b *model.Pipeline, f string) ([]*forge_types.FileMeta, error) {
+	m := c.mirror(string(r.ForgeRemoteID))
+	files, err := m.dir(ctx, b.Commit, f)
+	if errors.Is(err, errNotFound) {
+		return nil, &forge_types.ErrConfigNotFound{Configs: []string{f}}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	configs := make([]*forge_types.FileMeta, 0, len(files))
+	for _, file := range files {
+		data, err := m.file(ctx, b.Commit, file)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, &forge_types.FileMeta{Name: file, Data: data})
+	}
+	return configs, nil
+}
+
+// Status is not supported by plain git servers.
+func (c *client) Status(_ context.Context, _ *model.User, _ *model.Repo, _ *model.Pipeline, _ *model.Workflow) error {
+	return nil
+}
+
+// Netrc returns the credentials for http(s) clone urls.
+func (c *client) Netrc(_ *model.User, r *model.Repo) (*model.Netrc, error) {
+	host, _, _, err := splitCloneURL(r.Clone)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Netrc{
+		Login:    c.username,
+		Password: c.password,
+		Machine:  host,
+		Type:     model.ForgeTypeGit,
+	}, nil
+}
+
+// Activate clones the mirror of the repository, so later polls detect changes.
+// As plain git servers have no webhook api, the webhook url is logged for
+// post-receive hooks of the repository.
+func (c *client) Activate(ctx context.Context, _ *model.User, r *model.Repo, link string) error {
+	if _, err := c.mirror(string(r.ForgeRemoteID)).fetch(ctx); err != nil {
+		return err
+	}
+
+	log.Info().Str("repo", r.FullName).Msgf("repository activated, optional webhook url for post-receive hooks: %s", link)
+	return nil
+}
+
+// Deactivate removes the mirror of the repository.
+func (c *client) Deactivate(_ context.Context, _ *model.User, r *model.Repo, _ string) error {
+	m := c.mirror(string(r.ForgeRemoteID))
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return os.RemoveAll(m.path)
+}
+
+// Branches returns the branches of the remote.
+func (c *client) Branches(ctx context.Context, _ *model.User, r *model.Repo, p *model.ListOptions) ([]string, error) {
+	refs, err := c.mirror(string(r.ForgeRemoteID)).remoteRefs(ctx, refsHeads+"*")
+	if err != nil {
+		return nil, err
+	}
+
+	branches := make([]string, 0, len(refs))
+	for name := range refs {
+		if branch, ok := strings.CutPrefix(name, refsHeads); ok {
+			branches = append(branches, branch)
+		}
+	}
+	slices.Sort(branches)
+	return model.ApplyPagination(p, branches), nil
+}
+
+// BranchHead returns the sha of the head commit of the branch on the remote.
+func (c *client) BranchHead(ctx context.Context, _ *model.User, r *model.Repo, branch string) (*model.Commit, error) {
+	refs, err := c.mirror(string(r.ForgeRemoteID)).remoteRefs(ctx, refsHeads+branch)
+	if err != nil {
+		return nil, err
+	}
+
+	sha, ok := refs[refsHeads+branch]
+	if !ok {
+		return nil, fmt.Errorf("branch %s not found", branch)
+	}
+	return &model.Commit{SHA: sha}, nil
+}
+
+// PullRequests is not supported by plain git servers.
+func (c *client) PullRequests(_ context.Context, _ *model.User, _ *model.Repo, _ *model.ListOptions) ([]*model.PullRequest, error) {
+	return nil, nil
+}
+
+// hookPayload is sent by post-receive hooks for each updated ref.
+type hookPayload struct {
+	Repo string `json:"repo"` // clone url or full name
+	Ref  string `json:"ref"`
+}
+
+// Hook fetches the ref of the webhook payload and returns a pipeline if it changed.
+// The webhook is authenticated by the hook token of the url.
+func (c *client) Hook(ctx context.Context, r *http.Request) (*model.Repo, *model.Pipeline, error) {
+	var payload hookPayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return nil, nil, fmt.Errorf("could not parse webhook payload: %w", err)
+	}
+	if !strings.HasPrefix(payload.Ref, refsHeads) && !strings.HasPrefix(payload.Ref, refsTags) {
+		return nil, nil, &forge_types.ErrIgnoreEvent{Event: payload.Ref, Reason: "only branches and tags are supported"}
+	}
+
+	cloneURL, repo, err := c.findRepo(payload.Repo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := c.mirror(cloneURL)
+	change, err := m.fetchRef(ctx, payload.Ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	if change == nil {
+		return repo, nil, nil
+	}
+	if change.After == "" {
+		return nil, nil, &forge_types.ErrIgnoreEvent{Event: payload.Ref, Reason: "ref was deleted"}
+	}
+
+	repo.Branch, err = m.remoteDefaultBranch(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	pipeline, err := pipelineFromChange(ctx, m, change)
+	return repo, pipeline, err
+}
+
+// findRepo returns the configured repository with the clone url or full name.
+func (c *client) findRepo(nameOrURL string) (string, *model.Repo, error) {
+	for _, cloneURL := range c.repos {
+		repo, err := repoFromURL(cloneURL)
+		if err != nil {
+			return "", nil, err
+		}
+		if cloneURL == nameOrURL || repo.FullName == nameOrURL {
+			return cloneURL, repo, nil
+		}
+	}
+	return "", nil, fmt.Errorf("repository %s is not configured", nameOrURL)
+}
+
+// OrgMembership returns full access as all users can access all repositories.
+func (c *client) OrgMembership(_ context.Context, _ *model.User, _ string) (*model.OrgPerm, error) {
+	return &model.OrgPerm{Member: true, Admin: true}, nil
+}
+
+// Org returns the owner of repositories as organization.
+func (c *client) Org(_ context.Context, u *model.User, org string) (*model.Org, error) {
+	return &model.Org{
+		Name:   org,
+		IsUser: u != nil && u.Login == org,
+	}, nil
+}
+
+// Poll fetches the mirror of the repository and returns a pipeline for each pushed branch and created tag.
+func (c *client) Poll(ctx context.Context, _ *model.User, r *model.Repo) ([]*model.Pipeline, error) {
+	cloneURL := string(r.ForgeRemoteID)
+	if !slices.Contains(c.repos, cloneURL) {
+		return nil, fmt.Errorf("repository %s is not configured", r.FullName)
+	}
+
+	m := c.mirror(cloneURL)
+	changes, err := m.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelines []*model.Pipeline
+	for _, change := range changes {
+		if change.After == "" {
+			continue
+		}
+		pipeline, err := pipelineFromChange(ctx, m, &change)
+		if err != nil {
+			return nil, err
+		}
+		pipelines = append(pipelines, pipeline)
+	}
+	return pipelines, nil
+}
+
+// ChangedFiles returns the files changed by head since it diverged from base.
+func (c *client) ChangedFiles(ctx context.Context, _ *model.User, r *model.Repo, base, head string) ([]string, error) {
+	return c.mirror(string(r.ForgeRemoteID)).changedFiles(ctx, base, head)
+}
+
+// pipelineFromChange creates a push pipeline for updated branches and a tag pipeline for created or moved tags.
+func pipelineFromChange(ctx context.Context, m *mirror, change *refChange) (*model.Pipeline, error) {
+	commit, err := m.commit(ctx, change.After)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline := &model.Pipeline{
+		Commit:    change.After,
+		Ref:       change.Name,
+		Author:    commit.Author,
+		Email:     commit.Email,
+		Message:   commit.Message,
+		Timestamp: commit.Timestamp,
+		Sender:    commit.Author,
+	}
+
+	if branch, ok := strings.CutPrefix(change.Name, refsHeads); ok {
+		pipeline.Event = model.EventPush
+		pipeline.Branch = branch
+		pipeline.BaseCommit = change.Before
+		if change.Before != "" {
+			pipeline.ChangedFiles, err = m.diff(ctx, change.Before, change.After)
+			if err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		pipeline.Event = model.EventTag
+	}
+
+	return pipeline, nil
+}
+
+// repoFromURL returns the repository with the clone url, the owner and name are
+// taken from the path of the url.
+func repoFromURL(cloneURL string) (*model.Repo, error) {
+	host, repoPath, ssh, err := splitCloneURL(cloneURL)
+	if err != nil {
+		return nil, err
+	}
+
+	repoPath = strings.TrimSuffix(strings.Trim(repoPath, "/"), ".git")
+	owner, name := path.Split(repoPath)
+	owner = strings.Trim(owner, "/")
+	if owner == "" {
+		owner = host
+	}
+	if name == "" || owner == "" {
+		return nil, fmt.Errorf("could not get repository name of clone url %s", cloneURL)
+	}
+
+	repo := &model.Repo{
+		ForgeRemoteID: model.ForgeRemoteID(cloneURL),
+		Owner:         owner,
+		Name:          name,
+		FullName:      owner + "/" + name,
+		Clone:         cloneURL,
+		IsSCMPrivate:  true,
+		Perm: &model.Perm{
+			Pull:  true,
+			Push:  true,
+			Admin: true,
+		},
+	}
+	if ssh {
+		repo.CloneSSH = cloneURL
+	}
+	return repo, nil
+}
+
+// splitCloneURL returns the host and path of urls and scp-like ssh addresses (user@host:path).
+func splitCloneURL(cloneURL string) (host, repoPath string, ssh bool, err error) {
+	if !strings.Contains(cloneURL, "://") {
+		if _, address, ok := strings.Cut(cloneURL, "@"); ok {
+			host, repoPath, ok = strings.Cut(address, ":")
+			if ok {
+				return host, repoPath, true, nil
+			}
+		}
+		return "", cloneURL, false, nil
+	}
+
+	u, err := url.Parse(cloneURL)
+	if err != nil {
+		return "", "", false, err
+	}
+	return u.Hostname(), u.Path, u.Scheme == "ssh", nil
+}
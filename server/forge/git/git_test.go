@@ -0,0 +1,250 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	forge_types "go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// password "secret".
+const testUsers = "octocat:$2a$04$WesnB3laCvLeV21OYetZy.v3mh1cqzdT0.M55lP6tIcmtYURv1qLW"
+
+func TestNew(t *testing.T) {
+	_, err := New(1, Opts{MirrorPath: t.TempDir()})
+	assert.ErrorContains(t, err, "must have at least one user")
+
+	_, err = New(1, Opts{Users: "octocat:plain", MirrorPath: t.TempDir()})
+	assert.ErrorContains(t, err, "only bcrypt is supported")
+
+	_, err = New(1, Opts{Users: testUsers})
+	assert.ErrorContains(t, err, "must have a mirror path")
+}
+
+func TestLogin(t *testing.T) {
+	forge, err := New(1, Opts{Users: testUsers, MirrorPath: t.TempDir()})
+	require.NoError(t, err)
+
+	user, redirect, err := forge.Login(t.Context(), &forge_types.OAuthRequest{})
+	assert.NoError(t, err)
+	assert.Nil(t, user)
+	assert.Equal(t, "/login", redirect)
+
+	_, _, err = forge.Login(t.Context(), &forge_types.OAuthRequest{Username: "octocat", Password: "wrong"})
+	assert.ErrorIs(t, err, errInvalidCredentials)
+
+	_, _, err = forge.Login(t.Context(), &forge_types.OAuthRequest{Username: "unknown", Password: "secret"})
+	assert.ErrorIs(t, err, errInvalidCredentials)
+
+	user, _, err = forge.Login(t.Context(), &forge_types.OAuthRequest{Username: "octocat", Password: "secret"})
+	assert.NoError(t, err)
+	assert.Equal(t, "octocat", user.Login)
+	assert.EqualValues(t, "octocat", user.ForgeRemoteID)
+}
+
+func TestRepoFromURL(t *testing.T) {
+	tests := []struct {
+		url      string
+		fullName string
+		ssh      bool
+	}{
+		{url: "https://git.example.com/group/project.git", fullName: "group/project"},
+		{url: "https://git.example.com/group/sub/project", fullName: "group/sub/project"},
+		{url: "https://git.example.com/project.git", fullName: "git.example.com/project"},
+		{url: "git@git.example.com:team/app.git", fullName: "team/app", ssh: true},
+		{url: "ssh://git@git.example.com:2222/srv/repo.git", fullName: "srv/repo", ssh: true},
+		{url: "/srv/git/project.git", fullName: "srv/git/project"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			repo, err := repoFromURL(tt.url)
+			require.NoError(t, err)
+			assert.Equal(t, tt.fullName, repo.FullName)
+			assert.EqualValues(t, tt.url, repo.ForgeRemoteID)
+			assert.Equal(t, tt.url, repo.Clone)
+			assert.Equal(t, tt.ssh, repo.CloneSSH != "")
+		})
+	}
+
+	_, err := repoFromURL("project.git")
+	assert.Error(t, err)
+}
+
+func TestPoll(t *testing.T) {
+	remote := newRemote(t)
+	remote.commit(t, ".woodpecker/build.yaml", "steps: []", "add pipeline")
+
+	forge, err := New(1, Opts{Users: testUsers, Repos: []string{remote.path}, MirrorPath: t.TempDir()})
+	require.NoError(t, err)
+	c := forge.(*client)
+
+	repo, err := forge.Repo(t.Context(), nil, model.ForgeRemoteID(remote.path), "", "")
+	require.NoError(t, err)
+	assert.Equal(t, "main", repo.Branch)
+
+	require.NoError(t, forge.Activate(t.Context(), nil, repo, "https://ci.example.com/api/hook"))
+
+	pipelines, err := c.Poll(t.Context(), nil, repo)
+	assert.NoError(t, err)
+	assert.Empty(t, pipelines)
+
+	before := remote.head(t)
+	remote.commit(t, "main.go", "package main", "add main")
+	after := remote.head(t)
+	remote.git(t, "tag", "v1.0.0")
+
+	pipelines, err = c.Poll(t.Context(), nil, repo)
+	require.NoError(t, err)
+	require.Len(t, pipelines, 2)
+
+	assert.Equal(t, model.EventPush, pipelines[0].Event)
+	assert.Equal(t, "main", pipelines[0].Branch)
+	assert.Equal(t, "refs/heads/main", pipelines[0].Ref)
+	assert.Equal(t, after, pipelines[0].Commit)
+	assert.Equal(t, before, pipelines[0].BaseCommit)
+	assert.Equal(t, "add main", pipelines[0].Message)
+	assert.Equal(t, "Octocat", pipelines[0].Author)
+	assert.Equal(t, "octocat@example.com", pipelines[0].Email)
+	assert.Equal(t, []string{"main.go"}, pipelines[0].ChangedFiles)
+
+	assert.Equal(t, model.EventTag, pipelines[1].Event)
+	assert.Equal(t, "refs/tags/v1.0.0", pipelines[1].Ref)
+	assert.Equal(t, after, pipelines[1].Commit)
+
+	pipelines, err = c.Poll(t.Context(), nil, repo)
+	assert.NoError(t, err)
+	assert.Empty(t, pipelines)
+}
+
+func TestFiles(t *testing.T) {
+	remote := newRemote(t)
+	remote.commit(t, ".woodpecker/build.yaml", "steps: [build]", "add build")
+	remote.commit(t, ".woodpecker/test.yaml", "steps: [test]", "add test")
+	remote.git(t, "checkout", "--quiet", "-b", "feature")
+	remote.commit(t, "feature.go", "package main", "add feature")
+
+	forge, err := New(1, Opts{Users: testUsers, Repos: []string{remote.path}, MirrorPath: t.TempDir()})
+	require.NoError(t, err)
+	repo, err := repoFromURL(remote.path)
+	require.NoError(t, err)
+
+	commit, err := forge.BranchHead(t.Context(), nil, repo, "feature")
+	require.NoError(t, err)
+	assert.Equal(t, remote.head(t), commit.SHA)
+
+	branches, err := forge.Branches(t.Context(), nil, repo, &model.ListOptions{All: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"feature", "main"}, branches)
+
+	pipeline := &model.Pipeline{Commit: commit.SHA}
+	data, err := forge.File(t.Context(), nil, repo, pipeline, ".woodpecker/build.yaml")
+	assert.NoError(t, err)
+	assert.Equal(t, "steps: [build]", string(data))
+
+	_, err = forge.File(t.Context(), nil, repo, pipeline, ".woodpecker.yaml")
+	assert.ErrorIs(t, err, &forge_types.ErrConfigNotFound{})
+
+	files, err := forge.Dir(t.Context(), nil, repo, pipeline, ".woodpecker")
+	assert.NoError(t, err)
+	if assert.Len(t, files, 2) {
+		assert.Equal(t, ".woodpecker/build.yaml", files[0].Name)
+		assert.Equal(t, ".woodpecker/test.yaml", files[1].Name)
+	}
+
+	changed, err := forge.(*client).ChangedFiles(t.Context(), nil, repo, "main", "feature")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"feature.go"}, changed)
+}
+
+func TestHook(t *testing.T) {
+	remote := newRemote(t)
+	remote.commit(t, "README.md", "# Readme", "initial commit")
+
+	forge, err := New(1, Opts{Users: testUsers, Repos: []string{remote.path}, MirrorPath: t.TempDir()})
+	require.NoError(t, err)
+	repo, err := repoFromURL(remote.path)
+	require.NoError(t, err)
+	require.NoError(t, forge.Activate(t.Context(), nil, repo, ""))
+
+	hook := func(body string) (*model.Repo, *model.Pipeline, error) {
+		return forge.Hook(t.Context(), httptest.NewRequest("POST", "/api/hook", strings.NewReader(body)))
+	}
+
+	remote.commit(t, "README.md", "# Changed", "update readme")
+	hookRepo, pipeline, err := hook(`{"repo": "` + repo.FullName + `", "ref": "refs/heads/main"}`)
+	require.NoError(t, err)
+	assert.Equal(t, repo.ForgeRemoteID, hookRepo.ForgeRemoteID)
+	assert.Equal(t, "main", hookRepo.Branch)
+	if assert.NotNil(t, pipeline) {
+		assert.Equal(t, model.EventPush, pipeline.Event)
+		assert.Equal(t, remote.head(t), pipeline.Commit)
+	}
+
+	// the ref was fetched by the webhook already
+	_, pipeline, err = hook(`{"repo": "` + remote.path + `", "ref": "refs/heads/main"}`)
+	assert.NoError(t, err)
+	assert.Nil(t, pipeline)
+	pipelines, err := forge.(*client).Poll(t.Context(), nil, repo)
+	assert.NoError(t, err)
+	assert.Empty(t, pipelines)
+
+	_, _, err = hook(`{"repo": "` + remote.path + `", "ref": "refs/notes/commits"}`)
+	assert.ErrorIs(t, err, &forge_types.ErrIgnoreEvent{})
+
+	_, _, err = hook(`{"repo": "unknown/repo", "ref": "refs/heads/main"}`)
+	assert.ErrorContains(t, err, "is not configured")
+}
+
+// remote is a git repository used as remote of the forge.
+type remote struct {
+	path string
+}
+
+func newRemote(t *testing.T) *remote {
+	r := &remote{path: filepath.Join(t.TempDir(), "octocat", "hello-world")}
+	require.NoError(t, os.MkdirAll(r.path, 0o755))
+	r.git(t, "init", "--quiet", "--initial-branch", "main")
+	return r
+}
+
+func (r *remote) git(t *testing.T, args ...string) string {
+	cmd := exec.Command("git", append([]string{"-c", "user.name=Octocat", "-c", "user.email=octocat@example.com", "-c", "tag.gpgSign=false", "-c", "commit.gpgSign=false"}, args...)...)
+	cmd.Dir = r.path
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+	return strings.TrimSpace(string(out))
+}
+
+func (r *remote) commit(t *testing.T, file, content, message string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(r.path, file)), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(r.path, file), []byte(content), 0o644))
+	r.git(t, "add", file)
+	r.git(t, "commit", "--quiet", "-m", message)
+}
+
+func (r *remote) head(t *testing.T) string {
+	return r.git(t, "rev-parse", "HEAD")
+}
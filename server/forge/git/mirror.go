@@ -0,0 +1,408 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+const (
+	refsHeads = "refs/heads/"
+	refsTags  = "refs/tags/"
+)
+
+var errNotFound = errors.New("file not found")
+
+var (
+	refspecs = []string{"+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*"}
+	// objectRefspecs fetch new commits without updating the refs used to detect changes.
+	objectRefspecs = []string{"+refs/heads/*:refs/fetched/heads/*", "+refs/tags/*:refs/fetched/tags/*"}
+)
+
+// ref is a branch or tag of a repository.
+type ref struct {
+	Name   string
+	Object string // sha of the object the ref points to
+	Commit string // sha of the commit the ref points to, differs from Object for annotated tags
+}
+
+// refChange is a ref updated by a fetch.
+type refChange struct {
+	Name   string
+	Before string // commit before the fetch, empty for created refs
+	After  string // commit after the fetch, empty for deleted refs
+}
+
+// mirror is a bare clone of a repository used to read files and to detect ref changes.
+type mirror struct {
+	url  string
+	path string
+	env  []string
+	mu   *sync.Mutex
+}
+
+// mirror returns the mirror of the repository with the clone url.
+func (c *client) mirror(url string) *mirror {
+	sum := sha256.Sum256([]byte(url))
+	path := filepath.Join(c.mirrorPath, hex.EncodeToString(sum[:])+".git")
+
+	mu, _ := c.mirrorLocks.LoadOrStore(path, &sync.Mutex{})
+	return &mirror{
+		url:  url,
+		path: path,
+		env:  c.gitEnv(),
+		mu:   mu.(*sync.Mutex),
+	}
+}
+
+// git runs the git command in the mirror and returns its output.
+func (m *mirror) git(ctx context.Context, args ...string) ([]byte, error) {
+	return m.run(ctx, append([]string{"--git-dir", m.path}, args...)...)
+}
+
+// lsRemote lists the refs of the remote matching the patterns, it does not require the mirror to exist.
+func (m *mirror) lsRemote(ctx context.Context, option string, patterns ...string) ([]byte, error) {
+	return m.run(ctx, append([]string{"ls-remote", option, m.url}, patterns...)...)
+}
+
+func (m *mirror) run(ctx context.Context, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Env = append(os.Environ(), m.env...)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("git %s: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
+
+// exists returns true if the mirror has been cloned already.
+func (m *mirror) exists() bool {
+	_, err := os.Stat(filepath.Join(m.path, "HEAD"))
+	return err == nil
+}
+
+// init creates the bare repository of the mirror if it does not exist yet.
+func (m *mirror) init(ctx context.Context) error {
+	if m.exists() {
+		return nil
+	}
+	if err := os.MkdirAll(m.path, 0o700); err != nil {
+		return err
+	}
+	_, err := m.git(ctx, "init", "--bare", "--quiet")
+	return err
+}
+
+// fetch updates all branches and tags of the mirror and returns the changed refs.
+// Nothing is returned for the initial fetch of a new mirror.
+func (m *mirror) fetch(ctx context.Context) ([]refChange, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	initial := !m.exists()
+	if err := m.init(ctx); err != nil {
+		return nil, err
+	}
+
+	before, err := m.refs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := m.git(ctx, append([]string{"fetch", "--quiet", "--prune", "--force", m.url}, refspecs...)...); err != nil {
+		return nil, err
+	}
+	if err := m.updateHead(ctx); err != nil {
+		return nil, err
+	}
+	after, err := m.refs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if initial {
+		return nil, nil
+	}
+	return diffRefs(before, after), nil
+}
+
+// fetchRef updates a single branch or tag of the mirror and returns its change,
+// or nil if the ref did not change.
+func (m *mirror) fetchRef(ctx context.Context, name string) (*refChange, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.init(ctx); err != nil {
+		return nil, err
+	}
+
+	before, err := m.refs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := m.git(ctx, "fetch", "--quiet", "--force", m.url, "+"+name+":"+name); err != nil {
+		return nil, err
+	}
+	after, err := m.refs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, change := range diffRefs(before, after) {
+		if change.Name == name {
+			return &change, nil
+		}
+	}
+	return nil, nil
+}
+
+// updateHead points HEAD of the mirror to the default branch of the remote.
+func (m *mirror) updateHead(ctx context.Context) error {
+	branch, err := m.remoteDefaultBranch(ctx)
+	if err != nil || branch == "" {
+		return err
+	}
+	_, err = m.git(ctx, "symbolic-ref", "HEAD", refsHeads+branch)
+	return err
+}
+
+// remoteDefaultBranch returns the branch HEAD of the remote points to.
+func (m *mirror) remoteDefaultBranch(ctx context.Context) (string, error) {
+	out, err := m.lsRemote(ctx, "--symref", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	for _, line := range splitLines(out) {
+		if target, ok := strings.CutPrefix(line, "ref: "); ok {
+			target, _, _ = strings.Cut(target, "\t")
+			return strings.TrimPrefix(target, refsHeads), nil
+		}
+	}
+	return "", nil
+}
+
+// refs returns all branches and tags of the mirror.
+func (m *mirror) refs(ctx context.Context) (map[string]ref, error) {
+	out, err := m.git(ctx, "for-each-ref", "--format=%(objectname) %(*objectname) %(refname)", refsHeads, refsTags)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make(map[string]ref)
+	for _, line := range splitLines(out) {
+		fields := strings.Fields(line)
+		switch len(fields) {
+		case 2: // branches and lightweight tags
+			refs[fields[1]] = ref{Name: fields[1], Object: fields[0], Commit: fields[0]}
+		case 3: // annotated tags
+			refs[fields[2]] = ref{Name: fields[2], Object: fields[0], Commit: fields[1]}
+		}
+	}
+	return refs, nil
+}
+
+// defaultBranch returns the branch HEAD of the mirror points to.
+func (m *mirror) defaultBranch(ctx context.Context) (string, error) {
+	out, err := m.git(ctx, "symbolic-ref", "--short", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// ensureCommit fetches the commits of the remote if the mirror does not contain the commit yet.
+func (m *mirror) ensureCommit(ctx context.Context, sha string) error {
+	if m.exists() {
+		if _, err := m.git(ctx, "cat-file", "-e", sha+"^{commit}"); err == nil {
+			return nil
+		}
+	}
+	return m.fetchObjects(ctx)
+}
+
+// fetchObjects fetches the commits of all branches and tags of the remote.
+// The branches and tags of the mirror are not updated, so changes are still detected by the next fetch.
+func (m *mirror) fetchObjects(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.init(ctx); err != nil {
+		return err
+	}
+	_, err := m.git(ctx, append([]string{"fetch", "--quiet", "--no-tags", "--force", m.url}, objectRefspecs...)...)
+	return err
+}
+
+// resolve returns the sha of the commit, branch or tag of the remote fetched by fetchObjects.
+func (m *mirror) resolve(ctx context.Context, rev string) (string, error) {
+	for _, candidate := range []string{"refs/fetched/heads/" + rev, "refs/fetched/tags/" + rev, rev} {
+		out, err := m.git(ctx, "rev-parse", "--verify", "--quiet", candidate+"^{commit}")
+		if err == nil {
+			return strings.TrimSpace(string(out)), nil
+		}
+	}
+	return "", fmt.Errorf("unknown revision %s", rev)
+}
+
+// remoteRefs returns the refs of the remote matching the patterns without fetching them.
+func (m *mirror) remoteRefs(ctx context.Context, patterns ...string) (map[string]string, error) {
+	out, err := m.lsRemote(ctx, "--refs", patterns...)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make(map[string]string)
+	for _, line := range splitLines(out) {
+		sha, name, ok := strings.Cut(line, "\t")
+		if ok {
+			refs[name] = sha
+		}
+	}
+	return refs, nil
+}
+
+// file returns the content of the file at the commit.
+func (m *mirror) file(ctx context.Context, sha, name string) ([]byte, error) {
+	if err := m.ensureCommit(ctx, sha); err != nil {
+		return nil, err
+	}
+	object := sha + ":" + strings.TrimPrefix(name, "/")
+	if _, err := m.git(ctx, "cat-file", "-e", object); err != nil {
+		return nil, errNotFound
+	}
+	return m.git(ctx, "cat-file", "blob", object)
+}
+
+// dir returns the paths of the files in the directory at the commit.
+// Files of subdirectories are not included.
+func (m *mirror) dir(ctx context.Context, sha, name string) ([]string, error) {
+	if err := m.ensureCommit(ctx, sha); err != nil {
+		return nil, err
+	}
+	out, err := m.git(ctx, "ls-tree", sha, "--", strings.Trim(name, "/")+"/")
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, line := range splitLines(out) {
+		info, path, ok := strings.Cut(line, "\t")
+		if ok && strings.Fields(info)[1] == "blob" {
+			files = append(files, path)
+		}
+	}
+	if len(files) == 0 {
+		return nil, errNotFound
+	}
+	return files, nil
+}
+
+// changedFiles returns the files changed by head since it diverged from base.
+// Base and head are commit shas, branches or tags.
+func (m *mirror) changedFiles(ctx context.Context, base, head string) ([]string, error) {
+	if err := m.fetchObjects(ctx); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if base, err = m.resolve(ctx, base); err != nil {
+		return nil, err
+	}
+	if head, err = m.resolve(ctx, head); err != nil {
+		return nil, err
+	}
+
+	return m.diff(ctx, base, head)
+}
+
+// diff returns the files changed by head since it diverged from base, both must be commits of the mirror.
+func (m *mirror) diff(ctx context.Context, base, head string) ([]string, error) {
+	out, err := m.git(ctx, "diff", "--name-only", "--no-renames", base+"..."+head, "--")
+	if err != nil {
+		return nil, err
+	}
+	return splitLines(out), nil
+}
+
+// commit is the metadata of a commit.
+type commit struct {
+	Author    string
+	Email     string
+	Timestamp int64
+	Message   string
+}
+
+// commit returns the metadata of the commit.
+func (m *mirror) commit(ctx context.Context, sha string) (*commit, error) {
+	out, err := m.git(ctx, "show", "--no-patch", "--format=%an%x00%ae%x00%ct%x00%B", sha)
+	if err != nil {
+		return nil, err
+	}
+	fields := strings.SplitN(string(out), "\x00", 4)
+	if len(fields) != 4 {
+		return nil, errors.New("unexpected output of git show")
+	}
+
+	c := &commit{
+		Author:  fields[0],
+		Email:   fields[1],
+		Message: strings.TrimSpace(fields[3]),
+	}
+	_, err = fmt.Sscan(fields[2], &c.Timestamp)
+	return c, err
+}
+
+// diffRefs returns the refs created, updated or deleted between before and after.
+func diffRefs(before, after map[string]ref) []refChange {
+	var changes []refChange
+	for name, a := range after {
+		b, ok := before[name]
+		if !ok || b.Object != a.Object {
+			changes = append(changes, refChange{Name: name, Before: b.Commit, After: a.Commit})
+		}
+	}
+	for name, b := range before {
+		if _, ok := after[name]; !ok {
+			changes = append(changes, refChange{Name: name, Before: b.Commit})
+		}
+	}
+	slices.SortFunc(changes, func(a, b refChange) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return changes
+}
+
+func splitLines(out []byte) []string {
+	var lines []string
+	for line := range strings.SplitSeq(strings.TrimSpace(string(out)), "\n") {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
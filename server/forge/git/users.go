@@ -0,0 +1,63 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var errInvalidCredentials = errors.New("invalid username or password")
+
+// parseUsers parses users in htpasswd format, one "username:bcrypt-hash" per line.
+func parseUsers(s string) (map[string][]byte, error) {
+	users := make(map[string][]byte)
+	for i, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		username, hash, ok := strings.Cut(line, ":")
+		if !ok || username == "" {
+			return nil, fmt.Errorf("invalid user in line %d: expected username:hash", i+1)
+		}
+		if _, err := bcrypt.Cost([]byte(hash)); err != nil {
+			return nil, fmt.Errorf("invalid password hash of user %s, only bcrypt is supported: %w", username, err)
+		}
+		users[username] = []byte(hash)
+	}
+	return users, nil
+}
+
+// authenticate verifies the password of the user.
+func (c *client) authenticate(username, password string) error {
+	hash, ok := c.users[username]
+	if !ok {
+		// compare anyway to not leak which users exist by the response time
+		_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+		return errInvalidCredentials
+	}
+	if err := bcrypt.CompareHashAndPassword(hash, []byte(password)); err != nil {
+		return errInvalidCredentials
+	}
+	return nil
+}
+
+// dummyHash is a bcrypt hash compared for unknown users.
+var dummyHash = []byte("$2a$10$/xE91h80a5P3xu6S6oSuwO.QEPJZmWPZxJS7gbNDDuwcsOVbLYAkm")
@@ -347,6 +347,39 @@ func (c *Gitea) Status(ctx context.Context, user *model.User, repo *model.Repo,
 	return err
 }
 
+// PullRequestComment creates or updates the comment of Woodpecker on the pull request of the pipeline.
+func (c *Gitea) PullRequestComment(ctx context.Context, u *model.User, r *model.Repo, p *model.Pipeline, body string) error {
+	var index int64
+	if _, err := fmt.Sscanf(p.Ref, "refs/pull/%d/head", &index); err != nil {
+		return fmt.Errorf("could not get pull request index from ref %s: %w", p.Ref, err)
+	}
+
+	client, err := c.newClientToken(ctx, u.AccessToken)
+	if err != nil {
+		return err
+	}
+
+	comments, err := shared_utils.Paginate(func(page int) ([]*gitea.Comment, error) {
+		comments, _, err := client.ListIssueComments(r.Owner, r.Name, index, gitea.ListIssueCommentOptions{
+			ListOptions: gitea.ListOptions{Page: page},
+		})
+		return comments, err
+	}, -1)
+	if err != nil {
+		return err
+	}
+
+	for _, comment := range comments {
+		if strings.Contains(comment.Body, forge.PullRequestCommentMarker) {
+			_, _, err := client.EditIssueComment(r.Owner, r.Name, comment.ID, gitea.EditIssueCommentOption{Body: body})
+			return err
+		}
+	}
+
+	_, _, err = client.CreateIssueComment(r.Owner, r.Name, index, gitea.CreateIssueCommentOption{Body: body})
+	return err
+}
+
 // Netrc returns a netrc file capable of authenticating Gitea requests and
 // cloning Gitea repositories. The netrc will use the global machine account
 // when configured.
@@ -474,6 +507,28 @@ func (c *Gitea) BranchHead(ctx context.Context, u *model.User, r *model.Repo, br
 	}, nil
 }
 
+// ChangedFiles returns the files changed by head since it diverged from base.
+func (c *Gitea) ChangedFiles(ctx context.Context, u *model.User, r *model.Repo, base, head string) ([]string, error) {
+	token := common.UserToken(ctx, r, u)
+	client, err := c.newClientToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	compare, _, err := client.CompareCommits(r.Owner, r.Name, base, head)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, commit := range compare.Commits {
+		for _, file := range commit.Files {
+			files = append(files, file.Filename)
+		}
+	}
+	return shared_utils.DeduplicateStrings(files), nil
+}
+
 func (c *Gitea) PullRequests(ctx context.Context, u *model.User, r *model.Repo, p *model.ListOptions) ([]*model.PullRequest, error) {
 	token := common.UserToken(ctx, r, u)
 	client, err := c.newClientToken(ctx, token)
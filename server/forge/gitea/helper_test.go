@@ -77,6 +77,15 @@ func Test_parsePush(t *testing.T) {
 		assert.Equal(t, []string{"CHANGELOG.md", "app/controller/application.rb"}, pipeline.ChangedFiles)
 	})
 
+	t.Run("Should not return changed files from a truncated push hook", func(t *testing.T) {
+		buf := bytes.NewBufferString(fixtures.HookPush)
+		hook, _ := parsePush(buf)
+		hook.TotalCommits = len(hook.Commits) + 1
+		pipeline := pipelineFromPush(hook)
+		assert.Equal(t, hook.Before, pipeline.BaseCommit)
+		assert.Empty(t, pipeline.ChangedFiles)
+	})
+
 	t.Run("Should return a Repo struct from a push hook", func(t *testing.T) {
 		buf := bytes.NewBufferString(fixtures.HookPush)
 		hook, _ := parsePush(buf)
@@ -85,6 +85,7 @@ func TestGiteaParser(t *testing.T) {
 				Author:       "6543",
 				Event:        "push",
 				Commit:       "28c3613ae62640216bea5e7dc71aa65356e4298b",
+				BaseCommit:   "0000000000000000000000000000000000000000",
 				Branch:       "fdsafdsa",
 				Ref:          "refs/heads/fdsafdsa",
 				Message:      "Delete '.woodpecker/.check.yml'\n",
@@ -119,6 +120,7 @@ func TestGiteaParser(t *testing.T) {
 				Author:       "gordon",
 				Event:        "push",
 				Commit:       "ef98532add3b2feb7a137426bba1248724367df5",
+				BaseCommit:   "4b2626259b5a97b6b4eab5e6cca66adb986b672b",
 				Branch:       "main",
 				Ref:          "refs/heads/main",
 				Message:      "bump\n",
@@ -153,6 +155,7 @@ func TestGiteaParser(t *testing.T) {
 				Author:       "test-user",
 				Event:        "push",
 				Commit:       "29be01c073851cf0db0c6a466e396b725a670453",
+				BaseCommit:   "6efcf5b7c98f3e7a491675164b7a2e7acac27941",
 				Branch:       "main",
 				Ref:          "refs/heads/main",
 				Message:      "add some text\n",
@@ -221,6 +224,7 @@ func TestGiteaParser(t *testing.T) {
 				Author:            "gordon",
 				Event:             "pull_request",
 				Commit:            "0d1a26e67d8f5eaf1f6ba5c57fc3c7d91ac0fd1c",
+				BaseCommit:        "9353195a19e45482665306e466c832c46560532d",
 				Branch:            "main",
 				Ref:               "refs/pull/1/head",
 				Refspec:           "feature/changes:main",
@@ -259,6 +263,7 @@ func TestGiteaParser(t *testing.T) {
 				Author:            "6543",
 				Event:             "pull_request",
 				Commit:            "36b5813240a9d2daa29b05046d56a53e18f39a3e",
+				BaseCommit:        "67012991d6c69b1c58378346fca366b864d8d1a1",
 				Branch:            "main",
 				Ref:               "refs/pull/1/head",
 				Refspec:           "6543-patch-1:main",
@@ -294,18 +299,19 @@ func TestGiteaParser(t *testing.T) {
 				},
 			},
 			pipe: &model.Pipeline{
-				Author:   "test",
-				Event:    "pull_request",
-				Commit:   "788ed8d02d3b7fcfcf6386dbcbca696aa1d4dc25",
-				Branch:   "main",
-				Ref:      "refs/pull/2/head",
-				Refspec:  "test-patch-1:main",
-				Title:    "New Pull",
-				Message:  "New Pull",
-				Sender:   "test",
-				Avatar:   "http://127.0.0.1:3000/avatars/dd46a756faad4727fb679320751f6dea",
-				Email:    "test@noreply.localhost",
-				ForgeURL: "http://127.0.0.1:3000/Test-CI/multi-line-secrets/pulls/2",
+				Author:     "test",
+				Event:      "pull_request",
+				Commit:     "788ed8d02d3b7fcfcf6386dbcbca696aa1d4dc25",
+				BaseCommit: "29be01c073851cf0db0c6a466e396b725a670453",
+				Branch:     "main",
+				Ref:        "refs/pull/2/head",
+				Refspec:    "test-patch-1:main",
+				Title:      "New Pull",
+				Message:    "New Pull",
+				Sender:     "test",
+				Avatar:     "http://127.0.0.1:3000/avatars/dd46a756faad4727fb679320751f6dea",
+				Email:      "test@noreply.localhost",
+				ForgeURL:   "http://127.0.0.1:3000/Test-CI/multi-line-secrets/pulls/2",
 				PullRequestLabels: []string{
 					"Kind/Bug",
 					"Kind/Security",
@@ -337,6 +343,7 @@ func TestGiteaParser(t *testing.T) {
 				Author:            "anbraten",
 				Event:             "pull_request_closed",
 				Commit:            "d555a5dd07f4d0148a58d4686ec381502ae6a2d4",
+				BaseCommit:        "068aee163ffd44eef28a7f9ebd43e2c01774f0fa",
 				Branch:            "main",
 				Ref:               "refs/pull/1/head",
 				Refspec:           "anbraten-patch-1:main",
@@ -359,6 +366,7 @@ func TestGiteaParser(t *testing.T) {
 				Event:             model.EventPullMetadata,
 				EventReason:       []string{"edited"},
 				Commit:            "07977177c2cd7d46bad37b8472a9d50e7acb9d1f",
+				BaseCommit:        "a40211c506550ebd79633d84e913dafa184c6d56",
 				Branch:            "main",
 				Ref:               "refs/pull/7/head",
 				Refspec:           "jony-patch-1:main",
@@ -381,6 +389,7 @@ func TestGiteaParser(t *testing.T) {
 				Event:             model.EventPullMetadata,
 				EventReason:       []string{"edited"},
 				Commit:            "07977177c2cd7d46bad37b8472a9d50e7acb9d1f",
+				BaseCommit:        "a40211c506550ebd79633d84e913dafa184c6d56",
 				Branch:            "main",
 				Ref:               "refs/pull/7/head",
 				Refspec:           "jony-patch-1:main",
@@ -423,6 +432,7 @@ func TestGiteaParser(t *testing.T) {
 				Event:             model.EventPullMetadata,
 				EventReason:       []string{"label_updated"},
 				Commit:            "07977177c2cd7d46bad37b8472a9d50e7acb9d1f",
+				BaseCommit:        "a40211c506550ebd79633d84e913dafa184c6d56",
 				Branch:            "main",
 				Ref:               "refs/pull/7/head",
 				Refspec:           "jony-patch-1:main",
@@ -445,6 +455,7 @@ func TestGiteaParser(t *testing.T) {
 				Event:             model.EventPullMetadata,
 				EventReason:       []string{"label_updated"},
 				Commit:            "07977177c2cd7d46bad37b8472a9d50e7acb9d1f",
+				BaseCommit:        "a40211c506550ebd79633d84e913dafa184c6d56",
 				Branch:            "main",
 				Ref:               "refs/pull/7/head",
 				Refspec:           "jony-patch-1:main",
@@ -467,6 +478,7 @@ func TestGiteaParser(t *testing.T) {
 				Event:             model.EventPullMetadata,
 				EventReason:       []string{"label_cleared"},
 				Commit:            "07977177c2cd7d46bad37b8472a9d50e7acb9d1f",
+				BaseCommit:        "a40211c506550ebd79633d84e913dafa184c6d56",
 				Branch:            "main",
 				Ref:               "refs/pull/7/head",
 				Refspec:           "jony-patch-1:main",
@@ -489,6 +501,7 @@ func TestGiteaParser(t *testing.T) {
 				Event:                model.EventPullMetadata,
 				EventReason:          []string{"milestoned"},
 				Commit:               "07977177c2cd7d46bad37b8472a9d50e7acb9d1f",
+				BaseCommit:           "a40211c506550ebd79633d84e913dafa184c6d56",
 				Branch:               "main",
 				Ref:                  "refs/pull/7/head",
 				Refspec:              "jony-patch-1:main",
@@ -512,6 +525,7 @@ func TestGiteaParser(t *testing.T) {
 				Event:                model.EventPullMetadata,
 				EventReason:          []string{"milestoned"},
 				Commit:               "07977177c2cd7d46bad37b8472a9d50e7acb9d1f",
+				BaseCommit:           "a40211c506550ebd79633d84e913dafa184c6d56",
 				Branch:               "main",
 				Ref:                  "refs/pull/7/head",
 				Refspec:              "jony-patch-1:main",
@@ -535,6 +549,7 @@ func TestGiteaParser(t *testing.T) {
 				Event:                model.EventPullMetadata,
 				EventReason:          []string{"demilestoned"},
 				Commit:               "07977177c2cd7d46bad37b8472a9d50e7acb9d1f",
+				BaseCommit:           "a40211c506550ebd79633d84e913dafa184c6d56",
 				Branch:               "main",
 				Ref:                  "refs/pull/7/head",
 				Refspec:              "jony-patch-1:main",
@@ -558,6 +573,7 @@ func TestGiteaParser(t *testing.T) {
 				Event:             model.EventPullMetadata,
 				EventReason:       []string{"assigned"},
 				Commit:            "07977177c2cd7d46bad37b8472a9d50e7acb9d1f",
+				BaseCommit:        "a40211c506550ebd79633d84e913dafa184c6d56",
 				Branch:            "main",
 				Ref:               "refs/pull/7/head",
 				Refspec:           "jony-patch-1:main",
@@ -580,6 +596,7 @@ func TestGiteaParser(t *testing.T) {
 				Event:             model.EventPullMetadata,
 				EventReason:       []string{"unassigned"},
 				Commit:            "07977177c2cd7d46bad37b8472a9d50e7acb9d1f",
+				BaseCommit:        "a40211c506550ebd79633d84e913dafa184c6d56",
 				Branch:            "main",
 				Ref:               "refs/pull/7/head",
 				Refspec:           "jony-patch-1:main",
@@ -617,6 +634,7 @@ func TestGiteaParser(t *testing.T) {
 				Author:            "anbraten",
 				Event:             "pull_request_closed",
 				Commit:            "d555a5dd07f4d0148a58d4686ec381502ae6a2d4",
+				BaseCommit:        "f2440f050054df0f8ecabcace648f1683509064c",
 				Branch:            "main",
 				Ref:               "refs/pull/1/head",
 				Refspec:           "anbraten-patch-1:main",
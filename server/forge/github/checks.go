@@ -0,0 +1,325 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-github/v80/github"
+
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/errors/types"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/utils"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/common"
+	forge_types "go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+const (
+	checkRunQueued     = "queued"
+	checkRunInProgress = "in_progress"
+	checkRunCompleted  = "completed"
+
+	actionRerequested = "rerequested"
+
+	annotationFailure = "failure"
+	annotationWarning = "warning"
+
+	// maxAnnotations is the max number of annotations GitHub accepts per request.
+	maxAnnotations = 50
+	// appTokenLeeway is the time an installation token has to be valid at least to be reused.
+	appTokenLeeway = time.Minute
+)
+
+type appToken struct {
+	token   string
+	expires time.Time
+}
+
+// appJWT returns a token to authenticate as GitHub App.
+func (c *client) appJWT() (string, error) {
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.RegisteredClaims{
+		// allow some clock drift between Woodpecker and GitHub
+		IssuedAt:  jwt.NewNumericDate(now.Add(-time.Minute)),
+		ExpiresAt: jwt.NewNumericDate(now.Add(9 * time.Minute)), //nolint:mnd
+		Issuer:    c.appID,
+	})
+	return token.SignedString(c.appPrivateKey)
+}
+
+// newAppClient returns a client authenticated with an installation token of the GitHub App for the repository.
+func (c *client) newAppClient(ctx context.Context, repo *model.Repo) (*github.Client, error) {
+	c.appTokensLock.Lock()
+	defer c.appTokensLock.Unlock()
+
+	if token, ok := c.appTokens[repo.FullName]; ok && time.Until(token.expires) > appTokenLeeway {
+		return c.newClientToken(ctx, token.token), nil
+	}
+
+	jwtToken, err := c.appJWT()
+	if err != nil {
+		return nil, fmt.Errorf("could not sign github app token: %w", err)
+	}
+	appClient := c.newClientToken(ctx, jwtToken)
+
+	installation, _, err := appClient.Apps.FindRepositoryInstallation(ctx, repo.Owner, repo.Name)
+	if err != nil {
+		return nil, fmt.Errorf("could not find installation of github app for %s: %w", repo.FullName, err)
+	}
+	token, _, err := appClient.Apps.CreateInstallationToken(ctx, installation.GetID(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create installation token of github app for %s: %w", repo.FullName, err)
+	}
+
+	c.appTokens[repo.FullName] = &appToken{
+		token:   token.GetToken(),
+		expires: token.GetExpiresAt().Time,
+	}
+	return c.newClientToken(ctx, token.GetToken()), nil
+}
+
+// checkRun creates or updates the check run of the workflow.
+func (c *client) checkRun(ctx context.Context, repo *model.Repo, pipeline *model.Pipeline, workflow *model.Workflow) error {
+	client, err := c.newAppClient(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	name := common.GetPipelineStatusContext(repo, pipeline, workflow)
+	externalID := strconv.FormatInt(pipeline.Number, 10)
+	status, conclusion := convertCheckRunStatus(workflow.State)
+
+	output := &github.CheckRunOutput{
+		Title:   github.Ptr(common.GetPipelineStatusDescription(workflow.State)),
+		Summary: github.Ptr(checkRunSummary(workflow)),
+	}
+	var completedAt *github.Timestamp
+	if status == checkRunCompleted {
+		output.Annotations = checkRunAnnotations(pipeline, workflow)
+		completedAt = &github.Timestamp{Time: time.Unix(workflow.Finished, 0)}
+	}
+
+	runs, _, err := client.Checks.ListCheckRunsForRef(ctx, repo.Owner, repo.Name, pipeline.Commit, &github.ListCheckRunsOptions{
+		CheckName:   github.Ptr(name),
+		Filter:      github.Ptr("all"),
+		ListOptions: github.ListOptions{PerPage: defaultPageSize},
+	})
+	if err != nil {
+		return err
+	}
+	for _, run := range runs.CheckRuns {
+		if run.GetExternalID() != externalID {
+			continue
+		}
+		_, _, err := client.Checks.UpdateCheckRun(ctx, repo.Owner, repo.Name, run.GetID(), github.UpdateCheckRunOptions{
+			Name:        name,
+			DetailsURL:  github.Ptr(common.GetPipelineStatusURL(repo, pipeline, workflow)),
+			ExternalID:  github.Ptr(externalID),
+			Status:      github.Ptr(status),
+			Conclusion:  conclusion,
+			CompletedAt: completedAt,
+			Output:      output,
+		})
+		return err
+	}
+
+	opts := github.CreateCheckRunOptions{
+		Name:        name,
+		HeadSHA:     pipeline.Commit,
+		DetailsURL:  github.Ptr(common.GetPipelineStatusURL(repo, pipeline, workflow)),
+		ExternalID:  github.Ptr(externalID),
+		Status:      github.Ptr(status),
+		Conclusion:  conclusion,
+		CompletedAt: completedAt,
+		Output:      output,
+	}
+	if workflow.Started > 0 {
+		opts.StartedAt = &github.Timestamp{Time: time.Unix(workflow.Started, 0)}
+	}
+	_, _, err = client.Checks.CreateCheckRun(ctx, repo.Owner, repo.Name, opts)
+	return err
+}
+
+// StepStatus updates the check run of the workflow with the current state of its steps.
+func (c *client) StepStatus(ctx context.Context, _ *model.User, repo *model.Repo, pipeline *model.Pipeline, workflow *model.Workflow) error {
+	if !c.Checks || pipeline.Event == model.EventDeploy {
+		return nil
+	}
+	return c.checkRun(ctx, repo, pipeline, workflow)
+}
+
+// RerunHook parses a check run webhook of the GitHub App sent by the re-run button of a check run.
+func (c *client) RerunHook(_ context.Context, r *http.Request) (*model.Repo, int64, error) {
+	if c.appWebhookSecret == "" {
+		return nil, 0, errors.New("github app webhook secret is not configured")
+	}
+
+	raw, err := github.ValidatePayload(r, []byte(c.appWebhookSecret))
+	if err != nil {
+		return nil, 0, err
+	}
+	payload, err := github.ParseWebHook(github.WebHookType(r), raw)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	hook, ok := payload.(*github.CheckRunEvent)
+	if !ok {
+		return nil, 0, &forge_types.ErrIgnoreEvent{Event: github.WebHookType(r)}
+	}
+	if hook.GetAction() != actionRerequested {
+		return nil, 0, &forge_types.ErrIgnoreEvent{
+			Event:  github.WebHookType(r),
+			Reason: fmt.Sprintf("action %s is not supported", hook.GetAction()),
+		}
+	}
+
+	number, err := strconv.ParseInt(hook.GetCheckRun().GetExternalID(), 10, 64)
+	if err != nil {
+		return nil, 0, &forge_types.ErrIgnoreEvent{
+			Event:  github.WebHookType(r),
+			Reason: "check run was not created by woodpecker",
+		}
+	}
+
+	return convertRepo(hook.GetRepo()), number, nil
+}
+
+// convertCheckRunStatus returns the status and conclusion of a check run for the Woodpecker status.
+func convertCheckRunStatus(status model.StatusValue) (string, *string) {
+	switch status {
+	case model.StatusPending, model.StatusBlocked, model.StatusCreated:
+		return checkRunQueued, nil
+	case model.StatusRunning:
+		return checkRunInProgress, nil
+	case model.StatusSuccess:
+		return checkRunCompleted, github.Ptr("success")
+	case model.StatusSkipped:
+		return checkRunCompleted, github.Ptr("skipped")
+	case model.StatusKilled, model.StatusDeclined:
+		return checkRunCompleted, github.Ptr("cancelled")
+	default:
+		return checkRunCompleted, github.Ptr("failure")
+	}
+}
+
+// checkRunSummary returns a markdown table of the steps of the workflow.
+func checkRunSummary(workflow *model.Workflow) string {
+	if len(workflow.Children) == 0 {
+		return common.GetPipelineStatusDescription(workflow.State)
+	}
+
+	var b strings.Builder
+	b.WriteString("| Step | Status | Exit code | Duration |\n| --- | --- | --- | --- |\n")
+	for _, step := range workflow.Children {
+		exitCode, duration := "", ""
+		if step.Finished > 0 {
+			exitCode = strconv.Itoa(step.ExitCode)
+			duration = (time.Duration(step.Finished-step.Started) * time.Second).String()
+		}
+		fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", step.Name, step.State, exitCode, duration)
+	}
+	return b.String()
+}
+
+// checkRunAnnotations returns the annotations of the workflow from the linter errors of its config
+// and from the annotations files written by its steps.
+func checkRunAnnotations(pipeline *model.Pipeline, workflow *model.Workflow) []*github.CheckRunAnnotation {
+	var annotations []*github.CheckRunAnnotation
+
+	for _, pipelineErr := range pipeline.Errors {
+		location := pipelineErrorLocation(pipelineErr)
+		if location.File == "" || utils.WorkflowName(location.File) != workflow.Name {
+			continue
+		}
+		level := annotationFailure
+		if pipelineErr.IsWarning {
+			level = annotationWarning
+		}
+		// GitHub requires a line, the linter does not know it for fields missing in the config
+		line := max(location.Line, 1)
+		annotation := &github.CheckRunAnnotation{
+			Path:            github.Ptr(location.File),
+			StartLine:       github.Ptr(line),
+			EndLine:         github.Ptr(line),
+			AnnotationLevel: github.Ptr(level),
+			Title:           github.Ptr(location.Field),
+			Message:         github.Ptr(pipelineErr.Message),
+		}
+		if location.Column > 0 {
+			annotation.StartColumn = github.Ptr(location.Column)
+			annotation.EndColumn = github.Ptr(location.Column)
+		}
+		annotations = append(annotations, annotation)
+	}
+
+	for _, step := range workflow.Children {
+		for _, stepAnnotation := range step.Annotations {
+			annotation := &github.CheckRunAnnotation{
+				Path:            github.Ptr(stepAnnotation.Path),
+				StartLine:       github.Ptr(stepAnnotation.StartLine),
+				EndLine:         github.Ptr(max(stepAnnotation.EndLine, stepAnnotation.StartLine)),
+				AnnotationLevel: github.Ptr(stepAnnotation.Level),
+				Message:         github.Ptr(stepAnnotation.Message),
+			}
+			if stepAnnotation.Title != "" {
+				annotation.Title = github.Ptr(stepAnnotation.Title)
+			}
+			// GitHub only accepts columns for annotations of a single line
+			if stepAnnotation.StartColumn > 0 && annotation.GetStartLine() == annotation.GetEndLine() {
+				annotation.StartColumn = github.Ptr(stepAnnotation.StartColumn)
+				annotation.EndColumn = github.Ptr(max(stepAnnotation.EndColumn, stepAnnotation.StartColumn))
+			}
+			annotations = append(annotations, annotation)
+		}
+	}
+
+	if len(annotations) > maxAnnotations {
+		annotations = annotations[:maxAnnotations]
+	}
+	return annotations
+}
+
+// errorLocation is the position of linter, deprecation and bad habit errors in the config.
+type errorLocation struct {
+	File   string `json:"file"`
+	Field  string `json:"field"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+}
+
+// pipelineErrorLocation returns the position of linter, deprecation and bad habit errors.
+func pipelineErrorLocation(pipelineErr *types.PipelineError) errorLocation {
+	var location errorLocation
+	if pipelineErr.Data == nil {
+		return location
+	}
+
+	// the data is a map if the pipeline was loaded from the store
+	data, err := json.Marshal(pipelineErr.Data)
+	if err != nil {
+		return location
+	}
+	_ = json.Unmarshal(data, &location)
+	return location
+}
@@ -0,0 +1,223 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v80/github"
+	gh_mock "github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+
+	pipeline_errors "go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/errors/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+	forge_types "go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func TestNewWithChecks(t *testing.T) {
+	_, err := New(1, Opts{Checks: true})
+	assert.ErrorContains(t, err, "require the id and private key of a github app")
+
+	_, err = New(1, Opts{Checks: true, AppID: "Iv1.123", AppPrivateKey: "invalid"})
+	assert.ErrorContains(t, err, "could not parse github app private key")
+}
+
+func TestConvertCheckRunStatus(t *testing.T) {
+	status, conclusion := convertCheckRunStatus(model.StatusPending)
+	assert.Equal(t, checkRunQueued, status)
+	assert.Nil(t, conclusion)
+
+	status, conclusion = convertCheckRunStatus(model.StatusRunning)
+	assert.Equal(t, checkRunInProgress, status)
+	assert.Nil(t, conclusion)
+
+	status, conclusion = convertCheckRunStatus(model.StatusKilled)
+	assert.Equal(t, checkRunCompleted, status)
+	assert.Equal(t, "cancelled", *conclusion)
+
+	status, conclusion = convertCheckRunStatus(model.StatusError)
+	assert.Equal(t, checkRunCompleted, status)
+	assert.Equal(t, "failure", *conclusion)
+}
+
+func TestCheckRunAnnotations(t *testing.T) {
+	pipeline := &model.Pipeline{
+		Errors: []*types.PipelineError{
+			{
+				Type:    types.PipelineErrorTypeLinter,
+				Message: "Additional property image is not allowed",
+				Data:    &pipeline_errors.LinterErrorData{File: ".woodpecker/build.yaml", Field: "steps.build", Line: 4, Column: 3},
+			},
+			{
+				Type:      types.PipelineErrorTypeDeprecation,
+				Message:   "Please use the new syntax",
+				IsWarning: true,
+				// data of pipelines loaded from the store
+				Data: map[string]any{"file": ".woodpecker/test.yaml", "field": "steps.test", "line": 2},
+			},
+		},
+	}
+	workflow := &model.Workflow{
+		Name: "build",
+		Children: []*model.Step{
+			{ID: 1, Name: "lint", State: model.StatusFailure, Finished: 1, Annotations: []*model.StepAnnotation{
+				{Path: "main.go", StartLine: 1, EndLine: 1, Level: annotationWarning, Message: "exported function without comment"},
+				{Path: "util.go", StartLine: 3, EndLine: 5, StartColumn: 2, Level: annotationFailure, Title: "vet", Message: "unreachable code"},
+			}},
+			{ID: 2, Name: "test", State: model.StatusSkipped},
+		},
+	}
+
+	assert.Equal(t, []*github.CheckRunAnnotation{
+		{
+			Path:            github.Ptr(".woodpecker/build.yaml"),
+			StartLine:       github.Ptr(4),
+			EndLine:         github.Ptr(4),
+			StartColumn:     github.Ptr(3),
+			EndColumn:       github.Ptr(3),
+			AnnotationLevel: github.Ptr(annotationFailure),
+			Title:           github.Ptr("steps.build"),
+			Message:         github.Ptr("Additional property image is not allowed"),
+		},
+		{
+			Path:            github.Ptr("main.go"),
+			StartLine:       github.Ptr(1),
+			EndLine:         github.Ptr(1),
+			AnnotationLevel: github.Ptr(annotationWarning),
+			Message:         github.Ptr("exported function without comment"),
+		},
+		{
+			// columns are dropped for annotations of multiple lines
+			Path:            github.Ptr("util.go"),
+			StartLine:       github.Ptr(3),
+			EndLine:         github.Ptr(5),
+			AnnotationLevel: github.Ptr(annotationFailure),
+			Title:           github.Ptr("vet"),
+			Message:         github.Ptr("unreachable code"),
+		},
+	}, checkRunAnnotations(pipeline, workflow))
+}
+
+func TestCheckRun(t *testing.T) {
+	server.Config.Server.StatusContext = "ci/woodpecker"
+	server.Config.Server.StatusContextFormat = "{{ .context }}/{{ .event }}/{{ .workflow }}"
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	var created github.CreateCheckRunOptions
+	mockedHTTPClient := gh_mock.NewMockedHTTPClient(
+		gh_mock.WithRequestMatch(
+			gh_mock.GetReposInstallationByOwnerByRepo,
+			github.Installation{ID: github.Ptr(int64(42))},
+		),
+		gh_mock.WithRequestMatch(
+			gh_mock.PostAppInstallationsAccessTokensByInstallationId,
+			github.InstallationToken{
+				Token:     github.Ptr("installation-token"),
+				ExpiresAt: &github.Timestamp{Time: time.Now().Add(time.Hour)},
+			},
+		),
+		gh_mock.WithRequestMatch(
+			gh_mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+			github.ListCheckRunsResults{
+				CheckRuns: []*github.CheckRun{{ID: github.Ptr(int64(1)), ExternalID: github.Ptr("4")}},
+			},
+		),
+		gh_mock.WithRequestMatchHandler(
+			gh_mock.PostReposCheckRunsByOwnerByRepo,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+				_, _ = w.Write([]byte(`{"id": 2}`))
+			}),
+		),
+	)
+	ctx := context.WithValue(t.Context(), githubClientKey, github.NewClient(mockedHTTPClient))
+
+	c := &client{
+		Checks:        true,
+		appID:         "Iv1.123",
+		appPrivateKey: key,
+		appTokens:     make(map[string]*appToken),
+	}
+
+	repo := &model.Repo{ID: 1, Owner: "octocat", Name: "hello-world", FullName: "octocat/hello-world"}
+	pipeline := &model.Pipeline{Number: 5, Event: model.EventPush, Commit: "6dcb09b5b57875f334f61aebed695e2e4193db5e"}
+	workflow := &model.Workflow{
+		PID:     1,
+		Name:    "build",
+		State:   model.StatusRunning,
+		Started: 1,
+		Children: []*model.Step{
+			{Name: "clone", State: model.StatusSuccess, Started: 1, Finished: 3},
+			{Name: "build", State: model.StatusRunning, Started: 3},
+		},
+	}
+
+	assert.NoError(t, c.Status(ctx, &model.User{}, repo, pipeline, workflow))
+	assert.Equal(t, "ci/woodpecker/push/build", created.Name)
+	assert.Equal(t, pipeline.Commit, created.HeadSHA)
+	assert.Equal(t, "5", created.GetExternalID())
+	assert.Equal(t, checkRunInProgress, created.GetStatus())
+	assert.Nil(t, created.Conclusion)
+	assert.Equal(t, "| Step | Status | Exit code | Duration |\n| --- | --- | --- | --- |\n"+
+		"| clone | success | 0 | 2s |\n"+
+		"| build | running |  |  |\n", created.Output.GetSummary())
+	assert.Contains(t, c.appTokens, "octocat/hello-world")
+}
+
+func TestRerunHook(t *testing.T) {
+	const secret = "app-secret"
+	c := &client{appWebhookSecret: secret}
+
+	request := func(event, payload string) *http.Request {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(payload))
+		req := httptest.NewRequest(http.MethodPost, "/api/hook/forges/1", strings.NewReader(payload))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(github.EventTypeHeader, event)
+		req.Header.Set(github.SHA256SignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+		return req
+	}
+
+	repo, number, err := c.RerunHook(t.Context(), request("check_run",
+		`{"action": "rerequested", "check_run": {"id": 1, "external_id": "5"}, "repository": {"id": 42, "name": "hello-world", "owner": {"login": "octocat"}}}`))
+	assert.NoError(t, err)
+	assert.EqualValues(t, "42", repo.ForgeRemoteID)
+	assert.EqualValues(t, 5, number)
+
+	_, _, err = c.RerunHook(t.Context(), request("check_run",
+		`{"action": "completed", "check_run": {"id": 1, "external_id": "5"}, "repository": {"id": 42}}`))
+	assert.ErrorIs(t, err, &forge_types.ErrIgnoreEvent{})
+
+	req := request("check_run", `{"action": "rerequested"}`)
+	req.Header.Set(github.SHA256SignatureHeader, "sha256=invalid")
+	_, _, err = c.RerunHook(t.Context(), req)
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, &forge_types.ErrIgnoreEvent{})
+}
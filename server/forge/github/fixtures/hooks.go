@@ -56,6 +56,28 @@ const HookPullRequestInvalidState = `
 }
 `
 
+// HookPushMergeQueue is a sample push hook to the temporary branch of a merge queue, and is expected to be ignored.
+const HookPushMergeQueue = `
+{
+  "ref": "refs/heads/gh-readonly-queue/main/pr-12-6dcb09b5b57875f334f61aebed695e2e4193db5e",
+  "after": "ec26c3e57ca3a959ca5aad62de7213c562f8c821"
+}
+`
+
+// HookMergeGroup is a sample merge group hook.
+// https://docs.github.com/en/webhooks/webhook-events-and-payloads#merge_group
+//
+//go:embed HookMergeGroup.json
+var HookMergeGroup string
+
+// HookMergeGroupDestroyed is a sample merge group hook of a destroyed merge group, and is expected to be ignored.
+const HookMergeGroupDestroyed = `
+{
+  "action": "destroyed",
+  "reason": "merged"
+}
+`
+
 // HookPush is a sample deployment hook.
 // https://developer.github.com/v3/activity/events/types/#deploymentevent
 //
@@ -17,6 +17,7 @@ package github
 
 import (
 	"context"
+	"crypto/rsa"
 	"crypto/tls"
 	"errors"
 	"fmt"
@@ -25,8 +26,10 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/go-github/v80/github"
 	"github.com/rs/zerolog/log"
 	"golang.org/x/oauth2"
@@ -59,6 +62,10 @@ type Opts struct {
 	MergeRef          bool   // Clone pull requests using the merge ref.
 	OnlyPublic        bool   // Only obtain OAuth tokens with access to public repos.
 	OAuthHost         string // Public url for oauth if different from url.
+	Checks            bool   // Report workflows as check runs of a GitHub App instead of commit statuses.
+	AppID             string // GitHub App id or client id, required for check runs.
+	AppPrivateKey     string // GitHub App private key in PEM format, required for check runs.
+	AppWebhookSecret  string // GitHub App webhook secret to re-run pipelines from check runs.
 }
 
 // New returns a Forge implementation that integrates with a GitHub Cloud or
@@ -74,12 +81,27 @@ func New(id int64, opts Opts) (forge.Forge, error) {
 		SkipVerify: opts.SkipVerify,
 		MergeRef:   opts.MergeRef,
 		OnlyPublic: opts.OnlyPublic,
+		Checks:     opts.Checks,
 	}
 	if opts.URL != defaultURL {
 		r.url = strings.TrimSuffix(opts.URL, "/")
 		r.API = r.url + "/api/v3/"
 	}
 
+	if opts.Checks {
+		if opts.AppID == "" || opts.AppPrivateKey == "" {
+			return nil, errors.New("github check runs require the id and private key of a github app")
+		}
+		key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(opts.AppPrivateKey))
+		if err != nil {
+			return nil, fmt.Errorf("could not parse github app private key: %w", err)
+		}
+		r.appID = opts.AppID
+		r.appPrivateKey = key
+		r.appWebhookSecret = opts.AppWebhookSecret
+		r.appTokens = make(map[string]*appToken)
+	}
+
 	return r, nil
 }
 
@@ -93,6 +115,13 @@ type client struct {
 	MergeRef   bool
 	OnlyPublic bool
 	oAuthHost  string
+	Checks     bool
+
+	appID            string
+	appPrivateKey    *rsa.PrivateKey
+	appWebhookSecret string
+	appTokens        map[string]*appToken
+	appTokensLock    sync.Mutex
 }
 
 // Name returns the string name of this driver.
@@ -553,6 +582,10 @@ func (c *client) Status(ctx context.Context, user *model.User, repo *model.Repo,
 		return err
 	}
 
+	if c.Checks {
+		return c.checkRun(ctx, repo, pipeline, workflow)
+	}
+
 	_, _, err := client.Repositories.CreateStatus(ctx, repo.Owner, repo.Name, pipeline.Commit, github.RepoStatus{
 		Context:     github.Ptr(common.GetPipelineStatusContext(repo, pipeline, workflow)),
 		State:       github.Ptr(convertStatus(workflow.State)),
@@ -562,6 +595,39 @@ func (c *client) Status(ctx context.Context, user *model.User, repo *model.Repo,
 	return err
 }
 
+var rePullRequestRef = regexp.MustCompile(`^refs/pull/(\d+)/`)
+
+// PullRequestComment creates or updates the comment of Woodpecker on the pull request of the pipeline.
+func (c *client) PullRequestComment(ctx context.Context, u *model.User, r *model.Repo, p *model.Pipeline, body string) error {
+	matches := rePullRequestRef.FindStringSubmatch(p.Ref)
+	//nolint:mnd
+	if len(matches) != 2 {
+		return fmt.Errorf("could not get pull request number from ref %s", p.Ref)
+	}
+	number, _ := strconv.Atoi(matches[1])
+
+	token := common.UserToken(ctx, r, u)
+	client := c.newClientToken(ctx, token)
+
+	opts := &github.IssueListCommentsOptions{ListOptions: github.ListOptions{Page: 1, PerPage: defaultPageSize}}
+	for opts.Page > 0 {
+		comments, resp, err := client.Issues.ListComments(ctx, r.Owner, r.Name, number, opts)
+		if err != nil {
+			return err
+		}
+		for _, comment := range comments {
+			if strings.Contains(comment.GetBody(), forge.PullRequestCommentMarker) {
+				_, _, err := client.Issues.EditComment(ctx, r.Owner, r.Name, comment.GetID(), &github.IssueComment{Body: &body})
+				return err
+			}
+		}
+		opts.Page = resp.NextPage
+	}
+
+	_, _, err := client.Issues.CreateComment(ctx, r.Owner, r.Name, number, &github.IssueComment{Body: &body})
+	return err
+}
+
 // Activate activates a repository by creating the post-commit hook and
 // adding the SSH deploy key, if applicable.
 func (c *client) Activate(ctx context.Context, u *model.User, r *model.Repo, link string) error {
@@ -576,6 +642,7 @@ func (c *client) Activate(ctx context.Context, u *model.User, r *model.Repo, lin
 			"pull_request",
 			"pull_request_review",
 			"deployment",
+			"merge_group",
 		},
 		Config: &github.HookConfig{
 			URL:         &link,
@@ -621,6 +688,27 @@ func (c *client) BranchHead(ctx context.Context, u *model.User, r *model.Repo, b
 	}, nil
 }
 
+// ChangedFiles returns the files changed by head since it diverged from base.
+func (c *client) ChangedFiles(ctx context.Context, u *model.User, r *model.Repo, base, head string) ([]string, error) {
+	token := common.UserToken(ctx, r, u)
+	client := c.newClientToken(ctx, token)
+
+	fileList := make([]string, 0, 16)
+	opts := &github.ListOptions{Page: 1}
+	for opts.Page > 0 {
+		comp, resp, err := client.Repositories.CompareCommits(ctx, r.Owner, r.Name, base, head, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range comp.Files {
+			fileList = append(fileList, file.GetFilename(), file.GetPreviousFilename())
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return utils.DeduplicateStrings(fileList), nil
+}
+
 // Hook parses the post-commit hook from the Request body
 // and returns the required data in a standard format.
 func (c *client) Hook(ctx context.Context, r *http.Request) (*model.Repo, *model.Pipeline, error) {
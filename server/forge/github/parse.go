@@ -51,6 +51,11 @@ const (
 
 	labelCleared = "label_cleared"
 	labelUpdated = "label_updated"
+
+	actionChecksRequested = "checks_requested"
+
+	// mergeQueueBranchPrefix is the prefix of the temporary branches of merge groups.
+	mergeQueueBranchPrefix = "gh-readonly-queue/"
 )
 
 // parseHook parses a GitHub hook from an http.Request request and returns
@@ -85,6 +90,9 @@ func parseHook(r *http.Request, merge bool) (_ *github.PullRequest, _ *model.Rep
 	case *github.ReleaseEvent:
 		repo, pipeline := parseReleaseHook(hook)
 		return nil, repo, pipeline, "", "", nil
+	case *github.MergeGroupEvent:
+		repo, pipeline, err := parseMergeGroupHook(hook)
+		return nil, repo, pipeline, "", "", err
 	default:
 		return nil, nil, nil, "", "", &types.ErrIgnoreEvent{Event: github.Stringify(hook)}
 	}
@@ -96,18 +104,23 @@ func parsePushHook(hook *github.PushEvent) (_ *model.Repo, _ *model.Pipeline, cu
 	if hook.Deleted != nil && *hook.Deleted {
 		return nil, nil, "", ""
 	}
+	// temporary branches of merge queues are handled by the merge group event
+	if strings.HasPrefix(hook.GetRef(), "refs/heads/"+mergeQueueBranchPrefix) {
+		return nil, nil, "", ""
+	}
 
 	pipeline := &model.Pipeline{
-		Event:    model.EventPush,
-		Commit:   hook.GetHeadCommit().GetID(),
-		Ref:      hook.GetRef(),
-		ForgeURL: hook.GetHeadCommit().GetURL(),
-		Branch:   strings.ReplaceAll(hook.GetRef(), "refs/heads/", ""),
-		Message:  hook.GetHeadCommit().GetMessage(),
-		Email:    hook.GetHeadCommit().GetAuthor().GetEmail(),
-		Avatar:   hook.GetSender().GetAvatarURL(),
-		Author:   hook.GetSender().GetLogin(),
-		Sender:   hook.GetSender().GetLogin(),
+		Event:      model.EventPush,
+		Commit:     hook.GetHeadCommit().GetID(),
+		BaseCommit: hook.GetBefore(),
+		Ref:        hook.GetRef(),
+		ForgeURL:   hook.GetHeadCommit().GetURL(),
+		Branch:     strings.ReplaceAll(hook.GetRef(), "refs/heads/", ""),
+		Message:    hook.GetHeadCommit().GetMessage(),
+		Email:      hook.GetHeadCommit().GetAuthor().GetEmail(),
+		Avatar:     hook.GetSender().GetAvatarURL(),
+		Author:     hook.GetSender().GetLogin(),
+		Sender:     hook.GetSender().GetLogin(),
 	}
 	repo := convertRepoHook(hook.GetRepo())
 
@@ -196,6 +209,7 @@ func parsePullHook(hook *github.PullRequestEvent, merge bool) (*github.PullReque
 		Event:       event,
 		EventReason: []string{eventAction},
 		Commit:      hook.GetPullRequest().GetHead().GetSHA(),
+		BaseCommit:  hook.GetPullRequest().GetBase().GetSHA(),
 		ForgeURL:    hook.GetPullRequest().GetHTMLURL(),
 		Ref:         fmt.Sprintf(headRefs, hook.GetPullRequest().GetNumber()),
 		Branch:      hook.GetPullRequest().GetBase().GetRef(),
@@ -254,3 +268,31 @@ func parseReleaseHook(hook *github.ReleaseEvent) (*model.Repo, *model.Pipeline)
 
 	return convertRepo(hook.GetRepo()), pipeline
 }
+
+// parseMergeGroupHook parses a merge group hook and returns the Repo and Pipeline
+// details.
+func parseMergeGroupHook(hook *github.MergeGroupEvent) (*model.Repo, *model.Pipeline, error) {
+	if hook.GetAction() != actionChecksRequested {
+		return nil, nil, &types.ErrIgnoreEvent{
+			Event:  string(model.EventMergeGroup),
+			Reason: fmt.Sprintf("action %s is not supported", hook.GetAction()),
+		}
+	}
+
+	group := hook.GetMergeGroup()
+	pipeline := &model.Pipeline{
+		Event:      model.EventMergeGroup,
+		Commit:     group.GetHeadSHA(),
+		BaseCommit: group.GetBaseSHA(),
+		Ref:        group.GetHeadRef(),
+		ForgeURL:   fmt.Sprintf("%s/commit/%s", hook.GetRepo().GetHTMLURL(), group.GetHeadSHA()),
+		Branch:     strings.TrimPrefix(group.GetBaseRef(), "refs/heads/"),
+		Message:    group.GetHeadCommit().GetMessage(),
+		Email:      group.GetHeadCommit().GetAuthor().GetEmail(),
+		Avatar:     hook.GetSender().GetAvatarURL(),
+		Author:     hook.GetSender().GetLogin(),
+		Sender:     hook.GetSender().GetLogin(),
+	}
+
+	return convertRepo(hook.GetRepo()), pipeline, nil
+}
@@ -30,11 +30,12 @@ import (
 )
 
 const (
-	hookEvent   = "X-GitHub-Event"
-	hookDeploy  = "deployment"
-	hookPush    = "push"
-	hookPull    = "pull_request"
-	hookRelease = "release"
+	hookEvent      = "X-GitHub-Event"
+	hookDeploy     = "deployment"
+	hookPush       = "push"
+	hookPull       = "pull_request"
+	hookRelease    = "release"
+	hookMergeGroup = "merge_group"
 )
 
 func testHookRequest(payload []byte, event string) *http.Request {
@@ -168,6 +169,46 @@ func Test_parseHook(t *testing.T) {
 		assert.True(t, strings.HasPrefix(b.Ref, "refs/tags/"))
 	})
 
+	t.Run("skip push hook to merge queue branch", func(t *testing.T) {
+		req := testHookRequest([]byte(fixtures.HookPushMergeQueue), hookPush)
+		p, r, b, _, _, err := parseHook(req, false)
+		assert.NoError(t, err)
+		assert.Nil(t, r)
+		assert.Nil(t, b)
+		assert.Nil(t, p)
+	})
+
+	t.Run("merge group hook", func(t *testing.T) {
+		req := testHookRequest([]byte(fixtures.HookMergeGroup), hookMergeGroup)
+		p, r, b, cc, pc, err := parseHook(req, false)
+		assert.Empty(t, pc)
+		assert.Empty(t, cc)
+		assert.NoError(t, err)
+		assert.Nil(t, p)
+		assert.Equal(t, "octocat/Hello-World", r.FullName)
+		assert.Equal(t, &model.Pipeline{
+			Event:      model.EventMergeGroup,
+			Commit:     "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+			BaseCommit: "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			Ref:        "refs/heads/gh-readonly-queue/main/pr-12-6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			ForgeURL:   "https://github.com/octocat/Hello-World/commit/ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+			Branch:     "main",
+			Message:    "Merge pull request #12 from octocat/patch-1",
+			Email:      "octocat@github.com",
+			Avatar:     "https://github.com/images/error/octocat_happy.gif",
+			Author:     "octocat",
+			Sender:     "octocat",
+		}, b)
+	})
+
+	t.Run("ignore destroyed merge group hook", func(t *testing.T) {
+		req := testHookRequest([]byte(fixtures.HookMergeGroupDestroyed), hookMergeGroup)
+		_, r, b, _, _, err := parseHook(req, false)
+		assert.ErrorIs(t, err, &types.ErrIgnoreEvent{})
+		assert.Nil(t, r)
+		assert.Nil(t, b)
+	})
+
 	t.Run("pull review requested", func(t *testing.T) {
 		req := testHookRequest([]byte(fixtures.HookPullRequestReviewRequested), hookPull)
 		p, r, b, cc, pc, err := parseHook(req, false)
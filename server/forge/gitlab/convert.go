@@ -262,6 +262,7 @@ func convertPushHook(hook *gitlab.PushEvent) (*model.Repo, *model.Pipeline, erro
 
 	pipeline.Event = model.EventPush
 	pipeline.Commit = hook.After
+	pipeline.BaseCommit = hook.Before
 	pipeline.Branch = strings.TrimPrefix(hook.Ref, "refs/heads/")
 	pipeline.Ref = hook.Ref
 
@@ -282,7 +283,11 @@ func convertPushHook(hook *gitlab.PushEvent) (*model.Repo, *model.Pipeline, erro
 		files = append(files, cm.Removed...)
 		files = append(files, cm.Modified...)
 	}
-	pipeline.ChangedFiles = utils.DeduplicateStrings(files)
+	// the payload only contains a limited number of commits, leave it to the
+	// server to compare the commits if some are missing
+	if hook.TotalCommitsCount <= int64(len(hook.Commits)) {
+		pipeline.ChangedFiles = utils.DeduplicateStrings(files)
+	}
 
 	return repo, pipeline, nil
 }
@@ -460,6 +460,56 @@ func (g *GitLab) Status(ctx context.Context, user *model.User, repo *model.Repo,
 	return err
 }
 
+// PullRequestComment creates or updates the note of Woodpecker on the merge request of the pipeline.
+func (g *GitLab) PullRequestComment(ctx context.Context, u *model.User, r *model.Repo, p *model.Pipeline, body string) error {
+	var mergeID int64
+	if _, err := fmt.Sscanf(p.Ref, mergeRefs, &mergeID); err != nil {
+		return fmt.Errorf("could not get merge request id from ref %s: %w", p.Ref, err)
+	}
+
+	client, err := newClient(g.url, u.AccessToken, g.skipVerify)
+	if err != nil {
+		return err
+	}
+
+	_repo, err := g.getProject(ctx, client, r.ForgeRemoteID, r.Owner, r.Name)
+	if err != nil {
+		return err
+	}
+
+	opts := &gitlab.ListMergeRequestNotesOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: defaultPerPage,
+			Page:    1,
+		},
+	}
+	for {
+		notes, resp, err := client.Notes.ListMergeRequestNotes(_repo.ID, mergeID, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+
+		for _, note := range notes {
+			if strings.Contains(note.Body, forge.PullRequestCommentMarker) {
+				_, _, err := client.Notes.UpdateMergeRequestNote(_repo.ID, mergeID, note.ID, &gitlab.UpdateMergeRequestNoteOptions{
+					Body: &body,
+				}, gitlab.WithContext(ctx))
+				return err
+			}
+		}
+
+		if resp.CurrentPage >= resp.TotalPages {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	_, _, err = client.Notes.CreateMergeRequestNote(_repo.ID, mergeID, &gitlab.CreateMergeRequestNoteOptions{
+		Body: &body,
+	}, gitlab.WithContext(ctx))
+	return err
+}
+
 // Netrc returns a netrc file capable of authenticating Gitlab requests and
 // cloning Gitlab repositories. The netrc will use the global machine account
 // when configured.
@@ -632,6 +682,34 @@ func (g *GitLab) BranchHead(ctx context.Context, u *model.User, r *model.Repo, b
 	}, nil
 }
 
+// ChangedFiles returns the files changed by head since it diverged from base.
+func (g *GitLab) ChangedFiles(ctx context.Context, u *model.User, r *model.Repo, base, head string) ([]string, error) {
+	token := common.UserToken(ctx, r, u)
+	client, err := newClient(g.url, token, g.skipVerify)
+	if err != nil {
+		return nil, err
+	}
+
+	_repo, err := g.getProject(ctx, client, r.ForgeRemoteID, r.Owner, r.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	compare, _, err := client.Repositories.Compare(_repo.ID, &gitlab.CompareOptions{
+		From: &base,
+		To:   &head,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]string, 0, len(compare.Diffs)*2)
+	for _, diff := range compare.Diffs {
+		files = append(files, diff.NewPath, diff.OldPath)
+	}
+	return utils.DeduplicateStrings(files), nil
+}
+
 // Hook parses the post-commit hook from the Request body
 // and returns the required data in a standard format.
 func (g *GitLab) Hook(ctx context.Context, req *http.Request) (*model.Repo, *model.Pipeline, error) {
@@ -0,0 +1,33 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forge
+
+import (
+	"context"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// Poller is an optional interface for forges without webhooks.
+//
+// Active repositories of these forges are polled periodically for changes.
+//
+// Implementations: Git.
+type Poller interface {
+	// Poll fetches the refs of the repository and returns a pipeline for each
+	// pushed branch and created tag since the last poll.
+	// The first poll of a repository must only record the current refs.
+	Poll(ctx context.Context, u *model.User, r *model.Repo) ([]*model.Pipeline, error)
+}
@@ -42,7 +42,7 @@ func Refresh(c context.Context, forge Forge, _store store.Store, user *model.Use
 	// Remaining ttl of 30 minutes (1800 seconds) until a token is refreshed.
 	const tokenMinTTL = 1800
 
-	if refresher, ok := forge.(Refresher); ok {
+	if refresher, ok := As[Refresher](forge, CapabilityRefresh); ok {
 		// Check to see if the user token is expired or
 		// will expire within the next 30 minutes (1800 seconds).
 		// If not, there is nothing we really need to do here.
@@ -12,6 +12,7 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server/forge/bitbucket"
 	"go.woodpecker-ci.org/woodpecker/v3/server/forge/bitbucketdatacenter"
 	"go.woodpecker-ci.org/woodpecker/v3/server/forge/forgejo"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/git"
 	"go.woodpecker-ci.org/woodpecker/v3/server/forge/gitea"
 	"go.woodpecker-ci.org/woodpecker/v3/server/forge/github"
 	"go.woodpecker-ci.org/woodpecker/v3/server/forge/gitlab"
@@ -34,6 +35,8 @@ func Forge(forge *model.Forge) (forge.Forge, error) {
 		return setupForgejo(forge)
 	case model.ForgeTypeBitbucketDatacenter:
 		return setupBitbucketDatacenter(forge)
+	case model.ForgeTypeGit:
+		return setupGit(forge)
 	default:
 		return nil, fmt.Errorf("forge not configured")
 	}
@@ -130,6 +133,10 @@ func setupGitHub(forge *model.Forge) (forge.Forge, error) {
 	// get additional config and be false by default
 	mergeRef, _ := forge.AdditionalOptions["merge-ref"].(bool)
 	publicOnly, _ := forge.AdditionalOptions["public-only"].(bool)
+	checks, _ := forge.AdditionalOptions["checks"].(bool)
+	appID, _ := forge.AdditionalOptions["app-id"].(string)
+	appPrivateKey, _ := forge.AdditionalOptions["app-private-key"].(string)
+	appWebhookSecret, _ := forge.AdditionalOptions["app-webhook-secret"].(string)
 
 	opts := github.Opts{
 		URL:               forge.URL,
@@ -139,12 +146,20 @@ func setupGitHub(forge *model.Forge) (forge.Forge, error) {
 		MergeRef:          mergeRef,
 		OnlyPublic:        publicOnly,
 		OAuthHost:         forge.OAuthHost,
+		Checks:            checks,
+		AppID:             appID,
+		AppPrivateKey:     appPrivateKey,
+		AppWebhookSecret:  appWebhookSecret,
 	}
 	log.Debug().
 		Str("url", opts.URL).
 		Str("oauth-host", opts.OAuthHost).
 		Bool("merge-ref", opts.MergeRef).
 		Bool("only-public", opts.OnlyPublic).
+		Bool("checks", opts.Checks).
+		Str("app-id", opts.AppID).
+		Bool("app-private-key-set", opts.AppPrivateKey != "").
+		Bool("app-webhook-secret-set", opts.AppWebhookSecret != "").
 		Bool("skip-verify", opts.SkipVerify).
 		Bool("oauth-client-id-set", opts.OAuthClientID != "").
 		Bool("oauth-client-secret-set", opts.OAuthClientSecret != "").
@@ -188,10 +203,54 @@ func setupBitbucketDatacenter(forge *model.Forge) (forge.Forge, error) {
 	return bitbucketdatacenter.New(forge.ID, opts)
 }
 
+func setupGit(forge *model.Forge) (forge.Forge, error) {
+	repos, _ := forge.AdditionalOptions["repos"].(string)
+	username, _ := forge.AdditionalOptions["username"].(string)
+	password, _ := forge.AdditionalOptions["password"].(string)
+	sshKey, _ := forge.AdditionalOptions["ssh-key"].(string)
+	users, _ := forge.AdditionalOptions["users"].(string)
+	mirrorPath, _ := forge.AdditionalOptions["mirror-path"].(string)
+
+	opts := git.Opts{
+		URL:        forge.URL,
+		Repos:      strings.Split(repos, "\n"),
+		Username:   username,
+		Password:   password,
+		SSHKey:     sshKey,
+		Users:      users,
+		MirrorPath: mirrorPath,
+	}
+	log.Debug().
+		Str("url", opts.URL).
+		Int("repos", len(opts.Repos)).
+		Bool("password-set", opts.Password != "").
+		Bool("ssh-key-set", opts.SSHKey != "").
+		Str("mirror-path", opts.MirrorPath).
+		Str("type", string(forge.Type)).
+		Msg("setting up forge")
+	return git.New(forge.ID, opts)
+}
+
 func setupAddon(forge *model.Forge) (forge.Forge, error) {
+	if address, ok := forge.AdditionalOptions["address"].(string); ok && address != "" {
+		token, _ := forge.AdditionalOptions["token"].(string)
+		insecure, _ := forge.AdditionalOptions["insecure"].(bool)
+
+		log.Debug().Str("address", address).Bool("insecure", insecure).Msg("setting up forge")
+		client, err := addon.Connect(address, addon.ConnectOpts{
+			Token:      token,
+			Insecure:   insecure,
+			SkipVerify: forge.SkipVerify,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
+	}
+
 	executable, ok := forge.AdditionalOptions["executable"].(string)
-	if !ok {
-		return nil, fmt.Errorf("missing addon executable")
+	if !ok || executable == "" {
+		return nil, fmt.Errorf("missing addon executable or address")
 	}
 
 	log.Debug().Str("executable", executable).Msg("setting up forge")
@@ -17,4 +17,8 @@ package types
 type OAuthRequest struct {
 	Code  string
 	State string
+	// Username and Password are submitted by the login form of forges
+	// authenticating users by Woodpecker itself.
+	Username string
+	Password string
 }
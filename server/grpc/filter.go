@@ -15,17 +15,22 @@
 package grpc
 
 import (
+	"fmt"
 	"maps"
+	"math"
+	"slices"
+	"strconv"
 	"strings"
 
 	pipelineConsts "go.woodpecker-ci.org/woodpecker/v3/pipeline"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/types"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/rpc"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 	"go.woodpecker-ci.org/woodpecker/v3/server/queue"
 )
 
-func createFilterFunc(agentFilter rpc.Filter) queue.FilterFn {
-	return func(task *model.Task) (bool, int) {
+func createFilterFunc(agentFilter rpc.Filter, capabilities model.AgentCapabilities, pools []*agentPool) queue.FilterFn {
+	return func(task *model.Task, running []*model.Task) (bool, int) {
 		// Create a copy of the labels for filtering to avoid modifying the original task
 		labels := maps.Clone(task.Labels)
 
@@ -33,6 +38,14 @@ func createFilterFunc(agentFilter rpc.Filter) queue.FilterFn {
 			return false, 0
 		}
 
+		if !RequirementsMet(task.Requires, capabilities) {
+			return false, 0
+		}
+
+		if !poolsAllow(pools, task, running) {
+			return false, 0
+		}
+
 		// ignore internal labels for filtering
 		for k := range labels {
 			if strings.HasPrefix(k, pipelineConsts.InternalLabelPrefix) {
@@ -84,3 +97,115 @@ func requiredLabelsMissing(taskLabels, agentLabels map[string]string) bool {
 	}
 	return false
 }
+
+// RequirementsMet checks the requires section of a workflow against the capabilities of an agent.
+func RequirementsMet(requires map[string]string, capabilities model.AgentCapabilities) bool {
+	for key, value := range requires {
+		if !requirementMet(key, value, capabilities) {
+			return false
+		}
+	}
+	return true
+}
+
+// requirementMet checks a single requirement against the capabilities of an agent.
+// Capabilities the agent did not report never meet a requirement.
+func requirementMet(key, value string, capabilities model.AgentCapabilities) bool {
+	requirement, err := types.ParseRequirement(key, value)
+	if err != nil {
+		return false
+	}
+
+	switch requirement.Key {
+	case types.RequireCPUs:
+		return capabilities.CPUs != 0 && requirement.MatchSize(int64(capabilities.CPUs))
+	case types.RequireMemory:
+		return capabilities.Memory != 0 && requirement.MatchSize(capabilities.Memory)
+	case types.RequireDisk:
+		return capabilities.DiskFree != 0 && requirement.MatchSize(capabilities.DiskFree)
+	case types.RequireArch:
+		return slices.Contains(capabilities.Architectures, requirement.Value)
+	case types.RequireRuntime:
+		return slices.Contains(capabilities.Runtimes, requirement.Value)
+	}
+	return false
+}
+
+// ExplainMismatch returns why an agent polling with the given labels and capabilities can not take a task,
+// it mirrors the checks of the filter the agent polls with.
+func ExplainMismatch(agentLabels map[string]string, capabilities model.AgentCapabilities, task *model.Task) []string {
+	var reasons []string
+
+	for label, value := range agentLabels {
+		if len(label) > 0 && label[0] == '!' {
+			if taskValue, ok := task.Labels[label[1:]]; !ok || taskValue != value {
+				reasons = append(reasons, fmt.Sprintf("agent only takes workflows with label %s=%s", label[1:], value))
+			}
+		}
+	}
+
+	for label, taskValue := range task.Labels {
+		if taskValue == "" || strings.HasPrefix(label, pipelineConsts.InternalLabelPrefix) {
+			continue
+		}
+
+		agentValue, ok := agentLabels[label]
+		if !ok {
+			agentValue, ok = agentLabels["!"+label]
+		}
+		switch {
+		case !ok:
+			reasons = append(reasons, fmt.Sprintf("agent has no label %s, workflow requires %s=%s", label, label, taskValue))
+		case agentValue == "*" || agentValue == taskValue:
+		case label == pipelineConsts.LabelFilterOrg:
+			reasons = append(reasons, fmt.Sprintf("agent is restricted to organization %s, workflow belongs to organization %s", agentValue, taskValue))
+		default:
+			reasons = append(reasons, fmt.Sprintf("agent has label %s=%s, workflow requires %s=%s", label, agentValue, label, taskValue))
+		}
+	}
+
+	for key, value := range task.Requires {
+		if !requirementMet(key, value, capabilities) {
+			reasons = append(reasons, fmt.Sprintf("workflow requires %s %s, agent has %s", key, value, capabilityString(key, capabilities)))
+		}
+	}
+
+	slices.Sort(reasons)
+	return reasons
+}
+
+// capabilityString formats the capability of an agent a requirement checks.
+func capabilityString(key string, capabilities model.AgentCapabilities) string {
+	var value string
+	switch key {
+	case types.RequireCPUs:
+		value = strconv.Itoa(capabilities.CPUs)
+	case types.RequireMemory:
+		value = sizeString(capabilities.Memory)
+	case types.RequireDisk:
+		value = sizeString(capabilities.DiskFree)
+	case types.RequireArch:
+		value = strings.Join(capabilities.Architectures, ", ")
+	case types.RequireRuntime:
+		value = strings.Join(capabilities.Runtimes, ", ")
+	}
+	if value == "" || value == "0" {
+		return "unknown"
+	}
+	return value
+}
+
+// sizeString formats a size in bytes with binary units like requirements use them.
+func sizeString(size int64) string {
+	const units = "KMGT"
+	if size < 1<<10 {
+		return strconv.FormatInt(size, 10)
+	}
+	value := float64(size)
+	unit := -1
+	for value >= 1<<10 && unit < len(units)-1 {
+		value /= 1 << 10
+		unit++
+	}
+	return strconv.FormatFloat(math.Round(value*10)/10, 'f', -1, 64) + string(units[unit])
+}
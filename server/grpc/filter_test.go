@@ -25,11 +25,12 @@ import (
 
 func TestCreateFilterFunc(t *testing.T) {
 	tests := []struct {
-		name        string
-		agentFilter rpc.Filter
-		task        *model.Task
-		wantMatched bool
-		wantScore   int
+		name         string
+		agentFilter  rpc.Filter
+		capabilities model.AgentCapabilities
+		task         *model.Task
+		wantMatched  bool
+		wantScore    int
 	}{
 		{
 			name: "Two exact matches",
@@ -130,12 +131,50 @@ func TestCreateFilterFunc(t *testing.T) {
 			wantMatched: true,
 			wantScore:   20,
 		},
+		{
+			name: "Requirements met",
+			agentFilter: rpc.Filter{
+				Labels: map[string]string{"platform": "linux"},
+			},
+			capabilities: model.AgentCapabilities{CPUs: 16, Memory: 32 << 30, Architectures: []string{"amd64", "arm64"}},
+			task: &model.Task{
+				Labels:   map[string]string{"platform": "linux"},
+				Requires: map[string]string{"cpus": "8", "memory": ">=16G", "arch": "arm64"},
+			},
+			wantMatched: true,
+			wantScore:   10,
+		},
+		{
+			name: "Requirement not met",
+			agentFilter: rpc.Filter{
+				Labels: map[string]string{"platform": "linux"},
+			},
+			capabilities: model.AgentCapabilities{CPUs: 4, Memory: 32 << 30},
+			task: &model.Task{
+				Labels:   map[string]string{"platform": "linux"},
+				Requires: map[string]string{"cpus": ">=8"},
+			},
+			wantMatched: false,
+			wantScore:   0,
+		},
+		{
+			name: "Requirement of unknown capability",
+			agentFilter: rpc.Filter{
+				Labels: map[string]string{"platform": "linux"},
+			},
+			task: &model.Task{
+				Labels:   map[string]string{"platform": "linux"},
+				Requires: map[string]string{"memory": "<64G"},
+			},
+			wantMatched: false,
+			wantScore:   0,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			filterFunc := createFilterFunc(tt.agentFilter)
-			gotMatched, gotScore := filterFunc(tt.task)
+			filterFunc := createFilterFunc(tt.agentFilter, tt.capabilities, nil)
+			gotMatched, gotScore := filterFunc(tt.task, nil)
 
 			assert.Equal(t, tt.wantMatched, gotMatched, "Matched result")
 			assert.Equal(t, tt.wantScore, gotScore, "Score")
@@ -183,3 +222,41 @@ func TestMissingRequiredLabels(t *testing.T) {
 		}
 	}
 }
+
+func TestExplainMismatch(t *testing.T) {
+	task := &model.Task{
+		Labels: map[string]string{
+			"org-id":                  "2",
+			"platform":                "linux/arm64",
+			"repo":                    "org/repo",
+			"location":                "",
+			"woodpecker-ci.org/label": "ignored",
+		},
+		Requires: map[string]string{"memory": ">=16G", "arch": "arm64"},
+	}
+	agentLabels := map[string]string{
+		"org-id":   "1",
+		"platform": "linux/amd64",
+		"repo":     "*",
+		"!gpu":     "true",
+	}
+	capabilities := model.AgentCapabilities{Memory: 8 << 30, Architectures: []string{"amd64"}}
+
+	assert.Equal(t, []string{
+		"agent has label platform=linux/amd64, workflow requires platform=linux/arm64",
+		"agent is restricted to organization 1, workflow belongs to organization 2",
+		"agent only takes workflows with label gpu=true",
+		"workflow requires arch arm64, agent has amd64",
+		"workflow requires memory >=16G, agent has 8G",
+	}, ExplainMismatch(agentLabels, capabilities, task))
+
+	matched, _ := createFilterFunc(rpc.Filter{Labels: agentLabels}, capabilities, nil)(task, nil)
+	assert.False(t, matched)
+
+	agentLabels = map[string]string{"org-id": "*", "platform": "linux/arm64", "repo": "*"}
+	capabilities = model.AgentCapabilities{Memory: 32 << 30, Architectures: []string{"arm64"}}
+	assert.Empty(t, ExplainMismatch(agentLabels, capabilities, task))
+
+	matched, _ = createFilterFunc(rpc.Filter{Labels: agentLabels}, capabilities, nil)(task, nil)
+	assert.True(t, matched)
+}
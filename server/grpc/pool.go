@@ -0,0 +1,90 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"fmt"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// agentPool is an agent pool together with all of its member agents.
+type agentPool struct {
+	*model.AgentPool
+	members map[int64]bool
+}
+
+// resolveAgentPools returns the pools the agent is a member of.
+func resolveAgentPools(pools []*model.AgentPool, agents []*model.Agent, agent *model.Agent) []*agentPool {
+	var resolved []*agentPool
+	for _, pool := range pools {
+		if !pool.HasAgent(agent.ID, agent.Labels) {
+			continue
+		}
+
+		members := map[int64]bool{agent.ID: true}
+		for _, member := range agents {
+			if pool.HasAgent(member.ID, member.Labels) {
+				members[member.ID] = true
+			}
+		}
+		resolved = append(resolved, &agentPool{AgentPool: pool, members: members})
+	}
+	return resolved
+}
+
+// poolsAllow checks whether one of the pools of an agent lets it run the task.
+// Agents not being member of any pool run tasks of everyone.
+func poolsAllow(pools []*agentPool, task *model.Task, running []*model.Task) bool {
+	return len(pools) == 0 || len(explainPools(pools, task, running)) < len(pools)
+}
+
+// explainPools returns for each pool of an agent why it does not let the agent run the task.
+func explainPools(pools []*agentPool, task *model.Task, running []*model.Task) []string {
+	var reasons []string
+	for _, pool := range pools {
+		consumer, ok := pool.Consumer(task)
+		if !ok {
+			reasons = append(reasons, fmt.Sprintf("agent pool %s does not allow the repository", pool.Name))
+			continue
+		}
+
+		quota := pool.Quota(task)
+		if quota == 0 {
+			continue
+		}
+		count := 0
+		for _, runningTask := range running {
+			if runningConsumer, _ := pool.Consumer(runningTask); pool.members[runningTask.AgentID] && runningConsumer == consumer {
+				count++
+			}
+		}
+		if count >= quota {
+			reasons = append(reasons, fmt.Sprintf("agent pool %s is running %d of %d workflows allowed for %s", pool.Name, count, quota, consumer))
+		}
+	}
+	return reasons
+}
+
+// ExplainPools returns why the pools of an agent do not let it run a task,
+// it mirrors the pool checks of the filter the agent polls with.
+func ExplainPools(pools []*model.AgentPool, agents []*model.Agent, agent *model.Agent, task *model.Task, running []*model.Task) []string {
+	resolved := resolveAgentPools(pools, agents, agent)
+	reasons := explainPools(resolved, task, running)
+	if len(reasons) < len(resolved) {
+		return nil
+	}
+	return reasons
+}
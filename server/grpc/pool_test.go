@@ -0,0 +1,111 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/rpc"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func TestResolveAgentPools(t *testing.T) {
+	pools := []*model.AgentPool{
+		{Name: "security", Agents: []int64{1, 2}},
+		{Name: "burst", LabelSelector: map[string]string{"pool": "burst"}},
+	}
+	agents := []*model.Agent{
+		{ID: 1},
+		{ID: 2, Labels: map[string]string{"pool": "burst"}},
+		{ID: 3, Labels: map[string]string{"pool": "burst", "platform": "linux/amd64"}},
+		{ID: 4, Labels: map[string]string{"pool": "other"}},
+	}
+
+	resolved := resolveAgentPools(pools, agents, agents[1])
+	if assert.Len(t, resolved, 2) {
+		assert.Equal(t, map[int64]bool{1: true, 2: true}, resolved[0].members)
+		assert.Equal(t, map[int64]bool{2: true, 3: true}, resolved[1].members)
+	}
+
+	assert.Len(t, resolveAgentPools(pools, agents, agents[2]), 1)
+	assert.Empty(t, resolveAgentPools(pools, agents, agents[3]))
+}
+
+func TestPoolsAllow(t *testing.T) {
+	// the security org 1 has the pool for itself, orgs 2 and 3 may borrow two agents each
+	pools := []*agentPool{
+		{AgentPool: &model.AgentPool{Name: "security", AllowedOrgs: []int64{1}}, members: map[int64]bool{1: true, 2: true, 3: true}},
+		{AgentPool: &model.AgentPool{Name: "burst", AllowedOrgs: []int64{2, 3}, MaxConcurrent: 2}, members: map[int64]bool{1: true, 2: true, 3: true}},
+	}
+	running := []*model.Task{
+		{ID: "1", OrgID: 2, AgentID: 1},
+		{ID: "2", OrgID: 2, AgentID: 2},
+		{ID: "3", OrgID: 3, AgentID: 3},
+		{ID: "4", OrgID: 3, AgentID: 4},
+	}
+
+	assert.True(t, poolsAllow(nil, &model.Task{OrgID: 4}, running))
+	assert.True(t, poolsAllow(pools, &model.Task{OrgID: 1}, running))
+	assert.False(t, poolsAllow(pools, &model.Task{OrgID: 2}, running))
+	assert.True(t, poolsAllow(pools, &model.Task{OrgID: 3}, running))
+	assert.False(t, poolsAllow(pools, &model.Task{OrgID: 4}, running))
+
+	assert.Equal(t, []string{
+		"agent pool security does not allow the repository",
+		"agent pool burst is running 2 of 2 workflows allowed for org 2",
+	}, explainPools(pools, &model.Task{OrgID: 2}, running))
+
+	// explicitly allowed repos have a quota of their own
+	pools[1].AllowedRepos = []int64{5}
+	assert.True(t, poolsAllow(pools, &model.Task{OrgID: 2, RepoID: 5}, running))
+
+	// the owner of a pool is not limited by the quota of the borrowers
+	owned := []*agentPool{
+		{AgentPool: &model.AgentPool{Name: "security", AllowedOrgs: []int64{1, 2}, MaxConcurrent: 1, OrgQuotas: map[int64]int{1: 0}}, members: map[int64]bool{1: true, 2: true}},
+	}
+	ownerRunning := []*model.Task{{ID: "5", OrgID: 1, AgentID: 1}, {ID: "6", OrgID: 2, AgentID: 2}}
+	assert.True(t, poolsAllow(owned, &model.Task{OrgID: 1}, ownerRunning))
+	assert.Equal(t, []string{
+		"agent pool security is running 1 of 1 workflows allowed for org 2",
+	}, explainPools(owned, &model.Task{OrgID: 2}, ownerRunning))
+
+	filter := createFilterFunc(rpc.Filter{}, model.AgentCapabilities{}, pools)
+	matched, _ := filter(&model.Task{OrgID: 2}, running)
+	assert.False(t, matched)
+	matched, _ = filter(&model.Task{OrgID: 2}, running[2:])
+	assert.True(t, matched)
+}
+
+func TestExplainPools(t *testing.T) {
+	pools := []*model.AgentPool{
+		{Name: "security", Agents: []int64{1}, AllowedOrgs: []int64{1}},
+		{Name: "burst", Agents: []int64{1}, AllowedOrgs: []int64{2}, MaxConcurrent: 1},
+	}
+	agents := []*model.Agent{{ID: 1}, {ID: 2}}
+	running := []*model.Task{{ID: "1", OrgID: 2, AgentID: 1}}
+
+	assert.Empty(t, ExplainPools(pools, agents, agents[0], &model.Task{OrgID: 1}, running))
+	assert.Empty(t, ExplainPools(pools, agents, agents[1], &model.Task{OrgID: 3}, running))
+	assert.Equal(t, []string{
+		"agent pool security does not allow the repository",
+		"agent pool burst does not allow the repository",
+	}, ExplainPools(pools, agents, agents[0], &model.Task{OrgID: 3}, running))
+	assert.Equal(t, []string{
+		"agent pool security does not allow the repository",
+		"agent pool burst is running 1 of 1 workflows allowed for org 2",
+	}, ExplainPools(pools, agents, agents[0], &model.Task{OrgID: 2}, running))
+}
@@ -22,12 +22,15 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"slices"
 	"strconv"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
 	grpcMetadata "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/rpc"
 	"go.woodpecker-ci.org/woodpecker/v3/server"
@@ -50,6 +53,7 @@ type RPC struct {
 	store         store.Store
 	pipelineTime  *prometheus.GaugeVec
 	pipelineCount *prometheus.CounterVec
+	stepStatus    *stepStatusReporter
 }
 
 // Next blocks until it provides the next workflow to execute.
@@ -63,7 +67,7 @@ func (s *RPC) Next(c context.Context, agentFilter rpc.Filter) (*rpc.Workflow, er
 		return nil, err
 	}
 
-	if agent.NoSchedule {
+	if agent.NoSchedule || agent.Draining {
 		time.Sleep(1 * time.Second)
 		return nil, nil
 	}
@@ -78,7 +82,20 @@ func (s *RPC) Next(c context.Context, agentFilter rpc.Filter) (*rpc.Workflow, er
 
 	log.Trace().Msgf("Agent %s[%d] tries to pull task with labels: %v", agent.Name, agent.ID, agentFilter.Labels)
 
-	filterFn := createFilterFunc(agentFilter)
+	// remember the labels to explain why workflows are not picked up
+	if !maps.Equal(agent.Labels, agentFilter.Labels) {
+		agent.Labels = maps.Clone(agentFilter.Labels)
+		if err := s.store.AgentUpdate(agent); err != nil {
+			log.Error().Err(err).Msgf("could not update labels of agent %s[%d]", agent.Name, agent.ID)
+		}
+	}
+
+	pools, err := s.agentPools(agent)
+	if err != nil {
+		return nil, err
+	}
+
+	filterFn := createFilterFunc(agentFilter, agent.Capabilities, pools)
 
 	for {
 		// poll blocks until a task is available or the context is canceled / worker is kicked
@@ -87,6 +104,11 @@ func (s *RPC) Next(c context.Context, agentFilter rpc.Filter) (*rpc.Workflow, er
 			return nil, err
 		}
 
+		// agents which ran the workflow before it was handed out again may not change it anymore
+		if err := s.assignWorkflow(task, agent); err != nil {
+			log.Error().Err(err).Msgf("could not assign workflow task '%s' to agent %s[%d]", task.ID, agent.Name, agent.ID)
+		}
+
 		if task.ShouldRun() {
 			workflow := new(rpc.Workflow)
 			err = json.Unmarshal(task.Data, workflow)
@@ -182,6 +204,9 @@ func (s *RPC) Update(c context.Context, strWorkflowID string, state rpc.StepStat
 	if err := s.checkAgentPermissionByWorkflow(c, agent, strWorkflowID, currentPipeline, repo); err != nil {
 		return err
 	}
+	if err := checkAgentAssignedToWorkflow(agent, workflow); err != nil {
+		return err
+	}
 
 	if err := pipeline.UpdateStepStatus(s.store, step, state); err != nil {
 		log.Error().Err(err).Msg("rpc.update: cannot update step")
@@ -210,6 +235,14 @@ func (s *RPC) Update(c context.Context, strWorkflowID string, state rpc.StepStat
 	}
 	s.pubsub.Publish(message)
 
+	if state.Exited {
+		for _, w := range currentPipeline.Workflows {
+			if w.ID == workflow.ID {
+				s.stepStatus.push(repo, currentPipeline, w)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -231,6 +264,9 @@ func (s *RPC) Init(c context.Context, strWorkflowID string, state rpc.WorkflowSt
 		return err
 	}
 
+	if err := checkAgentAssignedToWorkflow(agent, workflow); err != nil {
+		return err
+	}
 	workflow.AgentID = agent.ID
 
 	currentPipeline, err := s.store.GetPipeline(workflow.PipelineID)
@@ -325,6 +361,9 @@ func (s *RPC) Done(c context.Context, strWorkflowID string, state rpc.WorkflowSt
 	if err := s.checkAgentPermissionByWorkflow(c, agent, strWorkflowID, currentPipeline, repo); err != nil {
 		return err
 	}
+	if err := checkAgentAssignedToWorkflow(agent, workflow); err != nil {
+		return err
+	}
 
 	logger := log.With().
 		Str("repo_id", fmt.Sprint(repo.ID)).
@@ -360,6 +399,9 @@ func (s *RPC) Done(c context.Context, strWorkflowID string, state rpc.WorkflowSt
 	}
 
 	s.updateForgeStatus(c, repo, currentPipeline, workflow)
+	if !model.IsThereRunningStage(currentPipeline.Workflows) {
+		s.reportPipeline(c, repo, currentPipeline)
+	}
 
 	// make sure writes to pubsub are non blocking (https://github.com/woodpecker-ci/woodpecker/blob/c919f32e0b6432a95e1a6d3d0ad662f591adf73f/server/logging/log.go#L9)
 	go func() {
@@ -408,6 +450,17 @@ func (s *RPC) Log(c context.Context, stepUUID string, rpcLogEntries []*rpc.LogEn
 	if err := s.checkAgentPermissionByWorkflow(c, agent, "", currentPipeline, nil); err != nil {
 		return err
 	}
+	workflows, err := s.store.WorkflowGetTree(currentPipeline)
+	if err != nil {
+		return err
+	}
+	for _, workflow := range workflows {
+		if workflow.PID == step.PPID {
+			if err := checkAgentAssignedToWorkflow(agent, workflow); err != nil {
+				return err
+			}
+		}
+	}
 
 	err = s.updateAgentLastWork(agent)
 	if err != nil {
@@ -461,6 +514,18 @@ func (s *RPC) RegisterAgent(ctx context.Context, info rpc.AgentInfo) (int64, err
 	agent.Capacity = int32(info.Capacity)
 	agent.Version = info.Version
 	agent.CustomLabels = info.CustomLabels
+	agent.Capabilities = model.AgentCapabilities{
+		CPUs:          info.Capabilities.CPUs,
+		Memory:        info.Capabilities.Memory,
+		DiskFree:      info.Capabilities.DiskFree,
+		Architectures: info.Capabilities.Architectures,
+		Runtimes:      info.Capabilities.Runtimes,
+	}
+	// a drain only lasts until the agent restarts
+	if !info.Reload {
+		agent.Draining = false
+		agent.DrainDeadline = 0
+	}
 
 	err = s.store.AgentUpdate(agent)
 	if err != nil {
@@ -487,7 +552,7 @@ func (s *RPC) UnregisterAgent(ctx context.Context) error {
 	return err
 }
 
-func (s *RPC) ReportHealth(ctx context.Context, status string) error {
+func (s *RPC) ReportHealth(ctx context.Context, status string, diskFree int64) error {
 	agent, err := s.getAgentFromContext(ctx)
 	if err != nil {
 		return err
@@ -499,10 +564,70 @@ func (s *RPC) ReportHealth(ctx context.Context, status string) error {
 	}
 
 	agent.LastContact = time.Now().Unix()
+	if diskFree != 0 {
+		// keep disk requirements of the workflows scheduled on current values
+		agent.Capabilities.DiskFree = diskFree
+	}
 
 	return s.store.AgentUpdate(agent)
 }
 
+// Drain stops handing out workflows to the agent until it registers again.
+func (s *RPC) Drain(ctx context.Context, deadline int64) error {
+	agent, err := s.getAgentFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Info().Msgf("agent %s[%d] is draining", agent.Name, agent.ID)
+	agent.Draining = true
+	agent.DrainDeadline = deadline
+	if err := s.store.AgentUpdate(agent); err != nil {
+		return err
+	}
+
+	s.queue.KickAgentWorkers(agent.ID)
+	return nil
+}
+
+// ReportWorkflows keeps the workflows an agent still runs attached to it, e.g. after the server restarted,
+// and returns the ones the agent has to cancel as the queue does not know them as running on this agent.
+func (s *RPC) ReportWorkflows(ctx context.Context, workflowIDs []string) ([]string, error) {
+	agent, err := s.getAgentFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	canceled := []string{}
+	for _, workflowID := range workflowIDs {
+		err := s.queue.Extend(ctx, agent.ID, workflowID)
+		switch {
+		case errors.Is(err, queue.ErrNotFound), errors.Is(err, queue.ErrAgentMissMatch):
+			canceled = append(canceled, workflowID)
+		case err != nil:
+			return nil, err
+		}
+	}
+
+	if len(canceled) != 0 {
+		log.Info().Msgf("agent %s[%d] has to cancel workflows %v which are not assigned to it anymore", agent.Name, agent.ID, canceled)
+	}
+	return canceled, nil
+}
+
+// AgentConfig returns the config an admin pushed to the agent.
+func (s *RPC) AgentConfig(ctx context.Context) (*rpc.AgentConfig, error) {
+	agent, err := s.getAgentFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.AgentConfig{
+		Capacity: int(agent.CapacityOverride),
+		Labels:   agent.LabelsOverride,
+	}, nil
+}
+
 func (s *RPC) checkAgentPermissionByWorkflow(_ context.Context, agent *model.Agent, strWorkflowID string, pipeline *model.Pipeline, repo *model.Repo) error {
 	var err error
 	if repo == nil && pipeline == nil {
@@ -541,6 +666,34 @@ func (s *RPC) checkAgentPermissionByWorkflow(_ context.Context, agent *model.Age
 	return errors.New(msg)
 }
 
+// assignWorkflow remembers the agent the workflow of the task was handed out to.
+func (s *RPC) assignWorkflow(task *model.Task, agent *model.Agent) error {
+	workflowID, err := strconv.ParseInt(task.ID, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	workflow, err := s.store.WorkflowLoad(workflowID)
+	if err != nil {
+		return err
+	}
+
+	workflow.AgentID = agent.ID
+	return s.store.WorkflowUpdate(workflow)
+}
+
+// checkAgentAssignedToWorkflow rejects agents changing a workflow which was handed out to another agent,
+// e.g. as the agent did not resume it in time after a restart of the server.
+func checkAgentAssignedToWorkflow(agent *model.Agent, workflow *model.Workflow) error {
+	if workflow.AgentID == 0 || workflow.AgentID == agent.ID {
+		return nil
+	}
+
+	msg := fmt.Sprintf("agent '%d' is not allowed to interact with workflow[%d] assigned to agent '%d'", agent.ID, workflow.ID, workflow.AgentID)
+	log.Warn().Int64("workflowId", workflow.ID).Msg(msg)
+	return status.Error(codes.FailedPrecondition, msg)
+}
+
 func (s *RPC) completeChildrenIfParentCompleted(completedWorkflow *model.Workflow) {
 	for _, c := range completedWorkflow.Children {
 		if c.Running() {
@@ -575,6 +728,48 @@ func (s *RPC) updateForgeStatus(ctx context.Context, repo *model.Repo, pipeline
 	}
 }
 
+// updateForgeStepStatus reports the progress of the steps of the workflow to forges supporting it.
+func (s *RPC) updateForgeStepStatus(ctx context.Context, repo *model.Repo, pipeline *model.Pipeline, workflow *model.Workflow) {
+	_forge, err := server.Config.Services.Manager.ForgeFromRepo(repo)
+	if err != nil {
+		log.Error().Err(err).Msgf("can not get forge for repo '%s'", repo.FullName)
+		return
+	}
+
+	reporter, ok := forge.As[forge.StepStatusReporter](_forge, forge.CapabilityStepStatus)
+	if !ok {
+		return
+	}
+
+	user, err := s.store.GetUser(repo.UserID)
+	if err != nil {
+		log.Error().Err(err).Msgf("cannot get user with id '%d'", repo.UserID)
+		return
+	}
+	forge.Refresh(ctx, _forge, s.store, user)
+
+	if err := reporter.StepStatus(ctx, user, repo, pipeline, workflow); err != nil {
+		log.Error().Err(err).Msgf("error setting step status for %s/%d", repo.FullName, pipeline.Number)
+	}
+}
+
+// reportPipeline comments the summary of the finished pipeline on its pull request.
+func (s *RPC) reportPipeline(ctx context.Context, repo *model.Repo, currentPipeline *model.Pipeline) {
+	user, err := s.store.GetUser(repo.UserID)
+	if err != nil {
+		log.Error().Err(err).Msgf("cannot get user with id '%d'", repo.UserID)
+		return
+	}
+
+	_forge, err := server.Config.Services.Manager.ForgeFromRepo(repo)
+	if err != nil {
+		log.Error().Err(err).Msgf("can not get forge for repo '%s'", repo.FullName)
+		return
+	}
+
+	pipeline.Report(ctx, _forge, repo, user, currentPipeline)
+}
+
 func (s *RPC) notify(repo *model.Repo, pipeline *model.Pipeline) (err error) {
 	message := pubsub.Message{
 		Labels: map[string]string{
@@ -624,6 +819,23 @@ func (s *RPC) getHostnameFromContext(ctx context.Context) (string, error) {
 	return "", errors.New("no hostname in metadata")
 }
 
+// agentPools returns the pools the agent is a member of.
+func (s *RPC) agentPools(agent *model.Agent) ([]*agentPool, error) {
+	pools, err := s.store.AgentPoolList(&model.ListOptions{All: true})
+	if err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(pools, func(pool *model.AgentPool) bool { return pool.HasAgent(agent.ID, agent.Labels) }) {
+		return nil, nil
+	}
+
+	agents, err := s.store.AgentList(&model.ListOptions{All: true})
+	if err != nil {
+		return nil, err
+	}
+	return resolveAgentPools(pools, agents, agent), nil
+}
+
 func (s *RPC) updateAgentLastWork(agent *model.Agent) error {
 	// only update agent.LastWork if not recently updated
 	if time.Unix(agent.LastWork, 0).Add(updateAgentLastWorkDelay).After(time.Now()) {
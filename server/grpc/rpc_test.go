@@ -24,6 +24,8 @@ import (
 
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/rpc"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/queue"
+	queue_mocks "go.woodpecker-ci.org/woodpecker/v3/server/queue/mocks"
 	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
 )
 
@@ -110,6 +112,43 @@ func TestRegisterAgent(t *testing.T) {
 
 		assert.EqualValues(t, 1337, agentID)
 	})
+
+	t.Run("When agent registers again after a reload it should keep draining", func(t *testing.T) {
+		store := store_mocks.NewMockStore(t)
+		storeAgent := &model.Agent{ID: 1337, Name: "hostname", Capacity: 2, Draining: true, DrainDeadline: 42}
+
+		store.On("AgentFind", int64(1337)).Once().Return(storeAgent, nil)
+		store.On("AgentUpdate", storeAgent).Once().Return(nil)
+		grpc := RPC{
+			store: store,
+		}
+		ctx := metadata.NewIncomingContext(
+			t.Context(),
+			metadata.Pairs("hostname", "hostname", "agent_id", "1337"),
+		)
+		_, err := grpc.RegisterAgent(ctx, rpc.AgentInfo{Capacity: 4, Reload: true})
+		assert.NoError(t, err)
+		assert.EqualValues(t, 4, storeAgent.Capacity)
+		assert.True(t, storeAgent.Draining)
+		assert.EqualValues(t, 42, storeAgent.DrainDeadline)
+	})
+}
+
+func TestAgentConfig(t *testing.T) {
+	store := store_mocks.NewMockStore(t)
+	store.On("AgentFind", int64(1337)).Return(&model.Agent{ID: 1337, CapacityOverride: 4, LabelsOverride: map[string]string{"gpu": "true"}}, nil)
+
+	grpc := RPC{
+		store: store,
+	}
+	ctx := metadata.NewIncomingContext(
+		t.Context(),
+		metadata.Pairs("hostname", "hostname", "agent_id", "1337"),
+	)
+
+	config, err := grpc.AgentConfig(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, &rpc.AgentConfig{Capacity: 4, Labels: map[string]string{"gpu": "true"}}, config)
 }
 
 func TestUpdateAgentLastWork(t *testing.T) {
@@ -159,3 +198,72 @@ func TestUpdateAgentLastWork(t *testing.T) {
 		assert.Equal(t, lastWork, agent.LastWork)
 	})
 }
+
+func TestReportWorkflows(t *testing.T) {
+	store := store_mocks.NewMockStore(t)
+	store.On("AgentFind", int64(1337)).Return(&model.Agent{ID: 1337}, nil)
+
+	q := queue_mocks.NewMockQueue(t)
+	q.On("Extend", mock.Anything, int64(1337), "1").Return(nil)
+	q.On("Extend", mock.Anything, int64(1337), "2").Return(queue.ErrNotFound)
+	q.On("Extend", mock.Anything, int64(1337), "3").Return(queue.ErrAgentMissMatch)
+
+	grpc := RPC{
+		store: store,
+		queue: q,
+	}
+	ctx := metadata.NewIncomingContext(
+		t.Context(),
+		metadata.Pairs("hostname", "hostname", "agent_id", "1337"),
+	)
+
+	canceled, err := grpc.ReportWorkflows(ctx, []string{"1", "2", "3"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2", "3"}, canceled)
+}
+
+func TestReportHealthDiskFree(t *testing.T) {
+	store := store_mocks.NewMockStore(t)
+	store.On("AgentFind", int64(1337)).Return(func(int64) (*model.Agent, error) {
+		return &model.Agent{ID: 1337, Capabilities: model.AgentCapabilities{DiskFree: 1024}}, nil
+	})
+	store.On("AgentUpdate", mock.MatchedBy(func(agent *model.Agent) bool {
+		return agent.Capabilities.DiskFree == 2048 && agent.LastContact != 0
+	})).Return(nil).Once()
+	store.On("AgentUpdate", mock.MatchedBy(func(agent *model.Agent) bool {
+		return agent.Capabilities.DiskFree == 1024
+	})).Return(nil).Once()
+
+	grpc := RPC{
+		store: store,
+	}
+	ctx := metadata.NewIncomingContext(
+		t.Context(),
+		metadata.Pairs("hostname", "hostname", "agent_id", "1337"),
+	)
+
+	assert.NoError(t, grpc.ReportHealth(ctx, "I am alive!", 2048))
+	// unknown free disk space keeps the registered value
+	assert.NoError(t, grpc.ReportHealth(ctx, "I am alive!", 0))
+}
+
+func TestWorkflowOfOtherAgent(t *testing.T) {
+	store := store_mocks.NewMockStore(t)
+	store.On("AgentFind", int64(1337)).Return(&model.Agent{ID: 1337, OrgID: model.IDNotSet}, nil)
+	// the workflow was handed out again to another agent
+	store.On("WorkflowLoad", int64(1)).Return(&model.Workflow{ID: 1, PipelineID: 2, AgentID: 42}, nil)
+	store.On("StepListFromWorkflowFind", mock.Anything).Return([]*model.Step{}, nil)
+	store.On("GetPipeline", int64(2)).Return(&model.Pipeline{ID: 2, RepoID: 3}, nil)
+	store.On("GetRepo", int64(3)).Return(&model.Repo{ID: 3}, nil)
+
+	grpc := RPC{
+		store: store,
+	}
+	ctx := metadata.NewIncomingContext(
+		t.Context(),
+		metadata.Pairs("hostname", "hostname", "agent_id", "1337"),
+	)
+
+	assert.ErrorContains(t, grpc.Init(ctx, "1", rpc.WorkflowState{}), "assigned to agent '42'")
+	assert.ErrorContains(t, grpc.Done(ctx, "1", rpc.WorkflowState{Error: "canceled"}), "assigned to agent '42'")
+}
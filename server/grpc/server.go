@@ -37,7 +37,7 @@ type WoodpeckerServer struct {
 	peer RPC
 }
 
-func NewWoodpeckerServer(queue queue.Queue, logger logging.Log, pubsub *pubsub.Publisher, store store.Store) proto.WoodpeckerServer {
+func NewWoodpeckerServer(ctx context.Context, queue queue.Queue, logger logging.Log, pubsub *pubsub.Publisher, store store.Store) proto.WoodpeckerServer {
 	pipelineTime := prometheus_auto.NewGaugeVec(prometheus.GaugeOpts{
 		Namespace: "woodpecker",
 		Name:      "pipeline_time",
@@ -56,6 +56,8 @@ func NewWoodpeckerServer(queue queue.Queue, logger logging.Log, pubsub *pubsub.P
 		pipelineTime:  pipelineTime,
 		pipelineCount: pipelineCount,
 	}
+	peer.stepStatus = newStepStatusReporter(peer.updateForgeStepStatus)
+	go peer.stepStatus.run(ctx)
 	return &WoodpeckerServer{peer: peer}
 }
 
@@ -105,6 +107,18 @@ func (s *WoodpeckerServer) Update(c context.Context, req *proto.UpdateRequest) (
 		Error:    req.GetState().GetError(),
 		ExitCode: int(req.GetState().GetExitCode()),
 	}
+	for _, annotation := range req.GetState().GetAnnotations() {
+		state.Annotations = append(state.Annotations, &rpc.Annotation{
+			Path:        annotation.GetPath(),
+			StartLine:   int(annotation.GetStartLine()),
+			EndLine:     int(annotation.GetEndLine()),
+			StartColumn: int(annotation.GetStartColumn()),
+			EndColumn:   int(annotation.GetEndColumn()),
+			Level:       annotation.GetLevel(),
+			Title:       annotation.GetTitle(),
+			Message:     annotation.GetMessage(),
+		})
+	}
 	res := new(proto.Empty)
 	err := s.peer.Update(c, req.GetId(), state)
 	return res, err
@@ -179,6 +193,14 @@ func (s *WoodpeckerServer) RegisterAgent(c context.Context, req *proto.RegisterA
 		Backend:      agentInfo.GetBackend(),
 		Capacity:     int(agentInfo.GetCapacity()),
 		CustomLabels: agentInfo.GetCustomLabels(),
+		Capabilities: rpc.Capabilities{
+			CPUs:          int(agentInfo.GetCapabilities().GetCpus()),
+			Memory:        agentInfo.GetCapabilities().GetMemory(),
+			DiskFree:      agentInfo.GetCapabilities().GetDiskFree(),
+			Architectures: agentInfo.GetCapabilities().GetArchitectures(),
+			Runtimes:      agentInfo.GetCapabilities().GetRuntimes(),
+		},
+		Reload: agentInfo.GetReload(),
 	})
 	res.AgentId = agentID
 	return res, err
@@ -191,6 +213,30 @@ func (s *WoodpeckerServer) UnregisterAgent(ctx context.Context, _ *proto.Empty)
 
 func (s *WoodpeckerServer) ReportHealth(c context.Context, req *proto.ReportHealthRequest) (*proto.Empty, error) {
 	res := new(proto.Empty)
-	err := s.peer.ReportHealth(c, req.GetStatus())
+	err := s.peer.ReportHealth(c, req.GetStatus(), req.GetDiskFree())
+	return res, err
+}
+
+func (s *WoodpeckerServer) Drain(c context.Context, req *proto.DrainRequest) (*proto.Empty, error) {
+	res := new(proto.Empty)
+	err := s.peer.Drain(c, req.GetDeadline())
 	return res, err
 }
+
+func (s *WoodpeckerServer) ReportWorkflows(c context.Context, req *proto.ReportWorkflowsRequest) (*proto.ReportWorkflowsResponse, error) {
+	res := new(proto.ReportWorkflowsResponse)
+	canceled, err := s.peer.ReportWorkflows(c, req.GetWorkflowIds())
+	res.CanceledWorkflowIds = canceled
+	return res, err
+}
+
+func (s *WoodpeckerServer) AgentConfig(c context.Context, _ *proto.Empty) (*proto.AgentConfigResponse, error) {
+	res := new(proto.AgentConfigResponse)
+	config, err := s.peer.AgentConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	res.Capacity = int32(config.Capacity)
+	res.Labels = config.Labels
+	return res, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"sync"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// stepStatus is the progress of the steps of a workflow to report to the forge.
+type stepStatus struct {
+	repo     *model.Repo
+	pipeline *model.Pipeline
+	workflow *model.Workflow
+}
+
+// stepStatusReporter reports the progress of the steps of workflows to forges in the background,
+// so slow forge APIs do not delay the updates of agents. If a workflow changed multiple times
+// before it was reported, only its latest state is reported.
+type stepStatusReporter struct {
+	sync.Mutex
+	// pending holds the latest state of workflows by their id
+	pending map[int64]stepStatus
+	// order is the order the workflows changed in
+	order []int64
+	// wake signals the worker that there are pending workflows
+	wake   chan struct{}
+	report func(ctx context.Context, repo *model.Repo, pipeline *model.Pipeline, workflow *model.Workflow)
+}
+
+func newStepStatusReporter(report func(ctx context.Context, repo *model.Repo, pipeline *model.Pipeline, workflow *model.Workflow)) *stepStatusReporter {
+	return &stepStatusReporter{
+		pending: make(map[int64]stepStatus),
+		wake:    make(chan struct{}, 1),
+		report:  report,
+	}
+}
+
+// push queues the workflow to be reported, replacing its state if it is still pending.
+func (r *stepStatusReporter) push(repo *model.Repo, pipeline *model.Pipeline, workflow *model.Workflow) {
+	if r == nil {
+		return
+	}
+
+	r.Lock()
+	if _, ok := r.pending[workflow.ID]; !ok {
+		r.order = append(r.order, workflow.ID)
+	}
+	r.pending[workflow.ID] = stepStatus{repo: repo, pipeline: pipeline, workflow: workflow}
+	r.Unlock()
+
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (r *stepStatusReporter) pop() (stepStatus, bool) {
+	r.Lock()
+	defer r.Unlock()
+
+	if len(r.order) == 0 {
+		return stepStatus{}, false
+	}
+	workflowID := r.order[0]
+	r.order = r.order[1:]
+	status := r.pending[workflowID]
+	delete(r.pending, workflowID)
+	return status, true
+}
+
+// run reports the pending workflows until the context is canceled.
+func (r *stepStatusReporter) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.wake:
+		}
+
+		for {
+			status, ok := r.pop()
+			if !ok || ctx.Err() != nil {
+				break
+			}
+			r.report(ctx, status.repo, status.pipeline, status.workflow)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func TestStepStatusReporter(t *testing.T) {
+	reported := make(chan *model.Workflow, 10)
+	r := newStepStatusReporter(func(_ context.Context, _ *model.Repo, _ *model.Pipeline, workflow *model.Workflow) {
+		reported <- workflow
+	})
+
+	// only the latest state of a workflow is reported
+	r.push(&model.Repo{}, &model.Pipeline{}, &model.Workflow{ID: 1, State: model.StatusRunning})
+	r.push(&model.Repo{}, &model.Pipeline{}, &model.Workflow{ID: 2, State: model.StatusRunning})
+	r.push(&model.Repo{}, &model.Pipeline{}, &model.Workflow{ID: 1, State: model.StatusSuccess})
+
+	go r.run(t.Context())
+
+	for _, want := range []*model.Workflow{
+		{ID: 1, State: model.StatusSuccess},
+		{ID: 2, State: model.StatusRunning},
+	} {
+		select {
+		case workflow := <-reported:
+			assert.Equal(t, want, workflow)
+		case <-time.After(time.Second):
+			t.Fatal("workflow was not reported")
+		}
+	}
+
+	select {
+	case workflow := <-reported:
+		t.Fatalf("workflow %d was reported twice", workflow.ID)
+	case <-time.After(10 * time.Millisecond):
+	}
+}
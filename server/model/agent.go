@@ -17,6 +17,7 @@ package model
 import (
 	"encoding/base32"
 	"fmt"
+	"time"
 
 	"github.com/google/tink/go/subtle/random"
 
@@ -24,24 +25,61 @@ import (
 )
 
 type Agent struct {
-	ID           int64             `json:"id"            xorm:"pk autoincr 'id'"`
-	Created      int64             `json:"created"       xorm:"created"`
-	Updated      int64             `json:"updated"       xorm:"updated"`
-	Name         string            `json:"name"          xorm:"name"`
-	OwnerID      int64             `json:"owner_id"      xorm:"'owner_id'"`
-	Token        string            `json:"token"         xorm:"token"`
-	LastContact  int64             `json:"last_contact"  xorm:"last_contact"`
-	LastWork     int64             `json:"last_work"     xorm:"last_work"` // last time the agent did something, this value is used to determine if the agent is still doing work used by the autoscaler
-	Platform     string            `json:"platform"      xorm:"VARCHAR(100) 'platform'"`
-	Backend      string            `json:"backend"       xorm:"VARCHAR(100) 'backend'"`
-	Capacity     int32             `json:"capacity"      xorm:"capacity"`
-	Version      string            `json:"version"       xorm:"'version'"`
-	NoSchedule   bool              `json:"no_schedule"   xorm:"no_schedule"`
-	CustomLabels map[string]string `json:"custom_labels" xorm:"JSON 'custom_labels'"`
+	ID           int64             `json:"id"             xorm:"pk autoincr 'id'"`
+	Created      int64             `json:"created"        xorm:"created"`
+	Updated      int64             `json:"updated"        xorm:"updated"`
+	Name         string            `json:"name"           xorm:"name"`
+	OwnerID      int64             `json:"owner_id"       xorm:"'owner_id'"`
+	Token        string            `json:"token"          xorm:"token"`
+	LastContact  int64             `json:"last_contact"   xorm:"last_contact"`
+	LastWork     int64             `json:"last_work"      xorm:"last_work"` // last time the agent did something, this value is used to determine if the agent is still doing work used by the autoscaler
+	Platform     string            `json:"platform"       xorm:"VARCHAR(100) 'platform'"`
+	Backend      string            `json:"backend"        xorm:"VARCHAR(100) 'backend'"`
+	Capacity     int32             `json:"capacity"       xorm:"capacity"`
+	Version      string            `json:"version"        xorm:"'version'"`
+	NoSchedule   bool              `json:"no_schedule"    xorm:"no_schedule"`
+	CustomLabels map[string]string `json:"custom_labels"  xorm:"JSON 'custom_labels'"`
+	Capabilities AgentCapabilities `json:"capabilities"   xorm:"JSON 'capabilities'"`
+	// Labels the agent last polled for workflows with, including the ones enforced by the server
+	Labels map[string]string `json:"labels" xorm:"JSON 'labels'"`
 	// OrgID is counted as unset if set to -1, this is done to ensure a new(Agent) still enforce the OrgID check by default
-	OrgID int64 `json:"org_id"        xorm:"INDEX 'org_id'"`
+	OrgID int64 `json:"org_id"         xorm:"INDEX 'org_id'"`
+	// Draining agents finish their running workflows but get no new ones until they register again
+	Draining bool `json:"draining"       xorm:"draining"`
+	// DrainDeadline is the time a draining agent cancels its workflows at, 0 if it waits for them
+	DrainDeadline int64 `json:"drain_deadline" xorm:"drain_deadline"`
+	// CapacityOverride and LabelsOverride are pushed to the agent and take precedence over its own capacity and custom labels
+	CapacityOverride int32             `json:"capacity_override" xorm:"capacity_override"`
+	LabelsOverride   map[string]string `json:"labels_override"   xorm:"JSON 'labels_override'"`
+	// Status, RunningTasks and DrainETA are computed from the queue and not stored
+	Status       AgentStatus `json:"status"         xorm:"-"`
+	RunningTasks int         `json:"running_tasks"  xorm:"-"`
+	DrainETA     int64       `json:"drain_eta"      xorm:"-"`
 } //	@name	Agent
 
+// AgentCapabilities are detected by the agent on the machine running its workflows, zero values are unknown.
+type AgentCapabilities struct {
+	CPUs          int      `json:"cpus"`
+	Memory        int64    `json:"memory"`
+	DiskFree      int64    `json:"disk_free"`
+	Architectures []string `json:"architectures"`
+	Runtimes      []string `json:"runtimes"`
+} //	@name	AgentCapabilities
+
+// AgentStatus is the state of an agent.
+type AgentStatus string //	@name	AgentStatus
+
+const (
+	AgentStatusIdle     AgentStatus = "idle"     // connected without running workflows
+	AgentStatusBusy     AgentStatus = "busy"     // running workflows
+	AgentStatusDraining AgentStatus = "draining" // finishing its running workflows without taking new ones
+	AgentStatusDrained  AgentStatus = "drained"  // draining without running workflows
+	AgentStatusOffline  AgentStatus = "offline"  // no contact within AgentOfflineTimeout
+)
+
+// AgentOfflineTimeout is the time without contact after which an agent counts as offline.
+const AgentOfflineTimeout = time.Minute
+
 const (
 	IDNotSet = -1
 )
@@ -72,6 +110,22 @@ func (a *Agent) GetServerLabels() (map[string]string, error) {
 	return filters, nil
 }
 
+// GetStatus returns the status of the agent running the given number of workflows.
+func (a *Agent) GetStatus(runningTasks int, now time.Time) AgentStatus {
+	switch {
+	case runningTasks > 0 && (a.Draining || a.NoSchedule):
+		return AgentStatusDraining
+	case runningTasks > 0:
+		return AgentStatusBusy
+	case now.Sub(time.Unix(a.LastContact, 0)) > AgentOfflineTimeout:
+		return AgentStatusOffline
+	case a.Draining || a.NoSchedule:
+		return AgentStatusDrained
+	default:
+		return AgentStatusIdle
+	}
+}
+
 func (a *Agent) CanAccessRepo(repo *Repo) bool {
 	// global agent
 	if a.OrgID == IDNotSet {
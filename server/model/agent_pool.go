@@ -0,0 +1,156 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var (
+	ErrAgentPoolNameInvalid       = errors.New("pool name is required")
+	ErrAgentPoolMembersInvalid    = errors.New("pool needs member agents or a label selector")
+	ErrAgentPoolMaxRunningInvalid = errors.New("max concurrent workflows can not be negative")
+)
+
+// AgentPool is a named group of agents which only takes workflows of the orgs and repos allowed to use it.
+type AgentPool struct {
+	ID   int64  `json:"id"   xorm:"pk autoincr 'id'"`
+	Name string `json:"name" xorm:"NOT NULL UNIQUE 'name'"`
+	// Agents are the ids of the member agents
+	Agents []int64 `json:"agents" xorm:"JSON 'agents'"`
+	// LabelSelector adds all agents polling with these labels to the pool
+	LabelSelector map[string]string `json:"label_selector" xorm:"JSON 'label_selector'"`
+	// AllowedOrgs and AllowedRepos may run workflows on the pool, everyone may if both are empty
+	AllowedOrgs  []int64 `json:"allowed_orgs"  xorm:"JSON 'allowed_orgs'"`
+	AllowedRepos []int64 `json:"allowed_repos" xorm:"JSON 'allowed_repos'"`
+	// MaxConcurrent limits the workflows each org or repo runs on the pool at the same time, 0 means unlimited
+	MaxConcurrent int `json:"max_concurrent" xorm:"max_concurrent"`
+	// OrgQuotas and RepoQuotas override MaxConcurrent for single consumers, e.g. to not limit the owner of the pool
+	OrgQuotas  map[int64]int `json:"org_quotas"  xorm:"JSON 'org_quotas'"`
+	RepoQuotas map[int64]int `json:"repo_quotas" xorm:"JSON 'repo_quotas'"`
+	Created    int64         `json:"created"     xorm:"created"`
+	Updated    int64         `json:"updated"     xorm:"updated"`
+} //	@name	AgentPool
+
+// TableName return database table name for xorm.
+func (AgentPool) TableName() string {
+	return "agent_pools"
+}
+
+// Validate validates the required fields.
+func (p *AgentPool) Validate() error {
+	if p.Name == "" {
+		return ErrAgentPoolNameInvalid
+	}
+	if len(p.Agents) == 0 && len(p.LabelSelector) == 0 {
+		return ErrAgentPoolMembersInvalid
+	}
+	if p.MaxConcurrent < 0 {
+		return ErrAgentPoolMaxRunningInvalid
+	}
+	for _, quota := range p.OrgQuotas {
+		if quota < 0 {
+			return ErrAgentPoolMaxRunningInvalid
+		}
+	}
+	for _, quota := range p.RepoQuotas {
+		if quota < 0 {
+			return ErrAgentPoolMaxRunningInvalid
+		}
+	}
+	return nil
+}
+
+// Apply applies the patch to the agent pool.
+func (p *AgentPool) Apply(patch *AgentPoolPatch) {
+	if patch.Name != nil {
+		p.Name = *patch.Name
+	}
+	if patch.Agents != nil {
+		p.Agents = *patch.Agents
+	}
+	if patch.LabelSelector != nil {
+		p.LabelSelector = *patch.LabelSelector
+	}
+	if patch.AllowedOrgs != nil {
+		p.AllowedOrgs = *patch.AllowedOrgs
+	}
+	if patch.AllowedRepos != nil {
+		p.AllowedRepos = *patch.AllowedRepos
+	}
+	if patch.MaxConcurrent != nil {
+		p.MaxConcurrent = *patch.MaxConcurrent
+	}
+	if patch.OrgQuotas != nil {
+		p.OrgQuotas = *patch.OrgQuotas
+	}
+	if patch.RepoQuotas != nil {
+		p.RepoQuotas = *patch.RepoQuotas
+	}
+}
+
+// HasAgent returns whether the agent with the given id and labels is a member of the pool.
+func (p *AgentPool) HasAgent(agentID int64, labels map[string]string) bool {
+	if slices.Contains(p.Agents, agentID) {
+		return true
+	}
+	if len(p.LabelSelector) == 0 {
+		return false
+	}
+	for key, value := range p.LabelSelector {
+		if labels[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Consumer returns who uses the pool when running the task and whether it is allowed to.
+// Repos allowed explicitly are consumers of their own, all other repos share the quota of their org.
+func (p *AgentPool) Consumer(task *Task) (string, bool) {
+	switch {
+	case slices.Contains(p.AllowedRepos, task.RepoID):
+		return fmt.Sprintf("repo %d", task.RepoID), true
+	case slices.Contains(p.AllowedOrgs, task.OrgID), len(p.AllowedRepos) == 0 && len(p.AllowedOrgs) == 0:
+		return fmt.Sprintf("org %d", task.OrgID), true
+	}
+	return "", false
+}
+
+// Quota returns how many workflows the consumer of the task may run on the pool at the same time, 0 means unlimited.
+func (p *AgentPool) Quota(task *Task) int {
+	quotas, id := p.OrgQuotas, task.OrgID
+	if slices.Contains(p.AllowedRepos, task.RepoID) {
+		quotas, id = p.RepoQuotas, task.RepoID
+	}
+	if quota, ok := quotas[id]; ok {
+		return quota
+	}
+	return p.MaxConcurrent
+}
+
+// AgentPoolPatch represents an agent pool update.
+type AgentPoolPatch struct {
+	Name          *string            `json:"name,omitempty"`
+	Agents        *[]int64           `json:"agents,omitempty"`
+	LabelSelector *map[string]string `json:"label_selector,omitempty"`
+	AllowedOrgs   *[]int64           `json:"allowed_orgs,omitempty"`
+	AllowedRepos  *[]int64           `json:"allowed_repos,omitempty"`
+	MaxConcurrent *int               `json:"max_concurrent,omitempty"`
+	OrgQuotas     *map[int64]int     `json:"org_quotas,omitempty"`
+	RepoQuotas    *map[int64]int     `json:"repo_quotas,omitempty"`
+} //	@name	AgentPoolPatch
@@ -0,0 +1,75 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAgentPoolValidate(t *testing.T) {
+	assert.ErrorIs(t, (&AgentPool{Agents: []int64{1}}).Validate(), ErrAgentPoolNameInvalid)
+	assert.ErrorIs(t, (&AgentPool{Name: "pool"}).Validate(), ErrAgentPoolMembersInvalid)
+	assert.ErrorIs(t, (&AgentPool{Name: "pool", Agents: []int64{1}, MaxConcurrent: -1}).Validate(), ErrAgentPoolMaxRunningInvalid)
+	assert.ErrorIs(t, (&AgentPool{Name: "pool", Agents: []int64{1}, OrgQuotas: map[int64]int{1: -1}}).Validate(), ErrAgentPoolMaxRunningInvalid)
+	assert.ErrorIs(t, (&AgentPool{Name: "pool", Agents: []int64{1}, RepoQuotas: map[int64]int{1: -1}}).Validate(), ErrAgentPoolMaxRunningInvalid)
+	assert.NoError(t, (&AgentPool{Name: "pool", LabelSelector: map[string]string{"gpu": "true"}}).Validate())
+}
+
+func TestAgentPoolHasAgent(t *testing.T) {
+	pool := &AgentPool{Agents: []int64{1}, LabelSelector: map[string]string{"gpu": "true", "platform": "linux/amd64"}}
+
+	assert.True(t, pool.HasAgent(1, nil))
+	assert.True(t, pool.HasAgent(2, map[string]string{"gpu": "true", "platform": "linux/amd64", "repo": "*"}))
+	assert.False(t, pool.HasAgent(2, map[string]string{"gpu": "true"}))
+	assert.False(t, (&AgentPool{Agents: []int64{1}}).HasAgent(2, nil))
+}
+
+func TestAgentPoolConsumer(t *testing.T) {
+	pool := &AgentPool{AllowedOrgs: []int64{1}, AllowedRepos: []int64{5}}
+
+	consumer, ok := pool.Consumer(&Task{OrgID: 1, RepoID: 2})
+	assert.True(t, ok)
+	assert.Equal(t, "org 1", consumer)
+
+	consumer, ok = pool.Consumer(&Task{OrgID: 3, RepoID: 5})
+	assert.True(t, ok)
+	assert.Equal(t, "repo 5", consumer)
+
+	_, ok = pool.Consumer(&Task{OrgID: 3, RepoID: 6})
+	assert.False(t, ok)
+
+	consumer, ok = (&AgentPool{}).Consumer(&Task{OrgID: 3, RepoID: 6})
+	assert.True(t, ok)
+	assert.Equal(t, "org 3", consumer)
+}
+
+func TestAgentPoolQuota(t *testing.T) {
+	pool := &AgentPool{
+		AllowedOrgs:   []int64{1, 2},
+		AllowedRepos:  []int64{5, 6},
+		MaxConcurrent: 2,
+		OrgQuotas:     map[int64]int{1: 0},
+		RepoQuotas:    map[int64]int{5: 4, 7: 1},
+	}
+
+	assert.Equal(t, 0, pool.Quota(&Task{OrgID: 1, RepoID: 2}))
+	assert.Equal(t, 2, pool.Quota(&Task{OrgID: 2, RepoID: 3}))
+	assert.Equal(t, 4, pool.Quota(&Task{OrgID: 1, RepoID: 5}))
+	assert.Equal(t, 2, pool.Quota(&Task{OrgID: 3, RepoID: 6}))
+	// quotas only apply to repos allowed explicitly, others share the quota of their org
+	assert.Equal(t, 0, pool.Quota(&Task{OrgID: 1, RepoID: 7}))
+}
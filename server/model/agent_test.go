@@ -16,6 +16,7 @@ package model
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -90,3 +91,19 @@ func TestAgent_CanAccessRepo(t *testing.T) {
 		assert.False(t, agent.CanAccessRepo(otherRepo))
 	})
 }
+
+func TestAgent_GetStatus(t *testing.T) {
+	now := time.Unix(10000, 0)
+	online := now.Unix() - 10
+	offline := now.Add(-2 * AgentOfflineTimeout).Unix()
+
+	assert.Equal(t, AgentStatusIdle, (&Agent{LastContact: online}).GetStatus(0, now))
+	assert.Equal(t, AgentStatusBusy, (&Agent{LastContact: online}).GetStatus(2, now))
+	assert.Equal(t, AgentStatusDraining, (&Agent{LastContact: online, Draining: true}).GetStatus(1, now))
+	assert.Equal(t, AgentStatusDraining, (&Agent{LastContact: online, NoSchedule: true}).GetStatus(1, now))
+	assert.Equal(t, AgentStatusDrained, (&Agent{LastContact: online, Draining: true}).GetStatus(0, now))
+	assert.Equal(t, AgentStatusDrained, (&Agent{LastContact: online, NoSchedule: true}).GetStatus(0, now))
+	assert.Equal(t, AgentStatusOffline, (&Agent{LastContact: offline, Draining: true}).GetStatus(0, now))
+	assert.Equal(t, AgentStatusOffline, (&Agent{LastContact: offline}).GetStatus(0, now))
+	assert.Equal(t, AgentStatusOffline, (&Agent{}).GetStatus(0, now))
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+var (
+	ErrConfigTemplateNameInvalid = errors.New("invalid template name")
+	ErrConfigTemplateDataInvalid = errors.New("template data is required")
+
+	configTemplateNamePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+)
+
+// ConfigTemplate is a workflow fragment of an organization which workflows of
+// its repositories can use with include or extends.
+type ConfigTemplate struct {
+	ID      int64  `json:"id"      xorm:"pk autoincr 'id'"`
+	OrgID   int64  `json:"org_id"  xorm:"NOT NULL DEFAULT 0 UNIQUE(s) INDEX 'org_id'"`
+	Name    string `json:"name"    xorm:"NOT NULL UNIQUE(s) 'name'"`
+	Data    string `json:"data"    xorm:"TEXT 'data'"`
+	Created int64  `json:"created" xorm:"created"`
+	Updated int64  `json:"updated" xorm:"updated"`
+} //	@name	ConfigTemplate
+
+// TableName return database table name for xorm.
+func (ConfigTemplate) TableName() string {
+	return "config_templates"
+}
+
+// Validate validates the required fields and formats.
+func (t *ConfigTemplate) Validate() error {
+	if !configTemplateNamePattern.MatchString(t.Name) {
+		return fmt.Errorf("%w: '%s'", ErrConfigTemplateNameInvalid, t.Name)
+	}
+	if len(t.Data) == 0 {
+		return ErrConfigTemplateDataInvalid
+	}
+	return nil
+}
@@ -14,6 +14,8 @@
 
 package model
 
+import "slices"
+
 type ForgeType string
 
 const (
@@ -23,6 +25,7 @@ const (
 	ForgeTypeForgejo             ForgeType = "forgejo"
 	ForgeTypeBitbucket           ForgeType = "bitbucket"
 	ForgeTypeBitbucketDatacenter ForgeType = "bitbucket-dc"
+	ForgeTypeGit                 ForgeType = "git"
 	ForgeTypeAddon               ForgeType = "addon"
 )
 
@@ -53,6 +56,44 @@ func (f *Forge) PublicCopy() *Forge {
 	return forge
 }
 
+// forgeSecretOptions are the additional options holding credentials, they are not exposed by the API.
+var forgeSecretOptions = []string{
+	"app-private-key",
+	"app-webhook-secret",
+	"token",
+	"password",
+	"ssh-key",
+}
+
+// AdminCopy returns a copy of the forge without the credentials stored in the additional options.
+func (f *Forge) AdminCopy() *Forge {
+	forge := *f
+	forge.AdditionalOptions = make(map[string]any, len(f.AdditionalOptions))
+	for key, value := range f.AdditionalOptions {
+		if !slices.Contains(forgeSecretOptions, key) {
+			forge.AdditionalOptions[key] = value
+		}
+	}
+	return &forge
+}
+
+// KeepSecretOptions takes over the credentials of the given forge that are not set in the additional options.
+func (f *Forge) KeepSecretOptions(from *Forge) {
+	for _, key := range forgeSecretOptions {
+		if value, ok := f.AdditionalOptions[key]; ok && value != "" {
+			continue
+		}
+		value, ok := from.AdditionalOptions[key]
+		if !ok {
+			continue
+		}
+		if f.AdditionalOptions == nil {
+			f.AdditionalOptions = make(map[string]any)
+		}
+		f.AdditionalOptions[key] = value
+	}
+}
+
 // ForgeWithOAuthClientSecret allows to update the client secret.
 type ForgeWithOAuthClientSecret struct {
 	Forge
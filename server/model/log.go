@@ -40,3 +40,31 @@ type LogEntry struct {
 func (LogEntry) TableName() string {
 	return "log_entries"
 }
+
+// LogSearchResult is the result of a search in the step logs of a repository.
+type LogSearchResult struct {
+	Matches []*LogMatch `json:"matches"`
+	// Truncated is set if the match limit was reached before all pipelines of the page were searched.
+	Truncated bool `json:"truncated"`
+	// Cursor is the position of the last match if truncated, to continue the search on the same page after it.
+	Cursor string `json:"cursor,omitempty"`
+	// NextPage is the page containing older pipelines or 0 if there are none.
+	NextPage int `json:"next_page"`
+} //	@name	LogSearchResult
+
+// LogMatch is a log line matching a search query.
+type LogMatch struct {
+	PipelineNumber int64           `json:"pipeline_number"`
+	StepID         int64           `json:"step_id"`
+	StepName       string          `json:"step_name"`
+	Line           int             `json:"line"`
+	Time           int64           `json:"time"`
+	Data           string          `json:"data"`
+	Highlights     []LogMatchRange `json:"highlights"`
+} //	@name	LogMatch
+
+// LogMatchRange is the byte range [start, end) of a match in a log line.
+type LogMatchRange struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+} //	@name	LogMatchRange
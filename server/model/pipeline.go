@@ -36,6 +36,7 @@ type Pipeline struct {
 	DeployTo             string                 `json:"deploy_to"               xorm:"deploy"`
 	DeployTask           string                 `json:"deploy_task"             xorm:"deploy_task"`
 	Commit               string                 `json:"commit"                  xorm:"commit"`
+	BaseCommit           string                 `json:"base_commit,omitempty"   xorm:"base_commit"` // commit before a push or base of a pull request
 	Branch               string                 `json:"branch"                  xorm:"branch"`
 	Ref                  string                 `json:"ref"                     xorm:"ref"`
 	Refspec              string                 `json:"refspec"                 xorm:"refspec"`
@@ -55,6 +56,7 @@ type Pipeline struct {
 	PullRequestMilestone string                 `json:"pr_milestone,omitempty"  xorm:"pr_milestone"`
 	IsPrerelease         bool                   `json:"is_prerelease,omitempty" xorm:"is_prerelease"`
 	FromFork             bool                   `json:"from_fork,omitempty"     xorm:"from_fork"`
+	LogsPruned           bool                   `json:"logs_pruned,omitempty"   xorm:"logs_pruned"`
 } //	@name	Pipeline
 
 // TableName return database table name for xorm.
@@ -5,6 +5,10 @@ type QueueTask struct {
 	Task
 	PipelineNumber int64  `json:"pipeline_number"`
 	AgentName      string `json:"agent_name"`
+	// Position is the effective position of a pending task in the queue, 0 if it is blocked.
+	Position int `json:"position,omitempty"`
+	// Reason explains the position of a pending task.
+	Reason string `json:"reason,omitempty"`
 }
 
 // QueueInfo represents the response structure for queue information API.
@@ -20,3 +24,33 @@ type QueueInfo struct {
 	} `json:"stats"`
 	Paused bool `json:"paused"`
 } //	@name	QueueInfo
+
+// QueueTaskExplanation explains why a task of the queue does not run yet.
+type QueueTaskExplanation struct {
+	Task QueueTask `json:"task"`
+	// State is pending, waiting_on_deps or running
+	State string `json:"state"`
+	// Reasons summarize why the task does not run yet
+	Reasons      []string              `json:"reasons"`
+	Dependencies []QueueTaskDependency `json:"dependencies"`
+	Agents       []QueueAgentMatch     `json:"agents"`
+} //	@name	QueueTaskExplanation
+
+// QueueTaskDependency is a dependency of a task and its state in the queue.
+type QueueTaskDependency struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// State is pending, waiting_on_deps, running or the status of the finished dependency
+	State string `json:"state"`
+} //	@name	QueueTaskDependency
+
+// QueueAgentMatch tells whether an agent can take a task of the queue.
+type QueueAgentMatch struct {
+	AgentID   int64       `json:"agent_id"`
+	AgentName string      `json:"agent_name"`
+	Status    AgentStatus `json:"status"`
+	// Matched is set if the labels and capabilities of the agent match the task
+	Matched bool `json:"matched"`
+	// Reasons explain why the agent can not take the task
+	Reasons []string `json:"reasons"`
+} //	@name	QueueAgentMatch
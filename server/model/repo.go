@@ -73,6 +73,10 @@ type Repo struct {
 	CancelPreviousPipelineEvents []WebhookEvent       `json:"cancel_previous_pipeline_events" xorm:"json 'cancel_previous_pipeline_events'"`
 	NetrcTrustedPlugins          []string             `json:"netrc_trusted"                   xorm:"json 'netrc_trusted'"`
 	ConfigExtensionEndpoint      string               `json:"config_extension_endpoint"       xorm:"varchar(500) 'config_extension_endpoint'"`
+	CommitDirectives             *CommitDirectives    `json:"commit_directives"               xorm:"json 'commit_directives'"`
+	QueuePriorities              map[WebhookEvent]int `json:"queue_priorities"                xorm:"json 'queue_priorities'"`
+	// AgentAffinityWait is the time in seconds workflows wait for the agent that last ran them, 0 disables the affinity
+	AgentAffinityWait int64 `json:"agent_affinity_wait" xorm:"agent_affinity_wait"`
 } //	@name	Repo
 
 // TableName return database table name for xorm.
@@ -131,6 +135,23 @@ func (r *Repo) Update(from *Repo) {
 	r.IsSCMPrivate = from.IsSCMPrivate
 }
 
+// GetCommitDirectives returns the commit message directives of the repository or the default ones if none are set.
+func (r *Repo) GetCommitDirectives() CommitDirectives {
+	if r.CommitDirectives == nil {
+		return DefaultCommitDirectives
+	}
+	return *r.CommitDirectives
+}
+
+// GetQueuePriority returns the priority of the tasks of pipelines triggered by the event.
+// Repositories can override the priorities of single events, the other ones use the default priority.
+func (r *Repo) GetQueuePriority(event WebhookEvent) int {
+	if priority, ok := r.QueuePriorities[event]; ok {
+		return priority
+	}
+	return DefaultQueuePriorities[event]
+}
+
 // RepoPatch represents a repository patch object.
 type RepoPatch struct {
 	Config                       *string                    `json:"config_file,omitempty"`
@@ -144,6 +165,9 @@ type RepoPatch struct {
 	NetrcTrusted                 *[]string                  `json:"netrc_trusted"`
 	Trusted                      *TrustedConfigurationPatch `json:"trusted"`
 	ConfigExtensionEndpoint      *string                    `json:"config_extension_endpoint,omitempty"`
+	CommitDirectives             *CommitDirectives          `json:"commit_directives,omitempty"`
+	QueuePriorities              *map[WebhookEvent]int      `json:"queue_priorities,omitempty"`
+	AgentAffinityWait            *int64                     `json:"agent_affinity_wait,omitempty"`
 } //	@name	RepoPatch
 
 type ForgeRemoteID string
@@ -164,6 +188,38 @@ type TrustedConfigurationPatch struct {
 	Security *bool `json:"security"`
 }
 
+// CommitDirectives are the keywords of the directives in commit messages, e.g. `ci skip` for `[ci skip]`.
+// Keywords are matched case-insensitive and ignoring whitespace.
+type CommitDirectives struct {
+	// Skip directives skip the pipeline.
+	Skip []string `json:"skip"`
+	// Only directives restrict the pipeline to the listed workflows, e.g. `[ci only: lint,test]`.
+	Only []string `json:"only"`
+	// Full directives ignore the path filters of the workflows.
+	Full []string `json:"full"`
+} //	@name	CommitDirectives
+
+// DefaultCommitDirectives are used by repositories without own commit directives.
+var DefaultCommitDirectives = CommitDirectives{
+	Skip: []string{"ci skip", "skip ci"},
+	Only: []string{"ci only"},
+	Full: []string{"ci full"},
+}
+
+// DefaultQueuePriorities are the priorities of the tasks by the event of their pipeline.
+var DefaultQueuePriorities = map[WebhookEvent]int{
+	EventDeploy:       3,
+	EventPush:         2,
+	EventTag:          2,
+	EventRelease:      2,
+	EventManual:       2,
+	EventMergeGroup:   2,
+	EventPull:         1,
+	EventPullClosed:   1,
+	EventPullMetadata: 1,
+	EventCron:         0,
+}
+
 // RepoLastPipeline represents a repository with last pipeline execution information.
 type RepoLastPipeline struct {
 	*Repo
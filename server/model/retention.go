@@ -0,0 +1,90 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "errors"
+
+var errRetentionNegative = errors.New("retention values must not be negative")
+
+// RetentionPolicy describes which pipelines and logs of a repository are pruned.
+// A policy with neither OrgID nor RepoID set is the global policy. The most
+// specific policy of a repository is used, policies are not merged.
+//
+// A pipeline is deleted if it is not protected by any of the keep rules:
+// the newest KeepLast pipelines, pipelines created within the last KeepDays days,
+// the newest pipeline of every branch and the newest deployment of every target.
+// The logs of pipelines created more than LogDays days ago are deleted while
+// the pipeline itself is kept.
+type RetentionPolicy struct {
+	ID                   int64 `json:"-"                      xorm:"pk autoincr 'id'"`
+	OrgID                int64 `json:"org_id"                 xorm:"UNIQUE(s) 'org_id'"`
+	RepoID               int64 `json:"repo_id"                xorm:"UNIQUE(s) 'repo_id'"`
+	KeepLast             int64 `json:"keep_last"              xorm:"keep_last"`
+	KeepDays             int64 `json:"keep_days"              xorm:"keep_days"`
+	KeepLatestPerBranch  bool  `json:"keep_latest_per_branch" xorm:"keep_latest_per_branch"`
+	KeepLatestDeployment bool  `json:"keep_latest_deployment" xorm:"keep_latest_deployment"`
+	LogDays              int64 `json:"log_days"               xorm:"log_days"`
+} //	@name	RetentionPolicy
+
+// TableName returns the database table name for xorm.
+func (RetentionPolicy) TableName() string {
+	return "retention_policies"
+}
+
+// PrunesPipelines returns true if the policy deletes pipelines at all.
+func (p *RetentionPolicy) PrunesPipelines() bool {
+	return p.KeepLast > 0 || p.KeepDays > 0
+}
+
+// IsEmpty returns true if the policy neither deletes pipelines nor logs.
+func (p *RetentionPolicy) IsEmpty() bool {
+	return !p.PrunesPipelines() && p.LogDays <= 0
+}
+
+// Validate validates the retention policy values.
+func (p *RetentionPolicy) Validate() error {
+	if p.KeepLast < 0 || p.KeepDays < 0 || p.LogDays < 0 {
+		return errRetentionNegative
+	}
+	return nil
+}
+
+// Apply applies the patch to the retention policy.
+func (p *RetentionPolicy) Apply(patch *RetentionPolicyPatch) {
+	if patch.KeepLast != nil {
+		p.KeepLast = *patch.KeepLast
+	}
+	if patch.KeepDays != nil {
+		p.KeepDays = *patch.KeepDays
+	}
+	if patch.KeepLatestPerBranch != nil {
+		p.KeepLatestPerBranch = *patch.KeepLatestPerBranch
+	}
+	if patch.KeepLatestDeployment != nil {
+		p.KeepLatestDeployment = *patch.KeepLatestDeployment
+	}
+	if patch.LogDays != nil {
+		p.LogDays = *patch.LogDays
+	}
+}
+
+// RetentionPolicyPatch represents a retention policy update.
+type RetentionPolicyPatch struct {
+	KeepLast             *int64 `json:"keep_last,omitempty"`
+	KeepDays             *int64 `json:"keep_days,omitempty"`
+	KeepLatestPerBranch  *bool  `json:"keep_latest_per_branch,omitempty"`
+	KeepLatestDeployment *bool  `json:"keep_latest_deployment,omitempty"`
+	LogDays              *int64 `json:"log_days,omitempty"`
+} //	@name	RetentionPolicyPatch
@@ -0,0 +1,100 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// StatsFilter selects the pipelines statistics are computed from.
+type StatsFilter struct {
+	RepoIDs []int64
+	After   int64
+	Before  int64
+	// Interval is the length of a timeline bucket in seconds.
+	Interval int64
+}
+
+// StatsAggregates holds the pipelines and steps counted by the store, grouped by
+// the keys statistics are computed from.
+type StatsAggregates struct {
+	// Timeline counts the pipelines per timeline bucket and status.
+	Timeline []*StatsCount
+	// Branches counts the pipelines per branch and status.
+	Branches []*StatsCount
+	// Steps counts the steps per name and status.
+	Steps []*StatsCount
+	// FlakySteps counts the commits a step both failed and succeeded on per step name.
+	FlakySteps []*StatsCount
+	// Durations counts the pipelines per duration.
+	Durations []*StatsCount
+	// QueueWaits counts the pipelines per time waited in the queue.
+	QueueWaits []*StatsCount
+	// StepDurations counts the steps per name and duration.
+	StepDurations []*StatsCount
+}
+
+// StatsCount is the number of pipelines or steps with the same group key.
+type StatsCount struct {
+	Name   string      `xorm:"name"`
+	Bucket int64       `xorm:"bucket"`
+	Status StatusValue `xorm:"status"`
+	Value  int64       `xorm:"value"`
+	Count  int         `xorm:"total"`
+}
+
+// Stats represents aggregated pipeline statistics of one or more repositories.
+type Stats struct {
+	After       int64          `json:"after"`
+	Before      int64          `json:"before"`
+	Pipelines   int            `json:"pipelines"`
+	Success     int            `json:"success"`
+	Failure     int            `json:"failure"`
+	SuccessRate float64        `json:"success_rate"`
+	Duration    StatsDuration  `json:"duration"`
+	QueueWait   StatsDuration  `json:"queue_wait"`
+	Steps       []*StepStats   `json:"steps"`
+	FlakySteps  []*StepStats   `json:"flaky_steps"`
+	Branches    []*BranchStats `json:"branches"`
+	Timeline    []*StatsBucket `json:"timeline"`
+} //	@name	Stats
+
+// StatsDuration holds percentiles of durations in seconds.
+type StatsDuration struct {
+	P50 int64 `json:"p50"`
+	P95 int64 `json:"p95"`
+} //	@name	StatsDuration
+
+// StepStats represents the statistics of all steps with the same name.
+type StepStats struct {
+	Name        string        `json:"name"`
+	Runs        int           `json:"runs"`
+	Failures    int           `json:"failures"`
+	SuccessRate float64       `json:"success_rate"`
+	Duration    StatsDuration `json:"duration"`
+	// Flaky is the number of commits the step both failed and succeeded on.
+	Flaky int `json:"flaky"`
+} //	@name	StepStats
+
+// BranchStats represents the statistics of all pipelines of a branch.
+type BranchStats struct {
+	Branch      string  `json:"branch"`
+	Pipelines   int     `json:"pipelines"`
+	SuccessRate float64 `json:"success_rate"`
+} //	@name	BranchStats
+
+// StatsBucket represents the pipelines created in one interval of the timeline.
+type StatsBucket struct {
+	Start     int64 `json:"start"`
+	Pipelines int   `json:"pipelines"`
+	Success   int   `json:"success"`
+	Failure   int   `json:"failure"`
+} //	@name	StatsBucket
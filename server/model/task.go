@@ -31,10 +31,16 @@ type Task struct {
 	Labels       map[string]string      `json:"labels"       xorm:"json 'labels'"`
 	Dependencies []string               `json:"dependencies" xorm:"json 'dependencies'"`
 	RunOn        []string               `json:"run_on"       xorm:"json 'run_on'"`
+	Requires     map[string]string      `json:"requires"     xorm:"json 'requires'"`
 	DepStatus    map[string]StatusValue `json:"dep_status"   xorm:"json 'dependencies_status'"`
 	AgentID      int64                  `json:"agent_id"     xorm:"'agent_id'"`
 	PipelineID   int64                  `json:"pipeline_id"  xorm:"'pipeline_id'"`
 	RepoID       int64                  `json:"repo_id"      xorm:"'repo_id'"`
+	OrgID        int64                  `json:"org_id"       xorm:"'org_id'"`
+	Priority     int                    `json:"priority"     xorm:"'priority'"`
+	Queued       int64                  `json:"queued"       xorm:"'queued'"`
+	// AffinityWait is the time in seconds the task waits for the agent that last ran its workflow
+	AffinityWait int64 `json:"affinity_wait" xorm:"'affinity_wait'"`
 } //	@name	Task
 
 // TableName return database table name for xorm.
@@ -29,6 +29,7 @@ type Workflow struct {
 	Platform   string            `json:"platform,omitempty"   xorm:"platform"`
 	Environ    map[string]string `json:"environ,omitempty"    xorm:"json 'environ'"`
 	AxisID     int               `json:"-"                    xorm:"axis_id"`
+	Report     *WorkflowReport   `json:"-"                    xorm:"json 'report'"`
 	Children   []*Step           `json:"children,omitempty"   xorm:"-"`
 }
 
@@ -37,6 +38,14 @@ func (Workflow) TableName() string {
 	return "workflows"
 }
 
+// WorkflowReport defines the summary of the workflow posted on pull requests.
+type WorkflowReport struct {
+	// Comment enables the pull request comment.
+	Comment bool `json:"comment"`
+	// Logs are the names of the steps whose last log lines are added to the comment.
+	Logs []string `json:"logs,omitempty"`
+}
+
 // Running returns true if the process state is pending or running.
 func (p *Workflow) Running() bool {
 	return p.State == StatusPending || p.State == StatusRunning
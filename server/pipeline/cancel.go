@@ -96,6 +96,7 @@ func Cancel(ctx context.Context, _forge forge.Forge, store store.Store, repo *mo
 		return err
 	}
 	publishToTopic(killedPipeline, repo)
+	Report(ctx, _forge, repo, user, killedPipeline)
 
 	return nil
 }
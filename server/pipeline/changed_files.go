@@ -0,0 +1,99 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// loadChangedFiles completes the changed files of push, pull request and merge group pipelines
+// by comparing the commits with the forge if the webhook payload did not contain them.
+func loadChangedFiles(ctx context.Context, _forge forge.Forge, user *model.User, repo *model.Repo, pipeline *model.Pipeline) {
+	if len(pipeline.ChangedFiles) > 0 || (pipeline.Event != model.EventPush && pipeline.Event != model.EventMergeGroup && !pipeline.IsPullRequest()) {
+		return
+	}
+
+	lister, ok := forge.As[forge.ChangedFilesLister](_forge, forge.CapabilityChangedFiles)
+	if !ok {
+		return
+	}
+
+	base := changedFilesBase(repo, pipeline)
+	if base == "" || base == pipeline.Commit {
+		return
+	}
+
+	files, err := lister.ChangedFiles(ctx, user, repo, base, pipeline.Commit)
+	if err != nil {
+		log.Warn().Err(err).Str("repo", repo.FullName).Msgf("could not compare commit %s to %s", pipeline.Commit, base)
+		return
+	}
+	pipeline.ChangedFiles = files
+}
+
+// changedFilesBase returns the commit or branch the changes of the pipeline are compared to.
+func changedFilesBase(repo *model.Repo, pipeline *model.Pipeline) string {
+	if pipeline.BaseCommit != "" && strings.Trim(pipeline.BaseCommit, "0") != "" {
+		return pipeline.BaseCommit
+	}
+
+	if pipeline.IsPullRequest() {
+		// the refspec of pull requests is <source>:<target>
+		if _, target, ok := strings.Cut(pipeline.Refspec, ":"); ok {
+			return target
+		}
+		return ""
+	}
+
+	// new branches are compared to the default branch
+	if pipeline.Branch != repo.Branch {
+		return repo.Branch
+	}
+	return ""
+}
+
+// compareChangedFiles returns a function listing the files changed by the pipeline
+// compared to a branch. The results are cached as all workflows of a pipeline
+// compare the same commit.
+func compareChangedFiles(ctx context.Context, _forge forge.Forge, user *model.User, repo *model.Repo, pipeline *model.Pipeline) func(string) ([]string, error) {
+	lister, ok := forge.As[forge.ChangedFilesLister](_forge, forge.CapabilityChangedFiles)
+	if !ok {
+		return nil
+	}
+
+	var mu sync.Mutex
+	cache := make(map[string][]string)
+	return func(branch string) ([]string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if files, ok := cache[branch]; ok {
+			return files, nil
+		}
+		files, err := lister.ChangedFiles(ctx, user, repo, branch, pipeline.Commit)
+		if err != nil {
+			return nil, err
+		}
+		cache[branch] = files
+		return files, nil
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	forge_mocks "go.woodpecker-ci.org/woodpecker/v3/server/forge/mocks"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+type changedFilesForge struct {
+	*forge_mocks.MockForge
+	calls []string
+}
+
+func (f *changedFilesForge) ChangedFiles(_ context.Context, _ *model.User, _ *model.Repo, base, head string) ([]string, error) {
+	f.calls = append(f.calls, base+"..."+head)
+	return []string{base + ".txt"}, nil
+}
+
+func TestLoadChangedFiles(t *testing.T) {
+	repo := &model.Repo{FullName: "octocat/hello-world", Branch: "main"}
+
+	tests := []struct {
+		name     string
+		pipeline *model.Pipeline
+		calls    []string
+		files    []string
+	}{
+		{
+			name:     "files of payload are kept",
+			pipeline: &model.Pipeline{Event: model.EventPush, Commit: "b", BaseCommit: "a", ChangedFiles: []string{"README.md"}},
+			files:    []string{"README.md"},
+		},
+		{
+			name:     "push",
+			pipeline: &model.Pipeline{Event: model.EventPush, Branch: "main", Commit: "b", BaseCommit: "a"},
+			calls:    []string{"a...b"},
+			files:    []string{"a.txt"},
+		},
+		{
+			name:     "new branch",
+			pipeline: &model.Pipeline{Event: model.EventPush, Branch: "feature", Commit: "b", BaseCommit: "0000000000000000000000000000000000000000"},
+			calls:    []string{"main...b"},
+			files:    []string{"main.txt"},
+		},
+		{
+			name:     "pull request",
+			pipeline: &model.Pipeline{Event: model.EventPull, Commit: "b", Refspec: "feature:develop"},
+			calls:    []string{"develop...b"},
+			files:    []string{"develop.txt"},
+		},
+		{
+			name:     "tag",
+			pipeline: &model.Pipeline{Event: model.EventTag, Commit: "b", BaseCommit: "a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			forge := &changedFilesForge{MockForge: forge_mocks.NewMockForge(t)}
+			loadChangedFiles(t.Context(), forge, &model.User{}, repo, tt.pipeline)
+			assert.Equal(t, tt.calls, forge.calls)
+			assert.Equal(t, tt.files, tt.pipeline.ChangedFiles)
+		})
+	}
+}
+
+func TestCompareChangedFiles(t *testing.T) {
+	repo := &model.Repo{FullName: "octocat/hello-world"}
+	pipeline := &model.Pipeline{Commit: "b"}
+
+	assert.Nil(t, compareChangedFiles(t.Context(), forge_mocks.NewMockForge(t), &model.User{}, repo, pipeline))
+
+	forge := &changedFilesForge{MockForge: forge_mocks.NewMockForge(t)}
+	compare := compareChangedFiles(t.Context(), forge, &model.User{}, repo, pipeline)
+	for range 2 {
+		files, err := compare("main")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"main.txt"}, files)
+	}
+	assert.Equal(t, []string{"main...b"}, forge.calls)
+}
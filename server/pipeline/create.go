@@ -19,6 +19,8 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/rs/zerolog/log"
 
@@ -30,7 +32,13 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server/store"
 )
 
-var skipPipelineRegex = regexp.MustCompile(`\[(?i:ci *skip|skip *ci)\]`)
+// commitDirectiveRegex matches directives like `[ci skip]` or `[ci only: lint,test]` in commit messages.
+var commitDirectiveRegex = regexp.MustCompile(`\[([^\[\]:]+)(?::([^\[\]]*))?\]`)
+
+const (
+	eventReasonDirectiveOnly = "directive_only"
+	eventReasonDirectiveFull = "directive_full"
+)
 
 // Create a new pipeline and start it.
 func Create(ctx context.Context, _store store.Store, repo *model.Repo, pipeline *model.Pipeline) (*model.Pipeline, error) {
@@ -41,16 +49,20 @@ func Create(ctx context.Context, _store store.Store, repo *model.Repo, pipeline
 		return nil, errors.New(msg)
 	}
 
-	if pipeline.Event == model.EventPush || pipeline.IsPullRequest() {
-		skipMatch := skipPipelineRegex.FindString(pipeline.Message)
-		if len(skipMatch) > 0 {
-			ref := pipeline.Commit
-			if len(ref) == 0 {
-				ref = pipeline.Ref
-			}
-			log.Debug().Str("repo", repo.FullName).Msgf("ignoring pipeline as skip-ci was found in the commit (%s) message '%s'", ref, pipeline.Message)
-			return nil, ErrFiltered
+	directives := parseCommitDirectives(repo, pipeline)
+	if directives.skip {
+		ref := pipeline.Commit
+		if len(ref) == 0 {
+			ref = pipeline.Ref
 		}
+		log.Debug().Str("repo", repo.FullName).Msgf("ignoring pipeline as skip-ci was found in the commit (%s) message '%s'", ref, pipeline.Message)
+		return nil, ErrFiltered
+	}
+	if len(directives.workflows) > 0 {
+		pipeline.EventReason = append(pipeline.EventReason, eventReasonDirectiveOnly)
+	}
+	if directives.full {
+		pipeline.EventReason = append(pipeline.EventReason, eventReasonDirectiveFull)
 	}
 
 	_forge, err := server.Config.Services.Manager.ForgeFromRepo(repo)
@@ -65,6 +77,9 @@ func Create(ctx context.Context, _store store.Store, repo *model.Repo, pipeline
 	// the pipeline.
 	forge.Refresh(ctx, _forge, _store, repoUser)
 
+	// webhook payloads of some forges miss changed files, e.g. for force-pushes
+	loadChangedFiles(ctx, _forge, repoUser, repo, pipeline)
+
 	// update some pipeline fields
 	pipeline.RepoID = repo.ID
 	pipeline.Status = model.StatusCreated
@@ -91,7 +106,7 @@ func Create(ctx context.Context, _store store.Store, repo *model.Repo, pipeline
 		return nil, updatePipelineWithErr(ctx, _forge, _store, pipeline, repo, repoUser, fmt.Errorf("could not load config from forge: %w", configFetchErr))
 	}
 
-	pipelineItems, parseErr := parsePipeline(_forge, _store, pipeline, repoUser, repo, forgeYamlConfigs, nil)
+	pipelineItems, parseErr := parsePipeline(ctx, _forge, _store, pipeline, repoUser, repo, forgeYamlConfigs, nil)
 	if pipeline_errors.HasBlockingErrors(parseErr) {
 		log.Debug().Str("repo", repo.FullName).Err(parseErr).Msg("failed to parse yaml")
 		return pipeline, updatePipelineWithErr(ctx, _forge, _store, pipeline, repo, repoUser, parseErr)
@@ -151,6 +166,55 @@ func Create(ctx context.Context, _store store.Store, repo *model.Repo, pipeline
 	return pipeline, nil
 }
 
+// commitDirectives are the directives found in the commit message of a pipeline.
+type commitDirectives struct {
+	// skip the pipeline
+	skip bool
+	// workflows the pipeline is restricted to
+	workflows []string
+	// full ignores the path filters
+	full bool
+}
+
+// parseCommitDirectives parses the directives configured for the repo from the commit message
+// of push and pull request pipelines.
+func parseCommitDirectives(repo *model.Repo, pipeline *model.Pipeline) commitDirectives {
+	var directives commitDirectives
+	if pipeline.Event != model.EventPush && !pipeline.IsPullRequest() {
+		return directives
+	}
+
+	keywords := repo.GetCommitDirectives()
+	for _, match := range commitDirectiveRegex.FindAllStringSubmatch(pipeline.Message, -1) {
+		keyword := normalizeDirective(match[1])
+		switch {
+		case containsDirective(keywords.Skip, keyword):
+			directives.skip = true
+		case containsDirective(keywords.Only, keyword):
+			for name := range strings.SplitSeq(match[2], ",") {
+				if name = strings.TrimSpace(name); name != "" && !slices.Contains(directives.workflows, name) {
+					directives.workflows = append(directives.workflows, name)
+				}
+			}
+		case containsDirective(keywords.Full, keyword):
+			directives.full = true
+		}
+	}
+
+	return directives
+}
+
+func containsDirective(keywords []string, keyword string) bool {
+	return slices.ContainsFunc(keywords, func(k string) bool {
+		return normalizeDirective(k) == keyword
+	})
+}
+
+// normalizeDirective lowercases the keyword and removes all whitespace so `[CI Skip]` matches `ciskip`.
+func normalizeDirective(keyword string) string {
+	return strings.ToLower(strings.Join(strings.Fields(keyword), ""))
+}
+
 func updatePipelineWithErr(ctx context.Context, _forge forge.Forge, _store store.Store, pipeline *model.Pipeline, repo *model.Repo, repoUser *model.User, err error) error {
 	_pipeline, err := UpdateToStatusError(_store, *pipeline, err)
 	if err != nil {
@@ -0,0 +1,95 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func TestParseCommitDirectives(t *testing.T) {
+	t.Parallel()
+
+	custom := &model.Repo{CommitDirectives: &model.CommitDirectives{
+		Skip: []string{"no build"},
+		Only: []string{"run"},
+	}}
+
+	testCases := []struct {
+		name     string
+		repo     *model.Repo
+		pipeline *model.Pipeline
+		want     commitDirectives
+	}{
+		{
+			name:     "no directive",
+			repo:     &model.Repo{},
+			pipeline: &model.Pipeline{Event: model.EventPush, Message: "fix [typo] in readme"},
+			want:     commitDirectives{},
+		},
+		{
+			name:     "default skip",
+			repo:     &model.Repo{},
+			pipeline: &model.Pipeline{Event: model.EventPush, Message: "update docs [CI SKIP]"},
+			want:     commitDirectives{skip: true},
+		},
+		{
+			name:     "default skip without whitespace",
+			repo:     &model.Repo{},
+			pipeline: &model.Pipeline{Event: model.EventPull, Message: "update docs [skipci]"},
+			want:     commitDirectives{skip: true},
+		},
+		{
+			name:     "only and full",
+			repo:     &model.Repo{},
+			pipeline: &model.Pipeline{Event: model.EventPush, Message: "refactor\n\n[ci only: lint, test] [ci only: test,build] [ci full]"},
+			want:     commitDirectives{workflows: []string{"lint", "test", "build"}, full: true},
+		},
+		{
+			name:     "only without workflows",
+			repo:     &model.Repo{},
+			pipeline: &model.Pipeline{Event: model.EventPush, Message: "[ci only:]"},
+			want:     commitDirectives{},
+		},
+		{
+			name:     "ignored for tags",
+			repo:     &model.Repo{},
+			pipeline: &model.Pipeline{Event: model.EventTag, Message: "[ci skip]"},
+			want:     commitDirectives{},
+		},
+		{
+			name:     "custom directives",
+			repo:     custom,
+			pipeline: &model.Pipeline{Event: model.EventPush, Message: "[ci skip] [run: lint]"},
+			want:     commitDirectives{workflows: []string{"lint"}},
+		},
+		{
+			name:     "custom skip",
+			repo:     custom,
+			pipeline: &model.Pipeline{Event: model.EventPush, Message: "[No Build]"},
+			want:     commitDirectives{skip: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, parseCommitDirectives(tc.repo, tc.pipeline))
+		})
+	}
+}
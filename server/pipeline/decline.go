@@ -65,6 +65,7 @@ func Decline(ctx context.Context, store store.Store, pipeline *model.Pipeline, u
 	updatePipelineStatus(ctx, forge, pipeline, repo, user)
 
 	publishToTopic(pipeline, repo)
+	Report(ctx, forge, repo, user, pipeline)
 
 	return pipeline, nil
 }
@@ -25,6 +25,7 @@ import (
 	pipeline_errors "go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
 	pipeline_metadata "go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/metadata"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/compiler"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/matrix"
 	"go.woodpecker-ci.org/woodpecker/v3/server"
 	"go.woodpecker-ci.org/woodpecker/v3/server/forge"
 	forge_types "go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
@@ -33,7 +34,7 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server/store"
 )
 
-func parsePipeline(forge forge.Forge, store store.Store, currentPipeline *model.Pipeline, user *model.User, repo *model.Repo, yamls []*forge_types.FileMeta, envs map[string]string) ([]*stepbuilder.Item, error) {
+func parsePipeline(ctx context.Context, forge forge.Forge, store store.Store, currentPipeline *model.Pipeline, user *model.User, repo *model.Repo, yamls []*forge_types.FileMeta, envs map[string]string) ([]*stepbuilder.Item, error) {
 	netrc, err := forge.Netrc(user, repo)
 	if err != nil {
 		log.Error().Err(err).Msg("failed to generate netrc file")
@@ -47,12 +48,17 @@ func parsePipeline(forge forge.Forge, store store.Store, currentPipeline *model.
 
 	secretService := server.Config.Services.Manager.SecretServiceFromRepo(repo)
 	secs, err := secretService.SecretListPipeline(repo, currentPipeline)
+	var secretNames []string
 	if err != nil {
 		log.Error().Err(err).Msgf("error getting secrets for %s#%d", repo.FullName, currentPipeline.Number)
+	} else {
+		secretNames = make([]string, 0, len(secs))
 	}
 
 	var secrets []compiler.Secret
 	for _, sec := range secs {
+		secretNames = append(secretNames, sec.Name)
+
 		var events []string
 		for _, event := range sec.Events {
 			events = append(events, string(event))
@@ -95,6 +101,8 @@ func parsePipeline(forge forge.Forge, store store.Store, currentPipeline *model.
 
 	maps.Copy(envs, currentPipeline.AdditionalVariables)
 
+	directives := parseCommitDirectives(repo, currentPipeline)
+
 	b := stepbuilder.StepBuilder{
 		Repo:                repo,
 		Curr:                currentPipeline,
@@ -105,12 +113,22 @@ func parsePipeline(forge forge.Forge, store store.Store, currentPipeline *model.
 		Forge:               forge,
 		TrustedClonePlugins: append(repo.NetrcTrustedPlugins, server.Config.Pipeline.TrustedClonePlugins...),
 		PrivilegedPlugins:   server.Config.Pipeline.PrivilegedPlugins,
+		Secrets:             secretNames,
 		RepoTrusted: &pipeline_metadata.TrustedConfiguration{
 			Network:  repo.Trusted.Network,
 			Volumes:  repo.Trusted.Volumes,
 			Security: repo.Trusted.Security,
 		},
-		DefaultLabels: server.Config.Pipeline.DefaultWorkflowLabels,
+		DefaultLabels:       server.Config.Pipeline.DefaultWorkflowLabels,
+		CompareChangedFiles: compareChangedFiles(ctx, forge, user, repo, currentPipeline),
+		Workflows:           directives.workflows,
+		IgnorePathFilters:   directives.full,
+		MatrixOptions: []matrix.Option{
+			matrix.WithLimitCombinations(server.Config.Pipeline.MaxMatrixCombinations),
+			matrix.WithLoader(func(path string) ([]byte, error) {
+				return forge.File(ctx, user, repo, currentPipeline, path)
+			}),
+		},
 		CompilerOptions: []compiler.Option{
 			compiler.WithLocal(false),
 			compiler.WithRegistry(registries...),
@@ -141,7 +159,7 @@ func createPipelineItems(c context.Context, forge forge.Forge, store store.Store
 	currentPipeline *model.Pipeline, user *model.User, repo *model.Repo,
 	yamls []*forge_types.FileMeta, envs map[string]string,
 ) (*model.Pipeline, []*stepbuilder.Item, error) {
-	pipelineItems, err := parsePipeline(forge, store, currentPipeline, user, repo, yamls, envs)
+	pipelineItems, err := parsePipeline(c, forge, store, currentPipeline, user, repo, yamls, envs)
 	if pipeline_errors.HasBlockingErrors(err) {
 		currentPipeline, uErr := UpdateToStatusError(store, *currentPipeline, err)
 		if uErr != nil {
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"time"
 
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/rpc"
 	"go.woodpecker-ci.org/woodpecker/v3/server"
@@ -26,19 +27,23 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server/pipeline/stepbuilder"
 )
 
-func queuePipeline(ctx context.Context, repo *model.Repo, pipelineItems []*stepbuilder.Item) error {
+func queuePipeline(ctx context.Context, repo *model.Repo, activePipeline *model.Pipeline, pipelineItems []*stepbuilder.Item) error {
 	var tasks []*model.Task
 	for _, item := range pipelineItems {
 		if item.Workflow.State == model.StatusSkipped {
 			continue
 		}
 		task := &model.Task{
-			ID:         fmt.Sprint(item.Workflow.ID),
-			PID:        item.Workflow.PID,
-			Name:       item.Workflow.Name,
-			Labels:     make(map[string]string),
-			PipelineID: item.Workflow.PipelineID,
-			RepoID:     repo.ID,
+			ID:           fmt.Sprint(item.Workflow.ID),
+			PID:          item.Workflow.PID,
+			Name:         item.Workflow.Name,
+			Labels:       make(map[string]string),
+			PipelineID:   item.Workflow.PipelineID,
+			RepoID:       repo.ID,
+			OrgID:        repo.OrgID,
+			Priority:     repo.GetQueuePriority(activePipeline.Event),
+			Queued:       time.Now().Unix(),
+			AffinityWait: repo.AgentAffinityWait,
 		}
 		maps.Copy(task.Labels, item.Labels)
 		err := task.ApplyLabelsFromRepo(repo)
@@ -47,6 +52,7 @@ func queuePipeline(ctx context.Context, repo *model.Repo, pipelineItems []*stepb
 		}
 		task.Dependencies = getTaskDependencies(item.DependsOn, pipelineItems)
 		task.RunOn = item.RunsOn
+		task.Requires = item.Requires
 		task.DepStatus = make(map[string]model.StatusValue)
 
 		task.Data, err = json.Marshal(rpc.Workflow{
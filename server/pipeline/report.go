@@ -0,0 +1,212 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/common"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+const (
+	// reportLogLines is the number of log lines of a step added to the report.
+	reportLogLines = 30
+	// reportLineLength is the max length of a log line in the report.
+	reportLineLength = 500
+)
+
+var (
+	ansiEscape = regexp.MustCompile(`\x1b\[[0-9;?]*[a-zA-Z]`)
+	backticks  = regexp.MustCompile("`+")
+
+	// reportLocks serializes the reports of a pull request, so concurrent status
+	// transitions update the existing comment instead of creating a second one.
+	reportLocks sync.Map
+)
+
+// Report creates or updates the pull request comment with the summary of a finished,
+// canceled or declined pipeline if one of its workflows enabled it with the report option.
+func Report(ctx context.Context, _forge forge.Forge, repo *model.Repo, user *model.User, pipeline *model.Pipeline) {
+	if !pipeline.IsPullRequest() || !reportEnabled(pipeline) {
+		return
+	}
+
+	commenter, ok := forge.As[forge.PullRequestCommenter](_forge, forge.CapabilityPullRequestComment)
+	if !ok {
+		log.Debug().Str("repo", repo.FullName).Msgf("forge %s does not support pull request comments", _forge.Name())
+		return
+	}
+
+	mu, _ := reportLocks.LoadOrStore(fmt.Sprintf("%d/%s", repo.ID, pipeline.Ref), &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	defer mu.(*sync.Mutex).Unlock()
+
+	body := reportBody(repo, pipeline, server.Config.Services.LogStore.LogFind)
+	if err := commenter.PullRequestComment(ctx, user, repo, pipeline, body); err != nil {
+		log.Error().Err(err).Str("repo", repo.FullName).Msgf("could not comment report of pipeline %d", pipeline.Number)
+	}
+}
+
+func reportEnabled(pipeline *model.Pipeline) bool {
+	for _, workflow := range pipeline.Workflows {
+		if workflow.Report != nil && workflow.Report.Comment {
+			return true
+		}
+	}
+	return false
+}
+
+// reportBody returns the markdown summary of the pipeline.
+func reportBody(repo *model.Repo, pipeline *model.Pipeline, logs func(*model.Step) ([]*model.LogEntry, error)) string {
+	var b strings.Builder
+
+	b.WriteString(forge.PullRequestCommentMarker + "\n")
+	fmt.Fprintf(&b, "### %s [Pipeline #%d](%s): %s\n\n",
+		statusIcon(pipeline.Status),
+		pipeline.Number,
+		common.GetPipelineStatusURL(repo, pipeline, nil),
+		common.GetPipelineStatusDescription(pipeline.Status),
+	)
+
+	if len(pipeline.Workflows) > 0 {
+		b.WriteString("| Workflow | Status | Failed steps |\n| --- | --- | --- |\n")
+		for _, workflow := range pipeline.Workflows {
+			var failed []string
+			for _, step := range workflow.Children {
+				if step.Failing() {
+					failed = append(failed, fmt.Sprintf("`%s` (exit code %d)", step.Name, step.ExitCode))
+				}
+			}
+			fmt.Fprintf(&b, "| [%s](%s) | %s %s | %s |\n",
+				workflowTitle(workflow),
+				common.GetPipelineStatusURL(repo, pipeline, workflow),
+				statusIcon(workflow.State),
+				workflow.State,
+				strings.Join(failed, ", "),
+			)
+		}
+		b.WriteString("\n")
+	}
+
+	var errs, warnings []string
+	for _, err := range pipeline.Errors {
+		if err.IsWarning {
+			warnings = append(warnings, fmt.Sprintf("- [%s] %s", err.Type, err.Message))
+		} else {
+			errs = append(errs, fmt.Sprintf("- [%s] %s", err.Type, err.Message))
+		}
+	}
+	if len(errs) > 0 {
+		fmt.Fprintf(&b, "**Errors**\n\n%s\n\n", strings.Join(errs, "\n"))
+	}
+	if len(warnings) > 0 {
+		fmt.Fprintf(&b, "**Warnings**\n\n%s\n\n", strings.Join(warnings, "\n"))
+	}
+
+	for _, workflow := range pipeline.Workflows {
+		if workflow.Report == nil || !workflow.Report.Comment {
+			continue
+		}
+		for _, step := range workflow.Children {
+			if !slices.Contains(workflow.Report.Logs, step.Name) || step.State == model.StatusSkipped {
+				continue
+			}
+			entries, err := logs(step)
+			if err != nil {
+				log.Error().Err(err).Str("repo", repo.FullName).Msgf("could not get logs of step %d for report", step.ID)
+				continue
+			}
+			tail := logTail(entries)
+			fence := codeFence(tail)
+			fmt.Fprintf(&b, "<details><summary>Logs of <code>%s</code> of %s</summary>\n\n%s\n%s\n%s\n\n</details>\n\n",
+				step.Name, workflowTitle(workflow), fence, tail, fence)
+		}
+	}
+
+	fmt.Fprintf(&b, "<sub>Commit %s</sub>\n", shortSHA(pipeline.Commit))
+	return b.String()
+}
+
+// logTail returns the last lines written to stdout and stderr.
+func logTail(entries []*model.LogEntry) string {
+	lines := make([]string, 0, reportLogLines)
+	for _, entry := range entries {
+		if entry.Type != model.LogEntryStdout && entry.Type != model.LogEntryStderr {
+			continue
+		}
+		line := ansiEscape.ReplaceAllString(strings.TrimRight(string(entry.Data), "\r\n"), "")
+		if len(line) > reportLineLength {
+			line = line[:reportLineLength] + "…"
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) > reportLogLines {
+		lines = lines[len(lines)-reportLogLines:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// codeFence returns a fence longer than every run of backticks in the content,
+// so fences printed by the step can not close the code block of the report.
+func codeFence(content string) string {
+	length := 3 //nolint:mnd
+	for _, run := range backticks.FindAllString(content, -1) {
+		if len(run) >= length {
+			length = len(run) + 1
+		}
+	}
+	return strings.Repeat("`", length)
+}
+
+// workflowTitle returns the name of the workflow followed by its matrix axis.
+func workflowTitle(workflow *model.Workflow) string {
+	if workflow.AxisID > 0 {
+		return fmt.Sprintf("%s #%d", workflow.Name, workflow.AxisID)
+	}
+	return workflow.Name
+}
+
+func statusIcon(status model.StatusValue) string {
+	switch status {
+	case model.StatusSuccess:
+		return "✅"
+	case model.StatusFailure, model.StatusError:
+		return "❌"
+	case model.StatusKilled, model.StatusDeclined:
+		return "⛔"
+	case model.StatusSkipped:
+		return "⏭️"
+	default:
+		return "⏳"
+	}
+}
+
+func shortSHA(sha string) string {
+	const length = 8
+	if len(sha) > length {
+		return sha[:length]
+	}
+	return sha
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/errors/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func TestReportBody(t *testing.T) {
+	server.Config.Server.Host = "https://ci.example.com"
+
+	repo := &model.Repo{ID: 1, FullName: "octocat/hello-world"}
+	pipeline := &model.Pipeline{
+		Number: 5,
+		Status: model.StatusFailure,
+		Commit: "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		Errors: []*types.PipelineError{
+			{Type: types.PipelineErrorTypeDeprecation, Message: "secrets are deprecated", IsWarning: true},
+		},
+		Workflows: []*model.Workflow{
+			{
+				PID:    1,
+				Name:   "build",
+				State:  model.StatusSuccess,
+				Report: &model.WorkflowReport{Comment: true},
+				Children: []*model.Step{
+					{ID: 1, Name: "build", State: model.StatusSuccess},
+				},
+			},
+			{
+				PID:    2,
+				Name:   "test",
+				AxisID: 2,
+				State:  model.StatusFailure,
+				Report: &model.WorkflowReport{Comment: true, Logs: []string{"test", "lint"}},
+				Children: []*model.Step{
+					{ID: 2, Name: "test", State: model.StatusFailure, ExitCode: 2, Failure: model.FailureFail},
+					{ID: 3, Name: "lint", State: model.StatusSkipped},
+				},
+			},
+		},
+	}
+
+	logs := func(step *model.Step) ([]*model.LogEntry, error) {
+		if step.ID != 2 {
+			return nil, fmt.Errorf("unexpected step %d", step.ID)
+		}
+		return []*model.LogEntry{
+			{Type: model.LogEntryStdout, Data: []byte("--- FAIL: TestMain\n")},
+			{Type: model.LogEntryStdout, Data: []byte("```\n")},
+		}, nil
+	}
+
+	body := reportBody(repo, pipeline, logs)
+	assert.True(t, strings.HasPrefix(body, forge.PullRequestCommentMarker))
+	assert.Contains(t, body, "### ❌ [Pipeline #5](https://ci.example.com/repos/1/pipeline/5): ")
+	assert.Contains(t, body, "| [build](https://ci.example.com/repos/1/pipeline/5/1) | ✅ success |  |")
+	assert.Contains(t, body, "| [test #2](https://ci.example.com/repos/1/pipeline/5/2) | ❌ failure | `test` (exit code 2) |")
+	assert.Contains(t, body, "**Warnings**\n\n- [deprecation] secrets are deprecated")
+	assert.NotContains(t, body, "**Errors**")
+	assert.Contains(t, body, "<details><summary>Logs of <code>test</code> of test #2</summary>\n\n````\n--- FAIL: TestMain\n```\n````\n")
+	assert.NotContains(t, body, "<code>lint</code>")
+	assert.Contains(t, body, "<sub>Commit 6dcb09b5</sub>")
+}
+
+func TestLogTail(t *testing.T) {
+	var entries []*model.LogEntry
+	entries = append(entries, &model.LogEntry{Type: model.LogEntryExitCode, Data: []byte("1")})
+	for i := range reportLogLines + 5 {
+		entries = append(entries, &model.LogEntry{Type: model.LogEntryStdout, Data: fmt.Appendf(nil, "\x1b[31mline %d\x1b[0m\n", i)})
+	}
+	entries = append(entries, &model.LogEntry{Type: model.LogEntryStderr, Data: []byte(strings.Repeat("x", reportLineLength+10))})
+
+	lines := strings.Split(logTail(entries), "\n")
+	assert.Len(t, lines, reportLogLines)
+	assert.Equal(t, "line 6", lines[0])
+	assert.Equal(t, strings.Repeat("x", reportLineLength)+"…", lines[len(lines)-1])
+}
+
+func TestCodeFence(t *testing.T) {
+	assert.Equal(t, "```", codeFence("--- FAIL: TestMain"))
+	assert.Equal(t, "```", codeFence("use `go test`"))
+	assert.Equal(t, "````", codeFence("```go\nfunc main() {}\n```"))
+	assert.Equal(t, "``````", codeFence("`````"))
+}
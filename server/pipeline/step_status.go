@@ -34,6 +34,19 @@ func UpdateStepStatus(store store.Store, step *model.Step, state rpc.StepState)
 		if state.ExitCode == pipeline.ExitCodeKilled {
 			step.State = model.StatusKilled
 		}
+		step.Annotations = nil
+		for _, annotation := range state.Annotations {
+			step.Annotations = append(step.Annotations, &model.StepAnnotation{
+				Path:        annotation.Path,
+				StartLine:   annotation.StartLine,
+				EndLine:     annotation.EndLine,
+				StartColumn: annotation.StartColumn,
+				EndColumn:   annotation.EndColumn,
+				Level:       annotation.Level,
+				Title:       annotation.Title,
+				Message:     annotation.Message,
+			})
+		}
 	} else if step.Finished == 0 {
 		step.Started = state.Started
 		step.State = model.StatusRunning
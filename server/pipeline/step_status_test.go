@@ -95,6 +95,9 @@ func TestUpdateStepStatusExited(t *testing.T) {
 		Finished: int64(34),
 		ExitCode: pipeline.ExitCodeKilled,
 		Error:    "an error",
+		Annotations: []*rpc.Annotation{
+			{Path: "main.go", StartLine: 1, EndLine: 1, Level: rpc.AnnotationFailure, Message: "unused variable"},
+		},
 	}
 
 	err := UpdateStepStatus(mockStoreStep(t), step, state)
@@ -104,6 +107,9 @@ func TestUpdateStepStatusExited(t *testing.T) {
 	assert.EqualValues(t, 34, step.Finished)
 	assert.EqualValues(t, pipeline.ExitCodeKilled, step.ExitCode)
 	assert.EqualValues(t, "an error", step.Error)
+	assert.Equal(t, []*model.StepAnnotation{
+		{Path: "main.go", StartLine: 1, EndLine: 1, Level: rpc.AnnotationFailure, Message: "unused variable"},
+	}, step.Annotations)
 }
 
 func TestUpdateStepStatusExitedButNot137(t *testing.T) {
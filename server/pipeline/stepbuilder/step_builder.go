@@ -18,7 +18,7 @@ package stepbuilder
 import (
 	"fmt"
 	"maps"
-	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -36,6 +36,7 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/linter"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/matrix"
 	yaml_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/types"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/utils"
 	forge_types "go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 )
@@ -54,6 +55,15 @@ type StepBuilder struct {
 	TrustedClonePlugins []string
 	PrivilegedPlugins   []string
 	CompilerOptions     []compiler.Option
+	MatrixOptions       []matrix.Option
+	// Secrets are the names of the secrets available to the pipeline, nil if unknown.
+	Secrets []string
+	// CompareChangedFiles returns the files changed compared to a branch, used by path constraints with compare_to.
+	CompareChangedFiles func(branch string) ([]string, error)
+	// Workflows restricts the pipeline to the workflows with the given names and their dependencies.
+	Workflows []string
+	// IgnorePathFilters disables the path constraints of all workflows and steps.
+	IgnorePathFilters bool
 }
 
 type Item struct {
@@ -61,6 +71,7 @@ type Item struct {
 	Labels    map[string]string
 	DependsOn []string
 	RunsOn    []string
+	Requires  map[string]string
 	Config    *backend_types.Config
 }
 
@@ -68,10 +79,22 @@ func (b *StepBuilder) Build() (items []*Item, errorsAndWarnings error) {
 	b.Yamls = forge_types.SortByName(b.Yamls)
 
 	pidSequence := 1
+	// errors of the config are reported once instead of once for every matrix axis
+	reported := make(map[string]struct{})
+
+	// collect the dependencies of all workflows to lint them individually
+	workflows := make(map[string][]string, len(b.Yamls))
+	for _, y := range b.Yamls {
+		var dependsOn []string
+		if parsed, err := yaml.ParseBytes(y.Data); err == nil {
+			dependsOn = parsed.DependsOn
+		}
+		workflows[SanitizePath(y.Name)] = dependsOn
+	}
 
 	for _, y := range b.Yamls {
 		// matrix axes
-		axes, err := matrix.ParseString(string(y.Data))
+		axes, err := matrix.ParseString(string(y.Data), b.MatrixOptions...)
 		if err != nil {
 			return nil, err
 		}
@@ -89,11 +112,18 @@ func (b *StepBuilder) Build() (items []*Item, errorsAndWarnings error) {
 			if len(axes) > 1 {
 				workflow.AxisID = i + 1
 			}
-			item, err := b.genItemForWorkflow(workflow, axis, string(y.Data))
+			item, err := b.genItemForWorkflow(workflow, workflows, axis, string(y.Data))
 			if err != nil && pipeline_errors.HasBlockingErrors(err) {
 				return nil, err
 			} else if err != nil {
-				errorsAndWarnings = multierr.Append(errorsAndWarnings, err)
+				for _, pipelineErr := range pipeline_errors.GetPipelineErrors(err) {
+					key := fmt.Sprintf("%s|%s|%t|%v", pipelineErr.Type, pipelineErr.Message, pipelineErr.IsWarning, pipelineErr.Data)
+					if _, ok := reported[key]; ok {
+						continue
+					}
+					reported[key] = struct{}{}
+					errorsAndWarnings = multierr.Append(errorsAndWarnings, pipelineErr)
+				}
 			}
 
 			if item == nil {
@@ -107,6 +137,10 @@ func (b *StepBuilder) Build() (items []*Item, errorsAndWarnings error) {
 		// depend on https://github.com/woodpecker-ci/woodpecker/issues/778
 	}
 
+	if len(b.Workflows) > 0 {
+		items = filterItemsByName(items, b.Workflows)
+	}
+
 	items = filterItemsWithMissingDependencies(items)
 
 	// check if at least one step can start if slice is not empty
@@ -117,7 +151,7 @@ func (b *StepBuilder) Build() (items []*Item, errorsAndWarnings error) {
 	return items, errorsAndWarnings
 }
 
-func (b *StepBuilder) genItemForWorkflow(workflow *model.Workflow, axis matrix.Axis, data string) (item *Item, errorsAndWarnings error) {
+func (b *StepBuilder) genItemForWorkflow(workflow *model.Workflow, workflows map[string][]string, axis matrix.Axis, data string) (item *Item, errorsAndWarnings error) {
 	workflowMetadata := MetadataFromStruct(b.Forge, b.Repo, b.Curr, b.Prev, workflow, b.Host)
 	environ := b.environmentVariables(workflowMetadata, axis)
 
@@ -143,7 +177,7 @@ func (b *StepBuilder) genItemForWorkflow(workflow *model.Workflow, axis matrix.A
 	}
 
 	// lint pipeline
-	errorsAndWarnings = multierr.Append(errorsAndWarnings, linter.New(
+	linterOptions := []linter.Option{
 		linter.WithTrusted(linter.TrustedConfiguration{
 			Network:  b.Repo.Trusted.Network,
 			Volumes:  b.Repo.Trusted.Volumes,
@@ -151,7 +185,12 @@ func (b *StepBuilder) genItemForWorkflow(workflow *model.Workflow, axis matrix.A
 		}),
 		linter.PrivilegedPlugins(b.PrivilegedPlugins),
 		linter.WithTrustedClonePlugins(b.TrustedClonePlugins),
-	).Lint([]*linter.WorkflowConfig{{
+		linter.WithWorkflows(workflows),
+	}
+	if b.Secrets != nil {
+		linterOptions = append(linterOptions, linter.WithSecrets(b.Secrets))
+	}
+	errorsAndWarnings = multierr.Append(errorsAndWarnings, linter.New(linterOptions...).Lint([]*linter.WorkflowConfig{{
 		Workflow:  parsed,
 		File:      workflow.Name,
 		RawConfig: data,
@@ -160,6 +199,9 @@ func (b *StepBuilder) genItemForWorkflow(workflow *model.Workflow, axis matrix.A
 		return nil, errorsAndWarnings
 	}
 
+	workflowMetadata.Curr.Commit.CompareChangedFiles = b.compareChangedFiles(parsed)
+	workflowMetadata.Curr.Commit.IgnorePathFilters = b.IgnorePathFilters
+
 	// checking if filtered.
 	if match, err := parsed.When.Match(workflowMetadata, true, environ); !match && err == nil {
 		log.Debug().Str("pipeline", workflow.Name).Msg(
@@ -182,12 +224,20 @@ func (b *StepBuilder) genItemForWorkflow(workflow *model.Workflow, axis matrix.A
 		return nil, nil
 	}
 
+	if parsed.Report.Comment {
+		workflow.Report = &model.WorkflowReport{
+			Comment: true,
+			Logs:    parsed.Report.Logs,
+		}
+	}
+
 	item = &Item{
 		Workflow:  workflow,
 		Config:    ir,
 		Labels:    parsed.Labels,
 		DependsOn: parsed.DependsOn,
 		RunsOn:    parsed.RunsOn,
+		Requires:  parsed.Requires,
 	}
 	if len(item.Labels) == 0 {
 		item.Labels = make(map[string]string, len(b.DefaultLabels))
@@ -222,6 +272,41 @@ func (b *StepBuilder) genItemForWorkflow(workflow *model.Workflow, axis matrix.A
 	return item, errorsAndWarnings
 }
 
+// compareChangedFiles returns the changed files for all branches the path constraints of the workflow compare to.
+func (b *StepBuilder) compareChangedFiles(parsed *yaml_types.Workflow) map[string][]string {
+	if b.CompareChangedFiles == nil {
+		return nil
+	}
+
+	constraints := parsed.When.Constraints
+	for _, container := range append(parsed.Steps.ContainerList, parsed.Services.ContainerList...) {
+		constraints = append(constraints, container.When.Constraints...)
+	}
+
+	var result map[string][]string
+	for _, c := range constraints {
+		branch := c.Path.CompareTo
+		if branch == "" {
+			continue
+		}
+		if _, ok := result[branch]; ok {
+			continue
+		}
+
+		files, err := b.CompareChangedFiles(branch)
+		if err != nil {
+			// the path constraint falls back to the changed files of the event
+			log.Warn().Err(err).Str("repo", b.Repo.FullName).Msgf("could not compare commit to branch '%s'", branch)
+			continue
+		}
+		if result == nil {
+			result = make(map[string][]string)
+		}
+		result[branch] = files
+	}
+	return result
+}
+
 func workflowListContainsItemsToRun(items []*Item) bool {
 	for i := range items {
 		if items[i].Workflow.State == model.StatusPending {
@@ -256,6 +341,33 @@ func filterItemsWithMissingDependencies(items []*Item) []*Item {
 	return items
 }
 
+// filterItemsByName keeps only the items with the given names and the items they depend on.
+func filterItemsByName(items []*Item, names []string) []*Item {
+	keep := make(map[string]bool, len(names))
+	pending := slices.Clone(names)
+	for len(pending) > 0 {
+		name := pending[0]
+		pending = pending[1:]
+		if keep[name] {
+			continue
+		}
+		keep[name] = true
+		for _, item := range items {
+			if item.Workflow.Name == name {
+				pending = append(pending, item.DependsOn...)
+			}
+		}
+	}
+
+	filtered := make([]*Item, 0, len(items))
+	for _, item := range items {
+		if keep[item.Workflow.Name] {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
 func containsItemWithName(name string, items []*Item) bool {
 	for _, item := range items {
 		if name == item.Workflow.Name {
@@ -297,9 +409,5 @@ func (b *StepBuilder) toInternalRepresentation(parsed *yaml_types.Workflow, envi
 }
 
 func SanitizePath(path string) string {
-	path = filepath.Base(path)
-	path = strings.TrimSuffix(path, ".yml")
-	path = strings.TrimSuffix(path, ".yaml")
-	path = strings.TrimPrefix(path, ".")
-	return path
+	return utils.WorkflowName(path)
 }
@@ -223,12 +223,110 @@ depends_on:
 	}
 
 	items, err := b.Build()
-	assert.NoError(t, err)
+	assert.False(t, errors.HasBlockingErrors(err))
+	assert.ErrorContains(t, err, "Workflow `missing` does not exist")
 	assert.Len(t, items, 3, "Should have generated 3 items")
 	assert.Len(t, items[0].DependsOn, 2, "Should have 2 dependencies")
 	assert.Equal(t, "test", items[0].DependsOn[1], "Should depend on test")
 }
 
+func TestOnlyWorkflows(t *testing.T) {
+	t.Parallel()
+
+	b := StepBuilder{
+		Forge:       getMockForge(t),
+		Repo:        &model.Repo{},
+		RepoTrusted: &metadata.TrustedConfiguration{},
+		Curr: &model.Pipeline{
+			Event: model.EventPush,
+		},
+		Prev:      &model.Pipeline{},
+		Workflows: []string{"deploy"},
+		Yamls: []*forge_types.FileMeta{
+			{Name: "build", Data: []byte(`
+when:
+  event: push
+steps:
+  - name: build
+    image: scratch
+`)},
+			{Name: "lint", Data: []byte(`
+when:
+  event: push
+steps:
+  - name: lint
+    image: scratch
+`)},
+			{Name: "test", Data: []byte(`
+when:
+  event: push
+steps:
+  - name: test
+    image: scratch
+
+depends_on:
+  - build
+`)},
+			{Name: "deploy", Data: []byte(`
+when:
+  event: push
+steps:
+  - name: deploy
+    image: scratch
+
+depends_on:
+  - test
+`)},
+		},
+	}
+
+	items, err := b.Build()
+	assert.NoError(t, err)
+	var names []string
+	for _, item := range items {
+		names = append(names, item.Workflow.Name)
+	}
+	assert.ElementsMatch(t, []string{"build", "test", "deploy"}, names)
+}
+
+func TestIgnorePathFilters(t *testing.T) {
+	t.Parallel()
+
+	b := StepBuilder{
+		Forge:       getMockForge(t),
+		Repo:        &model.Repo{},
+		RepoTrusted: &metadata.TrustedConfiguration{},
+		Curr: &model.Pipeline{
+			Event:        model.EventPush,
+			ChangedFiles: []string{"README.md"},
+		},
+		Prev:              &model.Pipeline{},
+		IgnorePathFilters: true,
+		Yamls: []*forge_types.FileMeta{
+			{Name: "build", Data: []byte(`
+when:
+  event: push
+  path: 'src/**'
+steps:
+  - name: build
+    image: scratch
+  - name: test
+    image: scratch
+    when:
+      event: push
+      path: 'test/**'
+`)},
+		},
+	}
+
+	items, err := b.Build()
+	assert.NoError(t, err)
+	if assert.Len(t, items, 1) {
+		// clone, build and test
+		assert.Len(t, items[0].Config.Stages, 3)
+	}
+}
+
 func TestRunsOn(t *testing.T) {
 	t.Parallel()
 
@@ -548,6 +646,48 @@ steps:
 	assert.Equal(t, "1.15", items[1].Workflow.Environ["GO_VERSION"])
 }
 
+func TestMatrixWarnings(t *testing.T) {
+	t.Parallel()
+
+	b := StepBuilder{
+		Forge:       getMockForge(t),
+		RepoTrusted: &metadata.TrustedConfiguration{},
+		Repo:        &model.Repo{},
+		Curr:        &model.Pipeline{Event: model.EventPush},
+		Prev:        &model.Pipeline{},
+		Host:        "",
+		Yamls: []*forge_types.FileMeta{
+			{Data: []byte(`
+when:
+  event: push
+
+matrix:
+  GO_VERSION:
+    - 1.14
+    - 1.15
+
+steps:
+  - name: build
+    image: golang:${GO_VERSION}
+    commands:
+      - go build
+  - name: release
+    image: golang:${GO_VERSION}
+    commands:
+      - go build
+    when:
+      event: tag
+`)},
+		},
+	}
+
+	items, err := b.Build()
+	assert.Len(t, items, 2)
+	// the warning of the workflow is not repeated for every axis
+	assert.Len(t, errors.GetPipelineErrors(err), 1)
+	assert.ErrorContains(t, err, "Step can never run")
+}
+
 func TestMissingWorkflowDeps(t *testing.T) {
 	t.Parallel()
 
@@ -575,7 +715,8 @@ depends_on:
 	}
 
 	items, err := b.Build()
-	assert.NoError(t, err)
+	assert.False(t, errors.HasBlockingErrors(err))
+	assert.ErrorContains(t, err, "Workflow `non-existing` does not exist")
 	assert.Empty(t, items, "Workflows with missing dependencies should be filtered out")
 }
 
@@ -730,3 +871,70 @@ func getMockForge(t *testing.T) forge.Forge {
 	forge.On("URL").Return("https://codeberg.org")
 	return forge
 }
+
+func TestWorkflowDependencyCycle(t *testing.T) {
+	t.Parallel()
+
+	b := StepBuilder{
+		Forge:       getMockForge(t),
+		RepoTrusted: &metadata.TrustedConfiguration{},
+		Repo:        &model.Repo{},
+		Curr:        &model.Pipeline{Event: model.EventPush},
+		Prev:        &model.Pipeline{},
+		Yamls: []*forge_types.FileMeta{
+			{Name: "build", Data: []byte(`
+when:
+  event: push
+steps:
+  - name: build
+    image: scratch
+depends_on:
+  - deploy
+`)},
+			{Name: "deploy", Data: []byte(`
+when:
+  event: push
+steps:
+  - name: deploy
+    image: scratch
+depends_on:
+  - build
+`)},
+		},
+	}
+
+	items, err := b.Build()
+	assert.True(t, errors.HasBlockingErrors(err))
+	assert.ErrorContains(t, err, "Dependency cycle detected: build -> deploy -> build")
+	assert.Empty(t, items)
+}
+
+func TestUnknownSecret(t *testing.T) {
+	t.Parallel()
+
+	b := StepBuilder{
+		Forge:       getMockForge(t),
+		RepoTrusted: &metadata.TrustedConfiguration{},
+		Repo:        &model.Repo{},
+		Curr:        &model.Pipeline{Event: model.EventPush},
+		Prev:        &model.Pipeline{},
+		Secrets:     []string{"token"},
+		Yamls: []*forge_types.FileMeta{
+			{Name: "build", Data: []byte(`
+when:
+  event: push
+steps:
+  - name: build
+    image: scratch
+    environment:
+      TOKEN:
+        from_secret: token
+      PASSWORD:
+        from_secret: password
+`)},
+		},
+	}
+
+	_, err := b.Build()
+	assert.ErrorContains(t, err, "Secret `password` does not exist")
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package poll
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/pipeline"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+)
+
+// Run polls the active repositories of forges without webhooks for changes in the given interval.
+func Run(ctx context.Context, store store.Store, interval time.Duration) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+			log.Trace().Msg("poll: poll repos")
+			if err := Poll(ctx, store); err != nil {
+				log.Error().Err(err).Msg("poll run failed")
+			}
+		}
+	}
+}
+
+// Poll polls all active repositories of forges implementing forge.Poller once
+// and creates the pipelines of the detected changes.
+func Poll(ctx context.Context, store store.Store) error {
+	repos, err := store.RepoListAll(true, &model.ListOptions{All: true})
+	if err != nil {
+		return fmt.Errorf("could not load repos: %w", err)
+	}
+
+	for _, repo := range repos {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		_forge, err := server.Config.Services.Manager.ForgeFromRepo(repo)
+		if err != nil {
+			log.Error().Err(err).Str("repo", repo.FullName).Msgf("cannot get forge with id: %d", repo.ForgeID)
+			continue
+		}
+		poller, ok := forge.As[forge.Poller](_forge, forge.CapabilityPoll)
+		if !ok {
+			continue
+		}
+
+		if err := pollRepo(ctx, store, _forge, poller, repo); err != nil {
+			log.Error().Err(err).Str("repo", repo.FullName).Msg("could not poll repo")
+		}
+	}
+
+	return nil
+}
+
+func pollRepo(ctx context.Context, store store.Store, _forge forge.Forge, poller forge.Poller, repo *model.Repo) error {
+	if repo.UserID == 0 {
+		log.Warn().Str("repo", repo.FullName).Msg("ignoring repo without owner")
+		return nil
+	}
+
+	user, err := store.GetUser(repo.UserID)
+	if err != nil {
+		return err
+	}
+	forge.Refresh(ctx, _forge, store, user)
+
+	pipelines, err := poller.Poll(ctx, user, repo)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range pipelines {
+		if _, err := pipeline.Create(ctx, store, repo, p); err != nil && !errors.Is(err, pipeline.ErrFiltered) {
+			log.Error().Err(err).Str("repo", repo.FullName).Str("ref", p.Ref).Msg("could not create pipeline")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package poll
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+	forge_mocks "go.woodpecker-ci.org/woodpecker/v3/server/forge/mocks"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	manager_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/mocks"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+)
+
+type pollerForge struct {
+	*forge_mocks.MockForge
+	polled []*model.Repo
+}
+
+func (f *pollerForge) Poll(_ context.Context, _ *model.User, r *model.Repo) ([]*model.Pipeline, error) {
+	f.polled = append(f.polled, r)
+	return nil, nil
+}
+
+func TestPoll(t *testing.T) {
+	_manager := manager_mocks.NewMockManager(t)
+	_store := store_mocks.NewMockStore(t)
+
+	owner := &model.User{ID: 1, Login: "octocat"}
+	polledRepo := &model.Repo{ID: 1, UserID: 1, ForgeID: 1, FullName: "octocat/polled"}
+	hookRepo := &model.Repo{ID: 2, UserID: 1, ForgeID: 2, FullName: "octocat/hook"}
+	orphanedRepo := &model.Repo{ID: 3, ForgeID: 1, FullName: "octocat/orphaned"}
+
+	poller := &pollerForge{MockForge: forge_mocks.NewMockForge(t)}
+	_manager.On("ForgeFromRepo", polledRepo).Return(poller, nil)
+	_manager.On("ForgeFromRepo", orphanedRepo).Return(poller, nil)
+	_manager.On("ForgeFromRepo", hookRepo).Return(forge_mocks.NewMockForge(t), nil)
+	_store.On("RepoListAll", true, mock.Anything).Return([]*model.Repo{polledRepo, hookRepo, orphanedRepo}, nil)
+	_store.On("GetUser", int64(1)).Return(owner, nil).Once()
+	server.Config.Services.Manager = _manager
+
+	assert.NoError(t, Poll(t.Context(), _store))
+	assert.Equal(t, []*model.Repo{polledRepo}, poller.polled)
+}
@@ -52,16 +52,51 @@ type fifo struct {
 	waitingOnDeps *list.List
 	extension     time.Duration
 	paused        bool
+	scheduling    SchedulingConfig
+	// lastAgents holds the agent that last ran a workflow by its affinity key
+	lastAgents map[string]lastAgent
+	// lastAgentsPruned is when expired entries were last removed from lastAgents
+	lastAgentsPruned time.Time
+	// resubmitted is called for expired tasks handed out again
+	resubmitted func(task *model.Task)
+}
+
+// lastAgent is the agent a workflow was last assigned to.
+type lastAgent struct {
+	agentID  int64
+	assigned time.Time
+}
+
+// scheduledTask is a pending task with its effective position in the queue.
+type scheduledTask struct {
+	element *list.Element
+	task    *model.Task
+	blocked bool
+	reason  string
 }
 
 // processTimeInterval is the time till the queue rearranges things,
 // as the agent pull in 10 milliseconds we should also give them work asap.
 const processTimeInterval = 100 * time.Millisecond
 
+const (
+	// affinityScore is added to the score of the agent that last ran a workflow,
+	// it outweighs any label match.
+	affinityScore = 1000
+	// affinityTTL is the time after which an agent no longer counts as having recently run a workflow.
+	affinityTTL = 24 * time.Hour
+	// affinityPruneInterval is how often agents which ran a workflow longer than affinityTTL ago are forgotten.
+	affinityPruneInterval = time.Minute
+)
+
 var ErrWorkerKicked = fmt.Errorf("worker was kicked")
 
 // NewMemoryQueue returns a new fifo queue.
 func NewMemoryQueue(ctx context.Context) Queue {
+	return newMemoryQueue(ctx, SchedulingConfig{})
+}
+
+func newMemoryQueue(ctx context.Context, scheduling SchedulingConfig) Queue {
 	q := &fifo{
 		ctx:           ctx,
 		workers:       map[*worker]struct{}{},
@@ -70,6 +105,8 @@ func NewMemoryQueue(ctx context.Context) Queue {
 		waitingOnDeps: list.New(),
 		extension:     constant.TaskTimeout,
 		paused:        false,
+		scheduling:    scheduling,
+		lastAgents:    map[string]lastAgent{},
 	}
 	go q.process()
 	return q
@@ -78,13 +115,31 @@ func NewMemoryQueue(ctx context.Context) Queue {
 // PushAtOnce pushes multiple tasks to the tail of this queue.
 func (q *fifo) PushAtOnce(_ context.Context, tasks []*model.Task) error {
 	q.Lock()
+	now := time.Now().Unix()
 	for _, task := range tasks {
+		if task.Queued == 0 {
+			task.Queued = now
+		}
 		q.pending.PushBack(task)
 	}
 	q.Unlock()
 	return nil
 }
 
+// PushRunning adds tasks that were running before a restart back as running.
+func (q *fifo) PushRunning(_ context.Context, tasks []*model.Task, deadline time.Time) error {
+	q.Lock()
+	for _, task := range tasks {
+		q.running[task.ID] = &entry{
+			item:     task,
+			done:     make(chan bool),
+			deadline: deadline,
+		}
+	}
+	q.Unlock()
+	return nil
+}
+
 // Poll retrieves and removes a task head of this queue.
 func (q *fifo) Poll(c context.Context, agentID int64, filter FilterFn) (*model.Task, error) {
 	q.Lock()
@@ -206,9 +261,16 @@ func (q *fifo) Info(_ context.Context) InfoT {
 	stats.Stats.WaitingOnDeps = q.waitingOnDeps.Len()
 	stats.Stats.Running = len(q.running)
 
-	for element := q.pending.Front(); element != nil; element = element.Next() {
-		task, _ := element.Value.(*model.Task)
-		stats.Pending = append(stats.Pending, task)
+	stats.Schedule = make(map[string]TaskSchedule, q.pending.Len())
+	position := 0
+	for _, scheduled := range q.schedule() {
+		stats.Pending = append(stats.Pending, scheduled.task)
+		schedule := TaskSchedule{Reason: scheduled.reason}
+		if !scheduled.blocked {
+			position++
+			schedule.Position = position
+		}
+		stats.Schedule[scheduled.task.ID] = schedule
 	}
 	for element := q.waitingOnDeps.Front(); element != nil; element = element.Next() {
 		task, _ := element.Value.(*model.Task)
@@ -267,10 +329,12 @@ func (q *fifo) process() {
 		}
 
 		q.resubmitExpiredPipelines()
+		q.pruneLastAgents()
 		q.filterWaiting()
 		for pending, worker := q.assignToWorker(); pending != nil && worker != nil; pending, worker = q.assignToWorker() {
 			task, _ := pending.Value.(*model.Task)
 			task.AgentID = worker.agentID
+			q.lastAgents[affinityKey(task)] = lastAgent{agentID: worker.agentID, assigned: time.Now()}
 			delete(q.workers, worker)
 			q.pending.Remove(pending)
 			q.running[task.ID] = &entry{
@@ -314,18 +378,34 @@ func (q *fifo) filterWaiting() {
 }
 
 func (q *fifo) assignToWorker() (*list.Element, *worker) {
-	var next *list.Element
 	var bestWorker *worker
 	var bestScore int
 
-	for element := q.pending.Front(); element != nil; element = next {
-		next = element.Next()
-		task, _ := element.Value.(*model.Task)
+	running := make([]*model.Task, 0, len(q.running))
+	for _, entry := range q.running {
+		running = append(running, entry.item)
+	}
+
+	for _, scheduled := range q.schedule() {
+		if scheduled.blocked {
+			// blocked tasks are sorted last
+			break
+		}
+		element, task := scheduled.element, scheduled.task
 		log.Debug().Msgf("queue: trying to assign task: %v with deps %v", task.ID, task.Dependencies)
 
+		preferredAgent, waitForAgent := q.affinity(task)
 		for worker := range q.workers {
-			matched, score := worker.filter(task)
-			if matched && score > bestScore {
+			matched, score := worker.filter(task, running)
+			if !matched {
+				continue
+			}
+			if preferredAgent != 0 && worker.agentID == preferredAgent {
+				score += affinityScore
+			} else if waitForAgent {
+				continue
+			}
+			if score > bestScore {
 				bestWorker = worker
 				bestScore = score
 			}
@@ -339,11 +419,108 @@ func (q *fifo) assignToWorker() (*list.Element, *worker) {
 	return nil, nil
 }
 
+// schedule returns the pending tasks in the order they are handed out to workers.
+// Tasks exceeding the running tasks quota of their org or repo are blocked and sorted last.
+func (q *fifo) schedule() []*scheduledTask {
+	orgRunning := make(map[int64]int)
+	repoRunning := make(map[int64]int)
+	for _, entry := range q.running {
+		orgRunning[entry.item.OrgID]++
+		repoRunning[entry.item.RepoID]++
+	}
+
+	tasks := make([]*scheduledTask, 0, q.pending.Len())
+	for element := q.pending.Front(); element != nil; element = element.Next() {
+		task, _ := element.Value.(*model.Task)
+		scheduled := &scheduledTask{element: element, task: task}
+
+		switch maxOrg, maxRepo := q.scheduling.MaxRunningPerOrg, q.scheduling.MaxRunningPerRepo; {
+		case maxOrg > 0 && orgRunning[task.OrgID] >= maxOrg:
+			scheduled.blocked = true
+			scheduled.reason = fmt.Sprintf("org quota reached: %d of %d tasks running", orgRunning[task.OrgID], maxOrg)
+		case maxRepo > 0 && repoRunning[task.RepoID] >= maxRepo:
+			scheduled.blocked = true
+			scheduled.reason = fmt.Sprintf("repo quota reached: %d of %d tasks running", repoRunning[task.RepoID], maxRepo)
+		default:
+			scheduled.reason = fmt.Sprintf("priority %d, %d tasks of org running with weight %d",
+				task.Priority, orgRunning[task.OrgID], q.scheduling.orgWeight(task.OrgID))
+			if agentID, wait := q.affinity(task); wait {
+				scheduled.reason += fmt.Sprintf(", waiting for agent %d that last ran the workflow", agentID)
+			}
+		}
+
+		tasks = append(tasks, scheduled)
+	}
+
+	// the stable sort keeps the queue order for tasks with the same priority and share
+	slices.SortStableFunc(tasks, func(a, b *scheduledTask) int {
+		if a.blocked != b.blocked {
+			if a.blocked {
+				return 1
+			}
+			return -1
+		}
+		if a.task.Priority != b.task.Priority {
+			return b.task.Priority - a.task.Priority
+		}
+		// compare running/weight of the orgs without dividing
+		return orgRunning[a.task.OrgID]*q.scheduling.orgWeight(b.task.OrgID) -
+			orgRunning[b.task.OrgID]*q.scheduling.orgWeight(a.task.OrgID)
+	})
+
+	return tasks
+}
+
+// affinity returns the agent that recently ran the workflow of the task if the repo of the task
+// prefers it and whether the task still waits for that agent instead of taking any other one.
+func (q *fifo) affinity(task *model.Task) (agentID int64, wait bool) {
+	if task.AffinityWait <= 0 {
+		return 0, false
+	}
+	last, ok := q.lastAgents[affinityKey(task)]
+	if !ok || time.Since(last.assigned) > affinityTTL {
+		return 0, false
+	}
+	waitUntil := time.Unix(task.Queued, 0).Add(time.Duration(task.AffinityWait) * time.Second)
+	return last.agentID, time.Now().Before(waitUntil)
+}
+
+// affinityKey identifies a workflow across pipelines.
+// The pid tells apart the axes of a matrix, which share the name of their workflow.
+func affinityKey(task *model.Task) string {
+	return fmt.Sprintf("%d/%s/%d", task.RepoID, task.Name, task.PID)
+}
+
+// pruneLastAgents removes the agents which ran a workflow too long ago to be preferred anymore.
+func (q *fifo) pruneLastAgents() {
+	if time.Since(q.lastAgentsPruned) < affinityPruneInterval {
+		return
+	}
+	q.lastAgentsPruned = time.Now()
+
+	for key, last := range q.lastAgents {
+		if time.Since(last.assigned) > affinityTTL {
+			delete(q.lastAgents, key)
+		}
+	}
+}
+
+// onResubmit registers a function called for expired tasks which are handed out again.
+func (q *fifo) onResubmit(fn func(task *model.Task)) {
+	q.Lock()
+	q.resubmitted = fn
+	q.Unlock()
+}
+
 func (q *fifo) resubmitExpiredPipelines() {
 	for taskID, taskState := range q.running {
 		if time.Now().After(taskState.deadline) {
 			log.Info().Msgf("queue: resubmitting expired task %s", taskID)
 			taskState.error = ErrTaskExpired
+			taskState.item.AgentID = 0
+			if q.resubmitted != nil {
+				q.resubmitted(taskState.item)
+			}
 			q.pending.PushFront(taskState.item)
 			delete(q.running, taskID)
 			close(taskState.done)
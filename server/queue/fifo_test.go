@@ -15,6 +15,7 @@
 package queue
 
 import (
+	"container/list"
 	"context"
 	"errors"
 	"fmt"
@@ -28,7 +29,7 @@ import (
 )
 
 var (
-	filterFnTrue = func(*model.Task) (bool, int) { return true, 1 }
+	filterFnTrue = func(*model.Task, []*model.Task) (bool, int) { return true, 1 }
 	genDummyTask = func() *model.Task {
 		return &model.Task{
 			ID:   "1",
@@ -612,7 +613,7 @@ func TestFifoWithScoring(t *testing.T) {
 
 	// Create filter functions for different workers
 	filters := map[int]FilterFn{
-		1: func(task *model.Task) (bool, int) {
+		1: func(task *model.Task, _ []*model.Task) (bool, int) {
 			if task.Labels["org-id"] == "123" {
 				return true, 20
 			}
@@ -621,7 +622,7 @@ func TestFifoWithScoring(t *testing.T) {
 			}
 			return true, 1
 		},
-		2: func(task *model.Task) (bool, int) {
+		2: func(task *model.Task, _ []*model.Task) (bool, int) {
 			if task.Labels["org-id"] == "456" {
 				return true, 20
 			}
@@ -630,13 +631,13 @@ func TestFifoWithScoring(t *testing.T) {
 			}
 			return true, 1
 		},
-		3: func(task *model.Task) (bool, int) {
+		3: func(task *model.Task, _ []*model.Task) (bool, int) {
 			if task.Labels["platform"] == "windows" {
 				return true, 20
 			}
 			return true, 1
 		},
-		4: func(task *model.Task) (bool, int) {
+		4: func(task *model.Task, _ []*model.Task) (bool, int) {
 			if task.Labels["org-id"] == "123" {
 				return true, 20
 			}
@@ -645,7 +646,7 @@ func TestFifoWithScoring(t *testing.T) {
 			}
 			return true, 1
 		},
-		5: func(task *model.Task) (bool, int) {
+		5: func(task *model.Task, _ []*model.Task) (bool, int) {
 			if task.Labels["org-id"] == "*" {
 				return true, 15
 			}
@@ -697,3 +698,148 @@ func TestFifoWithScoring(t *testing.T) {
 		assert.Contains(t, expectedAgents, agentID, "Task %s should be assigned to one of the expected agents", taskID)
 	}
 }
+
+func TestFifoPriority(t *testing.T) {
+	ctx, cancel := context.WithCancelCause(t.Context())
+	t.Cleanup(func() { cancel(nil) })
+
+	q := NewMemoryQueue(ctx)
+	assert.NoError(t, q.PushAtOnce(ctx, []*model.Task{
+		{ID: "cron", Priority: 0},
+		{ID: "pull", Priority: 1},
+		{ID: "push", Priority: 2},
+		{ID: "deploy", Priority: 3},
+		{ID: "pull-2", Priority: 1},
+	}))
+
+	info := q.Info(ctx)
+	var pending []string
+	for _, task := range info.Pending {
+		pending = append(pending, task.ID)
+	}
+	assert.Equal(t, []string{"deploy", "push", "pull", "pull-2", "cron"}, pending)
+	assert.Equal(t, 1, info.Schedule["deploy"].Position)
+	assert.Equal(t, 5, info.Schedule["cron"].Position)
+
+	waitForProcess()
+	got, err := q.Poll(ctx, 1, filterFnTrue)
+	assert.NoError(t, err)
+	assert.Equal(t, "deploy", got.ID)
+}
+
+func TestFifoFairShare(t *testing.T) {
+	q := &fifo{
+		running: map[string]*entry{
+			"a-1": {item: &model.Task{ID: "a-1", OrgID: 1, RepoID: 1}},
+			"a-2": {item: &model.Task{ID: "a-2", OrgID: 1, RepoID: 1}},
+			"b-1": {item: &model.Task{ID: "b-1", OrgID: 2, RepoID: 2}},
+		},
+		pending: list.New(),
+	}
+	for _, task := range []*model.Task{
+		{ID: "a-3", OrgID: 1, RepoID: 1},
+		{ID: "b-2", OrgID: 2, RepoID: 2},
+		{ID: "c-1", OrgID: 3, RepoID: 3},
+		{ID: "a-4", OrgID: 1, RepoID: 4},
+	} {
+		q.pending.PushBack(task)
+	}
+
+	order := func() (ids []string) {
+		for _, scheduled := range q.schedule() {
+			if !scheduled.blocked {
+				ids = append(ids, scheduled.task.ID)
+			}
+		}
+		return ids
+	}
+
+	// org 3 runs nothing, org 2 runs less than org 1
+	assert.Equal(t, []string{"c-1", "b-2", "a-3", "a-4"}, order())
+
+	// org 1 may run four times as much as org 2
+	q.scheduling.OrgWeights = map[int64]int{1: 4}
+	assert.Equal(t, []string{"c-1", "a-3", "a-4", "b-2"}, order())
+
+	q.scheduling = SchedulingConfig{MaxRunningPerOrg: 2}
+	assert.Equal(t, []string{"c-1", "b-2"}, order())
+	scheduled := q.schedule()
+	assert.True(t, scheduled[2].blocked)
+	assert.Equal(t, "org quota reached: 2 of 2 tasks running", scheduled[2].reason)
+
+	q.scheduling = SchedulingConfig{MaxRunningPerRepo: 1}
+	assert.Equal(t, []string{"c-1", "a-4"}, order())
+}
+
+func TestFifoAffinity(t *testing.T) {
+	ctx, cancel := context.WithCancelCause(t.Context())
+	t.Cleanup(func() { cancel(nil) })
+
+	q := NewMemoryQueue(ctx)
+	assert.NoError(t, q.PushAtOnce(ctx, []*model.Task{{ID: "1", RepoID: 1, Name: "build", AffinityWait: 60}}))
+	waitForProcess()
+	got, err := q.Poll(ctx, 1, filterFnTrue)
+	assert.NoError(t, err)
+	assert.Equal(t, "1", got.ID)
+
+	// the next run of the workflow waits for agent 1
+	assert.NoError(t, q.PushAtOnce(ctx, []*model.Task{{ID: "2", RepoID: 1, Name: "build", AffinityWait: 60}}))
+	pollCtx, pollCancel := context.WithTimeout(ctx, 2*processTimeInterval)
+	got, err = q.Poll(pollCtx, 2, filterFnTrue)
+	pollCancel()
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, got)
+	assert.Contains(t, q.Info(ctx).Schedule["2"].Reason, "waiting for agent 1")
+
+	got, err = q.Poll(ctx, 1, filterFnTrue)
+	assert.NoError(t, err)
+	assert.Equal(t, "2", got.ID)
+
+	// other agents take the workflow once the wait is over
+	assert.NoError(t, q.PushAtOnce(ctx, []*model.Task{{
+		ID: "3", RepoID: 1, Name: "build", AffinityWait: 60, Queued: time.Now().Add(-2 * time.Minute).Unix(),
+	}}))
+	got, err = q.Poll(ctx, 2, filterFnTrue)
+	assert.NoError(t, err)
+	assert.Equal(t, "3", got.ID)
+}
+
+func TestFifoAffinityMatrix(t *testing.T) {
+	ctx, cancel := context.WithCancelCause(t.Context())
+	t.Cleanup(func() { cancel(nil) })
+
+	q := NewMemoryQueue(ctx)
+	assert.NoError(t, q.PushAtOnce(ctx, []*model.Task{
+		{ID: "1", PID: 1, RepoID: 1, Name: "test", AffinityWait: 60},
+		{ID: "2", PID: 2, RepoID: 1, Name: "test", AffinityWait: 60},
+	}))
+	waitForProcess()
+	got, err := q.Poll(ctx, 1, filterFnTrue)
+	assert.NoError(t, err)
+	assert.Equal(t, "1", got.ID)
+	got, err = q.Poll(ctx, 2, filterFnTrue)
+	assert.NoError(t, err)
+	assert.Equal(t, "2", got.ID)
+
+	// each axis of the next run waits for the agent that ran it, not all for the same one
+	assert.NoError(t, q.PushAtOnce(ctx, []*model.Task{
+		{ID: "3", PID: 1, RepoID: 1, Name: "test", AffinityWait: 60},
+		{ID: "4", PID: 2, RepoID: 1, Name: "test", AffinityWait: 60},
+	}))
+	got, err = q.Poll(ctx, 2, filterFnTrue)
+	assert.NoError(t, err)
+	assert.Equal(t, "4", got.ID)
+	got, err = q.Poll(ctx, 1, filterFnTrue)
+	assert.NoError(t, err)
+	assert.Equal(t, "3", got.ID)
+}
+
+func TestFifoPruneLastAgents(t *testing.T) {
+	q := &fifo{lastAgents: map[string]lastAgent{
+		"1/build/1": {agentID: 1, assigned: time.Now().Add(-affinityTTL - time.Minute)},
+		"1/test/2":  {agentID: 2, assigned: time.Now()},
+	}}
+
+	q.pruneLastAgents()
+	assert.Equal(t, map[string]lastAgent{"1/test/2": q.lastAgents["1/test/2"]}, q.lastAgents)
+}
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
@@ -603,6 +604,69 @@ func (_c *MockQueue_PushAtOnce_Call) RunAndReturn(run func(c context.Context, ta
 	return _c
 }
 
+// PushRunning provides a mock function for the type MockQueue
+func (_mock *MockQueue) PushRunning(c context.Context, tasks []*model.Task, deadline time.Time) error {
+	ret := _mock.Called(c, tasks, deadline)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PushRunning")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*model.Task, time.Time) error); ok {
+		r0 = returnFunc(c, tasks, deadline)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockQueue_PushRunning_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PushRunning'
+type MockQueue_PushRunning_Call struct {
+	*mock.Call
+}
+
+// PushRunning is a helper method to define mock.On call
+//   - c context.Context
+//   - tasks []*model.Task
+//   - deadline time.Time
+func (_e *MockQueue_Expecter) PushRunning(c interface{}, tasks interface{}, deadline interface{}) *MockQueue_PushRunning_Call {
+	return &MockQueue_PushRunning_Call{Call: _e.mock.On("PushRunning", c, tasks, deadline)}
+}
+
+func (_c *MockQueue_PushRunning_Call) Run(run func(c context.Context, tasks []*model.Task, deadline time.Time)) *MockQueue_PushRunning_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*model.Task
+		if args[1] != nil {
+			arg1 = args[1].([]*model.Task)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQueue_PushRunning_Call) Return(err error) *MockQueue_PushRunning_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockQueue_PushRunning_Call) RunAndReturn(run func(c context.Context, tasks []*model.Task, deadline time.Time) error) *MockQueue_PushRunning_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Resume provides a mock function for the type MockQueue
 func (_mock *MockQueue) Resume() {
 	_mock.Called()
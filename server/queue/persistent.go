@@ -17,23 +17,57 @@ package queue
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/rs/zerolog/log"
 
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
 )
 
 // WithTaskStore returns a queue that is backed by the TaskStore. This
 // ensures the task Queue can be restored when the system starts.
-func WithTaskStore(ctx context.Context, q Queue, s store.Store) Queue {
+// Tasks that were running are kept for their agents for the grace period.
+func WithTaskStore(ctx context.Context, q Queue, s store.Store, resumeGracePeriod time.Duration) Queue {
 	tasks, _ := s.TaskList()
-	if err := q.PushAtOnce(ctx, tasks); err != nil {
+
+	if observer, ok := q.(resubmitObserver); ok {
+		observer.onResubmit(func(task *model.Task) {
+			// the task is pending again and must not be resumed as running after another restart
+			if err := s.TaskUpdate(task); err != nil {
+				log.Error().Err(err).Msgf("resubmit queue item: %s: failed to update backup", task.ID)
+			}
+		})
+	}
+
+	var pending, running []*model.Task
+	for _, task := range tasks {
+		if task.AgentID != 0 {
+			running = append(running, task)
+		} else {
+			pending = append(pending, task)
+		}
+	}
+
+	if len(running) != 0 {
+		log.Info().Msgf("queue: waiting up to %s for agents to resume %d running tasks", resumeGracePeriod, len(running))
+		if err := q.PushRunning(ctx, running, time.Now().Add(resumeGracePeriod)); err != nil {
+			log.Error().Err(err).Msg("PushRunning failed")
+		}
+	}
+	if err := q.PushAtOnce(ctx, pending); err != nil {
 		log.Error().Err(err).Msg("PushAtOnce failed")
 	}
 	return &persistentQueue{q, s}
 }
 
+// resubmitObserver is implemented by queues handing out expired tasks again.
+type resubmitObserver interface {
+	onResubmit(fn func(task *model.Task))
+}
+
 type persistentQueue struct {
 	Queue
 	store store.Store
@@ -59,19 +93,31 @@ func (q *persistentQueue) PushAtOnce(c context.Context, tasks []*model.Task) err
 }
 
 // Poll retrieves and removes a task head of this queue.
+// The task stays in the backup with its agent till it is done, so the agent can resume it after a restart.
 func (q *persistentQueue) Poll(c context.Context, agentID int64, f FilterFn) (*model.Task, error) {
 	task, err := q.Queue.Poll(c, agentID, f)
 	if task != nil {
-		log.Debug().Msgf("pull queue item: %s: remove from backup", task.ID)
-		if deleteErr := q.store.TaskDelete(task.ID); deleteErr != nil {
-			log.Error().Err(deleteErr).Msgf("pull queue item: %s: failed to remove from backup", task.ID)
+		log.Debug().Msgf("pull queue item: %s: update backup", task.ID)
+		if updateErr := q.store.TaskUpdate(task); updateErr != nil {
+			log.Error().Err(updateErr).Msgf("pull queue item: %s: failed to update backup", task.ID)
 		} else {
-			log.Debug().Msgf("pull queue item: %s: successfully removed from backup", task.ID)
+			log.Debug().Msgf("pull queue item: %s: successfully updated backup", task.ID)
 		}
 	}
 	return task, err
 }
 
+// Done signals the task is complete.
+func (q *persistentQueue) Done(c context.Context, id string, exitStatus model.StatusValue) error {
+	if err := q.Queue.Done(c, id, exitStatus); err != nil {
+		return err
+	}
+	if err := q.store.TaskDelete(id); err != nil && !errors.Is(err, types.RecordNotExist) {
+		return err
+	}
+	return nil
+}
+
 // EvictAtOnce removes multiple pending tasks from the queue.
 func (q *persistentQueue) EvictAtOnce(c context.Context, ids []string) error {
 	if err := q.Queue.EvictAtOnce(c, ids); err != nil {
@@ -0,0 +1,75 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+)
+
+func TestPersistentQueueResume(t *testing.T) {
+	ctx, cancel := context.WithCancelCause(t.Context())
+	t.Cleanup(func() { cancel(nil) })
+
+	t.Run("agents resume running tasks", func(t *testing.T) {
+		pending := &model.Task{ID: "1"}
+		running := &model.Task{ID: "2", AgentID: 3}
+
+		store := store_mocks.NewMockStore(t)
+		store.On("TaskList").Return([]*model.Task{pending, running}, nil)
+		q := WithTaskStore(ctx, NewMemoryQueue(ctx), store, time.Hour)
+
+		info := q.Info(ctx)
+		assert.Equal(t, []*model.Task{pending}, info.Pending)
+		assert.Equal(t, []*model.Task{running}, info.Running)
+
+		assert.ErrorIs(t, q.Extend(ctx, 4, "2"), ErrAgentMissMatch)
+		assert.NoError(t, q.Extend(ctx, 3, "2"))
+
+		store.On("TaskUpdate", mock.Anything).Return(nil)
+		got, err := q.Poll(ctx, 5, filterFnTrue)
+		assert.NoError(t, err)
+		assert.Equal(t, pending, got)
+		store.AssertCalled(t, "TaskUpdate", &model.Task{ID: "1", AgentID: 5, Queued: pending.Queued})
+
+		store.On("TaskDelete", "2").Return(nil)
+		assert.NoError(t, q.Done(ctx, "2", model.StatusSuccess))
+		assert.Len(t, q.Info(ctx).Running, 1)
+	})
+
+	t.Run("tasks not resumed within the grace period are handed out again", func(t *testing.T) {
+		running := &model.Task{ID: "2", AgentID: 3}
+
+		store := store_mocks.NewMockStore(t)
+		store.On("TaskList").Return([]*model.Task{running}, nil)
+		store.On("TaskUpdate", &model.Task{ID: "2"}).Return(nil).Once()
+		q := WithTaskStore(ctx, NewMemoryQueue(ctx), store, 0)
+
+		waitForProcess()
+		info := q.Info(ctx)
+		assert.Empty(t, info.Running)
+		assert.Equal(t, []*model.Task{running}, info.Pending)
+		assert.ErrorIs(t, q.Extend(ctx, 3, "2"), ErrNotFound)
+		// the backup no longer assigns the task to the agent
+		store.AssertCalled(t, "TaskUpdate", &model.Task{ID: "2"})
+	})
+}
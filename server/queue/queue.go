@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store"
@@ -50,8 +51,18 @@ type InfoT struct {
 		Running       int `json:"running_count"`
 	} `json:"stats"`
 	Paused bool `json:"paused"`
+	// Schedule holds the effective position of the pending tasks by task id.
+	Schedule map[string]TaskSchedule `json:"schedule"`
 } //	@name	InfoT
 
+// TaskSchedule describes the effective position of a pending task in the queue.
+type TaskSchedule struct {
+	// Position is the position the task is handed out at, 0 if it is blocked.
+	Position int `json:"position"`
+	// Reason explains the position of the task.
+	Reason string `json:"reason"`
+} //	@name	TaskSchedule
+
 func (t *InfoT) String() string {
 	var sb strings.Builder
 
@@ -73,7 +84,8 @@ func (t *InfoT) String() string {
 // FilterFn filters tasks in the queue. If the Filter returns false,
 // the Task is skipped and not returned to the subscriber.
 // The int return value represents the matching score (higher is better).
-type FilterFn func(*model.Task) (bool, int)
+// The running tasks of the queue are passed along, e.g. to enforce quotas.
+type FilterFn func(task *model.Task, running []*model.Task) (bool, int)
 
 // Queue defines a task queue for scheduling tasks among
 // a pool of workers.
@@ -81,6 +93,10 @@ type Queue interface {
 	// PushAtOnce pushes multiple tasks to the tail of this queue.
 	PushAtOnce(c context.Context, tasks []*model.Task) error
 
+	// PushRunning adds tasks that were running before a restart of the server back as running.
+	// Tasks not extended by their agent until the deadline are handed out again.
+	PushRunning(c context.Context, tasks []*model.Task, deadline time.Time) error
+
 	// Poll retrieves and removes a task head of this queue.
 	Poll(c context.Context, agentID int64, f FilterFn) (*model.Task, error)
 
@@ -117,8 +133,30 @@ type Queue interface {
 
 // Config holds the configuration for the queue.
 type Config struct {
-	Backend Type
-	Store   store.Store
+	Backend    Type
+	Store      store.Store
+	Scheduling SchedulingConfig
+	// ResumeGracePeriod is the time agents have to resume the tasks they ran before a restart.
+	ResumeGracePeriod time.Duration
+}
+
+// SchedulingConfig configures the order pending tasks are handed out in.
+// Tasks are ordered by their priority first, then by the share of running
+// tasks of their org relative to its weight and finally by their age.
+type SchedulingConfig struct {
+	// MaxRunningPerOrg limits the running tasks of an org, 0 means unlimited.
+	MaxRunningPerOrg int
+	// MaxRunningPerRepo limits the running tasks of a repo, 0 means unlimited.
+	MaxRunningPerRepo int
+	// OrgWeights are the fair-share weights by org id, orgs without weight have a weight of 1.
+	OrgWeights map[int64]int
+}
+
+func (c SchedulingConfig) orgWeight(orgID int64) int {
+	if weight, ok := c.OrgWeights[orgID]; ok && weight > 0 {
+		return weight
+	}
+	return 1
 }
 
 // Queue type.
@@ -134,9 +172,9 @@ func New(ctx context.Context, config Config) (Queue, error) {
 
 	switch config.Backend {
 	case TypeMemory:
-		q = NewMemoryQueue(ctx)
+		q = newMemoryQueue(ctx, config.Scheduling)
 		if config.Store != nil {
-			q = WithTaskStore(ctx, q, config.Store)
+			q = WithTaskStore(ctx, q, config.Store, config.ResumeGracePeriod)
 		}
 	default:
 		return nil, fmt.Errorf("unsupported queue backend: %s", config.Backend)
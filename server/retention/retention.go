@@ -0,0 +1,264 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	prometheus_auto "github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	log_service "go.woodpecker-ci.org/woodpecker/v3/server/services/log"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+)
+
+var (
+	prunedPipelines = prometheus_auto.NewCounter(prometheus.CounterOpts{
+		Namespace: "woodpecker",
+		Name:      "retention_pruned_pipelines_total",
+		Help:      "Total number of pipelines deleted by the retention policies.",
+	})
+	prunedLogs = prometheus_auto.NewCounter(prometheus.CounterOpts{
+		Namespace: "woodpecker",
+		Name:      "retention_pruned_logs_total",
+		Help:      "Total number of pipelines whose logs got deleted by the retention policies.",
+	})
+	pruneErrors = prometheus_auto.NewCounter(prometheus.CounterOpts{
+		Namespace: "woodpecker",
+		Name:      "retention_errors_total",
+		Help:      "Total number of pipelines the retention policies failed to prune.",
+	})
+	lastRun = prometheus_auto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "woodpecker",
+		Name:      "retention_last_run_timestamp_seconds",
+		Help:      "Unix timestamp of the last completed retention run.",
+	})
+	lastRunDuration = prometheus_auto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "woodpecker",
+		Name:      "retention_last_run_duration_seconds",
+		Help:      "Duration of the last completed retention run.",
+	})
+)
+
+// Result summarizes a retention run.
+type Result struct {
+	PrunedPipelines int
+	PrunedLogs      int
+	Errors          int
+}
+
+// Run prunes pipelines and logs according to the retention policies in the given interval.
+func Run(ctx context.Context, store store.Store, logStore log_service.Service, interval time.Duration, global *model.RetentionPolicy) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+			start := time.Now()
+			log.Trace().Msg("retention: prune pipelines")
+
+			result, err := Prune(ctx, store, logStore, global, start)
+			if err != nil {
+				log.Error().Err(err).Msg("retention run failed")
+				continue
+			}
+
+			lastRun.Set(float64(time.Now().Unix()))
+			lastRunDuration.Set(time.Since(start).Seconds())
+			log.Debug().
+				Int("pipelines", result.PrunedPipelines).
+				Int("logs", result.PrunedLogs).
+				Int("errors", result.Errors).
+				Dur("duration", time.Since(start)).
+				Msg("retention run finished")
+		}
+	}
+}
+
+// Prune applies the retention policies to all repositories once.
+func Prune(ctx context.Context, store store.Store, logStore log_service.Service, global *model.RetentionPolicy, now time.Time) (*Result, error) {
+	policies, err := store.RetentionPolicyList()
+	if err != nil {
+		return nil, fmt.Errorf("could not load retention policies: %w", err)
+	}
+
+	orgPolicies := make(map[int64]*model.RetentionPolicy)
+	repoPolicies := make(map[int64]*model.RetentionPolicy)
+	for _, policy := range policies {
+		if policy.RepoID != 0 {
+			repoPolicies[policy.RepoID] = policy
+		} else if policy.OrgID != 0 {
+			orgPolicies[policy.OrgID] = policy
+		}
+	}
+
+	repos, err := store.RepoListAll(false, &model.ListOptions{All: true})
+	if err != nil {
+		return nil, fmt.Errorf("could not load repos: %w", err)
+	}
+
+	result := &Result{}
+	for _, repo := range repos {
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+
+		policy := Resolve(global, orgPolicies[repo.OrgID], repoPolicies[repo.ID])
+		if policy == nil || policy.IsEmpty() {
+			continue
+		}
+
+		if err := pruneRepo(ctx, store, logStore, repo, policy, now, result); err != nil {
+			log.Error().Err(err).Str("repo", repo.FullName).Msg("could not prune pipelines of repo")
+		}
+	}
+
+	return result, nil
+}
+
+// Resolve returns the most specific of the given policies.
+func Resolve(global, org, repo *model.RetentionPolicy) *model.RetentionPolicy {
+	switch {
+	case repo != nil:
+		return repo
+	case org != nil:
+		return org
+	}
+	return global
+}
+
+func pruneRepo(ctx context.Context, store store.Store, logStore log_service.Service, repo *model.Repo, policy *model.RetentionPolicy, now time.Time, result *Result) error {
+	pipelines, err := store.RetentionPipelineList(repo)
+	if err != nil {
+		return err
+	}
+
+	prune, pruneLogs := selectPipelines(pipelines, policy, now)
+
+	for _, pipeline := range prune {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if err := deletePipeline(store, logStore, pipeline); err != nil {
+			log.Error().Err(err).Str("repo", repo.FullName).Int64("pipeline", pipeline.Number).Msg("could not delete pipeline")
+			pruneErrors.Inc()
+			result.Errors++
+			continue
+		}
+		prunedPipelines.Inc()
+		result.PrunedPipelines++
+	}
+
+	for _, pipeline := range pruneLogs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if err := deleteLogs(store, logStore, pipeline); err != nil {
+			log.Error().Err(err).Str("repo", repo.FullName).Int64("pipeline", pipeline.Number).Msg("could not delete pipeline logs")
+			pruneErrors.Inc()
+			result.Errors++
+			continue
+		}
+		if err := store.RetentionSetLogsPruned(pipeline); err != nil {
+			return err
+		}
+		prunedLogs.Inc()
+		result.PrunedLogs++
+	}
+
+	return nil
+}
+
+// selectPipelines returns the pipelines to delete and the pipelines whose logs should be deleted.
+// The pipelines have to be ordered newest first.
+func selectPipelines(pipelines []*model.Pipeline, policy *model.RetentionPolicy, now time.Time) (prune, pruneLogs []*model.Pipeline) {
+	keepAfter := now.AddDate(0, 0, -int(policy.KeepDays)).Unix()
+	logsBefore := now.AddDate(0, 0, -int(policy.LogDays)).Unix()
+	latestBranches := make(map[string]struct{})
+	latestDeployments := make(map[string]struct{})
+
+	for i, pipeline := range pipelines {
+		keep := !policy.PrunesPipelines() || !isDone(pipeline)
+		if policy.KeepLast > 0 && int64(i) < policy.KeepLast {
+			keep = true
+		}
+		if policy.KeepDays > 0 && pipeline.Created >= keepAfter {
+			keep = true
+		}
+
+		switch pipeline.Event {
+		case model.EventDeploy:
+			if _, ok := latestDeployments[pipeline.DeployTo]; !ok {
+				latestDeployments[pipeline.DeployTo] = struct{}{}
+				keep = keep || policy.KeepLatestDeployment
+			}
+		case model.EventPush, model.EventManual, model.EventCron:
+			if _, ok := latestBranches[pipeline.Branch]; !ok {
+				latestBranches[pipeline.Branch] = struct{}{}
+				keep = keep || policy.KeepLatestPerBranch
+			}
+		}
+
+		if !keep {
+			prune = append(prune, pipeline)
+			continue
+		}
+
+		if policy.LogDays > 0 && !pipeline.LogsPruned && pipeline.Created < logsBefore && isDone(pipeline) {
+			pruneLogs = append(pruneLogs, pipeline)
+		}
+	}
+
+	return prune, pruneLogs
+}
+
+// isDone returns true if the pipeline will not change anymore.
+func isDone(pipeline *model.Pipeline) bool {
+	switch pipeline.Status {
+	case model.StatusRunning, model.StatusPending, model.StatusBlocked:
+		return false
+	}
+	return true
+}
+
+func deletePipeline(store store.Store, logStore log_service.Service, pipeline *model.Pipeline) error {
+	if !pipeline.LogsPruned {
+		if err := deleteLogs(store, logStore, pipeline); err != nil {
+			return err
+		}
+	}
+	return store.DeletePipeline(pipeline)
+}
+
+func deleteLogs(store store.Store, logStore log_service.Service, pipeline *model.Pipeline) error {
+	steps, err := store.StepList(pipeline)
+	if err != nil {
+		return err
+	}
+
+	for _, step := range steps {
+		if lErr := logStore.LogDelete(step); lErr != nil {
+			err = errors.Join(err, lErr)
+		}
+	}
+	return err
+}
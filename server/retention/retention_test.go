@@ -0,0 +1,160 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retention
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	log_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/log/mocks"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+)
+
+const day = 24 * 60 * 60
+
+func numbers(pipelines []*model.Pipeline) []int64 {
+	n := make([]int64, 0, len(pipelines))
+	for _, p := range pipelines {
+		n = append(n, p.Number)
+	}
+	return n
+}
+
+func TestSelectPipelines(t *testing.T) {
+	now := time.Unix(100*day, 0)
+	pipelines := []*model.Pipeline{
+		{Number: 8, Event: model.EventPush, Branch: "main", Status: model.StatusRunning, Created: 99 * day},
+		{Number: 7, Event: model.EventPull, Branch: "main", Status: model.StatusSuccess, Created: 98 * day},
+		{Number: 6, Event: model.EventPush, Branch: "main", Status: model.StatusSuccess, Created: 90 * day},
+		{Number: 5, Event: model.EventDeploy, DeployTo: "prod", Status: model.StatusSuccess, Created: 80 * day},
+		{Number: 4, Event: model.EventPush, Branch: "dev", Status: model.StatusFailure, Created: 70 * day},
+		{Number: 3, Event: model.EventPush, Branch: "main", Status: model.StatusSuccess, Created: 60 * day, LogsPruned: true},
+		{Number: 2, Event: model.EventDeploy, DeployTo: "prod", Status: model.StatusSuccess, Created: 50 * day},
+		{Number: 1, Event: model.EventPush, Branch: "main", Status: model.StatusPending, Created: 40 * day},
+	}
+
+	tests := []struct {
+		name      string
+		policy    *model.RetentionPolicy
+		prune     []int64
+		pruneLogs []int64
+	}{
+		{
+			name:      "empty policy",
+			policy:    &model.RetentionPolicy{},
+			prune:     []int64{},
+			pruneLogs: []int64{},
+		},
+		{
+			name:      "keep last",
+			policy:    &model.RetentionPolicy{KeepLast: 3},
+			prune:     []int64{5, 4, 3, 2},
+			pruneLogs: []int64{},
+		},
+		{
+			name:      "keep days",
+			policy:    &model.RetentionPolicy{KeepDays: 25},
+			prune:     []int64{4, 3, 2},
+			pruneLogs: []int64{},
+		},
+		{
+			name:      "keep days and last",
+			policy:    &model.RetentionPolicy{KeepDays: 5, KeepLast: 4},
+			prune:     []int64{4, 3, 2},
+			pruneLogs: []int64{},
+		},
+		{
+			name:      "keep latest",
+			policy:    &model.RetentionPolicy{KeepLast: 1, KeepLatestPerBranch: true, KeepLatestDeployment: true},
+			prune:     []int64{7, 6, 3, 2},
+			pruneLogs: []int64{},
+		},
+		{
+			name:      "logs only",
+			policy:    &model.RetentionPolicy{LogDays: 20},
+			prune:     []int64{},
+			pruneLogs: []int64{4, 2},
+		},
+		{
+			name:      "logs and pipelines",
+			policy:    &model.RetentionPolicy{KeepDays: 40, LogDays: 5},
+			prune:     []int64{2},
+			pruneLogs: []int64{6, 5, 4},
+		},
+		{
+			name:      "pruned pipelines have no logs to prune",
+			policy:    &model.RetentionPolicy{KeepDays: 25, LogDays: 5},
+			prune:     []int64{4, 3, 2},
+			pruneLogs: []int64{6, 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prune, pruneLogs := selectPipelines(pipelines, tt.policy, now)
+			assert.Equal(t, tt.prune, numbers(prune))
+			assert.Equal(t, tt.pruneLogs, numbers(pruneLogs))
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	global := &model.RetentionPolicy{KeepLast: 1}
+	org := &model.RetentionPolicy{OrgID: 1, KeepLast: 2}
+	repo := &model.RetentionPolicy{RepoID: 1, KeepLast: 3}
+
+	assert.Equal(t, repo, Resolve(global, org, repo))
+	assert.Equal(t, org, Resolve(global, org, nil))
+	assert.Equal(t, global, Resolve(global, nil, nil))
+	assert.Nil(t, Resolve(nil, nil, nil))
+}
+
+func TestPrune(t *testing.T) {
+	now := time.Unix(100*day, 0)
+	repo1 := &model.Repo{ID: 1, OrgID: 1, FullName: "org/repo1"}
+	repo2 := &model.Repo{ID: 2, OrgID: 2, FullName: "other/repo2"}
+
+	old := &model.Pipeline{ID: 11, Number: 1, Event: model.EventPush, Status: model.StatusSuccess, Created: 10 * day}
+	recent := &model.Pipeline{ID: 12, Number: 2, Event: model.EventPush, Status: model.StatusSuccess, Created: 95 * day}
+	step := &model.Step{ID: 21, PipelineID: 11}
+	recentStep := &model.Step{ID: 22, PipelineID: 12}
+
+	store := store_mocks.NewMockStore(t)
+	logStore := log_mocks.NewMockService(t)
+
+	store.On("RetentionPolicyList").Return([]*model.RetentionPolicy{
+		{OrgID: 1, KeepDays: 30, LogDays: 1},
+	}, nil)
+	store.On("RepoListAll", false, mock.Anything).Return([]*model.Repo{repo1, repo2}, nil)
+
+	// repo1 uses the org policy
+	store.On("RetentionPipelineList", repo1).Return([]*model.Pipeline{recent, old}, nil)
+	store.On("StepList", old).Return([]*model.Step{step}, nil)
+	store.On("StepList", recent).Return([]*model.Step{recentStep}, nil)
+	store.On("DeletePipeline", old).Return(nil)
+	store.On("RetentionSetLogsPruned", recent).Return(nil)
+	logStore.On("LogDelete", step).Return(nil)
+	logStore.On("LogDelete", recentStep).Return(nil)
+
+	// repo2 has no policy besides the empty global one
+
+	result, err := Prune(t.Context(), store, logStore, &model.RetentionPolicy{}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, &Result{PrunedPipelines: 1, PrunedLogs: 1}, result)
+}
@@ -57,6 +57,9 @@ func apiRoutes(e *gin.RouterGroup) {
 				orgBase.Use(session.MustOrg())
 				orgBase.GET("/permissions", api.GetOrgPermissions)
 				orgBase.GET("", session.MustOrgMember(false), api.GetOrg)
+				orgBase.GET("/stats", session.MustOrgMember(false), api.GetOrgStats)
+				orgBase.GET("/templates", session.MustOrgMember(false), api.GetOrgConfigTemplateList)
+				orgBase.GET("/templates/:template", session.MustOrgMember(false), api.GetOrgConfigTemplate)
 
 				org := orgBase.Group("")
 				{
@@ -69,12 +72,20 @@ func apiRoutes(e *gin.RouterGroup) {
 					org.PATCH("/secrets/:secret", api.PatchOrgSecret)
 					org.DELETE("/secrets/:secret", api.DeleteOrgSecret)
 
+					org.POST("/templates", api.PostOrgConfigTemplate)
+					org.PATCH("/templates/:template", api.PatchOrgConfigTemplate)
+					org.DELETE("/templates/:template", api.DeleteOrgConfigTemplate)
+
 					org.GET("/registries", api.GetOrgRegistryList)
 					org.POST("/registries", api.PostOrgRegistry)
 					org.GET("/registries/:registry", api.GetOrgRegistry)
 					org.PATCH("/registries/:registry", api.PatchOrgRegistry)
 					org.DELETE("/registries/:registry", api.DeleteOrgRegistry)
 
+					org.GET("/retention", api.GetOrgRetention)
+					org.PATCH("/retention", api.PatchOrgRetention)
+					org.DELETE("/retention", api.DeleteOrgRetention)
+
 					if !server.Config.Agent.DisableUserRegisteredAgentRegistration {
 						org.GET("/agents", api.GetOrgAgents)
 						org.POST("/agents", api.PostOrgAgent)
@@ -106,6 +117,7 @@ func apiRoutes(e *gin.RouterGroup) {
 
 					repo.GET("/branches", api.GetRepoBranches)
 					repo.GET("/pull_requests", api.GetRepoPullRequests)
+					repo.GET("/stats", api.GetRepoStats)
 
 					repo.GET("/pipelines", api.GetPipelines)
 					repo.POST("/pipelines", session.MustPush, api.CreatePipeline)
@@ -120,6 +132,7 @@ func apiRoutes(e *gin.RouterGroup) {
 					repo.POST("/pipelines/:number/approve", session.MustPush, api.PostApproval)
 					repo.POST("/pipelines/:number/decline", session.MustPush, api.PostDecline)
 
+					repo.GET("/logs/search", api.SearchRepoLogs)
 					repo.GET("/logs/:number/:stepId", api.GetStepLogs)
 					repo.DELETE("/logs/:number/:stepId", session.MustPush, api.DeleteStepLogs)
 
@@ -154,6 +167,9 @@ func apiRoutes(e *gin.RouterGroup) {
 					repo.POST("/chown", session.MustRepoAdmin(), api.ChownRepo)
 					repo.POST("/repair", session.MustRepoAdmin(), api.RepairRepo)
 					repo.POST("/move", session.MustRepoAdmin(), api.MoveRepo)
+					repo.GET("/retention", session.MustRepoAdmin(), api.GetRepoRetention)
+					repo.PATCH("/retention", session.MustRepoAdmin(), api.PatchRepoRetention)
+					repo.DELETE("/retention", session.MustRepoAdmin(), api.DeleteRepoRetention)
 				}
 			}
 		}
@@ -183,6 +199,7 @@ func apiRoutes(e *gin.RouterGroup) {
 			queue.POST("/pause", api.PauseQueue)
 			queue.POST("/resume", api.ResumeQueue)
 			queue.GET("/norunningpipelines", api.BlockTilQueueHasRunningItem)
+			queue.GET("/tasks/:task_id/explain", api.GetQueueTaskExplanation)
 		}
 
 		// global secrets can be read without actual values by any user
@@ -229,10 +246,23 @@ func apiRoutes(e *gin.RouterGroup) {
 			agentBase.POST("", api.PostAgent)
 			agentBase.GET("/:agent_id", api.GetAgent)
 			agentBase.GET("/:agent_id/tasks", api.GetAgentTasks)
+			agentBase.POST("/:agent_id/drain", api.PostAgentDrain)
+			agentBase.DELETE("/:agent_id/drain", api.DeleteAgentDrain)
+			agentBase.GET("/:agent_id/drain", api.GetAgentDrain)
 			agentBase.PATCH("/:agent_id", api.PatchAgent)
 			agentBase.DELETE("/:agent_id", api.DeleteAgent)
 		}
 
+		agentPoolBase := apiBase.Group("/agent-pools")
+		{
+			agentPoolBase.Use(session.MustAdmin())
+			agentPoolBase.GET("", api.GetAgentPools)
+			agentPoolBase.POST("", api.PostAgentPool)
+			agentPoolBase.GET("/:pool_id", api.GetAgentPool)
+			agentPoolBase.PATCH("/:pool_id", api.PatchAgentPool)
+			agentPoolBase.DELETE("/:pool_id", api.DeleteAgentPool)
+		}
+
 		apiBase.GET("/forges", api.GetForges)
 		apiBase.GET("/forges/:forgeId", api.GetForge)
 		forgeBase := apiBase.Group("/forges")
@@ -246,6 +276,7 @@ func apiRoutes(e *gin.RouterGroup) {
 		apiBase.GET("/signature/public-key", session.MustUser(), api.GetSignaturePublicKey)
 
 		apiBase.POST("/hook", api.PostHook)
+		apiBase.POST("/hook/forges/:forgeId", api.PostForgeHook)
 
 		stream := apiBase.Group("/stream")
 		{
@@ -0,0 +1,127 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/include"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+)
+
+type includeResolver struct {
+	parent Service
+	store  store.Store
+}
+
+// NewInclude returns a service resolving the include and extends keys of the
+// config files returned by the parent service.
+func NewInclude(parent Service, store store.Store) Service {
+	return &includeResolver{
+		parent: parent,
+		store:  store,
+	}
+}
+
+func (i *includeResolver) Fetch(ctx context.Context, forge forge.Forge, user *model.User, repo *model.Repo, pipeline *model.Pipeline, oldConfigData []*types.FileMeta, restart bool) ([]*types.FileMeta, error) {
+	files, err := i.parent.Fetch(ctx, forge, user, repo, pipeline, oldConfigData, restart)
+	if err != nil {
+		return files, err
+	}
+
+	loader := &includeLoader{
+		forge:    forge,
+		store:    i.store,
+		user:     user,
+		repo:     repo,
+		pipeline: pipeline,
+		repos:    make(map[string]*model.Repo),
+	}
+
+	resolved := make([]*types.FileMeta, 0, len(files))
+	for _, file := range files {
+		data, err := include.Resolve(ctx, file.Data, loader.load)
+		if err != nil {
+			return nil, fmt.Errorf("could not resolve includes of %s: %w", file.Name, err)
+		}
+		resolved = append(resolved, &types.FileMeta{Name: file.Name, Data: data})
+	}
+	return resolved, nil
+}
+
+type includeLoader struct {
+	forge    forge.Forge
+	store    store.Store
+	user     *model.User
+	repo     *model.Repo
+	pipeline *model.Pipeline
+	// repos caches the included repositories by full name
+	repos map[string]*model.Repo
+}
+
+func (l *includeLoader) load(ctx context.Context, source *include.Source) ([]byte, error) {
+	if source.Template != "" {
+		template, err := l.store.ConfigTemplateFind(l.repo.OrgID, source.Template)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(template.Data), nil
+	}
+
+	if source.Repo == "" {
+		return l.forge.File(ctx, l.user, l.repo, l.pipeline, source.Path)
+	}
+
+	// files of other repositories are read with the token of the repo owner, so the config of
+	// a fork must not be able to point to them
+	if l.pipeline.IsPullRequest() && l.pipeline.FromFork {
+		return nil, fmt.Errorf("including %s is not allowed for pull requests from forks", source.Repo)
+	}
+
+	repo, err := l.lookupRepo(ctx, source.Repo)
+	if err != nil {
+		return nil, err
+	}
+	if repo.IsSCMPrivate && !strings.EqualFold(repo.Owner, l.repo.Owner) {
+		return nil, fmt.Errorf("including %s is not allowed, private repositories can only be included by repositories of the same owner", source.Repo)
+	}
+	// the forge reads files at the commit of the pipeline, so a pipeline pointing to the ref is used
+	return l.forge.File(ctx, l.user, repo, &model.Pipeline{Commit: source.Ref}, source.Path)
+}
+
+// lookupRepo gets the repository from the forge, this makes sure the user has access to it.
+func (l *includeLoader) lookupRepo(ctx context.Context, fullName string) (*model.Repo, error) {
+	if repo, ok := l.repos[fullName]; ok {
+		return repo, nil
+	}
+
+	// the owner might contain slashes, e.g. for nested GitLab groups
+	idx := strings.LastIndex(fullName, "/")
+	if idx <= 0 || idx == len(fullName)-1 {
+		return nil, fmt.Errorf("invalid repository name %s", fullName)
+	}
+	owner, name := fullName[:idx], fullName[idx+1:]
+	repo, err := l.forge.Repo(ctx, l.user, "", owner, name)
+	if err != nil {
+		return nil, fmt.Errorf("could not access repository %s: %w", fullName, err)
+	}
+	l.repos[fullName] = repo
+	return repo, nil
+}
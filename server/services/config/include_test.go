@@ -0,0 +1,139 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	forge_mocks "go.woodpecker-ci.org/woodpecker/v3/server/forge/mocks"
+	forge_types "go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/config"
+	config_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/config/mocks"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+)
+
+func TestFetchWithIncludes(t *testing.T) {
+	t.Parallel()
+
+	user := &model.User{Login: "user"}
+	repo := &model.Repo{ID: 1, OrgID: 2, Owner: "org", Name: "app", FullName: "org/app"}
+	templateRepo := &model.Repo{ID: 3, Owner: "org", Name: "templates", FullName: "org/templates"}
+	pipeline := &model.Pipeline{Commit: "abc"}
+
+	files := []*forge_types.FileMeta{
+		{Name: ".woodpecker/build.yaml", Data: []byte("steps:\n  - name: build\n    image: alpine\n")},
+		{Name: ".woodpecker/test.yaml", Data: []byte(`
+include:
+  - repo: org/templates
+    ref: v1
+    path: go.yaml
+  - template: notify
+steps:
+  - name: test
+    image: golang
+`)},
+	}
+
+	parent := config_mocks.NewMockService(t)
+	parent.On("Fetch", mock.Anything, mock.Anything, user, repo, pipeline, mock.Anything, false).Return(files, nil)
+
+	forge := forge_mocks.NewMockForge(t)
+	forge.On("Repo", mock.Anything, user, model.ForgeRemoteID(""), "org", "templates").Return(templateRepo, nil)
+	forge.On("File", mock.Anything, user, templateRepo, &model.Pipeline{Commit: "v1"}, "go.yaml").Return([]byte("labels:\n  os: linux\n"), nil)
+
+	store := store_mocks.NewMockStore(t)
+	store.On("ConfigTemplateFind", int64(2), "notify").Return(&model.ConfigTemplate{
+		Data: "steps:\n  - name: notify\n    image: curl\n",
+	}, nil)
+
+	result, err := config.NewInclude(parent, store).Fetch(t.Context(), forge, user, repo, pipeline, nil, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []*forge_types.FileMeta{
+		files[0],
+		{Name: ".woodpecker/test.yaml", Data: []byte(`labels:
+  os: linux
+steps:
+  - name: notify
+    image: curl
+  - name: test
+    image: golang
+`)},
+	}, result)
+}
+
+func TestFetchWithIncludesDenied(t *testing.T) {
+	t.Parallel()
+
+	user := &model.User{Login: "user"}
+	repo := &model.Repo{ID: 1, OrgID: 2, Owner: "org", Name: "app", FullName: "org/app"}
+	files := []*forge_types.FileMeta{
+		{Name: ".woodpecker/test.yaml", Data: []byte(`
+include:
+  - repo: other/secrets
+    ref: main
+    path: go.yaml
+steps:
+  - name: test
+    image: golang
+`)},
+	}
+
+	t.Run("private repo of other owner", func(t *testing.T) {
+		t.Parallel()
+
+		pipeline := &model.Pipeline{Event: model.EventPush, Commit: "abc"}
+		parent := config_mocks.NewMockService(t)
+		parent.On("Fetch", mock.Anything, mock.Anything, user, repo, pipeline, mock.Anything, false).Return(files, nil)
+
+		forge := forge_mocks.NewMockForge(t)
+		forge.On("Repo", mock.Anything, user, model.ForgeRemoteID(""), "other", "secrets").Return(&model.Repo{
+			ID: 3, Owner: "other", Name: "secrets", FullName: "other/secrets", IsSCMPrivate: true,
+		}, nil)
+
+		_, err := config.NewInclude(parent, store_mocks.NewMockStore(t)).Fetch(t.Context(), forge, user, repo, pipeline, nil, false)
+		assert.ErrorContains(t, err, "private repositories can only be included by repositories of the same owner")
+	})
+
+	t.Run("public repo of other owner", func(t *testing.T) {
+		t.Parallel()
+
+		pipeline := &model.Pipeline{Event: model.EventPush, Commit: "abc"}
+		publicRepo := &model.Repo{ID: 3, Owner: "other", Name: "secrets", FullName: "other/secrets"}
+		parent := config_mocks.NewMockService(t)
+		parent.On("Fetch", mock.Anything, mock.Anything, user, repo, pipeline, mock.Anything, false).Return(files, nil)
+
+		forge := forge_mocks.NewMockForge(t)
+		forge.On("Repo", mock.Anything, user, model.ForgeRemoteID(""), "other", "secrets").Return(publicRepo, nil)
+		forge.On("File", mock.Anything, user, publicRepo, &model.Pipeline{Commit: "main"}, "go.yaml").Return([]byte("labels:\n  os: linux\n"), nil)
+
+		_, err := config.NewInclude(parent, store_mocks.NewMockStore(t)).Fetch(t.Context(), forge, user, repo, pipeline, nil, false)
+		assert.NoError(t, err)
+	})
+
+	t.Run("pull request from fork", func(t *testing.T) {
+		t.Parallel()
+
+		pipeline := &model.Pipeline{Event: model.EventPull, FromFork: true, Commit: "abc"}
+		parent := config_mocks.NewMockService(t)
+		parent.On("Fetch", mock.Anything, mock.Anything, user, repo, pipeline, mock.Anything, false).Return(files, nil)
+
+		_, err := config.NewInclude(parent, store_mocks.NewMockStore(t)).Fetch(t.Context(), forge_mocks.NewMockForge(t), user, repo, pipeline, nil, false)
+		assert.ErrorContains(t, err, "not allowed for pull requests from forks")
+	})
+}
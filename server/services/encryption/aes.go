@@ -17,6 +17,7 @@ package encryption
 import (
 	"crypto/cipher"
 	"encoding/base64"
+	"errors"
 	"fmt"
 
 	"github.com/google/tink/go/subtle/random"
@@ -27,6 +28,7 @@ import (
 
 type aesEncryptionService struct {
 	cipher  cipher.AEAD
+	key     []byte
 	keyID   string
 	store   store.Store
 	clients []types.EncryptionClient
@@ -51,6 +53,9 @@ func (svc *aesEncryptionService) Decrypt(ciphertext, associatedData string) (str
 	if err != nil {
 		return "", fmt.Errorf(errTemplateBase64DecryptionFailed, err)
 	}
+	if len(bytes) < AES_GCM_SIV_NonceSize {
+		return "", fmt.Errorf(errTemplateDecryptionFailed, errors.New(errMessageCiphertextTooShort))
+	}
 
 	nonce := bytes[:AES_GCM_SIV_NonceSize]
 	message := bytes[AES_GCM_SIV_NonceSize:]
@@ -36,6 +36,7 @@ func (svc *aesEncryptionService) loadCipher(password string) error {
 		return fmt.Errorf(errTemplateAesFailedGeneratingKeyID, err)
 	}
 	svc.keyID = string(keyHash)
+	svc.key = key
 
 	block, err := aes.NewCipher(key)
 	if err != nil {
@@ -58,11 +59,13 @@ func (svc *aesEncryptionService) validateKey() error {
 		return fmt.Errorf(errTemplateFailedLoadingServerConfig, err)
 	}
 
+	// the key id is a salted hash, so it has to be compared with the key instead of the stored id
 	plaintext, err := svc.Decrypt(ciphertextSample, keyIDAssociatedData)
-	if plaintext != svc.keyID {
+	if err != nil {
+		return errEncryptionKeyInvalid
+	}
+	if bcrypt.CompareHashAndPassword([]byte(plaintext), svc.key) != nil {
 		return errEncryptionKeyInvalid
-	} else if err != nil {
-		return err
 	}
 	return nil
 }
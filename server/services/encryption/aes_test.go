@@ -19,6 +19,9 @@ import (
 
 	"github.com/google/tink/go/subtle/random"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
 )
 
 func TestShortMessageLongKey(t *testing.T) {
@@ -48,3 +51,28 @@ func TestLongMessageShortKey(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, input, output)
 }
+
+func TestValidateKey(t *testing.T) {
+	var sample string
+	s := store_mocks.NewMockStore(t)
+	s.On("ServerConfigSet", ciphertextSampleConfigKey, mock.Anything).Run(func(args mock.Arguments) {
+		sample = args.String(1)
+	}).Return(nil).Once()
+	s.On("ServerConfigGet", ciphertextSampleConfigKey).Return(func(string) (string, error) {
+		return sample, nil
+	})
+
+	registered := &aesEncryptionService{store: s}
+	assert.NoError(t, registered.loadCipher("key"))
+	assert.NoError(t, registered.updateCiphertextSample())
+
+	// the key id is a salted hash and differs between every load of the same key
+	reloaded := &aesEncryptionService{store: s}
+	assert.NoError(t, reloaded.loadCipher("key"))
+	assert.NotEqual(t, registered.keyID, reloaded.keyID)
+	assert.NoError(t, reloaded.validateKey())
+
+	other := &aesEncryptionService{store: s}
+	assert.NoError(t, other.loadCipher("other-key"))
+	assert.ErrorIs(t, other.validateKey(), errEncryptionKeyInvalid)
+}
@@ -23,6 +23,7 @@ const (
 	disableEncryptionConfigFlag  = "encryption-disable-flag"
 
 	ciphertextSampleConfigKey = "encryption-ciphertext-sample"
+	reencryptStateConfigKey   = "encryption-reencrypt-state"
 
 	keyTypeTink = "tink"
 	keyTypeRaw  = "raw"
@@ -52,21 +53,31 @@ const (
 	errTemplateEncryptionFailed              = "encryption error: %w"
 	errTemplateBase64DecryptionFailed        = "decryption error: Base64 decryption failed. Cause: %w"
 	errTemplateDecryptionFailed              = "decryption error: %w"
+	errTemplateFailedReencrypting            = "failed re-encrypting data: %w"
+	errTemplateReencryptDecryptFailed        = "failed to decrypt %s id=%d: %w"
+	errTemplateReencryptEncryptFailed        = "failed to encrypt %s id=%d: %w"
 
 	// Error messages.
 	errMessageTemplateUnsupportedKeyType = "unsupported encryption key type: %s"
 	errMessageCantUseBothServices        = "cannot use raw encryption key and tink keyset at the same time"
 	errMessageNoKeysProvided             = "encryption enabled but no keys provided"
 	errMessageFailedRotatingEncryption   = "failed rotating encryption"
+	errMessageReencryptDryRunFailed      = "dry run found data that can not be decrypted with the given keys"
+	errMessageReencryptNotEnabled        = "encryption is not enabled, the data is stored unencrypted and must be read without a key"
+	errMessageCiphertextTooShort         = "ciphertext too short"
 
 	// Log messages.
-	logMessageEncryptionEnabled       = "encryption enabled"
-	logMessageEncryptionDisabled      = "encryption disabled"
-	logMessageEncryptionKeyRegistered = "registered new encryption key"
-	logMessageClientsInitialized      = "initialized encryption on registered clients"
-	logMessageClientsEnabled          = "enabled encryption on registered service"
-	logMessageClientsRotated          = "updated encryption key on registered service"
-	logMessageClientsDecrypted        = "disabled encryption on registered service"
+	logMessageEncryptionEnabled        = "encryption enabled"
+	logMessageEncryptionDisabled       = "encryption disabled"
+	logMessageEncryptionKeyRegistered  = "registered new encryption key"
+	logMessageClientsInitialized       = "initialized encryption on registered clients"
+	logMessageClientsEnabled           = "enabled encryption on registered service"
+	logMessageClientsRotated           = "updated encryption key on registered service"
+	logMessageClientsDecrypted         = "disabled encryption on registered service"
+	logMessageReencryptResuming        = "resuming interrupted re-encryption"
+	logMessageReencryptFinished        = "re-encrypted all data with new encryption key"
+	logMessageReencryptVerifyFailed    = "could neither decrypt with old nor with new encryption key"
+	logMessageReencryptAlreadyMigrated = "already encrypted with new encryption key, skipping"
 )
 
 // Tink.
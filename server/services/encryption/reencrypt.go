@@ -0,0 +1,331 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/encryption/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+	storeTypes "go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+const (
+	ReencryptKindSecrets    = "secrets"
+	ReencryptKindRegistries = "registries"
+
+	defaultReencryptBatchSize = 100
+)
+
+// ReencryptOptions configures a re-encryption run.
+type ReencryptOptions struct {
+	// BatchSize is the number of records written before the progress is persisted.
+	BatchSize int
+	// DryRun only verifies that every ciphertext can be decrypted, nothing is written.
+	DryRun bool
+	// Restart ignores the progress persisted by a previous interrupted run.
+	Restart bool
+	// Progress is called after every batch.
+	Progress func(ReencryptProgress)
+}
+
+// ReencryptProgress reports the state of a re-encryption run for one kind of record.
+type ReencryptProgress struct {
+	Kind      string
+	Total     int
+	Processed int
+	// Skipped counts records that were already encrypted with the new key by an interrupted run.
+	Skipped int
+	// Failed lists the ids of records that could not be decrypted (only reported on dry runs).
+	Failed []int64
+}
+
+// reencryptState is persisted in the server config to resume interrupted runs.
+type reencryptState struct {
+	Secrets    int64 `json:"secrets"`
+	Registries int64 `json:"registries"`
+}
+
+// reencryptRecord abstracts the encrypted field of a secret or registry.
+type reencryptRecord struct {
+	id    int64
+	value *string
+	save  func() error
+}
+
+// Reencrypt decrypts every secret value and registry password with the `from` service and
+// encrypts it again with the `to` service. Progress is persisted after every batch so an
+// interrupted run continues where it stopped. On success the ciphertext sample is updated
+// so that the server accepts the new key on its next start.
+func Reencrypt(ctx context.Context, s store.Store, from, to types.EncryptionService, opts ReencryptOptions) error {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultReencryptBatchSize
+	}
+
+	state := &reencryptState{}
+	if !opts.Restart && !opts.DryRun {
+		var err error
+		state, err = loadReencryptState(s)
+		if err != nil {
+			return err
+		}
+	}
+
+	secrets, err := s.SecretListAll()
+	if err != nil {
+		return fmt.Errorf(errTemplateFailedReencrypting, err)
+	}
+	secretRecords := make([]*reencryptRecord, 0, len(secrets))
+	for _, secret := range secrets {
+		secretRecords = append(secretRecords, &reencryptRecord{
+			id:    secret.ID,
+			value: &secret.Value,
+			save:  func() error { return s.SecretUpdate(secret) },
+		})
+	}
+
+	registries, err := s.RegistryListAll()
+	if err != nil {
+		return fmt.Errorf(errTemplateFailedReencrypting, err)
+	}
+	registryRecords := make([]*reencryptRecord, 0, len(registries))
+	for _, registry := range registries {
+		registryRecords = append(registryRecords, &reencryptRecord{
+			id:    registry.ID,
+			value: &registry.Password,
+			save:  func() error { return s.RegistryUpdate(registry) },
+		})
+	}
+
+	job := &reencryptJob{store: s, from: from, to: to, opts: opts, state: state}
+
+	var dryRunFailed bool
+	for _, kind := range []struct {
+		name    string
+		records []*reencryptRecord
+		last    *int64
+	}{
+		{ReencryptKindSecrets, secretRecords, &state.Secrets},
+		{ReencryptKindRegistries, registryRecords, &state.Registries},
+	} {
+		progress, err := job.run(ctx, kind.name, kind.records, kind.last)
+		if err != nil {
+			return fmt.Errorf(errTemplateFailedReencrypting, err)
+		}
+		if len(progress.Failed) != 0 {
+			dryRunFailed = true
+		}
+	}
+
+	if opts.DryRun {
+		if dryRunFailed {
+			return errors.New(errMessageReencryptDryRunFailed)
+		}
+		return nil
+	}
+
+	if err := registerKey(s, to); err != nil {
+		return fmt.Errorf(errTemplateFailedReencrypting, err)
+	}
+
+	if err := s.ServerConfigDelete(reencryptStateConfigKey); err != nil && !errors.Is(err, storeTypes.RecordNotExist) {
+		return fmt.Errorf(errTemplateFailedReencrypting, err)
+	}
+	log.Warn().Msg(logMessageReencryptFinished)
+	return nil
+}
+
+// CheckCurrentKey verifies that the service matches the encryption state of the stored data:
+// a key is required if encryption is enabled and has to decrypt the registered ciphertext sample,
+// while unencrypted data must not be read with a key.
+func CheckCurrentKey(s store.Store, svc types.EncryptionService) error {
+	_, plain := svc.(*noEncryption)
+
+	sample, err := s.ServerConfigGet(ciphertextSampleConfigKey)
+	if errors.Is(err, storeTypes.RecordNotExist) {
+		if !plain {
+			return errors.New(errMessageReencryptNotEnabled)
+		}
+		return nil
+	} else if err != nil {
+		return fmt.Errorf(errTemplateFailedLoadingServerConfig, err)
+	}
+
+	if plain {
+		return errors.New(errMessageNoKeysProvided)
+	}
+	if _, err := svc.Decrypt(sample, keyIDAssociatedData); err != nil {
+		return errEncryptionKeyInvalid
+	}
+	return nil
+}
+
+type reencryptJob struct {
+	store store.Store
+	from  types.EncryptionService
+	to    types.EncryptionService
+	opts  ReencryptOptions
+	state *reencryptState
+}
+
+func (j *reencryptJob) run(ctx context.Context, kind string, records []*reencryptRecord, last *int64) (*ReencryptProgress, error) {
+	slices.SortFunc(records, func(a, b *reencryptRecord) int {
+		return cmp.Compare(a.id, b.id)
+	})
+
+	progress := &ReencryptProgress{Kind: kind, Total: len(records)}
+	for start := 0; start < len(records); start += j.opts.BatchSize {
+		if err := ctx.Err(); err != nil {
+			return progress, err
+		}
+
+		batch := records[start:min(start+j.opts.BatchSize, len(records))]
+		for _, record := range batch {
+			if record.id <= *last {
+				progress.Skipped++
+				continue
+			}
+
+			if j.opts.DryRun {
+				if !j.verify(kind, record) {
+					progress.Failed = append(progress.Failed, record.id)
+				}
+				progress.Processed++
+				continue
+			}
+
+			migrated, err := j.reencrypt(kind, record)
+			if err != nil {
+				return progress, err
+			}
+			if migrated {
+				progress.Processed++
+			} else {
+				progress.Skipped++
+			}
+		}
+
+		if !j.opts.DryRun {
+			*last = batch[len(batch)-1].id
+			if err := saveReencryptState(j.store, j.state); err != nil {
+				return progress, err
+			}
+		}
+
+		if j.opts.Progress != nil {
+			j.opts.Progress(*progress)
+		}
+	}
+
+	return progress, nil
+}
+
+// verify reports if the record can be decrypted either with the old or with the new key.
+func (j *reencryptJob) verify(kind string, record *reencryptRecord) bool {
+	associatedData := strconv.FormatInt(record.id, 10)
+	if _, err := j.from.Decrypt(*record.value, associatedData); err == nil {
+		return true
+	}
+	if _, err := j.to.Decrypt(*record.value, associatedData); err == nil {
+		return true
+	}
+	log.Error().Str("kind", kind).Int64("id", record.id).Msg(logMessageReencryptVerifyFailed)
+	return false
+}
+
+// reencrypt migrates one record and reports false if it was already encrypted with the new key.
+func (j *reencryptJob) reencrypt(kind string, record *reencryptRecord) (bool, error) {
+	associatedData := strconv.FormatInt(record.id, 10)
+
+	// plaintext "decrypts" with anything, so records of an interrupted run have to be detected
+	// by trying the new key first
+	if _, plain := j.from.(*noEncryption); plain {
+		if _, err := j.to.Decrypt(*record.value, associatedData); err == nil {
+			return false, nil
+		}
+	}
+
+	plaintext, err := j.from.Decrypt(*record.value, associatedData)
+	if err != nil {
+		if _, err := j.to.Decrypt(*record.value, associatedData); err == nil {
+			log.Warn().Str("kind", kind).Int64("id", record.id).Msg(logMessageReencryptAlreadyMigrated)
+			return false, nil
+		}
+		return false, fmt.Errorf(errTemplateReencryptDecryptFailed, kind, record.id, err)
+	}
+
+	ciphertext, err := j.to.Encrypt(plaintext, associatedData)
+	if err != nil {
+		return false, fmt.Errorf(errTemplateReencryptEncryptFailed, kind, record.id, err)
+	}
+	*record.value = ciphertext
+
+	if err := record.save(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func loadReencryptState(s store.Store) (*reencryptState, error) {
+	state := &reencryptState{}
+	raw, err := s.ServerConfigGet(reencryptStateConfigKey)
+	if errors.Is(err, storeTypes.RecordNotExist) {
+		return state, nil
+	} else if err != nil {
+		return nil, fmt.Errorf(errTemplateFailedLoadingServerConfig, err)
+	}
+
+	if err := json.Unmarshal([]byte(raw), state); err != nil {
+		return nil, fmt.Errorf(errTemplateFailedLoadingServerConfig, err)
+	}
+	log.Warn().Int64(ReencryptKindSecrets, state.Secrets).Int64(ReencryptKindRegistries, state.Registries).Msg(logMessageReencryptResuming)
+	return state, nil
+}
+
+func saveReencryptState(s store.Store, state *reencryptState) error {
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if err := s.ServerConfigSet(reencryptStateConfigKey, string(raw)); err != nil {
+		return fmt.Errorf(errTemplateFailedUpdatingServerConfig, err)
+	}
+	return nil
+}
+
+// registerKey stores the ciphertext sample of the given service, or removes it if the data is now unencrypted.
+func registerKey(s store.Store, svc types.EncryptionService) error {
+	switch svc := svc.(type) {
+	case *aesEncryptionService:
+		return svc.updateCiphertextSample()
+	case *tinkEncryptionService:
+		return svc.updateCiphertextSample()
+	case *noEncryption:
+		err := s.ServerConfigDelete(ciphertextSampleConfigKey)
+		if err != nil && !errors.Is(err, storeTypes.RecordNotExist) {
+			return fmt.Errorf(errTemplateFailedUpdatingServerConfig, err)
+		}
+		return nil
+	}
+	return fmt.Errorf(errMessageTemplateUnsupportedKeyType, fmt.Sprintf("%T", svc))
+}
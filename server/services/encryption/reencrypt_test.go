@@ -0,0 +1,157 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+func TestReencrypt(t *testing.T) {
+	newKey, err := NewRawKeyService("new-key", nil)
+	assert.NoError(t, err)
+	oldKey, err := NewRawKeyService("old-key", nil)
+	assert.NoError(t, err)
+
+	t.Run("enable", func(t *testing.T) {
+		secrets := []*model.Secret{{ID: 2, Value: "b"}, {ID: 1, Value: "a"}}
+		registries := []*model.Registry{{ID: 1, Password: "pass"}}
+
+		s := store_mocks.NewMockStore(t)
+		s.On("ServerConfigGet", reencryptStateConfigKey).Return("", types.RecordNotExist)
+		s.On("SecretListAll").Return(secrets, nil)
+		s.On("RegistryListAll").Return(registries, nil)
+		s.On("SecretUpdate", mock.Anything).Return(nil).Times(2)
+		s.On("RegistryUpdate", mock.Anything).Return(nil).Once()
+		s.On("ServerConfigSet", reencryptStateConfigKey, mock.Anything).Return(nil)
+		s.On("ServerConfigSet", ciphertextSampleConfigKey, mock.Anything).Return(nil).Once()
+		s.On("ServerConfigDelete", reencryptStateConfigKey).Return(nil).Once()
+		newKey.(*aesEncryptionService).store = s
+
+		var progress []ReencryptProgress
+		err := Reencrypt(t.Context(), s, NewNoEncryptionService(), newKey, ReencryptOptions{
+			BatchSize: 1,
+			Progress:  func(p ReencryptProgress) { progress = append(progress, p) },
+		})
+		assert.NoError(t, err)
+		assert.Len(t, progress, 3)
+		assert.Equal(t, ReencryptProgress{Kind: ReencryptKindSecrets, Total: 2, Processed: 2}, progress[1])
+
+		for _, secret := range secrets {
+			assert.NotEqual(t, "a", secret.Value)
+			assert.NotEqual(t, "b", secret.Value)
+		}
+		plaintext, err := newKey.Decrypt(secrets[1].Value, "1")
+		assert.NoError(t, err)
+		assert.Equal(t, "a", plaintext)
+		plaintext, err = newKey.Decrypt(registries[0].Password, "1")
+		assert.NoError(t, err)
+		assert.Equal(t, "pass", plaintext)
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		migrated, err := newKey.Encrypt("b", "2")
+		assert.NoError(t, err)
+		old, err := oldKey.Encrypt("c", "3")
+		assert.NoError(t, err)
+		secrets := []*model.Secret{{ID: 1, Value: "already-done"}, {ID: 2, Value: migrated}, {ID: 3, Value: old}}
+
+		s := store_mocks.NewMockStore(t)
+		s.On("ServerConfigGet", reencryptStateConfigKey).Return(`{"secrets":1}`, nil)
+		s.On("SecretListAll").Return(secrets, nil)
+		s.On("RegistryListAll").Return([]*model.Registry{}, nil)
+		s.On("SecretUpdate", secrets[2]).Return(nil).Once()
+		s.On("ServerConfigSet", reencryptStateConfigKey, mock.Anything).Return(nil)
+		s.On("ServerConfigSet", ciphertextSampleConfigKey, mock.Anything).Return(nil).Once()
+		s.On("ServerConfigDelete", reencryptStateConfigKey).Return(nil).Once()
+		newKey.(*aesEncryptionService).store = s
+
+		var last ReencryptProgress
+		err = Reencrypt(t.Context(), s, oldKey, newKey, ReencryptOptions{
+			Progress: func(p ReencryptProgress) { last = p },
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, ReencryptProgress{Kind: ReencryptKindSecrets, Total: 3, Processed: 1, Skipped: 2}, last)
+		assert.Equal(t, "already-done", secrets[0].Value)
+		assert.Equal(t, migrated, secrets[1].Value)
+		plaintext, err := newKey.Decrypt(secrets[2].Value, "3")
+		assert.NoError(t, err)
+		assert.Equal(t, "c", plaintext)
+	})
+
+	t.Run("dry-run", func(t *testing.T) {
+		valid, err := oldKey.Encrypt("a", "1")
+		assert.NoError(t, err)
+		invalid, err := oldKey.Encrypt("b", "1")
+		assert.NoError(t, err)
+		secrets := []*model.Secret{{ID: 1, Value: valid}, {ID: 2, Value: invalid}}
+
+		s := store_mocks.NewMockStore(t)
+		s.On("SecretListAll").Return(secrets, nil)
+		s.On("RegistryListAll").Return([]*model.Registry{}, nil)
+
+		var last ReencryptProgress
+		err = Reencrypt(t.Context(), s, oldKey, newKey, ReencryptOptions{
+			DryRun:   true,
+			Progress: func(p ReencryptProgress) { last = p },
+		})
+		assert.Error(t, err)
+		assert.Equal(t, []int64{2}, last.Failed)
+		assert.Equal(t, valid, secrets[0].Value)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		s := store_mocks.NewMockStore(t)
+		s.On("ServerConfigGet", reencryptStateConfigKey).Return("", types.RecordNotExist)
+		s.On("SecretListAll").Return([]*model.Secret{{ID: 1, Value: "a"}}, nil)
+		s.On("RegistryListAll").Return([]*model.Registry{}, nil)
+
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		err := Reencrypt(ctx, s, NewNoEncryptionService(), newKey, ReencryptOptions{})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestCheckCurrentKey(t *testing.T) {
+	key, err := NewRawKeyService("key", nil)
+	assert.NoError(t, err)
+	other, err := NewRawKeyService("other-key", nil)
+	assert.NoError(t, err)
+	sample, err := key.Encrypt("key-id", keyIDAssociatedData)
+	assert.NoError(t, err)
+
+	t.Run("unencrypted", func(t *testing.T) {
+		s := store_mocks.NewMockStore(t)
+		s.On("ServerConfigGet", ciphertextSampleConfigKey).Return("", types.RecordNotExist)
+		assert.NoError(t, CheckCurrentKey(s, NewNoEncryptionService()))
+		assert.Error(t, CheckCurrentKey(s, key))
+	})
+
+	t.Run("encrypted", func(t *testing.T) {
+		s := store_mocks.NewMockStore(t)
+		s.On("ServerConfigGet", ciphertextSampleConfigKey).Return(sample, nil)
+		assert.NoError(t, CheckCurrentKey(s, key))
+		assert.ErrorIs(t, CheckCurrentKey(s, other), errEncryptionKeyInvalid)
+		assert.Error(t, CheckCurrentKey(s, NewNoEncryptionService()))
+	})
+}
@@ -0,0 +1,47 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"fmt"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/encryption/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+)
+
+// NewRawKeyService loads an AES encryption service from a raw key.
+// In contrast to the builder it neither validates the key nor migrates any stored data.
+func NewRawKeyService(key string, s store.Store) (types.EncryptionService, error) {
+	svc := &aesEncryptionService{store: s}
+	if err := svc.loadCipher(key); err != nil {
+		return nil, fmt.Errorf(errTemplateAesFailedLoadingCipher, err)
+	}
+	return svc, nil
+}
+
+// NewTinkService loads a tink encryption service from a keyset file.
+// In contrast to the builder it neither validates the keyset nor watches the file for changes.
+func NewTinkService(keysetFilePath string, s store.Store) (types.EncryptionService, error) {
+	svc := &tinkEncryptionService{keysetFilePath: keysetFilePath, store: s}
+	if err := svc.loadKeyset(); err != nil {
+		return nil, fmt.Errorf(errTemplateTinkFailedLoadingKeyset, err)
+	}
+	return svc, nil
+}
+
+// NewNoEncryptionService returns a service that stores data as plaintext.
+func NewNoEncryptionService() types.EncryptionService {
+	return &noEncryption{}
+}
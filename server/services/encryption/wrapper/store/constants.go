@@ -23,10 +23,22 @@ const (
 
 	errMessageTemplateFailedToRollbackSecretCreation = "failed creating secret: %w. Also failed deleting temporary secret record from store: %s"
 
+	errMessageTemplateFailedToEnableRegistries         = "failed enabling registry store encryption: %w"
+	errMessageTemplateFailedToMigrateRegistries        = "failed migrating registry store encryption: %w"
+	errMessageTemplateFailedToEncryptRegistry          = "failed to encrypt registry id=%d: %w"
+	errMessageTemplateFailedToDecryptRegistry          = "failed to decrypt registry id=%d: %w"
+	errMessageTemplateRegistryStorageError             = "Storage error: could not update registry in DB"
+	errMessageTemplateFailedToRollbackRegistryCreation = "failed creating registry: %w. Also failed deleting temporary registry record from store: %s"
+
 	errMessageInitSeveralTimes = "attempt to init encrypted storage more than once"
 
 	logMessageEnablingSecretsEncryption         = "Encrypting all secrets in database"
 	logMessageEnablingSecretsEncryptionSuccess  = "All secrets are encrypted"
 	logMessageMigratingSecretsEncryption        = "Migrating encryption keys"
 	logMessageMigratingSecretsEncryptionSuccess = "Secrets encryption migrated successfully"
+
+	logMessageEnablingRegistriesEncryption         = "Encrypting all registry passwords in database"
+	logMessageEnablingRegistriesEncryptionSuccess  = "All registry passwords are encrypted"
+	logMessageMigratingRegistriesEncryption        = "Migrating registry encryption keys"
+	logMessageMigratingRegistriesEncryptionSuccess = "Registry encryption migrated successfully"
 )
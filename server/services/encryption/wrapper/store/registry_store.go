@@ -0,0 +1,154 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"fmt"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func (wrapper *EncryptedRegistryStore) RegistryFind(repo *model.Repo, addr string) (*model.Registry, error) {
+	result, err := wrapper.store.RegistryFind(repo, addr)
+	if err != nil {
+		return nil, err
+	}
+	err = wrapper.decrypt(result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (wrapper *EncryptedRegistryStore) RegistryList(repo *model.Repo, b bool, p *model.ListOptions) ([]*model.Registry, error) {
+	results, err := wrapper.store.RegistryList(repo, b, p)
+	if err != nil {
+		return nil, err
+	}
+	err = wrapper.decryptList(results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (wrapper *EncryptedRegistryStore) RegistryCreate(registry *model.Registry) error {
+	// the id is part of the associated data, so the password can only be encrypted after the record exists
+	password := registry.Password
+	registry.Password = ""
+	err := wrapper.store.RegistryCreate(registry)
+	registry.Password = password
+	if err != nil {
+		return err
+	}
+
+	err = wrapper.encrypt(registry)
+	if err != nil {
+		deleteErr := wrapper.store.RegistryDelete(registry)
+		if deleteErr != nil {
+			return fmt.Errorf(errMessageTemplateFailedToRollbackRegistryCreation, err, deleteErr.Error())
+		}
+		return err
+	}
+
+	err = wrapper.store.RegistryUpdate(registry)
+	if err != nil {
+		deleteErr := wrapper.store.RegistryDelete(registry)
+		if deleteErr != nil {
+			return fmt.Errorf(errMessageTemplateFailedToRollbackRegistryCreation, err, deleteErr.Error())
+		}
+		return err
+	}
+
+	return wrapper.decrypt(registry)
+}
+
+func (wrapper *EncryptedRegistryStore) RegistryUpdate(registry *model.Registry) error {
+	err := wrapper.encrypt(registry)
+	if err != nil {
+		return err
+	}
+
+	err = wrapper.store.RegistryUpdate(registry)
+	if err != nil {
+		return err
+	}
+
+	return wrapper.decrypt(registry)
+}
+
+func (wrapper *EncryptedRegistryStore) RegistryDelete(registry *model.Registry) error {
+	return wrapper.store.RegistryDelete(registry)
+}
+
+func (wrapper *EncryptedRegistryStore) OrgRegistryFind(orgID int64, addr string) (*model.Registry, error) {
+	result, err := wrapper.store.OrgRegistryFind(orgID, addr)
+	if err != nil {
+		return nil, err
+	}
+	err = wrapper.decrypt(result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (wrapper *EncryptedRegistryStore) OrgRegistryList(orgID int64, p *model.ListOptions) ([]*model.Registry, error) {
+	results, err := wrapper.store.OrgRegistryList(orgID, p)
+	if err != nil {
+		return nil, err
+	}
+	err = wrapper.decryptList(results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (wrapper *EncryptedRegistryStore) GlobalRegistryFind(addr string) (*model.Registry, error) {
+	result, err := wrapper.store.GlobalRegistryFind(addr)
+	if err != nil {
+		return nil, err
+	}
+	err = wrapper.decrypt(result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (wrapper *EncryptedRegistryStore) GlobalRegistryList(p *model.ListOptions) ([]*model.Registry, error) {
+	results, err := wrapper.store.GlobalRegistryList(p)
+	if err != nil {
+		return nil, err
+	}
+	err = wrapper.decryptList(results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (wrapper *EncryptedRegistryStore) RegistryListAll() ([]*model.Registry, error) {
+	results, err := wrapper.store.RegistryListAll()
+	if err != nil {
+		return nil, err
+	}
+	err = wrapper.decryptList(results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/encryption"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+)
+
+func TestEncryptedRegistryStore(t *testing.T) {
+	svc, err := encryption.NewRawKeyService("key", nil)
+	assert.NoError(t, err)
+
+	var stored string
+	s := store_mocks.NewMockStore(t)
+	s.On("RegistryCreate", mock.Anything).Run(func(args mock.Arguments) {
+		registry := args.Get(0).(*model.Registry)
+		assert.Empty(t, registry.Password)
+		registry.ID = 1
+	}).Return(nil).Once()
+	s.On("RegistryUpdate", mock.Anything).Run(func(args mock.Arguments) {
+		stored = args.Get(0).(*model.Registry).Password
+	}).Return(nil).Once()
+	s.On("RegistryFind", mock.Anything, "docker.io").Return(func(*model.Repo, string) (*model.Registry, error) {
+		return &model.Registry{ID: 1, Address: "docker.io", Password: stored}, nil
+	}).Once()
+
+	wrapper := NewRegistryStore(s)
+	assert.NoError(t, wrapper.SetEncryptionService(svc))
+
+	registry := &model.Registry{Address: "docker.io", Username: "user", Password: "pass"}
+	assert.NoError(t, wrapper.RegistryCreate(registry))
+	assert.Equal(t, "pass", registry.Password)
+	assert.NotEqual(t, "pass", stored)
+
+	plaintext, err := svc.Decrypt(stored, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, "pass", plaintext)
+
+	found, err := wrapper.RegistryFind(&model.Repo{}, "docker.io")
+	assert.NoError(t, err)
+	assert.Equal(t, "pass", found.Password)
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/encryption/types"
+)
+
+type EncryptedRegistryStore struct {
+	store      model.RegistryStore
+	encryption types.EncryptionService
+}
+
+// Ensure wrapper match interface.
+var _ model.RegistryStore = new(EncryptedRegistryStore)
+
+func NewRegistryStore(registryStore model.RegistryStore) *EncryptedRegistryStore {
+	wrapper := EncryptedRegistryStore{registryStore, nil}
+	return &wrapper
+}
+
+func (wrapper *EncryptedRegistryStore) SetEncryptionService(service types.EncryptionService) error {
+	if wrapper.encryption != nil {
+		return errors.New(errMessageInitSeveralTimes)
+	}
+	wrapper.encryption = service
+	return nil
+}
+
+func (wrapper *EncryptedRegistryStore) EnableEncryption() error {
+	log.Warn().Msg(logMessageEnablingRegistriesEncryption)
+	registries, err := wrapper.store.RegistryListAll()
+	if err != nil {
+		return fmt.Errorf(errMessageTemplateFailedToEnableRegistries, err)
+	}
+	for _, registry := range registries {
+		// skip the registries already encrypted before enabling the encryption got interrupted
+		if wrapper.isEncrypted(registry) {
+			continue
+		}
+		if err := wrapper.encrypt(registry); err != nil {
+			return err
+		}
+		if err := wrapper._save(registry); err != nil {
+			return err
+		}
+	}
+	log.Warn().Msg(logMessageEnablingRegistriesEncryptionSuccess)
+	return nil
+}
+
+func (wrapper *EncryptedRegistryStore) MigrateEncryption(newEncryptionService types.EncryptionService) error {
+	log.Warn().Msg(logMessageMigratingRegistriesEncryption)
+	registries, err := wrapper.store.RegistryListAll()
+	if err != nil {
+		return fmt.Errorf(errMessageTemplateFailedToMigrateRegistries, err)
+	}
+	if err := wrapper.decryptList(registries); err != nil {
+		return err
+	}
+	wrapper.encryption = newEncryptionService
+	for _, registry := range registries {
+		if err := wrapper.encrypt(registry); err != nil {
+			return err
+		}
+		if err := wrapper._save(registry); err != nil {
+			return err
+		}
+	}
+	log.Warn().Msg(logMessageMigratingRegistriesEncryptionSuccess)
+	return nil
+}
+
+func (wrapper *EncryptedRegistryStore) encrypt(registry *model.Registry) error {
+	encryptedValue, err := wrapper.encryption.Encrypt(registry.Password, strconv.Itoa(int(registry.ID)))
+	if err != nil {
+		return fmt.Errorf(errMessageTemplateFailedToEncryptRegistry, registry.ID, err)
+	}
+	registry.Password = encryptedValue
+	return nil
+}
+
+// isEncrypted returns true if the password can be decrypted, the authentication of the
+// ciphertext makes sure plaintext values are never taken for encrypted ones.
+func (wrapper *EncryptedRegistryStore) isEncrypted(registry *model.Registry) bool {
+	_, err := wrapper.encryption.Decrypt(registry.Password, strconv.Itoa(int(registry.ID)))
+	return err == nil
+}
+
+func (wrapper *EncryptedRegistryStore) decrypt(registry *model.Registry) error {
+	decryptedValue, err := wrapper.encryption.Decrypt(registry.Password, strconv.Itoa(int(registry.ID)))
+	if err != nil {
+		return fmt.Errorf(errMessageTemplateFailedToDecryptRegistry, registry.ID, err)
+	}
+	registry.Password = decryptedValue
+	return nil
+}
+
+func (wrapper *EncryptedRegistryStore) decryptList(registries []*model.Registry) error {
+	for _, registry := range registries {
+		if err := wrapper.decrypt(registry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (wrapper *EncryptedRegistryStore) _save(registry *model.Registry) error {
+	err := wrapper.store.RegistryUpdate(registry)
+	if err != nil {
+		log.Err(err).Msg(errMessageTemplateRegistryStorageError)
+		return err
+	}
+	return nil
+}
@@ -45,16 +45,18 @@ func (wrapper *EncryptedSecretStore) SecretList(repo *model.Repo, b bool, p *mod
 }
 
 func (wrapper *EncryptedSecretStore) SecretCreate(secret *model.Secret) error {
-	newSecret := &model.Secret{}
-	err := wrapper.store.SecretCreate(newSecret)
+	// the id is part of the associated data, so the value can only be encrypted after the record exists
+	value := secret.Value
+	secret.Value = ""
+	err := wrapper.store.SecretCreate(secret)
+	secret.Value = value
 	if err != nil {
 		return err
 	}
-	secret.ID = newSecret.ID
 
 	err = wrapper.encrypt(secret)
 	if err != nil {
-		deleteErr := wrapper.store.SecretDelete(newSecret)
+		deleteErr := wrapper.store.SecretDelete(secret)
 		if deleteErr != nil {
 			return fmt.Errorf(errMessageTemplateFailedToRollbackSecretCreation, err, deleteErr.Error())
 		}
@@ -63,7 +65,7 @@ func (wrapper *EncryptedSecretStore) SecretCreate(secret *model.Secret) error {
 
 	err = wrapper.store.SecretUpdate(secret)
 	if err != nil {
-		deleteErr := wrapper.store.SecretDelete(newSecret)
+		deleteErr := wrapper.store.SecretDelete(secret)
 		if deleteErr != nil {
 			return fmt.Errorf(errMessageTemplateFailedToRollbackSecretCreation, err, deleteErr.Error())
 		}
@@ -53,6 +53,10 @@ func (wrapper *EncryptedSecretStore) EnableEncryption() error {
 		return fmt.Errorf(errMessageTemplateFailedToEnable, err)
 	}
 	for _, secret := range secrets {
+		// skip the secrets already encrypted before enabling the encryption got interrupted
+		if wrapper.isEncrypted(secret) {
+			continue
+		}
 		if err := wrapper.encrypt(secret); err != nil {
 			return err
 		}
@@ -95,6 +99,13 @@ func (wrapper *EncryptedSecretStore) encrypt(secret *model.Secret) error {
 	return nil
 }
 
+// isEncrypted returns true if the value can be decrypted, the authentication of the
+// ciphertext makes sure plaintext values are never taken for encrypted ones.
+func (wrapper *EncryptedSecretStore) isEncrypted(secret *model.Secret) bool {
+	_, err := wrapper.encryption.Decrypt(secret.Value, strconv.Itoa(int(secret.ID)))
+	return err == nil
+}
+
 func (wrapper *EncryptedSecretStore) decrypt(secret *model.Secret) error {
 	decryptedValue, err := wrapper.encryption.Decrypt(secret.Value, strconv.Itoa(int(secret.ID)))
 	if err != nil {
@@ -0,0 +1,154 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/encryption"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+// memoryStore keeps the secrets, registries and server config of a mock store in memory.
+type memoryStore struct {
+	*store_mocks.MockStore
+	secrets    map[int64]string
+	registries map[int64]string
+	config     map[string]string
+	updates    int
+}
+
+func newMemoryStore(t *testing.T) *memoryStore {
+	s := &memoryStore{
+		MockStore:  store_mocks.NewMockStore(t),
+		secrets:    map[int64]string{1: "secret-1", 2: "secret-2"},
+		registries: map[int64]string{1: "password-1"},
+		config:     map[string]string{},
+	}
+	s.On("SecretListAll").Return(func() ([]*model.Secret, error) {
+		var secrets []*model.Secret
+		for id, value := range s.secrets {
+			secrets = append(secrets, &model.Secret{ID: id, Value: value})
+		}
+		return secrets, nil
+	}).Maybe()
+	s.On("SecretUpdate", mock.Anything).Return(func(secret *model.Secret) error {
+		s.secrets[secret.ID] = secret.Value
+		s.updates++
+		return nil
+	}).Maybe()
+	s.On("SecretFind", mock.Anything, mock.Anything).Return(func(_ *model.Repo, name string) (*model.Secret, error) {
+		return &model.Secret{ID: 1, Name: name, Value: s.secrets[1]}, nil
+	}).Maybe()
+	s.On("RegistryListAll").Return(func() ([]*model.Registry, error) {
+		var registries []*model.Registry
+		for id, password := range s.registries {
+			registries = append(registries, &model.Registry{ID: id, Password: password})
+		}
+		return registries, nil
+	}).Maybe()
+	s.On("RegistryUpdate", mock.Anything).Return(func(registry *model.Registry) error {
+		s.registries[registry.ID] = registry.Password
+		s.updates++
+		return nil
+	}).Maybe()
+	s.On("RegistryFind", mock.Anything, mock.Anything).Return(func(_ *model.Repo, address string) (*model.Registry, error) {
+		return &model.Registry{ID: 1, Address: address, Password: s.registries[1]}, nil
+	}).Maybe()
+	s.On("ServerConfigGet", mock.Anything).Return(func(key string) (string, error) {
+		value, ok := s.config[key]
+		if !ok {
+			return "", types.RecordNotExist
+		}
+		return value, nil
+	}).Maybe()
+	s.On("ServerConfigSet", mock.Anything, mock.Anything).Return(func(key, value string) error {
+		s.config[key] = value
+		return nil
+	}).Maybe()
+	return s
+}
+
+// start sets up the encrypted stores like the server does on startup.
+func start(t *testing.T, s *memoryStore, args ...string) (*EncryptedSecretStore, *EncryptedRegistryStore) {
+	secretStore := NewSecretStore(s)
+	registryStore := NewRegistryStore(s)
+	cmd := &cli.Command{
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "encryption-raw-key"},
+			&cli.StringFlag{Name: "encryption-tink-keyset"},
+			&cli.BoolFlag{Name: "encryption-disable-flag"},
+		},
+		Action: func(_ context.Context, c *cli.Command) error {
+			return encryption.Encryption(c, s).WithClient(secretStore).WithClient(registryStore).Build()
+		},
+	}
+	assert.NoError(t, cmd.Run(t.Context(), append([]string{"server"}, args...)))
+	return secretStore, registryStore
+}
+
+func assertReadable(t *testing.T, secretStore *EncryptedSecretStore, registryStore *EncryptedRegistryStore) {
+	secret, err := secretStore.SecretFind(&model.Repo{}, "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, "secret-1", secret.Value)
+	registry, err := registryStore.RegistryFind(&model.Repo{}, "docker.io")
+	assert.NoError(t, err)
+	assert.Equal(t, "password-1", registry.Password)
+}
+
+func TestEnableEncryption(t *testing.T) {
+	t.Run("without key", func(t *testing.T) {
+		s := newMemoryStore(t)
+		secretStore, registryStore := start(t, s)
+		assertReadable(t, secretStore, registryStore)
+		assert.Equal(t, "secret-1", s.secrets[1])
+		assert.Zero(t, s.updates)
+	})
+
+	t.Run("existing plaintext data", func(t *testing.T) {
+		s := newMemoryStore(t)
+		secretStore, registryStore := start(t, s, "--encryption-raw-key", "key")
+		assertReadable(t, secretStore, registryStore)
+		assert.NotEqual(t, "secret-1", s.secrets[1])
+		assert.NotEqual(t, "secret-2", s.secrets[2])
+		assert.NotEqual(t, "password-1", s.registries[1])
+		assert.Equal(t, 3, s.updates)
+
+		// restarting with the same key neither fails nor encrypts the data again
+		secretStore, registryStore = start(t, s, "--encryption-raw-key", "key")
+		assertReadable(t, secretStore, registryStore)
+		assert.Equal(t, 3, s.updates)
+	})
+
+	t.Run("interrupted", func(t *testing.T) {
+		s := newMemoryStore(t)
+		svc, err := encryption.NewRawKeyService("key", nil)
+		assert.NoError(t, err)
+		// the first secret was encrypted, but the server stopped before it registered the key
+		s.secrets[1], err = svc.Encrypt("secret-1", "1")
+		assert.NoError(t, err)
+
+		secretStore, registryStore := start(t, s, "--encryption-raw-key", "key")
+		assertReadable(t, secretStore, registryStore)
+		assert.Equal(t, 2, s.updates)
+	})
+}
@@ -100,7 +100,10 @@ func (l logStore) LogAppend(step *model.Step, logEntries []*model.LogEntry) erro
 }
 
 func (l logStore) LogDelete(step *model.Step) error {
-	return os.Remove(l.filePath(step.ID))
+	if err := os.Remove(l.filePath(step.ID)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
 }
 
 func (l logStore) StepFinished(_ *model.Step) {}
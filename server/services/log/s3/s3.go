@@ -0,0 +1,592 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package s3 implements a log store which writes the logs as compressed objects
+// to a S3 compatible object storage. The logs of running steps are buffered in
+// memory and regularly uploaded as part objects, which are merged into a single
+// object once the step is finished.
+package s3
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	log_service "go.woodpecker-ci.org/woodpecker/v3/server/services/log"
+)
+
+const (
+	// requestTimeout is the timeout of a single request to the object storage.
+	requestTimeout = time.Minute
+	// maxLineLength is the max length of a serialized log entry.
+	maxLineLength int = 1 * 1024 * 1024 // 1mb
+	// flushInterval is the interval the buffered entries of running steps are uploaded in.
+	flushInterval = 10 * time.Second
+	// maxBufferedEntries is the number of buffered entries of a step triggering an upload before the next interval.
+	maxBufferedEntries = 1000
+)
+
+var errObjectNotFound = errors.New("object not found")
+
+// Config configures the S3 log store.
+type Config struct {
+	Endpoint        string
+	Bucket          string
+	Prefix          string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	Insecure        bool
+	PathStyle       bool
+}
+
+// objectStorage is the subset of object storage operations used by the log store.
+type objectStorage interface {
+	get(ctx context.Context, key string) ([]byte, error)
+	put(ctx context.Context, key string, data []byte) error
+	remove(ctx context.Context, key string) error
+	// list returns the keys of all objects starting with the prefix in lexical order.
+	list(ctx context.Context, prefix string) ([]string, error)
+}
+
+type logStore struct {
+	storage objectStorage
+	prefix  string
+
+	sync.Mutex
+	buffers map[int64][]*model.LogEntry
+	// stepLocks prevent concurrent uploads of a step from writing the same entries twice
+	stepLocks map[int64]*stepLock
+	// nextPart is the sequence number of the next part object of a running step
+	nextPart map[int64]int
+	// flushRequest wakes up the flush loop if a buffer is full
+	flushRequest chan struct{}
+}
+
+// stepLock serializes the object storage operations of a single step.
+type stepLock struct {
+	sync.Mutex
+	// users is the number of goroutines holding or waiting for the lock
+	users int
+}
+
+// NewLogStore returns a log store writing to the configured bucket.
+func NewLogStore(ctx context.Context, config Config) (log_service.Service, error) {
+	if config.Bucket == "" {
+		return nil, errors.New("a bucket is required for the s3 log store")
+	}
+
+	storage, err := newMinioStorage(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	store := newLogStore(storage, config.Prefix)
+	go store.flushLoop(ctx)
+	return store, nil
+}
+
+func newLogStore(storage objectStorage, prefix string) *logStore {
+	return &logStore{
+		storage:      storage,
+		prefix:       prefix,
+		buffers:      make(map[int64][]*model.LogEntry),
+		stepLocks:    make(map[int64]*stepLock),
+		nextPart:     make(map[int64]int),
+		flushRequest: make(chan struct{}, 1),
+	}
+}
+
+// lockStep locks the object storage operations of the step and returns the function releasing the lock.
+// Uploads of other steps are not blocked.
+func (l *logStore) lockStep(stepID int64) func() {
+	l.Lock()
+	lock, ok := l.stepLocks[stepID]
+	if !ok {
+		lock = &stepLock{}
+		l.stepLocks[stepID] = lock
+	}
+	lock.users++
+	l.Unlock()
+
+	lock.Lock()
+	return func() {
+		lock.Unlock()
+
+		l.Lock()
+		lock.users--
+		if lock.users == 0 {
+			delete(l.stepLocks, stepID)
+		}
+		l.Unlock()
+	}
+}
+
+func (l *logStore) objectKey(stepID int64) string {
+	return path.Join(l.prefix, fmt.Sprintf("%d.json.gz", stepID))
+}
+
+func (l *logStore) partPrefix(stepID int64) string {
+	return path.Join(l.prefix, fmt.Sprintf("%d.parts", stepID)) + "/"
+}
+
+func (l *logStore) partKey(stepID int64, part int) string {
+	return fmt.Sprintf("%s%08d.json.gz", l.partPrefix(stepID), part)
+}
+
+// LogFind returns the stored log entries followed by the ones of the running step.
+func (l *logStore) LogFind(step *model.Step) ([]*model.LogEntry, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	if step.Finished != 0 {
+		entries, merged, err := l.readMerged(ctx, step.ID)
+		if err != nil || merged {
+			return entries, err
+		}
+
+		// retry the upload of finished steps, e.g. if StepFinished failed or was never called
+		// because the server was restarted while the step was running
+		if err := l.flush(ctx, step.ID); err != nil {
+			log.Error().Err(err).Int64("step-id", step.ID).Msg("could not upload logs to object storage")
+		}
+	}
+
+	// parts are only merged while holding the lock of the step, so they can not vanish while reading them
+	unlock := l.lockStep(step.ID)
+	defer unlock()
+
+	entries, err := l.readObject(ctx, l.objectKey(step.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := l.storage.list(ctx, l.partPrefix(step.ID))
+	if err != nil {
+		return nil, err
+	}
+	for _, part := range parts {
+		partEntries, err := l.readObject(ctx, part)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, partEntries...)
+	}
+
+	l.Lock()
+	entries = append(entries, l.buffers[step.ID]...)
+	l.Unlock()
+
+	return entries, nil
+}
+
+// readMerged returns the entries of a finished step from its merged object. It reports false
+// if entries of the step are still buffered or the object was not uploaded yet.
+func (l *logStore) readMerged(ctx context.Context, stepID int64) ([]*model.LogEntry, bool, error) {
+	unlock := l.lockStep(stepID)
+	defer unlock()
+
+	l.Lock()
+	_, buffered := l.buffers[stepID]
+	l.Unlock()
+	if buffered {
+		return nil, false, nil
+	}
+
+	data, err := l.storage.get(ctx, l.objectKey(stepID))
+	if errors.Is(err, errObjectNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	entries, err := decode(data)
+	return entries, err == nil, err
+}
+
+func (l *logStore) LogAppend(step *model.Step, logEntries []*model.LogEntry) error {
+	l.Lock()
+	defer l.Unlock()
+
+	l.buffers[step.ID] = append(l.buffers[step.ID], logEntries...)
+	if len(l.buffers[step.ID]) >= maxBufferedEntries {
+		select {
+		case l.flushRequest <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+func (l *logStore) LogDelete(step *model.Step) error {
+	unlock := l.lockStep(step.ID)
+	defer unlock()
+
+	l.Lock()
+	delete(l.buffers, step.ID)
+	delete(l.nextPart, step.ID)
+	l.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	parts, err := l.storage.list(ctx, l.partPrefix(step.ID))
+	if err != nil {
+		return err
+	}
+	for _, part := range parts {
+		if err := l.storage.remove(ctx, part); err != nil {
+			return err
+		}
+	}
+	return l.storage.remove(ctx, l.objectKey(step.ID))
+}
+
+// StepFinished merges the uploaded parts and the buffered log entries of the step into a single object.
+// If the upload fails, the entries stay buffered and are still returned by LogFind.
+func (l *logStore) StepFinished(step *model.Step) {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	if err := l.flush(ctx, step.ID); err != nil {
+		log.Error().Err(err).Int64("step-id", step.ID).Msg("could not upload logs to object storage")
+	}
+}
+
+// flushLoop regularly uploads the buffered entries of all steps as part objects, so only the
+// entries of the last interval are lost if the server stops unexpectedly.
+func (l *logStore) flushLoop(ctx context.Context) {
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// upload what is left on shutdown, the context of the server is already canceled
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+			l.flushParts(shutdownCtx)
+			cancel()
+			return
+		case <-ticker.C:
+		case <-l.flushRequest:
+		}
+		l.flushParts(ctx)
+	}
+}
+
+func (l *logStore) flushParts(ctx context.Context) {
+	l.Lock()
+	stepIDs := make([]int64, 0, len(l.buffers))
+	for stepID := range l.buffers {
+		stepIDs = append(stepIDs, stepID)
+	}
+	l.Unlock()
+
+	for _, stepID := range stepIDs {
+		if err := l.flushPart(ctx, stepID); err != nil {
+			log.Error().Err(err).Int64("step-id", stepID).Msg("could not upload logs to object storage")
+		}
+	}
+}
+
+// flushPart uploads the buffered entries of the step as new part object.
+func (l *logStore) flushPart(ctx context.Context, stepID int64) error {
+	unlock := l.lockStep(stepID)
+	defer unlock()
+
+	l.Lock()
+	buffered := l.buffers[stepID]
+	l.Unlock()
+
+	if len(buffered) == 0 {
+		return nil
+	}
+
+	part, err := l.partNumber(ctx, stepID)
+	if err != nil {
+		return err
+	}
+
+	data, err := encode(buffered)
+	if err != nil {
+		return err
+	}
+
+	if err := l.storage.put(ctx, l.partKey(stepID, part), data); err != nil {
+		return err
+	}
+
+	l.Lock()
+	l.nextPart[stepID] = part + 1
+	l.Unlock()
+	l.dropBuffered(stepID, len(buffered))
+	return nil
+}
+
+// partNumber returns the number of the next part object of the step. Parts uploaded
+// before a restart of the server are looked up in the object storage.
+func (l *logStore) partNumber(ctx context.Context, stepID int64) (int, error) {
+	l.Lock()
+	part, ok := l.nextPart[stepID]
+	l.Unlock()
+	if ok {
+		return part, nil
+	}
+
+	parts, err := l.storage.list(ctx, l.partPrefix(stepID))
+	if err != nil {
+		return 0, err
+	}
+	if len(parts) == 0 {
+		return 0, nil
+	}
+
+	last := strings.TrimSuffix(strings.TrimPrefix(parts[len(parts)-1], l.partPrefix(stepID)), ".json.gz")
+	part, err = strconv.Atoi(last)
+	if err != nil {
+		return 0, fmt.Errorf("invalid log part object %s: %w", parts[len(parts)-1], err)
+	}
+	return part + 1, nil
+}
+
+// flush merges the uploaded parts and the buffered entries of a finished step into its object.
+func (l *logStore) flush(ctx context.Context, stepID int64) error {
+	unlock := l.lockStep(stepID)
+	defer unlock()
+
+	l.Lock()
+	buffered := l.buffers[stepID]
+	l.Unlock()
+
+	parts, err := l.storage.list(ctx, l.partPrefix(stepID))
+	if err != nil {
+		return err
+	}
+
+	if len(buffered) == 0 && len(parts) == 0 {
+		return nil
+	}
+
+	// entries might have been uploaded before if the step sent logs after it finished
+	entries, err := l.readObject(ctx, l.objectKey(stepID))
+	if err != nil {
+		return err
+	}
+	for _, part := range parts {
+		partEntries, err := l.readObject(ctx, part)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, partEntries...)
+	}
+	entries = append(entries, buffered...)
+
+	data, err := encode(entries)
+	if err != nil {
+		return err
+	}
+
+	if err := l.storage.put(ctx, l.objectKey(stepID), data); err != nil {
+		return err
+	}
+
+	l.Lock()
+	delete(l.nextPart, stepID)
+	l.Unlock()
+	l.dropBuffered(stepID, len(buffered))
+
+	for _, part := range parts {
+		if err := l.storage.remove(ctx, part); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dropBuffered drops the uploaded entries but keeps the ones appended in the meantime.
+func (l *logStore) dropBuffered(stepID int64, uploaded int) {
+	l.Lock()
+	defer l.Unlock()
+
+	if current := l.buffers[stepID]; len(current) > uploaded {
+		l.buffers[stepID] = current[uploaded:]
+	} else {
+		delete(l.buffers, stepID)
+	}
+}
+
+func (l *logStore) readObject(ctx context.Context, key string) ([]*model.LogEntry, error) {
+	data, err := l.storage.get(ctx, key)
+	if errors.Is(err, errObjectNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return decode(data)
+}
+
+// encode writes the entries as gzip compressed json lines.
+func encode(entries []*model.LogEntry) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	enc := json.NewEncoder(zw)
+	for _, entry := range entries {
+		if err := enc.Encode(entry); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decode(data []byte) ([]*model.LogEntry, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	buf := make([]byte, 0, bufio.MaxScanTokenSize)
+	s := bufio.NewScanner(zr)
+	s.Buffer(buf, maxLineLength)
+
+	var entries []*model.LogEntry
+	for s.Scan() {
+		line := s.Bytes()
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		entry := &model.LogEntry{}
+		if err := json.Unmarshal(line, entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, s.Err()
+}
+
+type minioStorage struct {
+	client *minio.Client
+	bucket string
+}
+
+func newMinioStorage(ctx context.Context, config Config) (*minioStorage, error) {
+	creds := credentials.NewChainCredentials([]credentials.Provider{
+		&credentials.EnvAWS{},
+		&credentials.EnvMinio{},
+		&credentials.FileAWSCredentials{},
+		&credentials.IAM{},
+	})
+	if config.AccessKeyID != "" {
+		creds = credentials.NewStaticV4(config.AccessKeyID, config.SecretAccessKey, "")
+	}
+
+	lookup := minio.BucketLookupAuto
+	if config.PathStyle {
+		lookup = minio.BucketLookupPath
+	}
+
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = "s3.amazonaws.com"
+	}
+	// allow the endpoint to be given as url
+	endpoint = strings.TrimPrefix(strings.TrimPrefix(endpoint, "https://"), "http://")
+
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:        creds,
+		Secure:       !config.Insecure,
+		Region:       config.Region,
+		BucketLookup: lookup,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create s3 client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	exists, err := client.BucketExists(ctx, config.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("could not access bucket %s: %w", config.Bucket, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("bucket %s does not exist", config.Bucket)
+	}
+
+	return &minioStorage{client: client, bucket: config.Bucket}, nil
+}
+
+func (m *minioStorage) get(ctx context.Context, key string) ([]byte, error) {
+	obj, err := m.client.GetObject(ctx, m.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, m.wrapErr(err)
+	}
+	defer obj.Close()
+
+	data, err := io.ReadAll(obj)
+	if err != nil {
+		return nil, m.wrapErr(err)
+	}
+	return data, nil
+}
+
+func (m *minioStorage) put(ctx context.Context, key string, data []byte) error {
+	_, err := m.client.PutObject(ctx, m.bucket, key, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType: "application/gzip",
+	})
+	return err
+}
+
+func (m *minioStorage) remove(ctx context.Context, key string) error {
+	return m.client.RemoveObject(ctx, m.bucket, key, minio.RemoveObjectOptions{})
+}
+
+func (m *minioStorage) list(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	for obj := range m.client.ListObjects(ctx, m.bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if obj.Err != nil {
+			return nil, obj.Err
+		}
+		keys = append(keys, obj.Key)
+	}
+	slices.Sort(keys)
+	return keys, nil
+}
+
+func (m *minioStorage) wrapErr(err error) error {
+	if minio.ToErrorResponse(err).Code == minio.NoSuchKey {
+		return errObjectNotFound
+	}
+	return err
+}
@@ -0,0 +1,258 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package s3
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+type memoryStorage struct {
+	sync.Mutex
+	objects map[string][]byte
+	failPut bool
+	// lists counts the list requests
+	lists int
+}
+
+func (m *memoryStorage) get(_ context.Context, key string) ([]byte, error) {
+	m.Lock()
+	defer m.Unlock()
+	data, ok := m.objects[key]
+	if !ok {
+		return nil, errObjectNotFound
+	}
+	return data, nil
+}
+
+func (m *memoryStorage) put(_ context.Context, key string, data []byte) error {
+	m.Lock()
+	defer m.Unlock()
+	if m.failPut {
+		return errors.New("upload failed")
+	}
+	m.objects[key] = data
+	return nil
+}
+
+func (m *memoryStorage) remove(_ context.Context, key string) error {
+	m.Lock()
+	defer m.Unlock()
+	delete(m.objects, key)
+	return nil
+}
+
+func (m *memoryStorage) list(_ context.Context, prefix string) ([]string, error) {
+	m.Lock()
+	defer m.Unlock()
+	m.lists++
+	var keys []string
+	for key := range m.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys, nil
+}
+
+func TestLogStore(t *testing.T) {
+	storage := &memoryStorage{objects: make(map[string][]byte)}
+	store := newLogStore(storage, "logs")
+	step := &model.Step{ID: 1}
+
+	entries := []*model.LogEntry{
+		{StepID: 1, Line: 0, Data: []byte("hello")},
+		{StepID: 1, Line: 1, Data: []byte("world")},
+	}
+
+	// running steps are served from the buffer
+	assert.NoError(t, store.LogAppend(step, entries[:1]))
+	assert.NoError(t, store.LogAppend(step, entries[1:]))
+	found, err := store.LogFind(step)
+	assert.NoError(t, err)
+	assert.Equal(t, entries, found)
+	assert.Empty(t, storage.objects)
+
+	// finished steps are uploaded
+	step.Finished = 1
+	store.StepFinished(step)
+	assert.Contains(t, storage.objects, "logs/1.json.gz")
+	assert.Empty(t, store.buffers)
+	found, err = store.LogFind(step)
+	assert.NoError(t, err)
+	assert.Equal(t, entries, found)
+
+	// late entries are appended to the object
+	late := &model.LogEntry{StepID: 1, Line: 2, Data: []byte("late")}
+	assert.NoError(t, store.LogAppend(step, []*model.LogEntry{late}))
+	store.StepFinished(step)
+	found, err = store.LogFind(step)
+	assert.NoError(t, err)
+	assert.Equal(t, append(entries, late), found)
+
+	assert.NoError(t, store.LogDelete(step))
+	assert.Empty(t, storage.objects)
+	found, err = store.LogFind(step)
+	assert.NoError(t, err)
+	assert.Empty(t, found)
+}
+
+func TestLogStoreFailedUpload(t *testing.T) {
+	storage := &memoryStorage{objects: make(map[string][]byte), failPut: true}
+	store := newLogStore(storage, "")
+	step := &model.Step{ID: 2, Finished: 1}
+	entries := []*model.LogEntry{{StepID: 2, Data: []byte("hello")}}
+
+	assert.NoError(t, store.LogAppend(step, entries))
+	store.StepFinished(step)
+	assert.Empty(t, storage.objects)
+
+	// entries stay available and the upload is retried on read
+	found, err := store.LogFind(step)
+	assert.NoError(t, err)
+	assert.Equal(t, entries, found)
+
+	storage.failPut = false
+	found, err = store.LogFind(step)
+	assert.NoError(t, err)
+	assert.Equal(t, entries, found)
+	assert.Contains(t, storage.objects, "2.json.gz")
+	assert.Empty(t, store.buffers)
+}
+
+func TestLogStoreRestart(t *testing.T) {
+	storage := &memoryStorage{objects: make(map[string][]byte)}
+	store := newLogStore(storage, "logs")
+	step := &model.Step{ID: 3}
+
+	entries := []*model.LogEntry{
+		{StepID: 3, Line: 0, Data: []byte("before")},
+		{StepID: 3, Line: 1, Data: []byte("restart")},
+		{StepID: 3, Line: 2, Data: []byte("after")},
+	}
+
+	// entries of running steps are uploaded as parts
+	assert.NoError(t, store.LogAppend(step, entries[:1]))
+	store.flushParts(t.Context())
+	assert.NoError(t, store.LogAppend(step, entries[1:2]))
+	store.flushParts(t.Context())
+	assert.Empty(t, store.buffers)
+	assert.Contains(t, storage.objects, "logs/3.parts/00000000.json.gz")
+	assert.Contains(t, storage.objects, "logs/3.parts/00000001.json.gz")
+
+	// a new server continues with the uploaded parts
+	store = newLogStore(storage, "logs")
+	found, err := store.LogFind(step)
+	assert.NoError(t, err)
+	assert.Equal(t, entries[:2], found)
+
+	assert.NoError(t, store.LogAppend(step, entries[2:]))
+	store.flushParts(t.Context())
+	assert.Contains(t, storage.objects, "logs/3.parts/00000002.json.gz")
+	found, err = store.LogFind(step)
+	assert.NoError(t, err)
+	assert.Equal(t, entries, found)
+
+	// the parts are merged once the step is finished
+	step.Finished = 1
+	store.StepFinished(step)
+	assert.Equal(t, []string{"logs/3.json.gz"}, slices.Collect(maps.Keys(storage.objects)))
+	found, err = store.LogFind(step)
+	assert.NoError(t, err)
+	assert.Equal(t, entries, found)
+
+	assert.NoError(t, store.LogDelete(step))
+	assert.Empty(t, storage.objects)
+}
+
+func TestLogStoreRestartFinished(t *testing.T) {
+	storage := &memoryStorage{objects: make(map[string][]byte)}
+	store := newLogStore(storage, "")
+	step := &model.Step{ID: 4}
+	entries := []*model.LogEntry{{StepID: 4, Data: []byte("hello")}}
+
+	assert.NoError(t, store.LogAppend(step, entries))
+	store.flushParts(t.Context())
+
+	// the server restarted before the step finished, the parts are merged on read
+	store = newLogStore(storage, "")
+	step.Finished = 1
+	found, err := store.LogFind(step)
+	assert.NoError(t, err)
+	assert.Equal(t, entries, found)
+	assert.Equal(t, []string{"4.json.gz"}, slices.Collect(maps.Keys(storage.objects)))
+}
+
+func TestLogStoreFlushRequest(t *testing.T) {
+	store := newLogStore(&memoryStorage{objects: make(map[string][]byte)}, "")
+	step := &model.Step{ID: 5}
+
+	assert.NoError(t, store.LogAppend(step, make([]*model.LogEntry, maxBufferedEntries-1)))
+	assert.Empty(t, store.flushRequest)
+	assert.NoError(t, store.LogAppend(step, make([]*model.LogEntry, 1)))
+	assert.Len(t, store.flushRequest, 1)
+}
+
+func TestLogStoreFinishedRead(t *testing.T) {
+	storage := &memoryStorage{objects: make(map[string][]byte)}
+	store := newLogStore(storage, "")
+	step := &model.Step{ID: 6}
+	entries := []*model.LogEntry{{StepID: 6, Data: []byte("hello")}}
+
+	assert.NoError(t, store.LogAppend(step, entries))
+	step.Finished = 1
+	store.StepFinished(step)
+	assert.Empty(t, store.stepLocks)
+
+	// the merged object of finished steps is read without looking for parts
+	lists := storage.lists
+	found, err := store.LogFind(step)
+	assert.NoError(t, err)
+	assert.Equal(t, entries, found)
+	assert.Equal(t, lists, storage.lists)
+}
+
+func TestLogStoreStepLock(t *testing.T) {
+	store := newLogStore(&memoryStorage{objects: make(map[string][]byte)}, "")
+
+	unlock := store.lockStep(1)
+	// other steps are not blocked by the upload of a step
+	store.lockStep(2)()
+
+	locked := make(chan struct{})
+	go func() {
+		defer store.lockStep(1)()
+		close(locked)
+	}()
+
+	select {
+	case <-locked:
+		t.Fatal("step was locked twice")
+	case <-time.After(10 * time.Millisecond):
+	}
+	unlock()
+	<-locked
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// maxQueryLength is the max length of a search query.
+const maxQueryLength = 1024
+
+// SearchOptions configures a log search.
+type SearchOptions struct {
+	Query      string
+	Regex      bool // treat the query as regular expression instead of a substring
+	IgnoreCase bool
+	Limit      int // max number of matches, 0 for unlimited
+	// After continues a truncated search after this match, the steps before its step are skipped
+	After *SearchCursor
+}
+
+// SearchCursor is the position of a match to continue a truncated search after.
+type SearchCursor struct {
+	StepID int64
+	Line   int
+}
+
+// String returns the cursor in the format "<step id>:<line>".
+func (c *SearchCursor) String() string {
+	return fmt.Sprintf("%d:%d", c.StepID, c.Line)
+}
+
+// ParseSearchCursor parses a cursor in the format "<step id>:<line>".
+func ParseSearchCursor(s string) (*SearchCursor, error) {
+	stepID, line, ok := strings.Cut(s, ":")
+	if !ok {
+		return nil, fmt.Errorf("invalid cursor %q", s)
+	}
+	cursor := &SearchCursor{}
+	var err error
+	if cursor.StepID, err = strconv.ParseInt(stepID, 10, 64); err != nil {
+		return nil, fmt.Errorf("invalid cursor %q: %w", s, err)
+	}
+	if cursor.Line, err = strconv.Atoi(line); err != nil {
+		return nil, fmt.Errorf("invalid cursor %q: %w", s, err)
+	}
+	return cursor, nil
+}
+
+// CompileQuery returns the regular expression used to search for the query.
+func CompileQuery(opts SearchOptions) (*regexp.Regexp, error) {
+	if opts.Query == "" {
+		return nil, errors.New("search query is empty")
+	}
+	if len(opts.Query) > maxQueryLength {
+		return nil, errors.New("search query is too long")
+	}
+
+	expr := opts.Query
+	if !opts.Regex {
+		expr = regexp.QuoteMeta(expr)
+	}
+	if opts.IgnoreCase {
+		expr = "(?i)" + expr
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	if re.MatchString("") {
+		return nil, errors.New("search query matches empty lines")
+	}
+	return re, nil
+}
+
+// Search returns the log lines of the steps matching the query in the order of the steps.
+// It works with every log service as it only relies on LogFind.
+// If the limit is reached, the remaining lines are skipped and truncated is set,
+// the last match can be used as cursor to continue the search.
+func Search(service Service, steps []*model.Step, opts SearchOptions) (matches []*model.LogMatch, truncated bool, err error) {
+	re, err := CompileQuery(opts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if opts.After != nil {
+		steps = stepsFrom(steps, opts.After.StepID)
+	}
+
+	matches = []*model.LogMatch{}
+	for _, step := range steps {
+		entries, err := service.LogFind(step)
+		if err != nil {
+			return nil, false, err
+		}
+
+		for _, entry := range entries {
+			if entry.Type != model.LogEntryStdout && entry.Type != model.LogEntryStderr {
+				continue
+			}
+			if opts.After != nil && step.ID == opts.After.StepID && entry.Line <= opts.After.Line {
+				continue
+			}
+
+			var highlights []model.LogMatchRange
+			for _, loc := range re.FindAllIndex(entry.Data, -1) {
+				// skip empty matches of expressions like `\b`
+				if loc[0] == loc[1] {
+					continue
+				}
+				highlights = append(highlights, model.LogMatchRange{Start: loc[0], End: loc[1]})
+			}
+			if len(highlights) == 0 {
+				continue
+			}
+			if opts.Limit > 0 && len(matches) >= opts.Limit {
+				return matches, true, nil
+			}
+
+			matches = append(matches, &model.LogMatch{
+				StepID:     step.ID,
+				StepName:   step.Name,
+				Line:       entry.Line,
+				Time:       entry.Time,
+				Data:       string(entry.Data),
+				Highlights: highlights,
+			})
+		}
+	}
+
+	return matches, false, nil
+}
+
+// stepsFrom returns the steps starting with the step of the id, or none if it is not part of them.
+func stepsFrom(steps []*model.Step, stepID int64) []*model.Step {
+	for i, step := range steps {
+		if step.ID == stepID {
+			return steps[i:]
+		}
+	}
+	return nil
+}
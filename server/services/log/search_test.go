@@ -0,0 +1,114 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	log_service "go.woodpecker-ci.org/woodpecker/v3/server/services/log"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/log/mocks"
+)
+
+func TestSearch(t *testing.T) {
+	build := &model.Step{ID: 1, Name: "build"}
+	test := &model.Step{ID: 2, Name: "test"}
+
+	service := mocks.NewMockService(t)
+	service.On("LogFind", build).Return([]*model.LogEntry{
+		{StepID: 1, Line: 0, Data: []byte("go build ./...")},
+		{StepID: 1, Line: 1, Data: []byte("error: undefined: foo"), Type: model.LogEntryStderr},
+		{StepID: 1, Line: 2, Data: []byte("error"), Type: model.LogEntryMetadata},
+	}, nil)
+	service.On("LogFind", test).Return([]*model.LogEntry{
+		{StepID: 2, Line: 0, Data: []byte("Error: test failed, error count 2")},
+	}, nil)
+	steps := []*model.Step{build, test}
+
+	t.Run("substring", func(t *testing.T) {
+		matches, truncated, err := log_service.Search(service, steps, log_service.SearchOptions{Query: "error"})
+		assert.NoError(t, err)
+		assert.False(t, truncated)
+		assert.Equal(t, []*model.LogMatch{
+			{StepID: 1, StepName: "build", Line: 1, Data: "error: undefined: foo", Highlights: []model.LogMatchRange{{Start: 0, End: 5}}},
+			{StepID: 2, StepName: "test", Line: 0, Data: "Error: test failed, error count 2", Highlights: []model.LogMatchRange{{Start: 20, End: 25}}},
+		}, matches)
+	})
+
+	t.Run("ignore case", func(t *testing.T) {
+		matches, _, err := log_service.Search(service, steps, log_service.SearchOptions{Query: "ERROR", IgnoreCase: true})
+		assert.NoError(t, err)
+		assert.Len(t, matches, 2)
+		assert.Equal(t, []model.LogMatchRange{{Start: 0, End: 5}, {Start: 20, End: 25}}, matches[1].Highlights)
+	})
+
+	t.Run("regex", func(t *testing.T) {
+		matches, _, err := log_service.Search(service, steps, log_service.SearchOptions{Query: `undefined: \w+`, Regex: true})
+		assert.NoError(t, err)
+		assert.Len(t, matches, 1)
+		assert.Equal(t, 1, matches[0].Line)
+		assert.Equal(t, []model.LogMatchRange{{Start: 7, End: 21}}, matches[0].Highlights)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		matches, truncated, err := log_service.Search(service, steps, log_service.SearchOptions{Query: "error", Limit: 1})
+		assert.NoError(t, err)
+		assert.True(t, truncated)
+		assert.Len(t, matches, 1)
+	})
+
+	t.Run("cursor", func(t *testing.T) {
+		matches, _, err := log_service.Search(service, steps, log_service.SearchOptions{Query: "error", Limit: 1, After: &log_service.SearchCursor{StepID: 1, Line: 1}})
+		assert.NoError(t, err)
+		if assert.Len(t, matches, 1) {
+			assert.EqualValues(t, 2, matches[0].StepID)
+		}
+
+		matches, _, err = log_service.Search(service, steps, log_service.SearchOptions{Query: "error", After: &log_service.SearchCursor{StepID: 3}})
+		assert.NoError(t, err)
+		assert.Empty(t, matches)
+	})
+
+	t.Run("empty matches", func(t *testing.T) {
+		// lines with empty matches only are no matches
+		matches, _, err := log_service.Search(service, steps, log_service.SearchOptions{Query: `\b`, Regex: true})
+		assert.NoError(t, err)
+		assert.Empty(t, matches)
+
+		_, _, err = log_service.Search(service, steps, log_service.SearchOptions{Query: "a*", Regex: true})
+		assert.ErrorContains(t, err, "matches empty lines")
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		_, _, err := log_service.Search(service, steps, log_service.SearchOptions{Query: "(", Regex: true})
+		assert.Error(t, err)
+		_, _, err = log_service.Search(service, steps, log_service.SearchOptions{})
+		assert.Error(t, err)
+	})
+}
+
+func TestParseSearchCursor(t *testing.T) {
+	cursor, err := log_service.ParseSearchCursor("7:12")
+	assert.NoError(t, err)
+	assert.Equal(t, &log_service.SearchCursor{StepID: 7, Line: 12}, cursor)
+	assert.Equal(t, "7:12", cursor.String())
+
+	_, err = log_service.ParseSearchCursor("7")
+	assert.Error(t, err)
+	_, err = log_service.ParseSearchCursor("a:1")
+	assert.Error(t, err)
+}
@@ -83,12 +83,17 @@ func NewManager(c *cli.Command, store store.Store, setupForge SetupForge) (Manag
 		return nil, err
 	}
 
+	secretStore, registryStore, err := setupEncryptedStores(c, store)
+	if err != nil {
+		return nil, err
+	}
+
 	return &manager{
 		signaturePrivateKey: signaturePrivateKey,
 		signaturePublicKey:  signaturePublicKey,
 		store:               store,
-		secret:              setupSecretService(store),
-		registry:            setupRegistryService(store, c.String("docker-config")),
+		secret:              setupSecretService(secretStore),
+		registry:            setupRegistryService(registryStore, c.String("docker-config")),
 		config:              configService,
 		environment:         environment.Parse(c.StringSlice("environment")),
 		forgeCache:          ttlcache.New(ttlcache.WithDisableTouchOnHit[int64, forge.Forge]()),
@@ -118,11 +123,13 @@ func (m *manager) RegistryService() registry.Service {
 }
 
 func (m *manager) ConfigServiceFromRepo(repo *model.Repo) config.Service {
+	service := m.config
 	if repo.ConfigExtensionEndpoint != "" {
-		return config.NewCombined(m.config, config.NewHTTP(strings.TrimRight(repo.ConfigExtensionEndpoint, "/"), m.client))
+		service = config.NewCombined(m.config, config.NewHTTP(strings.TrimRight(repo.ConfigExtensionEndpoint, "/"), m.client))
 	}
 
-	return m.config
+	// includes are resolved last, so config extensions can use them as well
+	return config.NewInclude(service, m.store)
 }
 
 func (m *manager) EnvironmentService() environment.Service {
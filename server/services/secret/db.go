@@ -16,15 +16,14 @@ package secret
 
 import (
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
-	"go.woodpecker-ci.org/woodpecker/v3/server/store"
 )
 
 type db struct {
-	store store.Store
+	store model.SecretStore
 }
 
 // NewDB returns a new local secret service.
-func NewDB(store store.Store) Service {
+func NewDB(store model.SecretStore) Service {
 	return &db{store: store}
 }
 
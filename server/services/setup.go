@@ -28,6 +28,8 @@ import (
 
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 	"go.woodpecker-ci.org/woodpecker/v3/server/services/config"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/encryption"
+	encryptedStore "go.woodpecker-ci.org/woodpecker/v3/server/services/encryption/wrapper/store"
 	"go.woodpecker-ci.org/woodpecker/v3/server/services/registry"
 	"go.woodpecker-ci.org/woodpecker/v3/server/services/secret"
 	"go.woodpecker-ci.org/woodpecker/v3/server/services/utils"
@@ -35,7 +37,7 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
 )
 
-func setupRegistryService(store store.Store, dockerConfig string) registry.Service {
+func setupRegistryService(store model.RegistryStore, dockerConfig string) registry.Service {
 	if dockerConfig != "" {
 		return registry.NewCombined(
 			registry.NewDB(store),
@@ -46,18 +48,22 @@ func setupRegistryService(store store.Store, dockerConfig string) registry.Servi
 	return registry.NewDB(store)
 }
 
-func setupSecretService(store store.Store) secret.Service {
-	// TODO(1544): fix encrypted store
-	// // encryption
-	// encryptedSecretStore := encryptedStore.NewSecretStore(v)
-	// err := encryption.Encryption(c, v).WithClient(encryptedSecretStore).Build()
-	// if err != nil {
-	// 	log.Fatal().Err(err).Msg("could not create encryption service")
-	// }
-
+func setupSecretService(store model.SecretStore) secret.Service {
 	return secret.NewDB(store)
 }
 
+// setupEncryptedStores wraps the secret and registry stores so values are encrypted before they are
+// written to the database and decrypted when they are read.
+func setupEncryptedStores(c *cli.Command, _store store.Store) (model.SecretStore, model.RegistryStore, error) {
+	secretStore := encryptedStore.NewSecretStore(_store)
+	registryStore := encryptedStore.NewRegistryStore(_store)
+	err := encryption.Encryption(c, _store).WithClient(secretStore).WithClient(registryStore).Build()
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create encryption service: %w", err)
+	}
+	return secretStore, registryStore, nil
+}
+
 func setupConfigService(c *cli.Command, client *utils.Client) (config.Service, error) {
 	timeout := c.Duration("forge-timeout")
 	retries := c.Uint("forge-retry")
@@ -123,13 +129,20 @@ func setupForgeService(c *cli.Command, _store store.Store) error {
 	_forge.OAuthHost = c.String("forge-oauth-host")
 
 	switch {
-	case c.String("addon-forge") != "":
+	case c.String("addon-forge") != "" || c.String("addon-forge-address") != "":
 		_forge.Type = model.ForgeTypeAddon
 		_forge.AdditionalOptions["executable"] = c.String("addon-forge")
+		_forge.AdditionalOptions["address"] = c.String("addon-forge-address")
+		_forge.AdditionalOptions["token"] = c.String("addon-forge-token")
+		_forge.AdditionalOptions["insecure"] = c.Bool("addon-forge-insecure")
 	case c.Bool("github"):
 		_forge.Type = model.ForgeTypeGithub
 		_forge.AdditionalOptions["merge-ref"] = c.Bool("github-merge-ref")
 		_forge.AdditionalOptions["public-only"] = c.Bool("github-public-only")
+		_forge.AdditionalOptions["checks"] = c.Bool("github-checks")
+		_forge.AdditionalOptions["app-id"] = c.String("github-app-id")
+		_forge.AdditionalOptions["app-private-key"] = c.String("github-app-private-key")
+		_forge.AdditionalOptions["app-webhook-secret"] = c.String("github-app-webhook-secret")
 		if _forge.URL == "" {
 			_forge.URL = "https://github.com"
 		}
@@ -156,6 +169,14 @@ func setupForgeService(c *cli.Command, _store store.Store) error {
 		_forge.AdditionalOptions["git-username"] = c.String("bitbucket-dc-git-username")
 		_forge.AdditionalOptions["git-password"] = c.String("bitbucket-dc-git-password")
 		_forge.AdditionalOptions["oauth-enable-project-admin-scope"] = c.Bool("bitbucket-dc-oauth-enable-oauth2-scope-project-admin")
+	case c.Bool("git"):
+		_forge.Type = model.ForgeTypeGit
+		_forge.AdditionalOptions["repos"] = strings.Join(c.StringSlice("git-repos"), "\n")
+		_forge.AdditionalOptions["username"] = c.String("git-username")
+		_forge.AdditionalOptions["password"] = c.String("git-password")
+		_forge.AdditionalOptions["ssh-key"] = c.String("git-ssh-key")
+		_forge.AdditionalOptions["users"] = c.String("git-users")
+		_forge.AdditionalOptions["mirror-path"] = c.String("git-mirror-path")
 	default:
 		return errors.New("forge not configured")
 	}
@@ -0,0 +1,205 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"cmp"
+	"slices"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// Options configures how statistics are aggregated.
+type Options struct {
+	After  int64
+	Before int64
+	// Interval is the length of a timeline bucket in seconds.
+	Interval int64
+	// Limit is the max number of entries in the step, flaky step and branch lists.
+	Limit int
+}
+
+// Compute builds the statistics from the pipelines and steps counted by the store.
+func Compute(aggregates *model.StatsAggregates, opts Options) *model.Stats {
+	stats := &model.Stats{
+		After:      opts.After,
+		Before:     opts.Before,
+		Steps:      []*model.StepStats{},
+		FlakySteps: []*model.StepStats{},
+		Branches:   []*model.BranchStats{},
+		Timeline:   []*model.StatsBucket{},
+	}
+
+	buckets := make(map[int64]*model.StatsBucket)
+	for _, count := range aggregates.Timeline {
+		bucket, ok := buckets[count.Bucket]
+		if !ok {
+			bucket = &model.StatsBucket{Start: count.Bucket}
+			buckets[count.Bucket] = bucket
+			stats.Timeline = append(stats.Timeline, bucket)
+		}
+		bucket.Pipelines += count.Count
+		stats.Pipelines += count.Count
+		if isSuccess(count.Status) {
+			bucket.Success += count.Count
+			stats.Success += count.Count
+		}
+		if isFailure(count.Status) {
+			bucket.Failure += count.Count
+			stats.Failure += count.Count
+		}
+	}
+	slices.SortFunc(stats.Timeline, func(a, b *model.StatsBucket) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+
+	stats.SuccessRate = rate(stats.Success, stats.Failure)
+	stats.Duration = percentiles(aggregates.Durations)
+	stats.QueueWait = percentiles(aggregates.QueueWaits)
+
+	branches := countByName(aggregates.Branches)
+	for name, branch := range branches {
+		stats.Branches = append(stats.Branches, &model.BranchStats{
+			Branch:      name,
+			Pipelines:   branch.runs,
+			SuccessRate: rate(branch.success, branch.failure),
+		})
+	}
+	slices.SortFunc(stats.Branches, func(a, b *model.BranchStats) int {
+		return cmp.Or(cmp.Compare(b.Pipelines, a.Pipelines), cmp.Compare(a.Branch, b.Branch))
+	})
+	stats.Branches = limit(stats.Branches, opts.Limit)
+
+	stepStats := computeSteps(aggregates)
+	stats.Steps = limit(slices.Clone(stepStats), opts.Limit)
+
+	for _, step := range stepStats {
+		if step.Flaky > 0 {
+			stats.FlakySteps = append(stats.FlakySteps, step)
+		}
+	}
+	slices.SortStableFunc(stats.FlakySteps, func(a, b *model.StepStats) int {
+		return cmp.Compare(b.Flaky, a.Flaky)
+	})
+	stats.FlakySteps = limit(stats.FlakySteps, opts.Limit)
+
+	return stats
+}
+
+// computeSteps returns the statistics per step name, ordered by the number of runs.
+func computeSteps(aggregates *model.StatsAggregates) []*model.StepStats {
+	durations := make(map[string][]*model.StatsCount)
+	for _, count := range aggregates.StepDurations {
+		durations[count.Name] = append(durations[count.Name], count)
+	}
+	flaky := make(map[string]int)
+	for _, count := range aggregates.FlakySteps {
+		flaky[count.Name] += count.Count
+	}
+
+	steps := countByName(aggregates.Steps)
+	result := make([]*model.StepStats, 0, len(steps))
+	for name, step := range steps {
+		result = append(result, &model.StepStats{
+			Name:        name,
+			Runs:        step.runs,
+			Failures:    step.failure,
+			SuccessRate: rate(step.success, step.failure),
+			Duration:    percentiles(durations[name]),
+			Flaky:       flaky[name],
+		})
+	}
+	slices.SortFunc(result, func(a, b *model.StepStats) int {
+		return cmp.Or(cmp.Compare(b.Runs, a.Runs), cmp.Compare(a.Name, b.Name))
+	})
+	return result
+}
+
+// countByName sums up the counts per status of every name.
+func countByName(counts []*model.StatsCount) map[string]*counter {
+	counters := make(map[string]*counter)
+	for _, count := range counts {
+		c, ok := counters[count.Name]
+		if !ok {
+			c = &counter{}
+			counters[count.Name] = c
+		}
+		c.add(count.Status, count.Count)
+	}
+	return counters
+}
+
+type counter struct {
+	runs, success, failure int
+}
+
+func (c *counter) add(status model.StatusValue, n int) {
+	c.runs += n
+	if isSuccess(status) {
+		c.success += n
+	}
+	if isFailure(status) {
+		c.failure += n
+	}
+}
+
+func isSuccess(status model.StatusValue) bool {
+	return status == model.StatusSuccess
+}
+
+func isFailure(status model.StatusValue) bool {
+	return status == model.StatusFailure || status == model.StatusError
+}
+
+// rate returns the share of successful runs of all finished runs.
+func rate(success, failure int) float64 {
+	if success+failure == 0 {
+		return 0
+	}
+	return float64(success) / float64(success+failure)
+}
+
+// percentiles returns the nearest-rank p50 and p95 of the values counted per value.
+func percentiles(counts []*model.StatsCount) model.StatsDuration {
+	counts = slices.Clone(counts)
+	total := 0
+	for _, count := range counts {
+		total += count.Count
+	}
+	if total == 0 {
+		return model.StatsDuration{}
+	}
+	slices.SortFunc(counts, func(a, b *model.StatsCount) int {
+		return cmp.Compare(a.Value, b.Value)
+	})
+	rank := func(p int) int64 {
+		n := max((p*total+99)/100, 1)
+		for _, count := range counts {
+			if n <= count.Count {
+				return max(count.Value, 0)
+			}
+			n -= count.Count
+		}
+		return max(counts[len(counts)-1].Value, 0)
+	}
+	return model.StatsDuration{P50: rank(50), P95: rank(95)}
+}
+
+func limit[T any](list []T, n int) []T {
+	if n > 0 && len(list) > n {
+		return list[:n]
+	}
+	return list
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func TestCompute(t *testing.T) {
+	aggregates := &model.StatsAggregates{
+		Timeline: []*model.StatsCount{
+			{Bucket: 1000, Status: model.StatusKilled, Count: 1},
+			{Bucket: 0, Status: model.StatusSuccess, Count: 2},
+			{Bucket: 0, Status: model.StatusFailure, Count: 1},
+			{Bucket: 1000, Status: model.StatusPending, Count: 1},
+		},
+		Branches: []*model.StatsCount{
+			{Name: "main", Status: model.StatusSuccess, Count: 2},
+			{Name: "main", Status: model.StatusFailure, Count: 1},
+			{Name: "dev", Status: model.StatusKilled, Count: 1},
+			{Name: "dev", Status: model.StatusPending, Count: 1},
+		},
+		Durations: []*model.StatsCount{
+			{Value: 70, Count: 1},
+			{Value: 40, Count: 1},
+			{Value: 20, Count: 1},
+		},
+		QueueWaits: []*model.StatsCount{
+			{Value: 0, Count: 1},
+			{Value: 5, Count: 1},
+			{Value: 10, Count: 1},
+			{Value: 30, Count: 1},
+		},
+		Steps: []*model.StatsCount{
+			{Name: "test", Status: model.StatusSuccess, Count: 2},
+			{Name: "test", Status: model.StatusFailure, Count: 1},
+			{Name: "lint", Status: model.StatusSuccess, Count: 2},
+		},
+		StepDurations: []*model.StatsCount{
+			{Name: "test", Value: 30, Count: 1},
+			{Name: "test", Value: 60, Count: 1},
+			{Name: "test", Value: 10, Count: 1},
+			{Name: "lint", Value: 5, Count: 2},
+		},
+		FlakySteps: []*model.StatsCount{
+			{Name: "test", Count: 1},
+		},
+	}
+
+	stats := Compute(aggregates, Options{After: 0, Before: 2000, Interval: 1000, Limit: 10})
+
+	assert.Equal(t, 5, stats.Pipelines)
+	assert.Equal(t, 2, stats.Success)
+	assert.Equal(t, 1, stats.Failure)
+	assert.InDelta(t, 2.0/3.0, stats.SuccessRate, 0.001)
+	assert.Equal(t, model.StatsDuration{P50: 40, P95: 70}, stats.Duration)
+	assert.Equal(t, model.StatsDuration{P50: 5, P95: 30}, stats.QueueWait)
+
+	assert.Equal(t, []*model.BranchStats{
+		{Branch: "main", Pipelines: 3, SuccessRate: 2.0 / 3.0},
+		{Branch: "dev", Pipelines: 2, SuccessRate: 0},
+	}, stats.Branches)
+
+	assert.Equal(t, []*model.StatsBucket{
+		{Start: 0, Pipelines: 3, Success: 2, Failure: 1},
+		{Start: 1000, Pipelines: 2},
+	}, stats.Timeline)
+
+	if assert.Len(t, stats.Steps, 2) {
+		assert.Equal(t, &model.StepStats{
+			Name:        "test",
+			Runs:        3,
+			Failures:    1,
+			SuccessRate: 2.0 / 3.0,
+			Duration:    model.StatsDuration{P50: 30, P95: 60},
+			Flaky:       1,
+		}, stats.Steps[0])
+		assert.Equal(t, "lint", stats.Steps[1].Name)
+		assert.Equal(t, model.StatsDuration{P50: 5, P95: 5}, stats.Steps[1].Duration)
+	}
+	if assert.Len(t, stats.FlakySteps, 1) {
+		assert.Equal(t, "test", stats.FlakySteps[0].Name)
+	}
+
+	limited := Compute(aggregates, Options{Limit: 1})
+	assert.Len(t, limited.Steps, 1)
+	assert.Len(t, limited.Branches, 1)
+}
+
+func TestComputeEmpty(t *testing.T) {
+	stats := Compute(&model.StatsAggregates{}, Options{Interval: 60})
+	assert.Equal(t, 0, stats.Pipelines)
+	assert.Zero(t, stats.SuccessRate)
+	assert.NotNil(t, stats.Steps)
+	assert.NotNil(t, stats.Timeline)
+}
+
+func TestPercentiles(t *testing.T) {
+	assert.Equal(t, model.StatsDuration{}, percentiles(nil))
+	assert.Equal(t, model.StatsDuration{P50: 10, P95: 100}, percentiles([]*model.StatsCount{
+		{Value: 100, Count: 6},
+		{Value: 10, Count: 94},
+	}))
+	assert.Equal(t, model.StatsDuration{P50: 0, P95: 0}, percentiles([]*model.StatsCount{
+		{Value: -5, Count: 1},
+	}))
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func (s storage) AgentPoolFind(id int64) (*model.AgentPool, error) {
+	pool := new(model.AgentPool)
+	return pool, wrapGet(s.engine.ID(id).Get(pool))
+}
+
+func (s storage) AgentPoolList(p *model.ListOptions) ([]*model.AgentPool, error) {
+	pools := make([]*model.AgentPool, 0)
+	return pools, s.paginate(p).OrderBy("name").Find(&pools)
+}
+
+func (s storage) AgentPoolCreate(pool *model.AgentPool) error {
+	_, err := s.engine.Insert(pool)
+	return err
+}
+
+func (s storage) AgentPoolUpdate(pool *model.AgentPool) error {
+	_, err := s.engine.ID(pool.ID).AllCols().Update(pool)
+	return err
+}
+
+func (s storage) AgentPoolDelete(pool *model.AgentPool) error {
+	return wrapDelete(s.engine.ID(pool.ID).Delete(new(model.AgentPool)))
+}
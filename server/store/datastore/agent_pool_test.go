@@ -0,0 +1,64 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+func TestAgentPools(t *testing.T) {
+	store, closer := newTestStore(t, new(model.AgentPool))
+	defer closer()
+
+	_, err := store.AgentPoolFind(1)
+	assert.ErrorIs(t, err, types.RecordNotExist)
+
+	pool := &model.AgentPool{Name: "security", Agents: []int64{1, 2}, AllowedOrgs: []int64{3}}
+	assert.NoError(t, store.AgentPoolCreate(pool))
+	assert.NotZero(t, pool.ID)
+	assert.NoError(t, store.AgentPoolCreate(&model.AgentPool{Name: "burst", LabelSelector: map[string]string{"pool": "burst"}}))
+	assert.Error(t, store.AgentPoolCreate(&model.AgentPool{Name: "security", Agents: []int64{3}}))
+
+	pool.AllowedRepos = []int64{4}
+	pool.MaxConcurrent = 2
+	pool.OrgQuotas = map[int64]int{3: 0}
+	assert.NoError(t, store.AgentPoolUpdate(pool))
+
+	found, err := store.AgentPoolFind(pool.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, found.Agents)
+	assert.Equal(t, []int64{3}, found.AllowedOrgs)
+	assert.Equal(t, []int64{4}, found.AllowedRepos)
+	assert.Equal(t, 2, found.MaxConcurrent)
+	assert.Equal(t, map[int64]int{3: 0}, found.OrgQuotas)
+
+	pools, err := store.AgentPoolList(&model.ListOptions{All: true})
+	assert.NoError(t, err)
+	if assert.Len(t, pools, 2) {
+		assert.Equal(t, "burst", pools[0].Name)
+		assert.Equal(t, map[string]string{"pool": "burst"}, pools[0].LabelSelector)
+		assert.Equal(t, "security", pools[1].Name)
+	}
+
+	assert.NoError(t, store.AgentPoolDelete(pool))
+	assert.ErrorIs(t, store.AgentPoolDelete(pool), types.RecordNotExist)
+	_, err = store.AgentPoolFind(pool.ID)
+	assert.ErrorIs(t, err, types.RecordNotExist)
+}
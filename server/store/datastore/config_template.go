@@ -0,0 +1,45 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"xorm.io/builder"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func (s storage) ConfigTemplateFind(orgID int64, name string) (*model.ConfigTemplate, error) {
+	template := new(model.ConfigTemplate)
+	return template, wrapGet(s.engine.Where(builder.Eq{"org_id": orgID, "name": name}).Get(template))
+}
+
+func (s storage) ConfigTemplateList(orgID int64, p *model.ListOptions) ([]*model.ConfigTemplate, error) {
+	templates := make([]*model.ConfigTemplate, 0)
+	return templates, s.paginate(p).Where("org_id = ?", orgID).OrderBy("name").Find(&templates)
+}
+
+func (s storage) ConfigTemplateCreate(template *model.ConfigTemplate) error {
+	_, err := s.engine.Insert(template)
+	return err
+}
+
+func (s storage) ConfigTemplateUpdate(template *model.ConfigTemplate) error {
+	_, err := s.engine.ID(template.ID).AllCols().Update(template)
+	return err
+}
+
+func (s storage) ConfigTemplateDelete(template *model.ConfigTemplate) error {
+	return wrapDelete(s.engine.ID(template.ID).Delete(new(model.ConfigTemplate)))
+}
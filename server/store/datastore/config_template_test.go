@@ -0,0 +1,58 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+func TestConfigTemplates(t *testing.T) {
+	store, closer := newTestStore(t, new(model.ConfigTemplate))
+	defer closer()
+
+	_, err := store.ConfigTemplateFind(1, "go")
+	assert.ErrorIs(t, err, types.RecordNotExist)
+
+	template := &model.ConfigTemplate{OrgID: 1, Name: "go", Data: "steps: []"}
+	assert.NoError(t, store.ConfigTemplateCreate(template))
+	assert.NotZero(t, template.ID)
+	assert.NoError(t, store.ConfigTemplateCreate(&model.ConfigTemplate{OrgID: 1, Name: "deploy", Data: "steps: []"}))
+	assert.NoError(t, store.ConfigTemplateCreate(&model.ConfigTemplate{OrgID: 2, Name: "go", Data: "steps: []"}))
+	assert.Error(t, store.ConfigTemplateCreate(&model.ConfigTemplate{OrgID: 1, Name: "go", Data: "steps: []"}))
+
+	template.Data = "labels: {}"
+	assert.NoError(t, store.ConfigTemplateUpdate(template))
+
+	found, err := store.ConfigTemplateFind(1, "go")
+	assert.NoError(t, err)
+	assert.Equal(t, "labels: {}", found.Data)
+
+	templates, err := store.ConfigTemplateList(1, &model.ListOptions{All: true})
+	assert.NoError(t, err)
+	if assert.Len(t, templates, 2) {
+		assert.Equal(t, "deploy", templates[0].Name)
+		assert.Equal(t, "go", templates[1].Name)
+	}
+
+	assert.NoError(t, store.ConfigTemplateDelete(template))
+	assert.ErrorIs(t, store.ConfigTemplateDelete(template), types.RecordNotExist)
+	_, err = store.ConfigTemplateFind(1, "go")
+	assert.ErrorIs(t, err, types.RecordNotExist)
+}
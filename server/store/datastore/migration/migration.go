@@ -76,6 +76,9 @@ var allBeans = []any{
 	new(model.Forge),
 	new(model.Workflow),
 	new(model.Org),
+	new(model.RetentionPolicy),
+	new(model.ConfigTemplate),
+	new(model.AgentPool),
 }
 
 // TODO: make xormigrate context aware
@@ -59,6 +59,12 @@ func (s storage) orgDelete(sess *xorm.Session, id int64) error {
 	if _, err := sess.Where("org_id = ?", id).Delete(new(model.Secret)); err != nil {
 		return err
 	}
+	if _, err := sess.Where("org_id = ?", id).Delete(new(model.RetentionPolicy)); err != nil {
+		return err
+	}
+	if _, err := sess.Where("org_id = ?", id).Delete(new(model.ConfigTemplate)); err != nil {
+		return err
+	}
 
 	var repos []*model.Repo
 	if err := sess.Where("org_id = ?", id).Find(&repos); err != nil {
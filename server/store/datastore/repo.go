@@ -120,6 +120,9 @@ func (s storage) deleteRepo(sess *xorm.Session, repo *model.Repo) error {
 	if _, err := sess.Where("repo_id = ?", repo.ID).Delete(new(model.Redirection)); err != nil {
 		return err
 	}
+	if _, err := sess.Where("repo_id = ?", repo.ID).Delete(new(model.RetentionPolicy)); err != nil {
+		return err
+	}
 
 	// delete related pipelines
 	for startPipelines := 0; ; startPipelines += batchSize {
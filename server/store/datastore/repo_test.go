@@ -296,6 +296,7 @@ func TestRepoCrud(t *testing.T) {
 		new(model.Registry),
 		new(model.Config),
 		new(model.Redirection),
+		new(model.RetentionPolicy),
 		new(model.Workflow))
 	defer closer()
 
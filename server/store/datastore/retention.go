@@ -0,0 +1,64 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"xorm.io/builder"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func (s storage) RetentionPolicyFind(orgID, repoID int64) (*model.RetentionPolicy, error) {
+	policy := new(model.RetentionPolicy)
+	return policy, wrapGet(s.engine.Where(builder.Eq{"org_id": orgID, "repo_id": repoID}).Get(policy))
+}
+
+func (s storage) RetentionPolicyList() ([]*model.RetentionPolicy, error) {
+	policies := make([]*model.RetentionPolicy, 0)
+	return policies, s.engine.OrderBy("id").Find(&policies)
+}
+
+func (s storage) RetentionPolicySave(policy *model.RetentionPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	if policy.ID == 0 {
+		_, err := s.engine.Insert(policy)
+		return err
+	}
+	_, err := s.engine.ID(policy.ID).AllCols().Update(policy)
+	return err
+}
+
+func (s storage) RetentionPolicyDelete(policy *model.RetentionPolicy) error {
+	return wrapDelete(s.engine.ID(policy.ID).Delete(new(model.RetentionPolicy)))
+}
+
+func (s storage) RetentionPipelineList(repo *model.Repo) ([]*model.Pipeline, error) {
+	pipelines := make([]*model.Pipeline, 0)
+	return pipelines, s.engine.
+		Cols("id", "repo_id", "number", "event", "status", "created", "branch", "deploy", "logs_pruned").
+		Where("repo_id = ?", repo.ID).
+		Desc("number").
+		Find(&pipelines)
+}
+
+func (s storage) RetentionSetLogsPruned(pipeline *model.Pipeline) error {
+	_, err := s.engine.ID(pipeline.ID).Cols("logs_pruned").Update(&model.Pipeline{LogsPruned: true})
+	if err == nil {
+		pipeline.LogsPruned = true
+	}
+	return err
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+func TestRetentionPolicies(t *testing.T) {
+	store, closer := newTestStore(t, new(model.RetentionPolicy))
+	defer closer()
+
+	_, err := store.RetentionPolicyFind(0, 1)
+	assert.ErrorIs(t, err, types.RecordNotExist)
+
+	repoPolicy := &model.RetentionPolicy{RepoID: 1, KeepLast: 10}
+	assert.NoError(t, store.RetentionPolicySave(repoPolicy))
+	assert.NotZero(t, repoPolicy.ID)
+	assert.NoError(t, store.RetentionPolicySave(&model.RetentionPolicy{OrgID: 2, KeepDays: 30, LogDays: 7}))
+	assert.Error(t, store.RetentionPolicySave(&model.RetentionPolicy{OrgID: 3, KeepDays: -1}))
+
+	repoPolicy.KeepLatestPerBranch = true
+	assert.NoError(t, store.RetentionPolicySave(repoPolicy))
+
+	policy, err := store.RetentionPolicyFind(0, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, repoPolicy, policy)
+
+	policy, err = store.RetentionPolicyFind(2, 0)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 30, policy.KeepDays)
+
+	policies, err := store.RetentionPolicyList()
+	assert.NoError(t, err)
+	assert.Len(t, policies, 2)
+
+	assert.NoError(t, store.RetentionPolicyDelete(repoPolicy))
+	assert.ErrorIs(t, store.RetentionPolicyDelete(repoPolicy), types.RecordNotExist)
+	_, err = store.RetentionPolicyFind(0, 1)
+	assert.ErrorIs(t, err, types.RecordNotExist)
+}
+
+func TestRetentionPipelineList(t *testing.T) {
+	store, closer := newTestStore(t, new(model.Repo), new(model.Step), new(model.Pipeline))
+	defer closer()
+
+	repo := &model.Repo{ID: 1, UserID: 1, FullName: "a/a", Owner: "a", Name: "a", ForgeRemoteID: "1"}
+	assert.NoError(t, store.CreateRepo(repo))
+
+	pipeline1 := &model.Pipeline{RepoID: 1, Status: model.StatusSuccess, Branch: "main", Message: "first"}
+	assert.NoError(t, store.CreatePipeline(pipeline1))
+	pipeline2 := &model.Pipeline{RepoID: 1, Status: model.StatusSuccess, Event: model.EventDeploy, DeployTo: "prod"}
+	assert.NoError(t, store.CreatePipeline(pipeline2))
+	assert.NoError(t, store.CreateRepo(&model.Repo{ID: 2, UserID: 1, FullName: "b/b", Owner: "b", Name: "b", ForgeRemoteID: "2"}))
+	assert.NoError(t, store.CreatePipeline(&model.Pipeline{RepoID: 2, Status: model.StatusSuccess}))
+
+	assert.NoError(t, store.RetentionSetLogsPruned(pipeline1))
+	assert.True(t, pipeline1.LogsPruned)
+
+	pipelines, err := store.RetentionPipelineList(repo)
+	assert.NoError(t, err)
+	if assert.Len(t, pipelines, 2) {
+		assert.Equal(t, pipeline2.ID, pipelines[0].ID)
+		assert.Equal(t, "prod", pipelines[0].DeployTo)
+		assert.Equal(t, pipeline1.ID, pipelines[1].ID)
+		assert.True(t, pipelines[1].LogsPruned)
+		assert.Equal(t, "main", pipelines[1].Branch)
+		assert.Empty(t, pipelines[1].Message)
+	}
+
+	pipeline, err := store.GetPipeline(pipeline1.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "first", pipeline.Message)
+	assert.True(t, pipeline.LogsPruned)
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"fmt"
+
+	"xorm.io/builder"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func (s storage) StatsAggregate(f *model.StatsFilter) (*model.StatsAggregates, error) {
+	aggregates := &model.StatsAggregates{}
+	pipelineCond := statsCond(f, "pipelines.")
+	stepCond := pipelineCond.And(builder.Neq{"steps.type": model.StepTypeService})
+
+	if f.Interval > 0 {
+		// the bucket is the start of the interval the pipeline was created in
+		bucket := fmt.Sprintf("pipelines.created - (pipelines.created - %d) %% %d", f.After, f.Interval)
+		if err := s.statsCount(&aggregates.Timeline, "pipelines",
+			bucket+" AS bucket, pipelines.status AS status, COUNT(*) AS total",
+			pipelineCond, bucket+", pipelines.status"); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.statsCount(&aggregates.Branches, "pipelines",
+		"pipelines.branch AS name, pipelines.status AS status, COUNT(*) AS total",
+		pipelineCond.And(builder.Neq{"pipelines.branch": ""}), "pipelines.branch, pipelines.status"); err != nil {
+		return nil, err
+	}
+
+	if err := s.statsCount(&aggregates.Durations, "pipelines",
+		"pipelines.finished - pipelines.started AS value, COUNT(*) AS total",
+		pipelineCond.And(builder.Neq{"pipelines.started": 0}, builder.Neq{"pipelines.finished": 0}),
+		"pipelines.finished - pipelines.started"); err != nil {
+		return nil, err
+	}
+
+	if err := s.statsCount(&aggregates.QueueWaits, "pipelines",
+		"pipelines.started - pipelines.created AS value, COUNT(*) AS total",
+		pipelineCond.And(builder.Neq{"pipelines.started": 0}),
+		"pipelines.started - pipelines.created"); err != nil {
+		return nil, err
+	}
+
+	if err := s.statsCount(&aggregates.Steps, "steps",
+		"steps.name AS name, steps.state AS status, COUNT(*) AS total",
+		stepCond, "steps.name, steps.state"); err != nil {
+		return nil, err
+	}
+
+	if err := s.statsCount(&aggregates.StepDurations, "steps",
+		"steps.name AS name, steps.finished - steps.started AS value, COUNT(*) AS total",
+		stepCond.And(builder.Neq{"steps.started": 0}, builder.Neq{"steps.finished": 0}),
+		"steps.name, steps.finished - steps.started"); err != nil {
+		return nil, err
+	}
+
+	// a step is flaky on a commit if it both succeeded and failed on it
+	commit := s.engine.Quote("pipelines.commit")
+	flakyCommits := builder.Select("steps.name AS name").
+		From("steps").
+		Join("INNER", "pipelines", "pipelines.id = steps.pipeline_id").
+		Where(stepCond.And(builder.Neq{commit: ""})).
+		GroupBy("steps.name, " + commit).
+		Having(fmt.Sprintf("SUM(CASE WHEN steps.state = '%s' THEN 1 ELSE 0 END) > 0 AND SUM(CASE WHEN steps.state IN ('%s', '%s') THEN 1 ELSE 0 END) > 0",
+			model.StatusSuccess, model.StatusFailure, model.StatusError))
+	query, args, err := builder.Select("name", "COUNT(*) AS total").
+		From(flakyCommits, "flaky_commits").
+		GroupBy("name").
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	aggregates.FlakySteps = make([]*model.StatsCount, 0)
+	if err := s.engine.SQL(query, args...).Find(&aggregates.FlakySteps); err != nil {
+		return nil, err
+	}
+
+	return aggregates, nil
+}
+
+// statsCount counts the pipelines or steps matching the condition per group.
+func (s storage) statsCount(counts *[]*model.StatsCount, table, columns string, cond builder.Cond, groupBy string) error {
+	*counts = make([]*model.StatsCount, 0)
+	sess := s.engine.Table(table).Select(columns)
+	if table == "steps" {
+		sess = sess.Join("INNER", "pipelines", "pipelines.id = steps.pipeline_id")
+	}
+	return sess.Where(cond).GroupBy(groupBy).Find(counts)
+}
+
+func statsCond(f *model.StatsFilter, prefix string) builder.Cond {
+	cond := builder.In(prefix+"repo_id", f.RepoIDs)
+	if f.After != 0 {
+		cond = cond.And(builder.Gte{prefix + "created": f.After})
+	}
+	if f.Before != 0 {
+		cond = cond.And(builder.Lt{prefix + "created": f.Before})
+	}
+	return cond
+}
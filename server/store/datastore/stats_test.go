@@ -0,0 +1,95 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func TestStatsAggregate(t *testing.T) {
+	store, closer := newTestStore(t, new(model.Repo), new(model.Step), new(model.Pipeline))
+	defer closer()
+
+	assert.NoError(t, store.CreateRepo(&model.Repo{ID: 1, UserID: 1, FullName: "a/a", Owner: "a", Name: "a", ForgeRemoteID: "1"}))
+	assert.NoError(t, store.CreateRepo(&model.Repo{ID: 2, UserID: 1, FullName: "b/b", Owner: "b", Name: "b", ForgeRemoteID: "2"}))
+
+	pipelines := []*model.Pipeline{
+		{RepoID: 1, Status: model.StatusSuccess, Branch: "main", Commit: "a", Started: 110, Finished: 150},
+		{RepoID: 1, Status: model.StatusFailure, Branch: "main", Commit: "b", Started: 230, Finished: 300},
+		{RepoID: 1, Status: model.StatusSuccess, Branch: "main", Commit: "b", Started: 400, Finished: 420},
+		{RepoID: 1, Status: model.StatusKilled, Branch: "dev", Commit: "c", Started: 1105},
+		{RepoID: 2, Status: model.StatusSuccess, Branch: "main", Commit: "d"},
+	}
+	steps := [][]*model.Step{
+		{
+			{PID: 1, Name: "test", State: model.StatusSuccess, Started: 110, Finished: 140},
+			{PID: 2, Name: "database", State: model.StatusSuccess, Type: model.StepTypeService},
+		},
+		{{Name: "test", State: model.StatusFailure, Started: 230, Finished: 290}},
+		{{Name: "test", State: model.StatusSuccess, Started: 400, Finished: 410}},
+		{{Name: "test", State: model.StatusKilled, Started: 1105}},
+		{{Name: "test", State: model.StatusSuccess}},
+	}
+	for i, pipeline := range pipelines {
+		assert.NoError(t, store.CreatePipeline(pipeline, steps[i]...))
+		// created is set on insert
+		_, err := store.engine.Exec("UPDATE pipelines SET created = ? WHERE id = ?", []int64{100, 200, 400, 1100, 100}[i], pipeline.ID)
+		assert.NoError(t, err)
+	}
+
+	aggregates, err := store.StatsAggregate(&model.StatsFilter{RepoIDs: []int64{1}, After: 0, Before: 2000, Interval: 1000})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []*model.StatsCount{
+		{Bucket: 0, Status: model.StatusSuccess, Count: 2},
+		{Bucket: 0, Status: model.StatusFailure, Count: 1},
+		{Bucket: 1000, Status: model.StatusKilled, Count: 1},
+	}, aggregates.Timeline)
+	assert.ElementsMatch(t, []*model.StatsCount{
+		{Name: "main", Status: model.StatusSuccess, Count: 2},
+		{Name: "main", Status: model.StatusFailure, Count: 1},
+		{Name: "dev", Status: model.StatusKilled, Count: 1},
+	}, aggregates.Branches)
+	assert.ElementsMatch(t, []*model.StatsCount{
+		{Value: 40, Count: 1},
+		{Value: 70, Count: 1},
+		{Value: 20, Count: 1},
+	}, aggregates.Durations)
+	assert.ElementsMatch(t, []*model.StatsCount{
+		{Value: 10, Count: 1},
+		{Value: 30, Count: 1},
+		{Value: 0, Count: 1},
+		{Value: 5, Count: 1},
+	}, aggregates.QueueWaits)
+	assert.ElementsMatch(t, []*model.StatsCount{
+		{Name: "test", Status: model.StatusSuccess, Count: 2},
+		{Name: "test", Status: model.StatusFailure, Count: 1},
+		{Name: "test", Status: model.StatusKilled, Count: 1},
+	}, aggregates.Steps)
+	assert.ElementsMatch(t, []*model.StatsCount{
+		{Name: "test", Value: 30, Count: 1},
+		{Name: "test", Value: 60, Count: 1},
+		{Name: "test", Value: 10, Count: 1},
+	}, aggregates.StepDurations)
+	assert.Equal(t, []*model.StatsCount{{Name: "test", Count: 1}}, aggregates.FlakySteps)
+
+	aggregates, err = store.StatsAggregate(&model.StatsFilter{RepoIDs: []int64{1, 2}, After: 1000, Before: 2000, Interval: 500})
+	assert.NoError(t, err)
+	assert.Equal(t, []*model.StatsCount{{Bucket: 1000, Status: model.StatusKilled, Count: 1}}, aggregates.Timeline)
+	assert.Empty(t, aggregates.FlakySteps)
+}
@@ -29,6 +29,11 @@ func (s storage) TaskInsert(task *model.Task) error {
 	return err
 }
 
+func (s storage) TaskUpdate(task *model.Task) error {
+	_, err := s.engine.ID(task.ID).AllCols().Update(task)
+	return err
+}
+
 func (s storage) TaskDelete(id string) error {
 	return wrapDelete(s.engine.Where("id = ?", id).Delete(new(model.Task)))
 }
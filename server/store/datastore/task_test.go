@@ -40,6 +40,15 @@ func TestTaskList(t *testing.T) {
 	assert.Equal(t, "foo", string(list[0].Data))
 	assert.EqualValues(t, map[string]model.StatusValue{"test": "dep"}, list[0].DepStatus)
 
+	list[0].AgentID = 4
+	assert.NoError(t, store.TaskUpdate(list[0]))
+
+	list, err = store.TaskList()
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.EqualValues(t, 4, list[0].AgentID)
+	assert.Equal(t, "foo", string(list[0].Data))
+
 	assert.NoError(t, store.TaskDelete("some_random_id"))
 
 	list, err = store.TaskList()
@@ -93,6 +93,36 @@ type Store interface {
 	// DeletePipeline deletes a pipeline.
 	DeletePipeline(*model.Pipeline) error
 
+	// Stats
+	// StatsAggregate counts the pipelines and steps statistics are computed from.
+	StatsAggregate(*model.StatsFilter) (*model.StatsAggregates, error)
+
+	// Retention
+	// RetentionPolicyFind gets the policy of an org or repo, for a repo policy orgID is 0.
+	RetentionPolicyFind(orgID, repoID int64) (*model.RetentionPolicy, error)
+	// RetentionPolicyList gets all org and repo policies.
+	RetentionPolicyList() ([]*model.RetentionPolicy, error)
+	// RetentionPolicySave creates or updates a policy.
+	RetentionPolicySave(*model.RetentionPolicy) error
+	// RetentionPolicyDelete deletes a policy.
+	RetentionPolicyDelete(*model.RetentionPolicy) error
+	// RetentionPipelineList gets all pipelines of a repo with the fields relevant for pruning, newest first.
+	RetentionPipelineList(*model.Repo) ([]*model.Pipeline, error)
+	// RetentionSetLogsPruned marks the logs of a pipeline as deleted.
+	RetentionSetLogsPruned(*model.Pipeline) error
+
+	// Config templates
+	// ConfigTemplateFind gets a template of an org by name.
+	ConfigTemplateFind(orgID int64, name string) (*model.ConfigTemplate, error)
+	// ConfigTemplateList gets the templates of an org.
+	ConfigTemplateList(orgID int64, p *model.ListOptions) ([]*model.ConfigTemplate, error)
+	// ConfigTemplateCreate creates a template.
+	ConfigTemplateCreate(*model.ConfigTemplate) error
+	// ConfigTemplateUpdate updates a template.
+	ConfigTemplateUpdate(*model.ConfigTemplate) error
+	// ConfigTemplateDelete deletes a template.
+	ConfigTemplateDelete(*model.ConfigTemplate) error
+
 	// Feeds
 	UserFeed(*model.User) ([]*model.Feed, error)
 
@@ -154,6 +184,7 @@ type Store interface {
 	// TaskList TODO: paginate & opt filter
 	TaskList() ([]*model.Task, error)
 	TaskInsert(*model.Task) error
+	TaskUpdate(*model.Task) error
 	TaskDelete(string) error
 
 	// ServerConfig
@@ -186,6 +217,17 @@ type Store interface {
 	AgentDelete(*model.Agent) error
 	AgentListForOrg(orgID int64, opt *model.ListOptions) ([]*model.Agent, error)
 
+	// AgentPoolFind gets an agent pool by id.
+	AgentPoolFind(int64) (*model.AgentPool, error)
+	// AgentPoolList gets all agent pools.
+	AgentPoolList(p *model.ListOptions) ([]*model.AgentPool, error)
+	// AgentPoolCreate creates an agent pool.
+	AgentPoolCreate(*model.AgentPool) error
+	// AgentPoolUpdate updates an agent pool.
+	AgentPoolUpdate(*model.AgentPool) error
+	// AgentPoolDelete deletes an agent pool.
+	AgentPoolDelete(*model.AgentPool) error
+
 	// Workflow
 	WorkflowGetTree(*model.Pipeline) ([]*model.Workflow, error)
 	WorkflowsCreate([]*model.Workflow) error
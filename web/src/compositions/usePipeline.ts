@@ -86,7 +86,7 @@ export default (pipeline: Ref<Pipeline | undefined>) => {
       return pipeline.value.branch;
     }
 
-    if (pipeline.value?.event === 'cron') {
+    if (pipeline.value?.event === 'cron' || pipeline.value?.event === 'merge_group') {
       return pipeline.value.ref.replaceAll('refs/heads/', '');
     }
 
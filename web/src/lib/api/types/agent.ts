@@ -13,4 +13,21 @@ export interface Agent {
   version: string;
   no_schedule: boolean;
   custom_labels: Record<string, string>;
+  capabilities?: AgentCapabilities;
+  labels?: Record<string, string>;
+  draining: boolean;
+  drain_deadline: number;
+  status: AgentStatus;
+  running_tasks: number;
+  drain_eta: number;
 }
+
+export interface AgentCapabilities {
+  cpus: number;
+  memory: number;
+  disk_free: number;
+  architectures: string[] | null;
+  runtimes: string[] | null;
+}
+
+export type AgentStatus = 'idle' | 'busy' | 'draining' | 'drained' | 'offline';
@@ -6,11 +6,16 @@ export interface Task {
   dependencies: string[];
   dep_status: Record<string, string>;
   run_on: string[];
+  requires: Record<string, string> | null;
   agent_id: number;
   agent_name: string;
   pipeline_id: number;
   pipeline_number: number;
   repo_id: number;
+  org_id: number;
+  priority: number;
+  position?: number;
+  reason?: string;
 }
 
 export interface QueueStats {
@@ -82,6 +82,18 @@ export interface Repo {
 
   // Endpoint for config extensions
   config_extension_endpoint: string;
+
+  // Keywords of the directives in commit messages, e.g. `ci skip` for `[ci skip]`
+  commit_directives?: CommitDirectives;
+
+  // Seconds workflows wait for the agent that last ran them, 0 disables the agent affinity
+  agent_affinity_wait: number;
+}
+
+export interface CommitDirectives {
+  skip: string[];
+  only: string[];
+  full: string[];
 }
 
 /* eslint-disable no-unused-vars */
@@ -111,6 +123,8 @@ export type RepoSettings = Pick<
   | 'allow_deploy'
   | 'cancel_previous_pipeline_events'
   | 'netrc_trusted'
+  | 'commit_directives'
+  | 'agent_affinity_wait'
 >;
 
 export type ExtensionSettings = Pick<Repo, 'config_extension_endpoint'>;
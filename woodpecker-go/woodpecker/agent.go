@@ -1,11 +1,17 @@
 package woodpecker
 
-import "fmt"
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
 
 const (
 	pathAgents     = "%s/api/agents"
 	pathAgent      = "%s/api/agents/%d"
 	pathAgentTasks = "%s/api/agents/%d/tasks"
+	pathAgentDrain = "%s/api/agents/%d/drain"
 )
 
 // AgentCreate creates a new agent.
@@ -48,3 +54,31 @@ func (c *client) AgentTasksList(agentID int64) ([]*Task, error) {
 	uri := fmt.Sprintf(pathAgentTasks, c.addr, agentID)
 	return out, c.get(uri, &out)
 }
+
+// AgentDrain stops scheduling new workflows to the agent with the given id.
+func (c *client) AgentDrain(agentID int64) (*Agent, error) {
+	out := new(Agent)
+	uri := fmt.Sprintf(pathAgentDrain, c.addr, agentID)
+	return out, c.post(uri, nil, out)
+}
+
+// AgentDrainCancel schedules workflows to the draining agent with the given id again.
+func (c *client) AgentDrainCancel(agentID int64) (*Agent, error) {
+	out := new(Agent)
+	uri := fmt.Sprintf(pathAgentDrain, c.addr, agentID)
+	return out, c.do(uri, http.MethodDelete, nil, out)
+}
+
+// AgentDrainWait blocks until the draining agent with the given id has no running
+// workflows anymore or the timeout passed. A zero timeout waits forever.
+func (c *client) AgentDrainWait(agentID int64, timeout time.Duration) (*Agent, error) {
+	out := new(Agent)
+	uri, err := url.Parse(fmt.Sprintf(pathAgentDrain, c.addr, agentID))
+	if err != nil {
+		return nil, err
+	}
+	if timeout > 0 {
+		uri.RawQuery = url.Values{"timeout": {timeout.String()}}.Encode()
+	}
+	return out, c.get(uri.String(), out)
+}
@@ -0,0 +1,42 @@
+package woodpecker
+
+import "fmt"
+
+const (
+	pathAgentPools = "%s/api/agent-pools"
+	pathAgentPool  = "%s/api/agent-pools/%d"
+)
+
+// AgentPoolList returns a list of all agent pools.
+func (c *client) AgentPoolList() ([]*AgentPool, error) {
+	out := make([]*AgentPool, 0, 5)
+	uri := fmt.Sprintf(pathAgentPools, c.addr)
+	return out, c.get(uri, &out)
+}
+
+// AgentPool returns an agent pool by id.
+func (c *client) AgentPool(poolID int64) (*AgentPool, error) {
+	out := new(AgentPool)
+	uri := fmt.Sprintf(pathAgentPool, c.addr, poolID)
+	return out, c.get(uri, out)
+}
+
+// AgentPoolCreate creates a new agent pool.
+func (c *client) AgentPoolCreate(in *AgentPool) (*AgentPool, error) {
+	out := new(AgentPool)
+	uri := fmt.Sprintf(pathAgentPools, c.addr)
+	return out, c.post(uri, in, out)
+}
+
+// AgentPoolUpdate updates the agent pool with the given id.
+func (c *client) AgentPoolUpdate(poolID int64, in *AgentPoolPatch) (*AgentPool, error) {
+	out := new(AgentPool)
+	uri := fmt.Sprintf(pathAgentPool, c.addr, poolID)
+	return out, c.patch(uri, in, out)
+}
+
+// AgentPoolDelete deletes the agent pool with the given id.
+func (c *client) AgentPoolDelete(poolID int64) error {
+	uri := fmt.Sprintf(pathAgentPool, c.addr, poolID)
+	return c.delete(uri)
+}
@@ -0,0 +1,61 @@
+package woodpecker
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_AgentPoolCreate(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/agent-pools", r.URL.Path)
+
+		in := new(AgentPool)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(in))
+		in.ID = 1
+		assert.NoError(t, json.NewEncoder(w).Encode(in))
+	}))
+	defer ts.Close()
+
+	client := NewClient(ts.URL, http.DefaultClient)
+	pool, err := client.AgentPoolCreate(&AgentPool{Name: "security", Agents: []int64{1, 2}, AllowedOrgs: []int64{3}})
+	assert.NoError(t, err)
+	assert.Equal(t, &AgentPool{ID: 1, Name: "security", Agents: []int64{1, 2}, AllowedOrgs: []int64{3}}, pool)
+}
+
+func TestClient_AgentPoolUpdate(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/api/agent-pools/1", r.URL.Path)
+
+		in := map[string]any{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&in))
+		assert.Equal(t, map[string]any{"max_concurrent": float64(2)}, in)
+		_, err := fmt.Fprint(w, `{"id":1,"name":"burst","label_selector":{"pool":"burst"},"max_concurrent":2}`)
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	client := NewClient(ts.URL, http.DefaultClient)
+	maxConcurrent := 2
+	pool, err := client.AgentPoolUpdate(1, &AgentPoolPatch{MaxConcurrent: &maxConcurrent})
+	assert.NoError(t, err)
+	assert.Equal(t, &AgentPool{ID: 1, Name: "burst", LabelSelector: map[string]string{"pool": "burst"}, MaxConcurrent: 2}, pool)
+}
+
+func TestClient_AgentPoolDelete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/api/agent-pools/1", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client := NewClient(ts.URL, http.DefaultClient)
+	assert.NoError(t, client.AgentPoolDelete(1))
+}
@@ -16,6 +16,7 @@ package woodpecker
 
 import (
 	"net/http"
+	"time"
 )
 
 // Client is used to communicate with a Woodpecker server.
@@ -65,6 +66,18 @@ type Client interface {
 	// RepoMove moves the repository
 	RepoMove(repoID int64, opt RepoMoveOptions) error
 
+	// RepoStats returns the pipeline statistics of a repository.
+	RepoStats(repoID int64, opt RepoStatsOptions) (*Stats, error)
+
+	// RepoRetention returns the retention policy used for a repository.
+	RepoRetention(repoID int64) (*RetentionPolicy, error)
+
+	// RepoRetentionUpdate updates the retention policy of a repository.
+	RepoRetentionUpdate(repoID int64, policy *RetentionPolicyPatch) (*RetentionPolicy, error)
+
+	// RepoRetentionDelete deletes the retention policy of a repository.
+	RepoRetentionDelete(repoID int64) error
+
 	// RepoChown updates a repository owner.
 	RepoChown(repoID int64) (*Repo, error)
 
@@ -110,6 +123,9 @@ type Client interface {
 	// StepLogEntries returns the LogEntries for the given pipeline step
 	StepLogEntries(repoID, pipeline, stepID int64) ([]*LogEntry, error)
 
+	// LogSearch searches the step logs of the recent pipelines of a repository.
+	LogSearch(repoID int64, opt LogSearchOptions) (*LogSearchResult, error)
+
 	// Deploy triggers a deployment for an existing pipeline using the specified
 	// target environment.
 	Deploy(repoID, pipeline int64, opt DeployOptions) (*Pipeline, error)
@@ -150,6 +166,15 @@ type Client interface {
 	// OrgRegistryDelete deletes an organization registry.
 	OrgRegistryDelete(orgID int64, registry string) error
 
+	// OrgRetention returns the retention policy used for an organization.
+	OrgRetention(orgID int64) (*RetentionPolicy, error)
+
+	// OrgRetentionUpdate updates the retention policy of an organization.
+	OrgRetentionUpdate(orgID int64, policy *RetentionPolicyPatch) (*RetentionPolicy, error)
+
+	// OrgRetentionDelete deletes the retention policy of an organization.
+	OrgRetentionDelete(orgID int64) error
+
 	// GlobalRegistry returns an global registry by address.
 	GlobalRegistry(registry string) (*Registry, error)
 
@@ -204,6 +229,21 @@ type Client interface {
 	// OrgSecretDelete deletes an organization secret.
 	OrgSecretDelete(orgID int64, secret string) error
 
+	// OrgConfigTemplate returns an organization config template by name.
+	OrgConfigTemplate(orgID int64, template string) (*ConfigTemplate, error)
+
+	// OrgConfigTemplateList returns a list of all organization config templates.
+	OrgConfigTemplateList(orgID int64, opt ListOptions) ([]*ConfigTemplate, error)
+
+	// OrgConfigTemplateCreate creates an organization config template.
+	OrgConfigTemplateCreate(orgID int64, template *ConfigTemplate) (*ConfigTemplate, error)
+
+	// OrgConfigTemplateUpdate updates an organization config template.
+	OrgConfigTemplateUpdate(orgID int64, template *ConfigTemplate) (*ConfigTemplate, error)
+
+	// OrgConfigTemplateDelete deletes an organization config template.
+	OrgConfigTemplateDelete(orgID int64, template string) error
+
 	// GlobalSecret returns an global secret by name.
 	GlobalSecret(secret string) (*Secret, error)
 
@@ -222,6 +262,9 @@ type Client interface {
 	// QueueInfo returns the queue state.
 	QueueInfo() (*Info, error)
 
+	// QueueExplain explains why a task of the queue does not run yet.
+	QueueExplain(taskID string) (*QueueTaskExplanation, error)
+
 	// LogLevel returns the current logging level.
 	LogLevel() (*LogLevel, error)
 
@@ -260,4 +303,28 @@ type Client interface {
 
 	// AgentTasksList returns a list of all tasks executed by an agent.
 	AgentTasksList(int64) ([]*Task, error)
+
+	// AgentDrain stops scheduling new workflows to an agent.
+	AgentDrain(int64) (*Agent, error)
+
+	// AgentDrainCancel schedules workflows to a draining agent again.
+	AgentDrainCancel(int64) (*Agent, error)
+
+	// AgentDrainWait blocks until a draining agent has no running workflows anymore.
+	AgentDrainWait(int64, time.Duration) (*Agent, error)
+
+	// AgentPoolList returns a list of all agent pools.
+	AgentPoolList() ([]*AgentPool, error)
+
+	// AgentPool returns an agent pool by id.
+	AgentPool(int64) (*AgentPool, error)
+
+	// AgentPoolCreate creates a new agent pool.
+	AgentPoolCreate(*AgentPool) (*AgentPool, error)
+
+	// AgentPoolUpdate updates an existing agent pool.
+	AgentPoolUpdate(int64, *AgentPoolPatch) (*AgentPool, error)
+
+	// AgentPoolDelete deletes an agent pool.
+	AgentPoolDelete(int64) error
 }
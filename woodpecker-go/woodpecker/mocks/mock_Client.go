@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"net/http"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 	"go.woodpecker-ci.org/woodpecker/v3/woodpecker-go/woodpecker"
@@ -213,79 +214,86 @@ func (_c *MockClient_AgentDelete_Call) RunAndReturn(run func(n int64) error) *Mo
 	return _c
 }
 
-// AgentList provides a mock function for the type MockClient
-func (_mock *MockClient) AgentList() ([]*woodpecker.Agent, error) {
-	ret := _mock.Called()
+// AgentDrain provides a mock function for the type MockClient
+func (_mock *MockClient) AgentDrain(n int64) (*woodpecker.Agent, error) {
+	ret := _mock.Called(n)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AgentList")
+		panic("no return value specified for AgentDrain")
 	}
 
-	var r0 []*woodpecker.Agent
+	var r0 *woodpecker.Agent
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() ([]*woodpecker.Agent, error)); ok {
-		return returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(int64) (*woodpecker.Agent, error)); ok {
+		return returnFunc(n)
 	}
-	if returnFunc, ok := ret.Get(0).(func() []*woodpecker.Agent); ok {
-		r0 = returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(int64) *woodpecker.Agent); ok {
+		r0 = returnFunc(n)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*woodpecker.Agent)
+			r0 = ret.Get(0).(*woodpecker.Agent)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func() error); ok {
-		r1 = returnFunc()
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(n)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_AgentList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AgentList'
-type MockClient_AgentList_Call struct {
+// MockClient_AgentDrain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AgentDrain'
+type MockClient_AgentDrain_Call struct {
 	*mock.Call
 }
 
-// AgentList is a helper method to define mock.On call
-func (_e *MockClient_Expecter) AgentList() *MockClient_AgentList_Call {
-	return &MockClient_AgentList_Call{Call: _e.mock.On("AgentList")}
+// AgentDrain is a helper method to define mock.On call
+//   - n int64
+func (_e *MockClient_Expecter) AgentDrain(n interface{}) *MockClient_AgentDrain_Call {
+	return &MockClient_AgentDrain_Call{Call: _e.mock.On("AgentDrain", n)}
 }
 
-func (_c *MockClient_AgentList_Call) Run(run func()) *MockClient_AgentList_Call {
+func (_c *MockClient_AgentDrain_Call) Run(run func(n int64)) *MockClient_AgentDrain_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		run(
+			arg0,
+		)
 	})
 	return _c
 }
 
-func (_c *MockClient_AgentList_Call) Return(agents []*woodpecker.Agent, err error) *MockClient_AgentList_Call {
-	_c.Call.Return(agents, err)
+func (_c *MockClient_AgentDrain_Call) Return(agent *woodpecker.Agent, err error) *MockClient_AgentDrain_Call {
+	_c.Call.Return(agent, err)
 	return _c
 }
 
-func (_c *MockClient_AgentList_Call) RunAndReturn(run func() ([]*woodpecker.Agent, error)) *MockClient_AgentList_Call {
+func (_c *MockClient_AgentDrain_Call) RunAndReturn(run func(n int64) (*woodpecker.Agent, error)) *MockClient_AgentDrain_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// AgentTasksList provides a mock function for the type MockClient
-func (_mock *MockClient) AgentTasksList(n int64) ([]*woodpecker.Task, error) {
+// AgentDrainCancel provides a mock function for the type MockClient
+func (_mock *MockClient) AgentDrainCancel(n int64) (*woodpecker.Agent, error) {
 	ret := _mock.Called(n)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AgentTasksList")
+		panic("no return value specified for AgentDrainCancel")
 	}
 
-	var r0 []*woodpecker.Task
+	var r0 *woodpecker.Agent
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64) ([]*woodpecker.Task, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(int64) (*woodpecker.Agent, error)); ok {
 		return returnFunc(n)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64) []*woodpecker.Task); ok {
+	if returnFunc, ok := ret.Get(0).(func(int64) *woodpecker.Agent); ok {
 		r0 = returnFunc(n)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*woodpecker.Task)
+			r0 = ret.Get(0).(*woodpecker.Agent)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
@@ -296,18 +304,18 @@ func (_mock *MockClient) AgentTasksList(n int64) ([]*woodpecker.Task, error) {
 	return r0, r1
 }
 
-// MockClient_AgentTasksList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AgentTasksList'
-type MockClient_AgentTasksList_Call struct {
+// MockClient_AgentDrainCancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AgentDrainCancel'
+type MockClient_AgentDrainCancel_Call struct {
 	*mock.Call
 }
 
-// AgentTasksList is a helper method to define mock.On call
+// AgentDrainCancel is a helper method to define mock.On call
 //   - n int64
-func (_e *MockClient_Expecter) AgentTasksList(n interface{}) *MockClient_AgentTasksList_Call {
-	return &MockClient_AgentTasksList_Call{Call: _e.mock.On("AgentTasksList", n)}
+func (_e *MockClient_Expecter) AgentDrainCancel(n interface{}) *MockClient_AgentDrainCancel_Call {
+	return &MockClient_AgentDrainCancel_Call{Call: _e.mock.On("AgentDrainCancel", n)}
 }
 
-func (_c *MockClient_AgentTasksList_Call) Run(run func(n int64)) *MockClient_AgentTasksList_Call {
+func (_c *MockClient_AgentDrainCancel_Call) Run(run func(n int64)) *MockClient_AgentDrainCancel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int64
 		if args[0] != nil {
@@ -320,525 +328,481 @@ func (_c *MockClient_AgentTasksList_Call) Run(run func(n int64)) *MockClient_Age
 	return _c
 }
 
-func (_c *MockClient_AgentTasksList_Call) Return(tasks []*woodpecker.Task, err error) *MockClient_AgentTasksList_Call {
-	_c.Call.Return(tasks, err)
+func (_c *MockClient_AgentDrainCancel_Call) Return(agent *woodpecker.Agent, err error) *MockClient_AgentDrainCancel_Call {
+	_c.Call.Return(agent, err)
 	return _c
 }
 
-func (_c *MockClient_AgentTasksList_Call) RunAndReturn(run func(n int64) ([]*woodpecker.Task, error)) *MockClient_AgentTasksList_Call {
+func (_c *MockClient_AgentDrainCancel_Call) RunAndReturn(run func(n int64) (*woodpecker.Agent, error)) *MockClient_AgentDrainCancel_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// AgentUpdate provides a mock function for the type MockClient
-func (_mock *MockClient) AgentUpdate(agent *woodpecker.Agent) (*woodpecker.Agent, error) {
-	ret := _mock.Called(agent)
+// AgentDrainWait provides a mock function for the type MockClient
+func (_mock *MockClient) AgentDrainWait(n int64, duration time.Duration) (*woodpecker.Agent, error) {
+	ret := _mock.Called(n, duration)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AgentUpdate")
+		panic("no return value specified for AgentDrainWait")
 	}
 
 	var r0 *woodpecker.Agent
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*woodpecker.Agent) (*woodpecker.Agent, error)); ok {
-		return returnFunc(agent)
+	if returnFunc, ok := ret.Get(0).(func(int64, time.Duration) (*woodpecker.Agent, error)); ok {
+		return returnFunc(n, duration)
 	}
-	if returnFunc, ok := ret.Get(0).(func(*woodpecker.Agent) *woodpecker.Agent); ok {
-		r0 = returnFunc(agent)
+	if returnFunc, ok := ret.Get(0).(func(int64, time.Duration) *woodpecker.Agent); ok {
+		r0 = returnFunc(n, duration)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*woodpecker.Agent)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(*woodpecker.Agent) error); ok {
-		r1 = returnFunc(agent)
+	if returnFunc, ok := ret.Get(1).(func(int64, time.Duration) error); ok {
+		r1 = returnFunc(n, duration)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_AgentUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AgentUpdate'
-type MockClient_AgentUpdate_Call struct {
+// MockClient_AgentDrainWait_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AgentDrainWait'
+type MockClient_AgentDrainWait_Call struct {
 	*mock.Call
 }
 
-// AgentUpdate is a helper method to define mock.On call
-//   - agent *woodpecker.Agent
-func (_e *MockClient_Expecter) AgentUpdate(agent interface{}) *MockClient_AgentUpdate_Call {
-	return &MockClient_AgentUpdate_Call{Call: _e.mock.On("AgentUpdate", agent)}
+// AgentDrainWait is a helper method to define mock.On call
+//   - n int64
+//   - duration time.Duration
+func (_e *MockClient_Expecter) AgentDrainWait(n interface{}, duration interface{}) *MockClient_AgentDrainWait_Call {
+	return &MockClient_AgentDrainWait_Call{Call: _e.mock.On("AgentDrainWait", n, duration)}
 }
 
-func (_c *MockClient_AgentUpdate_Call) Run(run func(agent *woodpecker.Agent)) *MockClient_AgentUpdate_Call {
+func (_c *MockClient_AgentDrainWait_Call) Run(run func(n int64, duration time.Duration)) *MockClient_AgentDrainWait_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *woodpecker.Agent
+		var arg0 int64
 		if args[0] != nil {
-			arg0 = args[0].(*woodpecker.Agent)
+			arg0 = args[0].(int64)
+		}
+		var arg1 time.Duration
+		if args[1] != nil {
+			arg1 = args[1].(time.Duration)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_AgentUpdate_Call) Return(agent1 *woodpecker.Agent, err error) *MockClient_AgentUpdate_Call {
-	_c.Call.Return(agent1, err)
+func (_c *MockClient_AgentDrainWait_Call) Return(agent *woodpecker.Agent, err error) *MockClient_AgentDrainWait_Call {
+	_c.Call.Return(agent, err)
 	return _c
 }
 
-func (_c *MockClient_AgentUpdate_Call) RunAndReturn(run func(agent *woodpecker.Agent) (*woodpecker.Agent, error)) *MockClient_AgentUpdate_Call {
+func (_c *MockClient_AgentDrainWait_Call) RunAndReturn(run func(n int64, duration time.Duration) (*woodpecker.Agent, error)) *MockClient_AgentDrainWait_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CronCreate provides a mock function for the type MockClient
-func (_mock *MockClient) CronCreate(repoID int64, cron *woodpecker.Cron) (*woodpecker.Cron, error) {
-	ret := _mock.Called(repoID, cron)
+// AgentList provides a mock function for the type MockClient
+func (_mock *MockClient) AgentList() ([]*woodpecker.Agent, error) {
+	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for CronCreate")
+		panic("no return value specified for AgentList")
 	}
 
-	var r0 *woodpecker.Cron
+	var r0 []*woodpecker.Agent
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.Cron) (*woodpecker.Cron, error)); ok {
-		return returnFunc(repoID, cron)
+	if returnFunc, ok := ret.Get(0).(func() ([]*woodpecker.Agent, error)); ok {
+		return returnFunc()
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.Cron) *woodpecker.Cron); ok {
-		r0 = returnFunc(repoID, cron)
+	if returnFunc, ok := ret.Get(0).(func() []*woodpecker.Agent); ok {
+		r0 = returnFunc()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*woodpecker.Cron)
+			r0 = ret.Get(0).([]*woodpecker.Agent)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int64, *woodpecker.Cron) error); ok {
-		r1 = returnFunc(repoID, cron)
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_CronCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronCreate'
-type MockClient_CronCreate_Call struct {
+// MockClient_AgentList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AgentList'
+type MockClient_AgentList_Call struct {
 	*mock.Call
 }
 
-// CronCreate is a helper method to define mock.On call
-//   - repoID int64
-//   - cron *woodpecker.Cron
-func (_e *MockClient_Expecter) CronCreate(repoID interface{}, cron interface{}) *MockClient_CronCreate_Call {
-	return &MockClient_CronCreate_Call{Call: _e.mock.On("CronCreate", repoID, cron)}
+// AgentList is a helper method to define mock.On call
+func (_e *MockClient_Expecter) AgentList() *MockClient_AgentList_Call {
+	return &MockClient_AgentList_Call{Call: _e.mock.On("AgentList")}
 }
 
-func (_c *MockClient_CronCreate_Call) Run(run func(repoID int64, cron *woodpecker.Cron)) *MockClient_CronCreate_Call {
+func (_c *MockClient_AgentList_Call) Run(run func()) *MockClient_AgentList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int64
-		if args[0] != nil {
-			arg0 = args[0].(int64)
-		}
-		var arg1 *woodpecker.Cron
-		if args[1] != nil {
-			arg1 = args[1].(*woodpecker.Cron)
-		}
-		run(
-			arg0,
-			arg1,
-		)
+		run()
 	})
 	return _c
 }
 
-func (_c *MockClient_CronCreate_Call) Return(cron1 *woodpecker.Cron, err error) *MockClient_CronCreate_Call {
-	_c.Call.Return(cron1, err)
+func (_c *MockClient_AgentList_Call) Return(agents []*woodpecker.Agent, err error) *MockClient_AgentList_Call {
+	_c.Call.Return(agents, err)
 	return _c
 }
 
-func (_c *MockClient_CronCreate_Call) RunAndReturn(run func(repoID int64, cron *woodpecker.Cron) (*woodpecker.Cron, error)) *MockClient_CronCreate_Call {
+func (_c *MockClient_AgentList_Call) RunAndReturn(run func() ([]*woodpecker.Agent, error)) *MockClient_AgentList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CronDelete provides a mock function for the type MockClient
-func (_mock *MockClient) CronDelete(repoID int64, cronID int64) error {
-	ret := _mock.Called(repoID, cronID)
+// AgentPool provides a mock function for the type MockClient
+func (_mock *MockClient) AgentPool(n int64) (*woodpecker.AgentPool, error) {
+	ret := _mock.Called(n)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CronDelete")
+		panic("no return value specified for AgentPool")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(int64, int64) error); ok {
-		r0 = returnFunc(repoID, cronID)
+	var r0 *woodpecker.AgentPool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64) (*woodpecker.AgentPool, error)); ok {
+		return returnFunc(n)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64) *woodpecker.AgentPool); ok {
+		r0 = returnFunc(n)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.AgentPool)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(n)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockClient_CronDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronDelete'
-type MockClient_CronDelete_Call struct {
+// MockClient_AgentPool_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AgentPool'
+type MockClient_AgentPool_Call struct {
 	*mock.Call
 }
 
-// CronDelete is a helper method to define mock.On call
-//   - repoID int64
-//   - cronID int64
-func (_e *MockClient_Expecter) CronDelete(repoID interface{}, cronID interface{}) *MockClient_CronDelete_Call {
-	return &MockClient_CronDelete_Call{Call: _e.mock.On("CronDelete", repoID, cronID)}
+// AgentPool is a helper method to define mock.On call
+//   - n int64
+func (_e *MockClient_Expecter) AgentPool(n interface{}) *MockClient_AgentPool_Call {
+	return &MockClient_AgentPool_Call{Call: _e.mock.On("AgentPool", n)}
 }
 
-func (_c *MockClient_CronDelete_Call) Run(run func(repoID int64, cronID int64)) *MockClient_CronDelete_Call {
+func (_c *MockClient_AgentPool_Call) Run(run func(n int64)) *MockClient_AgentPool_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int64
 		if args[0] != nil {
 			arg0 = args[0].(int64)
 		}
-		var arg1 int64
-		if args[1] != nil {
-			arg1 = args[1].(int64)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_CronDelete_Call) Return(err error) *MockClient_CronDelete_Call {
-	_c.Call.Return(err)
+func (_c *MockClient_AgentPool_Call) Return(agentPool *woodpecker.AgentPool, err error) *MockClient_AgentPool_Call {
+	_c.Call.Return(agentPool, err)
 	return _c
 }
 
-func (_c *MockClient_CronDelete_Call) RunAndReturn(run func(repoID int64, cronID int64) error) *MockClient_CronDelete_Call {
+func (_c *MockClient_AgentPool_Call) RunAndReturn(run func(n int64) (*woodpecker.AgentPool, error)) *MockClient_AgentPool_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CronGet provides a mock function for the type MockClient
-func (_mock *MockClient) CronGet(repoID int64, cronID int64) (*woodpecker.Cron, error) {
-	ret := _mock.Called(repoID, cronID)
+// AgentPoolCreate provides a mock function for the type MockClient
+func (_mock *MockClient) AgentPoolCreate(agentPool *woodpecker.AgentPool) (*woodpecker.AgentPool, error) {
+	ret := _mock.Called(agentPool)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CronGet")
+		panic("no return value specified for AgentPoolCreate")
 	}
 
-	var r0 *woodpecker.Cron
+	var r0 *woodpecker.AgentPool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, int64) (*woodpecker.Cron, error)); ok {
-		return returnFunc(repoID, cronID)
+	if returnFunc, ok := ret.Get(0).(func(*woodpecker.AgentPool) (*woodpecker.AgentPool, error)); ok {
+		return returnFunc(agentPool)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64, int64) *woodpecker.Cron); ok {
-		r0 = returnFunc(repoID, cronID)
+	if returnFunc, ok := ret.Get(0).(func(*woodpecker.AgentPool) *woodpecker.AgentPool); ok {
+		r0 = returnFunc(agentPool)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*woodpecker.Cron)
+			r0 = ret.Get(0).(*woodpecker.AgentPool)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int64, int64) error); ok {
-		r1 = returnFunc(repoID, cronID)
+	if returnFunc, ok := ret.Get(1).(func(*woodpecker.AgentPool) error); ok {
+		r1 = returnFunc(agentPool)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_CronGet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronGet'
-type MockClient_CronGet_Call struct {
+// MockClient_AgentPoolCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AgentPoolCreate'
+type MockClient_AgentPoolCreate_Call struct {
 	*mock.Call
 }
 
-// CronGet is a helper method to define mock.On call
-//   - repoID int64
-//   - cronID int64
-func (_e *MockClient_Expecter) CronGet(repoID interface{}, cronID interface{}) *MockClient_CronGet_Call {
-	return &MockClient_CronGet_Call{Call: _e.mock.On("CronGet", repoID, cronID)}
+// AgentPoolCreate is a helper method to define mock.On call
+//   - agentPool *woodpecker.AgentPool
+func (_e *MockClient_Expecter) AgentPoolCreate(agentPool interface{}) *MockClient_AgentPoolCreate_Call {
+	return &MockClient_AgentPoolCreate_Call{Call: _e.mock.On("AgentPoolCreate", agentPool)}
 }
 
-func (_c *MockClient_CronGet_Call) Run(run func(repoID int64, cronID int64)) *MockClient_CronGet_Call {
+func (_c *MockClient_AgentPoolCreate_Call) Run(run func(agentPool *woodpecker.AgentPool)) *MockClient_AgentPoolCreate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int64
+		var arg0 *woodpecker.AgentPool
 		if args[0] != nil {
-			arg0 = args[0].(int64)
-		}
-		var arg1 int64
-		if args[1] != nil {
-			arg1 = args[1].(int64)
+			arg0 = args[0].(*woodpecker.AgentPool)
 		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_CronGet_Call) Return(cron *woodpecker.Cron, err error) *MockClient_CronGet_Call {
-	_c.Call.Return(cron, err)
+func (_c *MockClient_AgentPoolCreate_Call) Return(agentPool1 *woodpecker.AgentPool, err error) *MockClient_AgentPoolCreate_Call {
+	_c.Call.Return(agentPool1, err)
 	return _c
 }
 
-func (_c *MockClient_CronGet_Call) RunAndReturn(run func(repoID int64, cronID int64) (*woodpecker.Cron, error)) *MockClient_CronGet_Call {
+func (_c *MockClient_AgentPoolCreate_Call) RunAndReturn(run func(agentPool *woodpecker.AgentPool) (*woodpecker.AgentPool, error)) *MockClient_AgentPoolCreate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CronList provides a mock function for the type MockClient
-func (_mock *MockClient) CronList(repoID int64, opt woodpecker.CronListOptions) ([]*woodpecker.Cron, error) {
-	ret := _mock.Called(repoID, opt)
+// AgentPoolDelete provides a mock function for the type MockClient
+func (_mock *MockClient) AgentPoolDelete(n int64) error {
+	ret := _mock.Called(n)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CronList")
+		panic("no return value specified for AgentPoolDelete")
 	}
 
-	var r0 []*woodpecker.Cron
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, woodpecker.CronListOptions) ([]*woodpecker.Cron, error)); ok {
-		return returnFunc(repoID, opt)
-	}
-	if returnFunc, ok := ret.Get(0).(func(int64, woodpecker.CronListOptions) []*woodpecker.Cron); ok {
-		r0 = returnFunc(repoID, opt)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*woodpecker.Cron)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(int64, woodpecker.CronListOptions) error); ok {
-		r1 = returnFunc(repoID, opt)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = returnFunc(n)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockClient_CronList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronList'
-type MockClient_CronList_Call struct {
+// MockClient_AgentPoolDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AgentPoolDelete'
+type MockClient_AgentPoolDelete_Call struct {
 	*mock.Call
 }
 
-// CronList is a helper method to define mock.On call
-//   - repoID int64
-//   - opt woodpecker.CronListOptions
-func (_e *MockClient_Expecter) CronList(repoID interface{}, opt interface{}) *MockClient_CronList_Call {
-	return &MockClient_CronList_Call{Call: _e.mock.On("CronList", repoID, opt)}
+// AgentPoolDelete is a helper method to define mock.On call
+//   - n int64
+func (_e *MockClient_Expecter) AgentPoolDelete(n interface{}) *MockClient_AgentPoolDelete_Call {
+	return &MockClient_AgentPoolDelete_Call{Call: _e.mock.On("AgentPoolDelete", n)}
 }
 
-func (_c *MockClient_CronList_Call) Run(run func(repoID int64, opt woodpecker.CronListOptions)) *MockClient_CronList_Call {
+func (_c *MockClient_AgentPoolDelete_Call) Run(run func(n int64)) *MockClient_AgentPoolDelete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int64
 		if args[0] != nil {
 			arg0 = args[0].(int64)
 		}
-		var arg1 woodpecker.CronListOptions
-		if args[1] != nil {
-			arg1 = args[1].(woodpecker.CronListOptions)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_CronList_Call) Return(crons []*woodpecker.Cron, err error) *MockClient_CronList_Call {
-	_c.Call.Return(crons, err)
+func (_c *MockClient_AgentPoolDelete_Call) Return(err error) *MockClient_AgentPoolDelete_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClient_CronList_Call) RunAndReturn(run func(repoID int64, opt woodpecker.CronListOptions) ([]*woodpecker.Cron, error)) *MockClient_CronList_Call {
+func (_c *MockClient_AgentPoolDelete_Call) RunAndReturn(run func(n int64) error) *MockClient_AgentPoolDelete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CronUpdate provides a mock function for the type MockClient
-func (_mock *MockClient) CronUpdate(repoID int64, cron *woodpecker.Cron) (*woodpecker.Cron, error) {
-	ret := _mock.Called(repoID, cron)
+// AgentPoolList provides a mock function for the type MockClient
+func (_mock *MockClient) AgentPoolList() ([]*woodpecker.AgentPool, error) {
+	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for CronUpdate")
+		panic("no return value specified for AgentPoolList")
 	}
 
-	var r0 *woodpecker.Cron
+	var r0 []*woodpecker.AgentPool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.Cron) (*woodpecker.Cron, error)); ok {
-		return returnFunc(repoID, cron)
+	if returnFunc, ok := ret.Get(0).(func() ([]*woodpecker.AgentPool, error)); ok {
+		return returnFunc()
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.Cron) *woodpecker.Cron); ok {
-		r0 = returnFunc(repoID, cron)
+	if returnFunc, ok := ret.Get(0).(func() []*woodpecker.AgentPool); ok {
+		r0 = returnFunc()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*woodpecker.Cron)
+			r0 = ret.Get(0).([]*woodpecker.AgentPool)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int64, *woodpecker.Cron) error); ok {
-		r1 = returnFunc(repoID, cron)
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_CronUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronUpdate'
-type MockClient_CronUpdate_Call struct {
+// MockClient_AgentPoolList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AgentPoolList'
+type MockClient_AgentPoolList_Call struct {
 	*mock.Call
 }
 
-// CronUpdate is a helper method to define mock.On call
-//   - repoID int64
-//   - cron *woodpecker.Cron
-func (_e *MockClient_Expecter) CronUpdate(repoID interface{}, cron interface{}) *MockClient_CronUpdate_Call {
-	return &MockClient_CronUpdate_Call{Call: _e.mock.On("CronUpdate", repoID, cron)}
+// AgentPoolList is a helper method to define mock.On call
+func (_e *MockClient_Expecter) AgentPoolList() *MockClient_AgentPoolList_Call {
+	return &MockClient_AgentPoolList_Call{Call: _e.mock.On("AgentPoolList")}
 }
 
-func (_c *MockClient_CronUpdate_Call) Run(run func(repoID int64, cron *woodpecker.Cron)) *MockClient_CronUpdate_Call {
+func (_c *MockClient_AgentPoolList_Call) Run(run func()) *MockClient_AgentPoolList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int64
-		if args[0] != nil {
-			arg0 = args[0].(int64)
-		}
-		var arg1 *woodpecker.Cron
-		if args[1] != nil {
-			arg1 = args[1].(*woodpecker.Cron)
-		}
-		run(
-			arg0,
-			arg1,
-		)
+		run()
 	})
 	return _c
 }
 
-func (_c *MockClient_CronUpdate_Call) Return(cron1 *woodpecker.Cron, err error) *MockClient_CronUpdate_Call {
-	_c.Call.Return(cron1, err)
+func (_c *MockClient_AgentPoolList_Call) Return(agentPools []*woodpecker.AgentPool, err error) *MockClient_AgentPoolList_Call {
+	_c.Call.Return(agentPools, err)
 	return _c
 }
 
-func (_c *MockClient_CronUpdate_Call) RunAndReturn(run func(repoID int64, cron *woodpecker.Cron) (*woodpecker.Cron, error)) *MockClient_CronUpdate_Call {
+func (_c *MockClient_AgentPoolList_Call) RunAndReturn(run func() ([]*woodpecker.AgentPool, error)) *MockClient_AgentPoolList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Deploy provides a mock function for the type MockClient
-func (_mock *MockClient) Deploy(repoID int64, pipeline int64, opt woodpecker.DeployOptions) (*woodpecker.Pipeline, error) {
-	ret := _mock.Called(repoID, pipeline, opt)
+// AgentPoolUpdate provides a mock function for the type MockClient
+func (_mock *MockClient) AgentPoolUpdate(n int64, agentPoolPatch *woodpecker.AgentPoolPatch) (*woodpecker.AgentPool, error) {
+	ret := _mock.Called(n, agentPoolPatch)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Deploy")
+		panic("no return value specified for AgentPoolUpdate")
 	}
 
-	var r0 *woodpecker.Pipeline
+	var r0 *woodpecker.AgentPool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, int64, woodpecker.DeployOptions) (*woodpecker.Pipeline, error)); ok {
-		return returnFunc(repoID, pipeline, opt)
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.AgentPoolPatch) (*woodpecker.AgentPool, error)); ok {
+		return returnFunc(n, agentPoolPatch)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64, int64, woodpecker.DeployOptions) *woodpecker.Pipeline); ok {
-		r0 = returnFunc(repoID, pipeline, opt)
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.AgentPoolPatch) *woodpecker.AgentPool); ok {
+		r0 = returnFunc(n, agentPoolPatch)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*woodpecker.Pipeline)
+			r0 = ret.Get(0).(*woodpecker.AgentPool)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int64, int64, woodpecker.DeployOptions) error); ok {
-		r1 = returnFunc(repoID, pipeline, opt)
+	if returnFunc, ok := ret.Get(1).(func(int64, *woodpecker.AgentPoolPatch) error); ok {
+		r1 = returnFunc(n, agentPoolPatch)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_Deploy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Deploy'
-type MockClient_Deploy_Call struct {
+// MockClient_AgentPoolUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AgentPoolUpdate'
+type MockClient_AgentPoolUpdate_Call struct {
 	*mock.Call
 }
 
-// Deploy is a helper method to define mock.On call
-//   - repoID int64
-//   - pipeline int64
-//   - opt woodpecker.DeployOptions
-func (_e *MockClient_Expecter) Deploy(repoID interface{}, pipeline interface{}, opt interface{}) *MockClient_Deploy_Call {
-	return &MockClient_Deploy_Call{Call: _e.mock.On("Deploy", repoID, pipeline, opt)}
+// AgentPoolUpdate is a helper method to define mock.On call
+//   - n int64
+//   - agentPoolPatch *woodpecker.AgentPoolPatch
+func (_e *MockClient_Expecter) AgentPoolUpdate(n interface{}, agentPoolPatch interface{}) *MockClient_AgentPoolUpdate_Call {
+	return &MockClient_AgentPoolUpdate_Call{Call: _e.mock.On("AgentPoolUpdate", n, agentPoolPatch)}
 }
 
-func (_c *MockClient_Deploy_Call) Run(run func(repoID int64, pipeline int64, opt woodpecker.DeployOptions)) *MockClient_Deploy_Call {
+func (_c *MockClient_AgentPoolUpdate_Call) Run(run func(n int64, agentPoolPatch *woodpecker.AgentPoolPatch)) *MockClient_AgentPoolUpdate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int64
 		if args[0] != nil {
 			arg0 = args[0].(int64)
 		}
-		var arg1 int64
+		var arg1 *woodpecker.AgentPoolPatch
 		if args[1] != nil {
-			arg1 = args[1].(int64)
-		}
-		var arg2 woodpecker.DeployOptions
-		if args[2] != nil {
-			arg2 = args[2].(woodpecker.DeployOptions)
+			arg1 = args[1].(*woodpecker.AgentPoolPatch)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_Deploy_Call) Return(pipeline1 *woodpecker.Pipeline, err error) *MockClient_Deploy_Call {
-	_c.Call.Return(pipeline1, err)
+func (_c *MockClient_AgentPoolUpdate_Call) Return(agentPool *woodpecker.AgentPool, err error) *MockClient_AgentPoolUpdate_Call {
+	_c.Call.Return(agentPool, err)
 	return _c
 }
 
-func (_c *MockClient_Deploy_Call) RunAndReturn(run func(repoID int64, pipeline int64, opt woodpecker.DeployOptions) (*woodpecker.Pipeline, error)) *MockClient_Deploy_Call {
+func (_c *MockClient_AgentPoolUpdate_Call) RunAndReturn(run func(n int64, agentPoolPatch *woodpecker.AgentPoolPatch) (*woodpecker.AgentPool, error)) *MockClient_AgentPoolUpdate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GlobalRegistry provides a mock function for the type MockClient
-func (_mock *MockClient) GlobalRegistry(registry string) (*woodpecker.Registry, error) {
-	ret := _mock.Called(registry)
+// AgentTasksList provides a mock function for the type MockClient
+func (_mock *MockClient) AgentTasksList(n int64) ([]*woodpecker.Task, error) {
+	ret := _mock.Called(n)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GlobalRegistry")
+		panic("no return value specified for AgentTasksList")
 	}
 
-	var r0 *woodpecker.Registry
+	var r0 []*woodpecker.Task
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (*woodpecker.Registry, error)); ok {
-		return returnFunc(registry)
+	if returnFunc, ok := ret.Get(0).(func(int64) ([]*woodpecker.Task, error)); ok {
+		return returnFunc(n)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) *woodpecker.Registry); ok {
-		r0 = returnFunc(registry)
+	if returnFunc, ok := ret.Get(0).(func(int64) []*woodpecker.Task); ok {
+		r0 = returnFunc(n)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*woodpecker.Registry)
+			r0 = ret.Get(0).([]*woodpecker.Task)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(registry)
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(n)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_GlobalRegistry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalRegistry'
-type MockClient_GlobalRegistry_Call struct {
+// MockClient_AgentTasksList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AgentTasksList'
+type MockClient_AgentTasksList_Call struct {
 	*mock.Call
 }
 
-// GlobalRegistry is a helper method to define mock.On call
-//   - registry string
-func (_e *MockClient_Expecter) GlobalRegistry(registry interface{}) *MockClient_GlobalRegistry_Call {
-	return &MockClient_GlobalRegistry_Call{Call: _e.mock.On("GlobalRegistry", registry)}
+// AgentTasksList is a helper method to define mock.On call
+//   - n int64
+func (_e *MockClient_Expecter) AgentTasksList(n interface{}) *MockClient_AgentTasksList_Call {
+	return &MockClient_AgentTasksList_Call{Call: _e.mock.On("AgentTasksList", n)}
 }
 
-func (_c *MockClient_GlobalRegistry_Call) Run(run func(registry string)) *MockClient_GlobalRegistry_Call {
+func (_c *MockClient_AgentTasksList_Call) Run(run func(n int64)) *MockClient_AgentTasksList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 int64
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(int64)
 		}
 		run(
 			arg0,
@@ -847,60 +811,60 @@ func (_c *MockClient_GlobalRegistry_Call) Run(run func(registry string)) *MockCl
 	return _c
 }
 
-func (_c *MockClient_GlobalRegistry_Call) Return(registry1 *woodpecker.Registry, err error) *MockClient_GlobalRegistry_Call {
-	_c.Call.Return(registry1, err)
+func (_c *MockClient_AgentTasksList_Call) Return(tasks []*woodpecker.Task, err error) *MockClient_AgentTasksList_Call {
+	_c.Call.Return(tasks, err)
 	return _c
 }
 
-func (_c *MockClient_GlobalRegistry_Call) RunAndReturn(run func(registry string) (*woodpecker.Registry, error)) *MockClient_GlobalRegistry_Call {
+func (_c *MockClient_AgentTasksList_Call) RunAndReturn(run func(n int64) ([]*woodpecker.Task, error)) *MockClient_AgentTasksList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GlobalRegistryCreate provides a mock function for the type MockClient
-func (_mock *MockClient) GlobalRegistryCreate(registry *woodpecker.Registry) (*woodpecker.Registry, error) {
-	ret := _mock.Called(registry)
+// AgentUpdate provides a mock function for the type MockClient
+func (_mock *MockClient) AgentUpdate(agent *woodpecker.Agent) (*woodpecker.Agent, error) {
+	ret := _mock.Called(agent)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GlobalRegistryCreate")
+		panic("no return value specified for AgentUpdate")
 	}
 
-	var r0 *woodpecker.Registry
+	var r0 *woodpecker.Agent
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*woodpecker.Registry) (*woodpecker.Registry, error)); ok {
-		return returnFunc(registry)
+	if returnFunc, ok := ret.Get(0).(func(*woodpecker.Agent) (*woodpecker.Agent, error)); ok {
+		return returnFunc(agent)
 	}
-	if returnFunc, ok := ret.Get(0).(func(*woodpecker.Registry) *woodpecker.Registry); ok {
-		r0 = returnFunc(registry)
+	if returnFunc, ok := ret.Get(0).(func(*woodpecker.Agent) *woodpecker.Agent); ok {
+		r0 = returnFunc(agent)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*woodpecker.Registry)
+			r0 = ret.Get(0).(*woodpecker.Agent)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(*woodpecker.Registry) error); ok {
-		r1 = returnFunc(registry)
+	if returnFunc, ok := ret.Get(1).(func(*woodpecker.Agent) error); ok {
+		r1 = returnFunc(agent)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_GlobalRegistryCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalRegistryCreate'
-type MockClient_GlobalRegistryCreate_Call struct {
+// MockClient_AgentUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AgentUpdate'
+type MockClient_AgentUpdate_Call struct {
 	*mock.Call
 }
 
-// GlobalRegistryCreate is a helper method to define mock.On call
-//   - registry *woodpecker.Registry
-func (_e *MockClient_Expecter) GlobalRegistryCreate(registry interface{}) *MockClient_GlobalRegistryCreate_Call {
-	return &MockClient_GlobalRegistryCreate_Call{Call: _e.mock.On("GlobalRegistryCreate", registry)}
+// AgentUpdate is a helper method to define mock.On call
+//   - agent *woodpecker.Agent
+func (_e *MockClient_Expecter) AgentUpdate(agent interface{}) *MockClient_AgentUpdate_Call {
+	return &MockClient_AgentUpdate_Call{Call: _e.mock.On("AgentUpdate", agent)}
 }
 
-func (_c *MockClient_GlobalRegistryCreate_Call) Run(run func(registry *woodpecker.Registry)) *MockClient_GlobalRegistryCreate_Call {
+func (_c *MockClient_AgentUpdate_Call) Run(run func(agent *woodpecker.Agent)) *MockClient_AgentUpdate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *woodpecker.Registry
+		var arg0 *woodpecker.Agent
 		if args[0] != nil {
-			arg0 = args[0].(*woodpecker.Registry)
+			arg0 = args[0].(*woodpecker.Agent)
 		}
 		run(
 			arg0,
@@ -909,111 +873,824 @@ func (_c *MockClient_GlobalRegistryCreate_Call) Run(run func(registry *woodpecke
 	return _c
 }
 
-func (_c *MockClient_GlobalRegistryCreate_Call) Return(registry1 *woodpecker.Registry, err error) *MockClient_GlobalRegistryCreate_Call {
-	_c.Call.Return(registry1, err)
+func (_c *MockClient_AgentUpdate_Call) Return(agent1 *woodpecker.Agent, err error) *MockClient_AgentUpdate_Call {
+	_c.Call.Return(agent1, err)
 	return _c
 }
 
-func (_c *MockClient_GlobalRegistryCreate_Call) RunAndReturn(run func(registry *woodpecker.Registry) (*woodpecker.Registry, error)) *MockClient_GlobalRegistryCreate_Call {
+func (_c *MockClient_AgentUpdate_Call) RunAndReturn(run func(agent *woodpecker.Agent) (*woodpecker.Agent, error)) *MockClient_AgentUpdate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GlobalRegistryDelete provides a mock function for the type MockClient
-func (_mock *MockClient) GlobalRegistryDelete(registry string) error {
-	ret := _mock.Called(registry)
+// CronCreate provides a mock function for the type MockClient
+func (_mock *MockClient) CronCreate(repoID int64, cron *woodpecker.Cron) (*woodpecker.Cron, error) {
+	ret := _mock.Called(repoID, cron)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GlobalRegistryDelete")
+		panic("no return value specified for CronCreate")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
-		r0 = returnFunc(registry)
+	var r0 *woodpecker.Cron
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.Cron) (*woodpecker.Cron, error)); ok {
+		return returnFunc(repoID, cron)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.Cron) *woodpecker.Cron); ok {
+		r0 = returnFunc(repoID, cron)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.Cron)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(int64, *woodpecker.Cron) error); ok {
+		r1 = returnFunc(repoID, cron)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockClient_GlobalRegistryDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalRegistryDelete'
-type MockClient_GlobalRegistryDelete_Call struct {
+// MockClient_CronCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronCreate'
+type MockClient_CronCreate_Call struct {
 	*mock.Call
 }
 
-// GlobalRegistryDelete is a helper method to define mock.On call
-//   - registry string
-func (_e *MockClient_Expecter) GlobalRegistryDelete(registry interface{}) *MockClient_GlobalRegistryDelete_Call {
-	return &MockClient_GlobalRegistryDelete_Call{Call: _e.mock.On("GlobalRegistryDelete", registry)}
+// CronCreate is a helper method to define mock.On call
+//   - repoID int64
+//   - cron *woodpecker.Cron
+func (_e *MockClient_Expecter) CronCreate(repoID interface{}, cron interface{}) *MockClient_CronCreate_Call {
+	return &MockClient_CronCreate_Call{Call: _e.mock.On("CronCreate", repoID, cron)}
 }
 
-func (_c *MockClient_GlobalRegistryDelete_Call) Run(run func(registry string)) *MockClient_GlobalRegistryDelete_Call {
+func (_c *MockClient_CronCreate_Call) Run(run func(repoID int64, cron *woodpecker.Cron)) *MockClient_CronCreate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 int64
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(int64)
+		}
+		var arg1 *woodpecker.Cron
+		if args[1] != nil {
+			arg1 = args[1].(*woodpecker.Cron)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_GlobalRegistryDelete_Call) Return(err error) *MockClient_GlobalRegistryDelete_Call {
-	_c.Call.Return(err)
+func (_c *MockClient_CronCreate_Call) Return(cron1 *woodpecker.Cron, err error) *MockClient_CronCreate_Call {
+	_c.Call.Return(cron1, err)
 	return _c
 }
 
-func (_c *MockClient_GlobalRegistryDelete_Call) RunAndReturn(run func(registry string) error) *MockClient_GlobalRegistryDelete_Call {
+func (_c *MockClient_CronCreate_Call) RunAndReturn(run func(repoID int64, cron *woodpecker.Cron) (*woodpecker.Cron, error)) *MockClient_CronCreate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GlobalRegistryList provides a mock function for the type MockClient
-func (_mock *MockClient) GlobalRegistryList(opt woodpecker.RegistryListOptions) ([]*woodpecker.Registry, error) {
-	ret := _mock.Called(opt)
+// CronDelete provides a mock function for the type MockClient
+func (_mock *MockClient) CronDelete(repoID int64, cronID int64) error {
+	ret := _mock.Called(repoID, cronID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GlobalRegistryList")
+		panic("no return value specified for CronDelete")
 	}
 
-	var r0 []*woodpecker.Registry
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(woodpecker.RegistryListOptions) ([]*woodpecker.Registry, error)); ok {
-		return returnFunc(opt)
-	}
-	if returnFunc, ok := ret.Get(0).(func(woodpecker.RegistryListOptions) []*woodpecker.Registry); ok {
-		r0 = returnFunc(opt)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = returnFunc(repoID, cronID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*woodpecker.Registry)
-		}
+		r0 = ret.Error(0)
 	}
-	if returnFunc, ok := ret.Get(1).(func(woodpecker.RegistryListOptions) error); ok {
-		r1 = returnFunc(opt)
+	return r0
+}
+
+// MockClient_CronDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronDelete'
+type MockClient_CronDelete_Call struct {
+	*mock.Call
+}
+
+// CronDelete is a helper method to define mock.On call
+//   - repoID int64
+//   - cronID int64
+func (_e *MockClient_Expecter) CronDelete(repoID interface{}, cronID interface{}) *MockClient_CronDelete_Call {
+	return &MockClient_CronDelete_Call{Call: _e.mock.On("CronDelete", repoID, cronID)}
+}
+
+func (_c *MockClient_CronDelete_Call) Run(run func(repoID int64, cronID int64)) *MockClient_CronDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_CronDelete_Call) Return(err error) *MockClient_CronDelete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_CronDelete_Call) RunAndReturn(run func(repoID int64, cronID int64) error) *MockClient_CronDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CronGet provides a mock function for the type MockClient
+func (_mock *MockClient) CronGet(repoID int64, cronID int64) (*woodpecker.Cron, error) {
+	ret := _mock.Called(repoID, cronID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CronGet")
+	}
+
+	var r0 *woodpecker.Cron
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, int64) (*woodpecker.Cron, error)); ok {
+		return returnFunc(repoID, cronID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, int64) *woodpecker.Cron); ok {
+		r0 = returnFunc(repoID, cronID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.Cron)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = returnFunc(repoID, cronID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_CronGet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronGet'
+type MockClient_CronGet_Call struct {
+	*mock.Call
+}
+
+// CronGet is a helper method to define mock.On call
+//   - repoID int64
+//   - cronID int64
+func (_e *MockClient_Expecter) CronGet(repoID interface{}, cronID interface{}) *MockClient_CronGet_Call {
+	return &MockClient_CronGet_Call{Call: _e.mock.On("CronGet", repoID, cronID)}
+}
+
+func (_c *MockClient_CronGet_Call) Run(run func(repoID int64, cronID int64)) *MockClient_CronGet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_CronGet_Call) Return(cron *woodpecker.Cron, err error) *MockClient_CronGet_Call {
+	_c.Call.Return(cron, err)
+	return _c
+}
+
+func (_c *MockClient_CronGet_Call) RunAndReturn(run func(repoID int64, cronID int64) (*woodpecker.Cron, error)) *MockClient_CronGet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CronList provides a mock function for the type MockClient
+func (_mock *MockClient) CronList(repoID int64, opt woodpecker.CronListOptions) ([]*woodpecker.Cron, error) {
+	ret := _mock.Called(repoID, opt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CronList")
+	}
+
+	var r0 []*woodpecker.Cron
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, woodpecker.CronListOptions) ([]*woodpecker.Cron, error)); ok {
+		return returnFunc(repoID, opt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, woodpecker.CronListOptions) []*woodpecker.Cron); ok {
+		r0 = returnFunc(repoID, opt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*woodpecker.Cron)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, woodpecker.CronListOptions) error); ok {
+		r1 = returnFunc(repoID, opt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_CronList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronList'
+type MockClient_CronList_Call struct {
+	*mock.Call
+}
+
+// CronList is a helper method to define mock.On call
+//   - repoID int64
+//   - opt woodpecker.CronListOptions
+func (_e *MockClient_Expecter) CronList(repoID interface{}, opt interface{}) *MockClient_CronList_Call {
+	return &MockClient_CronList_Call{Call: _e.mock.On("CronList", repoID, opt)}
+}
+
+func (_c *MockClient_CronList_Call) Run(run func(repoID int64, opt woodpecker.CronListOptions)) *MockClient_CronList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 woodpecker.CronListOptions
+		if args[1] != nil {
+			arg1 = args[1].(woodpecker.CronListOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_CronList_Call) Return(crons []*woodpecker.Cron, err error) *MockClient_CronList_Call {
+	_c.Call.Return(crons, err)
+	return _c
+}
+
+func (_c *MockClient_CronList_Call) RunAndReturn(run func(repoID int64, opt woodpecker.CronListOptions) ([]*woodpecker.Cron, error)) *MockClient_CronList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CronUpdate provides a mock function for the type MockClient
+func (_mock *MockClient) CronUpdate(repoID int64, cron *woodpecker.Cron) (*woodpecker.Cron, error) {
+	ret := _mock.Called(repoID, cron)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CronUpdate")
+	}
+
+	var r0 *woodpecker.Cron
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.Cron) (*woodpecker.Cron, error)); ok {
+		return returnFunc(repoID, cron)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.Cron) *woodpecker.Cron); ok {
+		r0 = returnFunc(repoID, cron)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.Cron)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, *woodpecker.Cron) error); ok {
+		r1 = returnFunc(repoID, cron)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_CronUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronUpdate'
+type MockClient_CronUpdate_Call struct {
+	*mock.Call
+}
+
+// CronUpdate is a helper method to define mock.On call
+//   - repoID int64
+//   - cron *woodpecker.Cron
+func (_e *MockClient_Expecter) CronUpdate(repoID interface{}, cron interface{}) *MockClient_CronUpdate_Call {
+	return &MockClient_CronUpdate_Call{Call: _e.mock.On("CronUpdate", repoID, cron)}
+}
+
+func (_c *MockClient_CronUpdate_Call) Run(run func(repoID int64, cron *woodpecker.Cron)) *MockClient_CronUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 *woodpecker.Cron
+		if args[1] != nil {
+			arg1 = args[1].(*woodpecker.Cron)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_CronUpdate_Call) Return(cron1 *woodpecker.Cron, err error) *MockClient_CronUpdate_Call {
+	_c.Call.Return(cron1, err)
+	return _c
+}
+
+func (_c *MockClient_CronUpdate_Call) RunAndReturn(run func(repoID int64, cron *woodpecker.Cron) (*woodpecker.Cron, error)) *MockClient_CronUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Deploy provides a mock function for the type MockClient
+func (_mock *MockClient) Deploy(repoID int64, pipeline int64, opt woodpecker.DeployOptions) (*woodpecker.Pipeline, error) {
+	ret := _mock.Called(repoID, pipeline, opt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Deploy")
+	}
+
+	var r0 *woodpecker.Pipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, int64, woodpecker.DeployOptions) (*woodpecker.Pipeline, error)); ok {
+		return returnFunc(repoID, pipeline, opt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, int64, woodpecker.DeployOptions) *woodpecker.Pipeline); ok {
+		r0 = returnFunc(repoID, pipeline, opt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.Pipeline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, int64, woodpecker.DeployOptions) error); ok {
+		r1 = returnFunc(repoID, pipeline, opt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_Deploy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Deploy'
+type MockClient_Deploy_Call struct {
+	*mock.Call
+}
+
+// Deploy is a helper method to define mock.On call
+//   - repoID int64
+//   - pipeline int64
+//   - opt woodpecker.DeployOptions
+func (_e *MockClient_Expecter) Deploy(repoID interface{}, pipeline interface{}, opt interface{}) *MockClient_Deploy_Call {
+	return &MockClient_Deploy_Call{Call: _e.mock.On("Deploy", repoID, pipeline, opt)}
+}
+
+func (_c *MockClient_Deploy_Call) Run(run func(repoID int64, pipeline int64, opt woodpecker.DeployOptions)) *MockClient_Deploy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 woodpecker.DeployOptions
+		if args[2] != nil {
+			arg2 = args[2].(woodpecker.DeployOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_Deploy_Call) Return(pipeline1 *woodpecker.Pipeline, err error) *MockClient_Deploy_Call {
+	_c.Call.Return(pipeline1, err)
+	return _c
+}
+
+func (_c *MockClient_Deploy_Call) RunAndReturn(run func(repoID int64, pipeline int64, opt woodpecker.DeployOptions) (*woodpecker.Pipeline, error)) *MockClient_Deploy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GlobalRegistry provides a mock function for the type MockClient
+func (_mock *MockClient) GlobalRegistry(registry string) (*woodpecker.Registry, error) {
+	ret := _mock.Called(registry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GlobalRegistry")
+	}
+
+	var r0 *woodpecker.Registry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*woodpecker.Registry, error)); ok {
+		return returnFunc(registry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *woodpecker.Registry); ok {
+		r0 = returnFunc(registry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.Registry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(registry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GlobalRegistry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalRegistry'
+type MockClient_GlobalRegistry_Call struct {
+	*mock.Call
+}
+
+// GlobalRegistry is a helper method to define mock.On call
+//   - registry string
+func (_e *MockClient_Expecter) GlobalRegistry(registry interface{}) *MockClient_GlobalRegistry_Call {
+	return &MockClient_GlobalRegistry_Call{Call: _e.mock.On("GlobalRegistry", registry)}
+}
+
+func (_c *MockClient_GlobalRegistry_Call) Run(run func(registry string)) *MockClient_GlobalRegistry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_GlobalRegistry_Call) Return(registry1 *woodpecker.Registry, err error) *MockClient_GlobalRegistry_Call {
+	_c.Call.Return(registry1, err)
+	return _c
+}
+
+func (_c *MockClient_GlobalRegistry_Call) RunAndReturn(run func(registry string) (*woodpecker.Registry, error)) *MockClient_GlobalRegistry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GlobalRegistryCreate provides a mock function for the type MockClient
+func (_mock *MockClient) GlobalRegistryCreate(registry *woodpecker.Registry) (*woodpecker.Registry, error) {
+	ret := _mock.Called(registry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GlobalRegistryCreate")
+	}
+
+	var r0 *woodpecker.Registry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*woodpecker.Registry) (*woodpecker.Registry, error)); ok {
+		return returnFunc(registry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*woodpecker.Registry) *woodpecker.Registry); ok {
+		r0 = returnFunc(registry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.Registry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*woodpecker.Registry) error); ok {
+		r1 = returnFunc(registry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GlobalRegistryCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalRegistryCreate'
+type MockClient_GlobalRegistryCreate_Call struct {
+	*mock.Call
+}
+
+// GlobalRegistryCreate is a helper method to define mock.On call
+//   - registry *woodpecker.Registry
+func (_e *MockClient_Expecter) GlobalRegistryCreate(registry interface{}) *MockClient_GlobalRegistryCreate_Call {
+	return &MockClient_GlobalRegistryCreate_Call{Call: _e.mock.On("GlobalRegistryCreate", registry)}
+}
+
+func (_c *MockClient_GlobalRegistryCreate_Call) Run(run func(registry *woodpecker.Registry)) *MockClient_GlobalRegistryCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *woodpecker.Registry
+		if args[0] != nil {
+			arg0 = args[0].(*woodpecker.Registry)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_GlobalRegistryCreate_Call) Return(registry1 *woodpecker.Registry, err error) *MockClient_GlobalRegistryCreate_Call {
+	_c.Call.Return(registry1, err)
+	return _c
+}
+
+func (_c *MockClient_GlobalRegistryCreate_Call) RunAndReturn(run func(registry *woodpecker.Registry) (*woodpecker.Registry, error)) *MockClient_GlobalRegistryCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GlobalRegistryDelete provides a mock function for the type MockClient
+func (_mock *MockClient) GlobalRegistryDelete(registry string) error {
+	ret := _mock.Called(registry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GlobalRegistryDelete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(registry)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_GlobalRegistryDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalRegistryDelete'
+type MockClient_GlobalRegistryDelete_Call struct {
+	*mock.Call
+}
+
+// GlobalRegistryDelete is a helper method to define mock.On call
+//   - registry string
+func (_e *MockClient_Expecter) GlobalRegistryDelete(registry interface{}) *MockClient_GlobalRegistryDelete_Call {
+	return &MockClient_GlobalRegistryDelete_Call{Call: _e.mock.On("GlobalRegistryDelete", registry)}
+}
+
+func (_c *MockClient_GlobalRegistryDelete_Call) Run(run func(registry string)) *MockClient_GlobalRegistryDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_GlobalRegistryDelete_Call) Return(err error) *MockClient_GlobalRegistryDelete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_GlobalRegistryDelete_Call) RunAndReturn(run func(registry string) error) *MockClient_GlobalRegistryDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GlobalRegistryList provides a mock function for the type MockClient
+func (_mock *MockClient) GlobalRegistryList(opt woodpecker.RegistryListOptions) ([]*woodpecker.Registry, error) {
+	ret := _mock.Called(opt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GlobalRegistryList")
+	}
+
+	var r0 []*woodpecker.Registry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(woodpecker.RegistryListOptions) ([]*woodpecker.Registry, error)); ok {
+		return returnFunc(opt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(woodpecker.RegistryListOptions) []*woodpecker.Registry); ok {
+		r0 = returnFunc(opt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*woodpecker.Registry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(woodpecker.RegistryListOptions) error); ok {
+		r1 = returnFunc(opt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GlobalRegistryList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalRegistryList'
+type MockClient_GlobalRegistryList_Call struct {
+	*mock.Call
+}
+
+// GlobalRegistryList is a helper method to define mock.On call
+//   - opt woodpecker.RegistryListOptions
+func (_e *MockClient_Expecter) GlobalRegistryList(opt interface{}) *MockClient_GlobalRegistryList_Call {
+	return &MockClient_GlobalRegistryList_Call{Call: _e.mock.On("GlobalRegistryList", opt)}
+}
+
+func (_c *MockClient_GlobalRegistryList_Call) Run(run func(opt woodpecker.RegistryListOptions)) *MockClient_GlobalRegistryList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 woodpecker.RegistryListOptions
+		if args[0] != nil {
+			arg0 = args[0].(woodpecker.RegistryListOptions)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_GlobalRegistryList_Call) Return(registrys []*woodpecker.Registry, err error) *MockClient_GlobalRegistryList_Call {
+	_c.Call.Return(registrys, err)
+	return _c
+}
+
+func (_c *MockClient_GlobalRegistryList_Call) RunAndReturn(run func(opt woodpecker.RegistryListOptions) ([]*woodpecker.Registry, error)) *MockClient_GlobalRegistryList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GlobalRegistryUpdate provides a mock function for the type MockClient
+func (_mock *MockClient) GlobalRegistryUpdate(registry *woodpecker.Registry) (*woodpecker.Registry, error) {
+	ret := _mock.Called(registry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GlobalRegistryUpdate")
+	}
+
+	var r0 *woodpecker.Registry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*woodpecker.Registry) (*woodpecker.Registry, error)); ok {
+		return returnFunc(registry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*woodpecker.Registry) *woodpecker.Registry); ok {
+		r0 = returnFunc(registry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.Registry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*woodpecker.Registry) error); ok {
+		r1 = returnFunc(registry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GlobalRegistryUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalRegistryUpdate'
+type MockClient_GlobalRegistryUpdate_Call struct {
+	*mock.Call
+}
+
+// GlobalRegistryUpdate is a helper method to define mock.On call
+//   - registry *woodpecker.Registry
+func (_e *MockClient_Expecter) GlobalRegistryUpdate(registry interface{}) *MockClient_GlobalRegistryUpdate_Call {
+	return &MockClient_GlobalRegistryUpdate_Call{Call: _e.mock.On("GlobalRegistryUpdate", registry)}
+}
+
+func (_c *MockClient_GlobalRegistryUpdate_Call) Run(run func(registry *woodpecker.Registry)) *MockClient_GlobalRegistryUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *woodpecker.Registry
+		if args[0] != nil {
+			arg0 = args[0].(*woodpecker.Registry)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_GlobalRegistryUpdate_Call) Return(registry1 *woodpecker.Registry, err error) *MockClient_GlobalRegistryUpdate_Call {
+	_c.Call.Return(registry1, err)
+	return _c
+}
+
+func (_c *MockClient_GlobalRegistryUpdate_Call) RunAndReturn(run func(registry *woodpecker.Registry) (*woodpecker.Registry, error)) *MockClient_GlobalRegistryUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GlobalSecret provides a mock function for the type MockClient
+func (_mock *MockClient) GlobalSecret(secret string) (*woodpecker.Secret, error) {
+	ret := _mock.Called(secret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GlobalSecret")
+	}
+
+	var r0 *woodpecker.Secret
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*woodpecker.Secret, error)); ok {
+		return returnFunc(secret)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *woodpecker.Secret); ok {
+		r0 = returnFunc(secret)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.Secret)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(secret)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GlobalSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalSecret'
+type MockClient_GlobalSecret_Call struct {
+	*mock.Call
+}
+
+// GlobalSecret is a helper method to define mock.On call
+//   - secret string
+func (_e *MockClient_Expecter) GlobalSecret(secret interface{}) *MockClient_GlobalSecret_Call {
+	return &MockClient_GlobalSecret_Call{Call: _e.mock.On("GlobalSecret", secret)}
+}
+
+func (_c *MockClient_GlobalSecret_Call) Run(run func(secret string)) *MockClient_GlobalSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_GlobalSecret_Call) Return(secret1 *woodpecker.Secret, err error) *MockClient_GlobalSecret_Call {
+	_c.Call.Return(secret1, err)
+	return _c
+}
+
+func (_c *MockClient_GlobalSecret_Call) RunAndReturn(run func(secret string) (*woodpecker.Secret, error)) *MockClient_GlobalSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GlobalSecretCreate provides a mock function for the type MockClient
+func (_mock *MockClient) GlobalSecretCreate(secret *woodpecker.Secret) (*woodpecker.Secret, error) {
+	ret := _mock.Called(secret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GlobalSecretCreate")
+	}
+
+	var r0 *woodpecker.Secret
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*woodpecker.Secret) (*woodpecker.Secret, error)); ok {
+		return returnFunc(secret)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*woodpecker.Secret) *woodpecker.Secret); ok {
+		r0 = returnFunc(secret)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.Secret)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*woodpecker.Secret) error); ok {
+		r1 = returnFunc(secret)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_GlobalRegistryList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalRegistryList'
-type MockClient_GlobalRegistryList_Call struct {
+// MockClient_GlobalSecretCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalSecretCreate'
+type MockClient_GlobalSecretCreate_Call struct {
 	*mock.Call
 }
 
-// GlobalRegistryList is a helper method to define mock.On call
-//   - opt woodpecker.RegistryListOptions
-func (_e *MockClient_Expecter) GlobalRegistryList(opt interface{}) *MockClient_GlobalRegistryList_Call {
-	return &MockClient_GlobalRegistryList_Call{Call: _e.mock.On("GlobalRegistryList", opt)}
+// GlobalSecretCreate is a helper method to define mock.On call
+//   - secret *woodpecker.Secret
+func (_e *MockClient_Expecter) GlobalSecretCreate(secret interface{}) *MockClient_GlobalSecretCreate_Call {
+	return &MockClient_GlobalSecretCreate_Call{Call: _e.mock.On("GlobalSecretCreate", secret)}
 }
 
-func (_c *MockClient_GlobalRegistryList_Call) Run(run func(opt woodpecker.RegistryListOptions)) *MockClient_GlobalRegistryList_Call {
+func (_c *MockClient_GlobalSecretCreate_Call) Run(run func(secret *woodpecker.Secret)) *MockClient_GlobalSecretCreate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 woodpecker.RegistryListOptions
+		var arg0 *woodpecker.Secret
 		if args[0] != nil {
-			arg0 = args[0].(woodpecker.RegistryListOptions)
+			arg0 = args[0].(*woodpecker.Secret)
 		}
 		run(
 			arg0,
@@ -1022,235 +1699,415 @@ func (_c *MockClient_GlobalRegistryList_Call) Run(run func(opt woodpecker.Regist
 	return _c
 }
 
-func (_c *MockClient_GlobalRegistryList_Call) Return(registrys []*woodpecker.Registry, err error) *MockClient_GlobalRegistryList_Call {
-	_c.Call.Return(registrys, err)
+func (_c *MockClient_GlobalSecretCreate_Call) Return(secret1 *woodpecker.Secret, err error) *MockClient_GlobalSecretCreate_Call {
+	_c.Call.Return(secret1, err)
 	return _c
 }
 
-func (_c *MockClient_GlobalRegistryList_Call) RunAndReturn(run func(opt woodpecker.RegistryListOptions) ([]*woodpecker.Registry, error)) *MockClient_GlobalRegistryList_Call {
+func (_c *MockClient_GlobalSecretCreate_Call) RunAndReturn(run func(secret *woodpecker.Secret) (*woodpecker.Secret, error)) *MockClient_GlobalSecretCreate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GlobalRegistryUpdate provides a mock function for the type MockClient
-func (_mock *MockClient) GlobalRegistryUpdate(registry *woodpecker.Registry) (*woodpecker.Registry, error) {
-	ret := _mock.Called(registry)
+// GlobalSecretDelete provides a mock function for the type MockClient
+func (_mock *MockClient) GlobalSecretDelete(secret string) error {
+	ret := _mock.Called(secret)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GlobalRegistryUpdate")
+		panic("no return value specified for GlobalSecretDelete")
 	}
 
-	var r0 *woodpecker.Registry
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(secret)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_GlobalSecretDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalSecretDelete'
+type MockClient_GlobalSecretDelete_Call struct {
+	*mock.Call
+}
+
+// GlobalSecretDelete is a helper method to define mock.On call
+//   - secret string
+func (_e *MockClient_Expecter) GlobalSecretDelete(secret interface{}) *MockClient_GlobalSecretDelete_Call {
+	return &MockClient_GlobalSecretDelete_Call{Call: _e.mock.On("GlobalSecretDelete", secret)}
+}
+
+func (_c *MockClient_GlobalSecretDelete_Call) Run(run func(secret string)) *MockClient_GlobalSecretDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_GlobalSecretDelete_Call) Return(err error) *MockClient_GlobalSecretDelete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_GlobalSecretDelete_Call) RunAndReturn(run func(secret string) error) *MockClient_GlobalSecretDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GlobalSecretList provides a mock function for the type MockClient
+func (_mock *MockClient) GlobalSecretList(opt woodpecker.SecretListOptions) ([]*woodpecker.Secret, error) {
+	ret := _mock.Called(opt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GlobalSecretList")
+	}
+
+	var r0 []*woodpecker.Secret
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*woodpecker.Registry) (*woodpecker.Registry, error)); ok {
-		return returnFunc(registry)
+	if returnFunc, ok := ret.Get(0).(func(woodpecker.SecretListOptions) ([]*woodpecker.Secret, error)); ok {
+		return returnFunc(opt)
 	}
-	if returnFunc, ok := ret.Get(0).(func(*woodpecker.Registry) *woodpecker.Registry); ok {
-		r0 = returnFunc(registry)
+	if returnFunc, ok := ret.Get(0).(func(woodpecker.SecretListOptions) []*woodpecker.Secret); ok {
+		r0 = returnFunc(opt)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*woodpecker.Registry)
+			r0 = ret.Get(0).([]*woodpecker.Secret)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(*woodpecker.Registry) error); ok {
-		r1 = returnFunc(registry)
+	if returnFunc, ok := ret.Get(1).(func(woodpecker.SecretListOptions) error); ok {
+		r1 = returnFunc(opt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GlobalSecretList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalSecretList'
+type MockClient_GlobalSecretList_Call struct {
+	*mock.Call
+}
+
+// GlobalSecretList is a helper method to define mock.On call
+//   - opt woodpecker.SecretListOptions
+func (_e *MockClient_Expecter) GlobalSecretList(opt interface{}) *MockClient_GlobalSecretList_Call {
+	return &MockClient_GlobalSecretList_Call{Call: _e.mock.On("GlobalSecretList", opt)}
+}
+
+func (_c *MockClient_GlobalSecretList_Call) Run(run func(opt woodpecker.SecretListOptions)) *MockClient_GlobalSecretList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 woodpecker.SecretListOptions
+		if args[0] != nil {
+			arg0 = args[0].(woodpecker.SecretListOptions)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_GlobalSecretList_Call) Return(secrets []*woodpecker.Secret, err error) *MockClient_GlobalSecretList_Call {
+	_c.Call.Return(secrets, err)
+	return _c
+}
+
+func (_c *MockClient_GlobalSecretList_Call) RunAndReturn(run func(opt woodpecker.SecretListOptions) ([]*woodpecker.Secret, error)) *MockClient_GlobalSecretList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GlobalSecretUpdate provides a mock function for the type MockClient
+func (_mock *MockClient) GlobalSecretUpdate(secret *woodpecker.Secret) (*woodpecker.Secret, error) {
+	ret := _mock.Called(secret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GlobalSecretUpdate")
+	}
+
+	var r0 *woodpecker.Secret
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*woodpecker.Secret) (*woodpecker.Secret, error)); ok {
+		return returnFunc(secret)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*woodpecker.Secret) *woodpecker.Secret); ok {
+		r0 = returnFunc(secret)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.Secret)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*woodpecker.Secret) error); ok {
+		r1 = returnFunc(secret)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GlobalSecretUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalSecretUpdate'
+type MockClient_GlobalSecretUpdate_Call struct {
+	*mock.Call
+}
+
+// GlobalSecretUpdate is a helper method to define mock.On call
+//   - secret *woodpecker.Secret
+func (_e *MockClient_Expecter) GlobalSecretUpdate(secret interface{}) *MockClient_GlobalSecretUpdate_Call {
+	return &MockClient_GlobalSecretUpdate_Call{Call: _e.mock.On("GlobalSecretUpdate", secret)}
+}
+
+func (_c *MockClient_GlobalSecretUpdate_Call) Run(run func(secret *woodpecker.Secret)) *MockClient_GlobalSecretUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *woodpecker.Secret
+		if args[0] != nil {
+			arg0 = args[0].(*woodpecker.Secret)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_GlobalSecretUpdate_Call) Return(secret1 *woodpecker.Secret, err error) *MockClient_GlobalSecretUpdate_Call {
+	_c.Call.Return(secret1, err)
+	return _c
+}
+
+func (_c *MockClient_GlobalSecretUpdate_Call) RunAndReturn(run func(secret *woodpecker.Secret) (*woodpecker.Secret, error)) *MockClient_GlobalSecretUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogLevel provides a mock function for the type MockClient
+func (_mock *MockClient) LogLevel() (*woodpecker.LogLevel, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogLevel")
+	}
+
+	var r0 *woodpecker.LogLevel
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*woodpecker.LogLevel, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() *woodpecker.LogLevel); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.LogLevel)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_GlobalRegistryUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalRegistryUpdate'
-type MockClient_GlobalRegistryUpdate_Call struct {
+// MockClient_LogLevel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogLevel'
+type MockClient_LogLevel_Call struct {
 	*mock.Call
 }
 
-// GlobalRegistryUpdate is a helper method to define mock.On call
-//   - registry *woodpecker.Registry
-func (_e *MockClient_Expecter) GlobalRegistryUpdate(registry interface{}) *MockClient_GlobalRegistryUpdate_Call {
-	return &MockClient_GlobalRegistryUpdate_Call{Call: _e.mock.On("GlobalRegistryUpdate", registry)}
+// LogLevel is a helper method to define mock.On call
+func (_e *MockClient_Expecter) LogLevel() *MockClient_LogLevel_Call {
+	return &MockClient_LogLevel_Call{Call: _e.mock.On("LogLevel")}
 }
 
-func (_c *MockClient_GlobalRegistryUpdate_Call) Run(run func(registry *woodpecker.Registry)) *MockClient_GlobalRegistryUpdate_Call {
+func (_c *MockClient_LogLevel_Call) Run(run func()) *MockClient_LogLevel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *woodpecker.Registry
-		if args[0] != nil {
-			arg0 = args[0].(*woodpecker.Registry)
-		}
-		run(
-			arg0,
-		)
+		run()
 	})
 	return _c
 }
 
-func (_c *MockClient_GlobalRegistryUpdate_Call) Return(registry1 *woodpecker.Registry, err error) *MockClient_GlobalRegistryUpdate_Call {
-	_c.Call.Return(registry1, err)
+func (_c *MockClient_LogLevel_Call) Return(logLevel *woodpecker.LogLevel, err error) *MockClient_LogLevel_Call {
+	_c.Call.Return(logLevel, err)
 	return _c
 }
 
-func (_c *MockClient_GlobalRegistryUpdate_Call) RunAndReturn(run func(registry *woodpecker.Registry) (*woodpecker.Registry, error)) *MockClient_GlobalRegistryUpdate_Call {
+func (_c *MockClient_LogLevel_Call) RunAndReturn(run func() (*woodpecker.LogLevel, error)) *MockClient_LogLevel_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GlobalSecret provides a mock function for the type MockClient
-func (_mock *MockClient) GlobalSecret(secret string) (*woodpecker.Secret, error) {
-	ret := _mock.Called(secret)
+// LogSearch provides a mock function for the type MockClient
+func (_mock *MockClient) LogSearch(repoID int64, opt woodpecker.LogSearchOptions) (*woodpecker.LogSearchResult, error) {
+	ret := _mock.Called(repoID, opt)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GlobalSecret")
+		panic("no return value specified for LogSearch")
 	}
 
-	var r0 *woodpecker.Secret
+	var r0 *woodpecker.LogSearchResult
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (*woodpecker.Secret, error)); ok {
-		return returnFunc(secret)
+	if returnFunc, ok := ret.Get(0).(func(int64, woodpecker.LogSearchOptions) (*woodpecker.LogSearchResult, error)); ok {
+		return returnFunc(repoID, opt)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) *woodpecker.Secret); ok {
-		r0 = returnFunc(secret)
+	if returnFunc, ok := ret.Get(0).(func(int64, woodpecker.LogSearchOptions) *woodpecker.LogSearchResult); ok {
+		r0 = returnFunc(repoID, opt)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*woodpecker.Secret)
+			r0 = ret.Get(0).(*woodpecker.LogSearchResult)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(secret)
+	if returnFunc, ok := ret.Get(1).(func(int64, woodpecker.LogSearchOptions) error); ok {
+		r1 = returnFunc(repoID, opt)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_GlobalSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalSecret'
-type MockClient_GlobalSecret_Call struct {
+// MockClient_LogSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogSearch'
+type MockClient_LogSearch_Call struct {
 	*mock.Call
 }
 
-// GlobalSecret is a helper method to define mock.On call
-//   - secret string
-func (_e *MockClient_Expecter) GlobalSecret(secret interface{}) *MockClient_GlobalSecret_Call {
-	return &MockClient_GlobalSecret_Call{Call: _e.mock.On("GlobalSecret", secret)}
+// LogSearch is a helper method to define mock.On call
+//   - repoID int64
+//   - opt woodpecker.LogSearchOptions
+func (_e *MockClient_Expecter) LogSearch(repoID interface{}, opt interface{}) *MockClient_LogSearch_Call {
+	return &MockClient_LogSearch_Call{Call: _e.mock.On("LogSearch", repoID, opt)}
 }
 
-func (_c *MockClient_GlobalSecret_Call) Run(run func(secret string)) *MockClient_GlobalSecret_Call {
+func (_c *MockClient_LogSearch_Call) Run(run func(repoID int64, opt woodpecker.LogSearchOptions)) *MockClient_LogSearch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 int64
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(int64)
+		}
+		var arg1 woodpecker.LogSearchOptions
+		if args[1] != nil {
+			arg1 = args[1].(woodpecker.LogSearchOptions)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_GlobalSecret_Call) Return(secret1 *woodpecker.Secret, err error) *MockClient_GlobalSecret_Call {
-	_c.Call.Return(secret1, err)
+func (_c *MockClient_LogSearch_Call) Return(logSearchResult *woodpecker.LogSearchResult, err error) *MockClient_LogSearch_Call {
+	_c.Call.Return(logSearchResult, err)
 	return _c
 }
 
-func (_c *MockClient_GlobalSecret_Call) RunAndReturn(run func(secret string) (*woodpecker.Secret, error)) *MockClient_GlobalSecret_Call {
+func (_c *MockClient_LogSearch_Call) RunAndReturn(run func(repoID int64, opt woodpecker.LogSearchOptions) (*woodpecker.LogSearchResult, error)) *MockClient_LogSearch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GlobalSecretCreate provides a mock function for the type MockClient
-func (_mock *MockClient) GlobalSecretCreate(secret *woodpecker.Secret) (*woodpecker.Secret, error) {
-	ret := _mock.Called(secret)
+// LogsPurge provides a mock function for the type MockClient
+func (_mock *MockClient) LogsPurge(repoID int64, pipeline int64) error {
+	ret := _mock.Called(repoID, pipeline)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GlobalSecretCreate")
+		panic("no return value specified for LogsPurge")
 	}
 
-	var r0 *woodpecker.Secret
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*woodpecker.Secret) (*woodpecker.Secret, error)); ok {
-		return returnFunc(secret)
-	}
-	if returnFunc, ok := ret.Get(0).(func(*woodpecker.Secret) *woodpecker.Secret); ok {
-		r0 = returnFunc(secret)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*woodpecker.Secret)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(*woodpecker.Secret) error); ok {
-		r1 = returnFunc(secret)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = returnFunc(repoID, pipeline)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockClient_GlobalSecretCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalSecretCreate'
-type MockClient_GlobalSecretCreate_Call struct {
+// MockClient_LogsPurge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogsPurge'
+type MockClient_LogsPurge_Call struct {
 	*mock.Call
 }
 
-// GlobalSecretCreate is a helper method to define mock.On call
-//   - secret *woodpecker.Secret
-func (_e *MockClient_Expecter) GlobalSecretCreate(secret interface{}) *MockClient_GlobalSecretCreate_Call {
-	return &MockClient_GlobalSecretCreate_Call{Call: _e.mock.On("GlobalSecretCreate", secret)}
+// LogsPurge is a helper method to define mock.On call
+//   - repoID int64
+//   - pipeline int64
+func (_e *MockClient_Expecter) LogsPurge(repoID interface{}, pipeline interface{}) *MockClient_LogsPurge_Call {
+	return &MockClient_LogsPurge_Call{Call: _e.mock.On("LogsPurge", repoID, pipeline)}
 }
 
-func (_c *MockClient_GlobalSecretCreate_Call) Run(run func(secret *woodpecker.Secret)) *MockClient_GlobalSecretCreate_Call {
+func (_c *MockClient_LogsPurge_Call) Run(run func(repoID int64, pipeline int64)) *MockClient_LogsPurge_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *woodpecker.Secret
+		var arg0 int64
 		if args[0] != nil {
-			arg0 = args[0].(*woodpecker.Secret)
+			arg0 = args[0].(int64)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_GlobalSecretCreate_Call) Return(secret1 *woodpecker.Secret, err error) *MockClient_GlobalSecretCreate_Call {
-	_c.Call.Return(secret1, err)
+func (_c *MockClient_LogsPurge_Call) Return(err error) *MockClient_LogsPurge_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClient_GlobalSecretCreate_Call) RunAndReturn(run func(secret *woodpecker.Secret) (*woodpecker.Secret, error)) *MockClient_GlobalSecretCreate_Call {
+func (_c *MockClient_LogsPurge_Call) RunAndReturn(run func(repoID int64, pipeline int64) error) *MockClient_LogsPurge_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GlobalSecretDelete provides a mock function for the type MockClient
-func (_mock *MockClient) GlobalSecretDelete(secret string) error {
-	ret := _mock.Called(secret)
+// Org provides a mock function for the type MockClient
+func (_mock *MockClient) Org(orgID int64) (*woodpecker.Org, error) {
+	ret := _mock.Called(orgID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GlobalSecretDelete")
+		panic("no return value specified for Org")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
-		r0 = returnFunc(secret)
+	var r0 *woodpecker.Org
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64) (*woodpecker.Org, error)); ok {
+		return returnFunc(orgID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64) *woodpecker.Org); ok {
+		r0 = returnFunc(orgID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.Org)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(orgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockClient_GlobalSecretDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalSecretDelete'
-type MockClient_GlobalSecretDelete_Call struct {
+// MockClient_Org_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Org'
+type MockClient_Org_Call struct {
 	*mock.Call
 }
 
-// GlobalSecretDelete is a helper method to define mock.On call
-//   - secret string
-func (_e *MockClient_Expecter) GlobalSecretDelete(secret interface{}) *MockClient_GlobalSecretDelete_Call {
-	return &MockClient_GlobalSecretDelete_Call{Call: _e.mock.On("GlobalSecretDelete", secret)}
+// Org is a helper method to define mock.On call
+//   - orgID int64
+func (_e *MockClient_Expecter) Org(orgID interface{}) *MockClient_Org_Call {
+	return &MockClient_Org_Call{Call: _e.mock.On("Org", orgID)}
 }
 
-func (_c *MockClient_GlobalSecretDelete_Call) Run(run func(secret string)) *MockClient_GlobalSecretDelete_Call {
+func (_c *MockClient_Org_Call) Run(run func(orgID int64)) *MockClient_Org_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 int64
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(int64)
 		}
 		run(
 			arg0,
@@ -1259,233 +2116,258 @@ func (_c *MockClient_GlobalSecretDelete_Call) Run(run func(secret string)) *Mock
 	return _c
 }
 
-func (_c *MockClient_GlobalSecretDelete_Call) Return(err error) *MockClient_GlobalSecretDelete_Call {
-	_c.Call.Return(err)
+func (_c *MockClient_Org_Call) Return(org *woodpecker.Org, err error) *MockClient_Org_Call {
+	_c.Call.Return(org, err)
 	return _c
 }
 
-func (_c *MockClient_GlobalSecretDelete_Call) RunAndReturn(run func(secret string) error) *MockClient_GlobalSecretDelete_Call {
+func (_c *MockClient_Org_Call) RunAndReturn(run func(orgID int64) (*woodpecker.Org, error)) *MockClient_Org_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GlobalSecretList provides a mock function for the type MockClient
-func (_mock *MockClient) GlobalSecretList(opt woodpecker.SecretListOptions) ([]*woodpecker.Secret, error) {
-	ret := _mock.Called(opt)
+// OrgConfigTemplate provides a mock function for the type MockClient
+func (_mock *MockClient) OrgConfigTemplate(orgID int64, template string) (*woodpecker.ConfigTemplate, error) {
+	ret := _mock.Called(orgID, template)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GlobalSecretList")
+		panic("no return value specified for OrgConfigTemplate")
 	}
 
-	var r0 []*woodpecker.Secret
+	var r0 *woodpecker.ConfigTemplate
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(woodpecker.SecretListOptions) ([]*woodpecker.Secret, error)); ok {
-		return returnFunc(opt)
+	if returnFunc, ok := ret.Get(0).(func(int64, string) (*woodpecker.ConfigTemplate, error)); ok {
+		return returnFunc(orgID, template)
 	}
-	if returnFunc, ok := ret.Get(0).(func(woodpecker.SecretListOptions) []*woodpecker.Secret); ok {
-		r0 = returnFunc(opt)
+	if returnFunc, ok := ret.Get(0).(func(int64, string) *woodpecker.ConfigTemplate); ok {
+		r0 = returnFunc(orgID, template)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*woodpecker.Secret)
+			r0 = ret.Get(0).(*woodpecker.ConfigTemplate)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(woodpecker.SecretListOptions) error); ok {
-		r1 = returnFunc(opt)
+	if returnFunc, ok := ret.Get(1).(func(int64, string) error); ok {
+		r1 = returnFunc(orgID, template)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_GlobalSecretList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalSecretList'
-type MockClient_GlobalSecretList_Call struct {
+// MockClient_OrgConfigTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgConfigTemplate'
+type MockClient_OrgConfigTemplate_Call struct {
 	*mock.Call
 }
 
-// GlobalSecretList is a helper method to define mock.On call
-//   - opt woodpecker.SecretListOptions
-func (_e *MockClient_Expecter) GlobalSecretList(opt interface{}) *MockClient_GlobalSecretList_Call {
-	return &MockClient_GlobalSecretList_Call{Call: _e.mock.On("GlobalSecretList", opt)}
+// OrgConfigTemplate is a helper method to define mock.On call
+//   - orgID int64
+//   - template string
+func (_e *MockClient_Expecter) OrgConfigTemplate(orgID interface{}, template interface{}) *MockClient_OrgConfigTemplate_Call {
+	return &MockClient_OrgConfigTemplate_Call{Call: _e.mock.On("OrgConfigTemplate", orgID, template)}
 }
 
-func (_c *MockClient_GlobalSecretList_Call) Run(run func(opt woodpecker.SecretListOptions)) *MockClient_GlobalSecretList_Call {
+func (_c *MockClient_OrgConfigTemplate_Call) Run(run func(orgID int64, template string)) *MockClient_OrgConfigTemplate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 woodpecker.SecretListOptions
+		var arg0 int64
 		if args[0] != nil {
-			arg0 = args[0].(woodpecker.SecretListOptions)
+			arg0 = args[0].(int64)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_GlobalSecretList_Call) Return(secrets []*woodpecker.Secret, err error) *MockClient_GlobalSecretList_Call {
-	_c.Call.Return(secrets, err)
+func (_c *MockClient_OrgConfigTemplate_Call) Return(configTemplate *woodpecker.ConfigTemplate, err error) *MockClient_OrgConfigTemplate_Call {
+	_c.Call.Return(configTemplate, err)
 	return _c
 }
 
-func (_c *MockClient_GlobalSecretList_Call) RunAndReturn(run func(opt woodpecker.SecretListOptions) ([]*woodpecker.Secret, error)) *MockClient_GlobalSecretList_Call {
+func (_c *MockClient_OrgConfigTemplate_Call) RunAndReturn(run func(orgID int64, template string) (*woodpecker.ConfigTemplate, error)) *MockClient_OrgConfigTemplate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GlobalSecretUpdate provides a mock function for the type MockClient
-func (_mock *MockClient) GlobalSecretUpdate(secret *woodpecker.Secret) (*woodpecker.Secret, error) {
-	ret := _mock.Called(secret)
+// OrgConfigTemplateCreate provides a mock function for the type MockClient
+func (_mock *MockClient) OrgConfigTemplateCreate(orgID int64, template *woodpecker.ConfigTemplate) (*woodpecker.ConfigTemplate, error) {
+	ret := _mock.Called(orgID, template)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GlobalSecretUpdate")
+		panic("no return value specified for OrgConfigTemplateCreate")
 	}
 
-	var r0 *woodpecker.Secret
+	var r0 *woodpecker.ConfigTemplate
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*woodpecker.Secret) (*woodpecker.Secret, error)); ok {
-		return returnFunc(secret)
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.ConfigTemplate) (*woodpecker.ConfigTemplate, error)); ok {
+		return returnFunc(orgID, template)
 	}
-	if returnFunc, ok := ret.Get(0).(func(*woodpecker.Secret) *woodpecker.Secret); ok {
-		r0 = returnFunc(secret)
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.ConfigTemplate) *woodpecker.ConfigTemplate); ok {
+		r0 = returnFunc(orgID, template)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*woodpecker.Secret)
+			r0 = ret.Get(0).(*woodpecker.ConfigTemplate)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(*woodpecker.Secret) error); ok {
-		r1 = returnFunc(secret)
+	if returnFunc, ok := ret.Get(1).(func(int64, *woodpecker.ConfigTemplate) error); ok {
+		r1 = returnFunc(orgID, template)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_GlobalSecretUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalSecretUpdate'
-type MockClient_GlobalSecretUpdate_Call struct {
+// MockClient_OrgConfigTemplateCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgConfigTemplateCreate'
+type MockClient_OrgConfigTemplateCreate_Call struct {
 	*mock.Call
 }
 
-// GlobalSecretUpdate is a helper method to define mock.On call
-//   - secret *woodpecker.Secret
-func (_e *MockClient_Expecter) GlobalSecretUpdate(secret interface{}) *MockClient_GlobalSecretUpdate_Call {
-	return &MockClient_GlobalSecretUpdate_Call{Call: _e.mock.On("GlobalSecretUpdate", secret)}
+// OrgConfigTemplateCreate is a helper method to define mock.On call
+//   - orgID int64
+//   - template *woodpecker.ConfigTemplate
+func (_e *MockClient_Expecter) OrgConfigTemplateCreate(orgID interface{}, template interface{}) *MockClient_OrgConfigTemplateCreate_Call {
+	return &MockClient_OrgConfigTemplateCreate_Call{Call: _e.mock.On("OrgConfigTemplateCreate", orgID, template)}
 }
 
-func (_c *MockClient_GlobalSecretUpdate_Call) Run(run func(secret *woodpecker.Secret)) *MockClient_GlobalSecretUpdate_Call {
+func (_c *MockClient_OrgConfigTemplateCreate_Call) Run(run func(orgID int64, template *woodpecker.ConfigTemplate)) *MockClient_OrgConfigTemplateCreate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *woodpecker.Secret
+		var arg0 int64
 		if args[0] != nil {
-			arg0 = args[0].(*woodpecker.Secret)
+			arg0 = args[0].(int64)
+		}
+		var arg1 *woodpecker.ConfigTemplate
+		if args[1] != nil {
+			arg1 = args[1].(*woodpecker.ConfigTemplate)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_GlobalSecretUpdate_Call) Return(secret1 *woodpecker.Secret, err error) *MockClient_GlobalSecretUpdate_Call {
-	_c.Call.Return(secret1, err)
+func (_c *MockClient_OrgConfigTemplateCreate_Call) Return(configTemplate *woodpecker.ConfigTemplate, err error) *MockClient_OrgConfigTemplateCreate_Call {
+	_c.Call.Return(configTemplate, err)
 	return _c
 }
 
-func (_c *MockClient_GlobalSecretUpdate_Call) RunAndReturn(run func(secret *woodpecker.Secret) (*woodpecker.Secret, error)) *MockClient_GlobalSecretUpdate_Call {
+func (_c *MockClient_OrgConfigTemplateCreate_Call) RunAndReturn(run func(orgID int64, template *woodpecker.ConfigTemplate) (*woodpecker.ConfigTemplate, error)) *MockClient_OrgConfigTemplateCreate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LogLevel provides a mock function for the type MockClient
-func (_mock *MockClient) LogLevel() (*woodpecker.LogLevel, error) {
-	ret := _mock.Called()
+// OrgConfigTemplateDelete provides a mock function for the type MockClient
+func (_mock *MockClient) OrgConfigTemplateDelete(orgID int64, template string) error {
+	ret := _mock.Called(orgID, template)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LogLevel")
-	}
-
-	var r0 *woodpecker.LogLevel
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() (*woodpecker.LogLevel, error)); ok {
-		return returnFunc()
-	}
-	if returnFunc, ok := ret.Get(0).(func() *woodpecker.LogLevel); ok {
-		r0 = returnFunc()
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*woodpecker.LogLevel)
-		}
+		panic("no return value specified for OrgConfigTemplateDelete")
 	}
-	if returnFunc, ok := ret.Get(1).(func() error); ok {
-		r1 = returnFunc()
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int64, string) error); ok {
+		r0 = returnFunc(orgID, template)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockClient_LogLevel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogLevel'
-type MockClient_LogLevel_Call struct {
+// MockClient_OrgConfigTemplateDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgConfigTemplateDelete'
+type MockClient_OrgConfigTemplateDelete_Call struct {
 	*mock.Call
 }
 
-// LogLevel is a helper method to define mock.On call
-func (_e *MockClient_Expecter) LogLevel() *MockClient_LogLevel_Call {
-	return &MockClient_LogLevel_Call{Call: _e.mock.On("LogLevel")}
+// OrgConfigTemplateDelete is a helper method to define mock.On call
+//   - orgID int64
+//   - template string
+func (_e *MockClient_Expecter) OrgConfigTemplateDelete(orgID interface{}, template interface{}) *MockClient_OrgConfigTemplateDelete_Call {
+	return &MockClient_OrgConfigTemplateDelete_Call{Call: _e.mock.On("OrgConfigTemplateDelete", orgID, template)}
 }
 
-func (_c *MockClient_LogLevel_Call) Run(run func()) *MockClient_LogLevel_Call {
+func (_c *MockClient_OrgConfigTemplateDelete_Call) Run(run func(orgID int64, template string)) *MockClient_OrgConfigTemplateDelete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
 	})
 	return _c
 }
 
-func (_c *MockClient_LogLevel_Call) Return(logLevel *woodpecker.LogLevel, err error) *MockClient_LogLevel_Call {
-	_c.Call.Return(logLevel, err)
+func (_c *MockClient_OrgConfigTemplateDelete_Call) Return(err error) *MockClient_OrgConfigTemplateDelete_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClient_LogLevel_Call) RunAndReturn(run func() (*woodpecker.LogLevel, error)) *MockClient_LogLevel_Call {
+func (_c *MockClient_OrgConfigTemplateDelete_Call) RunAndReturn(run func(orgID int64, template string) error) *MockClient_OrgConfigTemplateDelete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LogsPurge provides a mock function for the type MockClient
-func (_mock *MockClient) LogsPurge(repoID int64, pipeline int64) error {
-	ret := _mock.Called(repoID, pipeline)
+// OrgConfigTemplateList provides a mock function for the type MockClient
+func (_mock *MockClient) OrgConfigTemplateList(orgID int64, opt woodpecker.ListOptions) ([]*woodpecker.ConfigTemplate, error) {
+	ret := _mock.Called(orgID, opt)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LogsPurge")
+		panic("no return value specified for OrgConfigTemplateList")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(int64, int64) error); ok {
-		r0 = returnFunc(repoID, pipeline)
+	var r0 []*woodpecker.ConfigTemplate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, woodpecker.ListOptions) ([]*woodpecker.ConfigTemplate, error)); ok {
+		return returnFunc(orgID, opt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, woodpecker.ListOptions) []*woodpecker.ConfigTemplate); ok {
+		r0 = returnFunc(orgID, opt)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*woodpecker.ConfigTemplate)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(int64, woodpecker.ListOptions) error); ok {
+		r1 = returnFunc(orgID, opt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockClient_LogsPurge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogsPurge'
-type MockClient_LogsPurge_Call struct {
+// MockClient_OrgConfigTemplateList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgConfigTemplateList'
+type MockClient_OrgConfigTemplateList_Call struct {
 	*mock.Call
 }
 
-// LogsPurge is a helper method to define mock.On call
-//   - repoID int64
-//   - pipeline int64
-func (_e *MockClient_Expecter) LogsPurge(repoID interface{}, pipeline interface{}) *MockClient_LogsPurge_Call {
-	return &MockClient_LogsPurge_Call{Call: _e.mock.On("LogsPurge", repoID, pipeline)}
+// OrgConfigTemplateList is a helper method to define mock.On call
+//   - orgID int64
+//   - opt woodpecker.ListOptions
+func (_e *MockClient_Expecter) OrgConfigTemplateList(orgID interface{}, opt interface{}) *MockClient_OrgConfigTemplateList_Call {
+	return &MockClient_OrgConfigTemplateList_Call{Call: _e.mock.On("OrgConfigTemplateList", orgID, opt)}
 }
 
-func (_c *MockClient_LogsPurge_Call) Run(run func(repoID int64, pipeline int64)) *MockClient_LogsPurge_Call {
+func (_c *MockClient_OrgConfigTemplateList_Call) Run(run func(orgID int64, opt woodpecker.ListOptions)) *MockClient_OrgConfigTemplateList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int64
 		if args[0] != nil {
 			arg0 = args[0].(int64)
 		}
-		var arg1 int64
+		var arg1 woodpecker.ListOptions
 		if args[1] != nil {
-			arg1 = args[1].(int64)
+			arg1 = args[1].(woodpecker.ListOptions)
 		}
 		run(
 			arg0,
@@ -1495,74 +2377,80 @@ func (_c *MockClient_LogsPurge_Call) Run(run func(repoID int64, pipeline int64))
 	return _c
 }
 
-func (_c *MockClient_LogsPurge_Call) Return(err error) *MockClient_LogsPurge_Call {
-	_c.Call.Return(err)
+func (_c *MockClient_OrgConfigTemplateList_Call) Return(configTemplates []*woodpecker.ConfigTemplate, err error) *MockClient_OrgConfigTemplateList_Call {
+	_c.Call.Return(configTemplates, err)
 	return _c
 }
 
-func (_c *MockClient_LogsPurge_Call) RunAndReturn(run func(repoID int64, pipeline int64) error) *MockClient_LogsPurge_Call {
+func (_c *MockClient_OrgConfigTemplateList_Call) RunAndReturn(run func(orgID int64, opt woodpecker.ListOptions) ([]*woodpecker.ConfigTemplate, error)) *MockClient_OrgConfigTemplateList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Org provides a mock function for the type MockClient
-func (_mock *MockClient) Org(orgID int64) (*woodpecker.Org, error) {
-	ret := _mock.Called(orgID)
+// OrgConfigTemplateUpdate provides a mock function for the type MockClient
+func (_mock *MockClient) OrgConfigTemplateUpdate(orgID int64, template *woodpecker.ConfigTemplate) (*woodpecker.ConfigTemplate, error) {
+	ret := _mock.Called(orgID, template)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Org")
+		panic("no return value specified for OrgConfigTemplateUpdate")
 	}
 
-	var r0 *woodpecker.Org
+	var r0 *woodpecker.ConfigTemplate
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64) (*woodpecker.Org, error)); ok {
-		return returnFunc(orgID)
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.ConfigTemplate) (*woodpecker.ConfigTemplate, error)); ok {
+		return returnFunc(orgID, template)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64) *woodpecker.Org); ok {
-		r0 = returnFunc(orgID)
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.ConfigTemplate) *woodpecker.ConfigTemplate); ok {
+		r0 = returnFunc(orgID, template)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*woodpecker.Org)
+			r0 = ret.Get(0).(*woodpecker.ConfigTemplate)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = returnFunc(orgID)
+	if returnFunc, ok := ret.Get(1).(func(int64, *woodpecker.ConfigTemplate) error); ok {
+		r1 = returnFunc(orgID, template)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_Org_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Org'
-type MockClient_Org_Call struct {
+// MockClient_OrgConfigTemplateUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgConfigTemplateUpdate'
+type MockClient_OrgConfigTemplateUpdate_Call struct {
 	*mock.Call
 }
 
-// Org is a helper method to define mock.On call
+// OrgConfigTemplateUpdate is a helper method to define mock.On call
 //   - orgID int64
-func (_e *MockClient_Expecter) Org(orgID interface{}) *MockClient_Org_Call {
-	return &MockClient_Org_Call{Call: _e.mock.On("Org", orgID)}
+//   - template *woodpecker.ConfigTemplate
+func (_e *MockClient_Expecter) OrgConfigTemplateUpdate(orgID interface{}, template interface{}) *MockClient_OrgConfigTemplateUpdate_Call {
+	return &MockClient_OrgConfigTemplateUpdate_Call{Call: _e.mock.On("OrgConfigTemplateUpdate", orgID, template)}
 }
 
-func (_c *MockClient_Org_Call) Run(run func(orgID int64)) *MockClient_Org_Call {
+func (_c *MockClient_OrgConfigTemplateUpdate_Call) Run(run func(orgID int64, template *woodpecker.ConfigTemplate)) *MockClient_OrgConfigTemplateUpdate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int64
 		if args[0] != nil {
 			arg0 = args[0].(int64)
 		}
+		var arg1 *woodpecker.ConfigTemplate
+		if args[1] != nil {
+			arg1 = args[1].(*woodpecker.ConfigTemplate)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_Org_Call) Return(org *woodpecker.Org, err error) *MockClient_Org_Call {
-	_c.Call.Return(org, err)
+func (_c *MockClient_OrgConfigTemplateUpdate_Call) Return(configTemplate *woodpecker.ConfigTemplate, err error) *MockClient_OrgConfigTemplateUpdate_Call {
+	_c.Call.Return(configTemplate, err)
 	return _c
 }
 
-func (_c *MockClient_Org_Call) RunAndReturn(run func(orgID int64) (*woodpecker.Org, error)) *MockClient_Org_Call {
+func (_c *MockClient_OrgConfigTemplateUpdate_Call) RunAndReturn(run func(orgID int64, template *woodpecker.ConfigTemplate) (*woodpecker.ConfigTemplate, error)) *MockClient_OrgConfigTemplateUpdate_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1919,88 +2807,269 @@ type MockClient_OrgRegistryList_Call struct {
 
 // OrgRegistryList is a helper method to define mock.On call
 //   - orgID int64
-//   - opt woodpecker.RegistryListOptions
-func (_e *MockClient_Expecter) OrgRegistryList(orgID interface{}, opt interface{}) *MockClient_OrgRegistryList_Call {
-	return &MockClient_OrgRegistryList_Call{Call: _e.mock.On("OrgRegistryList", orgID, opt)}
+//   - opt woodpecker.RegistryListOptions
+func (_e *MockClient_Expecter) OrgRegistryList(orgID interface{}, opt interface{}) *MockClient_OrgRegistryList_Call {
+	return &MockClient_OrgRegistryList_Call{Call: _e.mock.On("OrgRegistryList", orgID, opt)}
+}
+
+func (_c *MockClient_OrgRegistryList_Call) Run(run func(orgID int64, opt woodpecker.RegistryListOptions)) *MockClient_OrgRegistryList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 woodpecker.RegistryListOptions
+		if args[1] != nil {
+			arg1 = args[1].(woodpecker.RegistryListOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_OrgRegistryList_Call) Return(registrys []*woodpecker.Registry, err error) *MockClient_OrgRegistryList_Call {
+	_c.Call.Return(registrys, err)
+	return _c
+}
+
+func (_c *MockClient_OrgRegistryList_Call) RunAndReturn(run func(orgID int64, opt woodpecker.RegistryListOptions) ([]*woodpecker.Registry, error)) *MockClient_OrgRegistryList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrgRegistryUpdate provides a mock function for the type MockClient
+func (_mock *MockClient) OrgRegistryUpdate(orgID int64, registry *woodpecker.Registry) (*woodpecker.Registry, error) {
+	ret := _mock.Called(orgID, registry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrgRegistryUpdate")
+	}
+
+	var r0 *woodpecker.Registry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.Registry) (*woodpecker.Registry, error)); ok {
+		return returnFunc(orgID, registry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.Registry) *woodpecker.Registry); ok {
+		r0 = returnFunc(orgID, registry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.Registry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, *woodpecker.Registry) error); ok {
+		r1 = returnFunc(orgID, registry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_OrgRegistryUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgRegistryUpdate'
+type MockClient_OrgRegistryUpdate_Call struct {
+	*mock.Call
+}
+
+// OrgRegistryUpdate is a helper method to define mock.On call
+//   - orgID int64
+//   - registry *woodpecker.Registry
+func (_e *MockClient_Expecter) OrgRegistryUpdate(orgID interface{}, registry interface{}) *MockClient_OrgRegistryUpdate_Call {
+	return &MockClient_OrgRegistryUpdate_Call{Call: _e.mock.On("OrgRegistryUpdate", orgID, registry)}
+}
+
+func (_c *MockClient_OrgRegistryUpdate_Call) Run(run func(orgID int64, registry *woodpecker.Registry)) *MockClient_OrgRegistryUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 *woodpecker.Registry
+		if args[1] != nil {
+			arg1 = args[1].(*woodpecker.Registry)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_OrgRegistryUpdate_Call) Return(registry1 *woodpecker.Registry, err error) *MockClient_OrgRegistryUpdate_Call {
+	_c.Call.Return(registry1, err)
+	return _c
+}
+
+func (_c *MockClient_OrgRegistryUpdate_Call) RunAndReturn(run func(orgID int64, registry *woodpecker.Registry) (*woodpecker.Registry, error)) *MockClient_OrgRegistryUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrgRetention provides a mock function for the type MockClient
+func (_mock *MockClient) OrgRetention(orgID int64) (*woodpecker.RetentionPolicy, error) {
+	ret := _mock.Called(orgID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrgRetention")
+	}
+
+	var r0 *woodpecker.RetentionPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64) (*woodpecker.RetentionPolicy, error)); ok {
+		return returnFunc(orgID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64) *woodpecker.RetentionPolicy); ok {
+		r0 = returnFunc(orgID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.RetentionPolicy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(orgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_OrgRetention_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgRetention'
+type MockClient_OrgRetention_Call struct {
+	*mock.Call
+}
+
+// OrgRetention is a helper method to define mock.On call
+//   - orgID int64
+func (_e *MockClient_Expecter) OrgRetention(orgID interface{}) *MockClient_OrgRetention_Call {
+	return &MockClient_OrgRetention_Call{Call: _e.mock.On("OrgRetention", orgID)}
+}
+
+func (_c *MockClient_OrgRetention_Call) Run(run func(orgID int64)) *MockClient_OrgRetention_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_OrgRetention_Call) Return(retentionPolicy *woodpecker.RetentionPolicy, err error) *MockClient_OrgRetention_Call {
+	_c.Call.Return(retentionPolicy, err)
+	return _c
+}
+
+func (_c *MockClient_OrgRetention_Call) RunAndReturn(run func(orgID int64) (*woodpecker.RetentionPolicy, error)) *MockClient_OrgRetention_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrgRetentionDelete provides a mock function for the type MockClient
+func (_mock *MockClient) OrgRetentionDelete(orgID int64) error {
+	ret := _mock.Called(orgID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrgRetentionDelete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = returnFunc(orgID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_OrgRetentionDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgRetentionDelete'
+type MockClient_OrgRetentionDelete_Call struct {
+	*mock.Call
+}
+
+// OrgRetentionDelete is a helper method to define mock.On call
+//   - orgID int64
+func (_e *MockClient_Expecter) OrgRetentionDelete(orgID interface{}) *MockClient_OrgRetentionDelete_Call {
+	return &MockClient_OrgRetentionDelete_Call{Call: _e.mock.On("OrgRetentionDelete", orgID)}
 }
 
-func (_c *MockClient_OrgRegistryList_Call) Run(run func(orgID int64, opt woodpecker.RegistryListOptions)) *MockClient_OrgRegistryList_Call {
+func (_c *MockClient_OrgRetentionDelete_Call) Run(run func(orgID int64)) *MockClient_OrgRetentionDelete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int64
 		if args[0] != nil {
 			arg0 = args[0].(int64)
 		}
-		var arg1 woodpecker.RegistryListOptions
-		if args[1] != nil {
-			arg1 = args[1].(woodpecker.RegistryListOptions)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_OrgRegistryList_Call) Return(registrys []*woodpecker.Registry, err error) *MockClient_OrgRegistryList_Call {
-	_c.Call.Return(registrys, err)
+func (_c *MockClient_OrgRetentionDelete_Call) Return(err error) *MockClient_OrgRetentionDelete_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClient_OrgRegistryList_Call) RunAndReturn(run func(orgID int64, opt woodpecker.RegistryListOptions) ([]*woodpecker.Registry, error)) *MockClient_OrgRegistryList_Call {
+func (_c *MockClient_OrgRetentionDelete_Call) RunAndReturn(run func(orgID int64) error) *MockClient_OrgRetentionDelete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OrgRegistryUpdate provides a mock function for the type MockClient
-func (_mock *MockClient) OrgRegistryUpdate(orgID int64, registry *woodpecker.Registry) (*woodpecker.Registry, error) {
-	ret := _mock.Called(orgID, registry)
+// OrgRetentionUpdate provides a mock function for the type MockClient
+func (_mock *MockClient) OrgRetentionUpdate(orgID int64, policy *woodpecker.RetentionPolicyPatch) (*woodpecker.RetentionPolicy, error) {
+	ret := _mock.Called(orgID, policy)
 
 	if len(ret) == 0 {
-		panic("no return value specified for OrgRegistryUpdate")
+		panic("no return value specified for OrgRetentionUpdate")
 	}
 
-	var r0 *woodpecker.Registry
+	var r0 *woodpecker.RetentionPolicy
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.Registry) (*woodpecker.Registry, error)); ok {
-		return returnFunc(orgID, registry)
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.RetentionPolicyPatch) (*woodpecker.RetentionPolicy, error)); ok {
+		return returnFunc(orgID, policy)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.Registry) *woodpecker.Registry); ok {
-		r0 = returnFunc(orgID, registry)
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.RetentionPolicyPatch) *woodpecker.RetentionPolicy); ok {
+		r0 = returnFunc(orgID, policy)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*woodpecker.Registry)
+			r0 = ret.Get(0).(*woodpecker.RetentionPolicy)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int64, *woodpecker.Registry) error); ok {
-		r1 = returnFunc(orgID, registry)
+	if returnFunc, ok := ret.Get(1).(func(int64, *woodpecker.RetentionPolicyPatch) error); ok {
+		r1 = returnFunc(orgID, policy)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_OrgRegistryUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgRegistryUpdate'
-type MockClient_OrgRegistryUpdate_Call struct {
+// MockClient_OrgRetentionUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgRetentionUpdate'
+type MockClient_OrgRetentionUpdate_Call struct {
 	*mock.Call
 }
 
-// OrgRegistryUpdate is a helper method to define mock.On call
+// OrgRetentionUpdate is a helper method to define mock.On call
 //   - orgID int64
-//   - registry *woodpecker.Registry
-func (_e *MockClient_Expecter) OrgRegistryUpdate(orgID interface{}, registry interface{}) *MockClient_OrgRegistryUpdate_Call {
-	return &MockClient_OrgRegistryUpdate_Call{Call: _e.mock.On("OrgRegistryUpdate", orgID, registry)}
+//   - policy *woodpecker.RetentionPolicyPatch
+func (_e *MockClient_Expecter) OrgRetentionUpdate(orgID interface{}, policy interface{}) *MockClient_OrgRetentionUpdate_Call {
+	return &MockClient_OrgRetentionUpdate_Call{Call: _e.mock.On("OrgRetentionUpdate", orgID, policy)}
 }
 
-func (_c *MockClient_OrgRegistryUpdate_Call) Run(run func(orgID int64, registry *woodpecker.Registry)) *MockClient_OrgRegistryUpdate_Call {
+func (_c *MockClient_OrgRetentionUpdate_Call) Run(run func(orgID int64, policy *woodpecker.RetentionPolicyPatch)) *MockClient_OrgRetentionUpdate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int64
 		if args[0] != nil {
 			arg0 = args[0].(int64)
 		}
-		var arg1 *woodpecker.Registry
+		var arg1 *woodpecker.RetentionPolicyPatch
 		if args[1] != nil {
-			arg1 = args[1].(*woodpecker.Registry)
+			arg1 = args[1].(*woodpecker.RetentionPolicyPatch)
 		}
 		run(
 			arg0,
@@ -2010,12 +3079,12 @@ func (_c *MockClient_OrgRegistryUpdate_Call) Run(run func(orgID int64, registry
 	return _c
 }
 
-func (_c *MockClient_OrgRegistryUpdate_Call) Return(registry1 *woodpecker.Registry, err error) *MockClient_OrgRegistryUpdate_Call {
-	_c.Call.Return(registry1, err)
+func (_c *MockClient_OrgRetentionUpdate_Call) Return(retentionPolicy *woodpecker.RetentionPolicy, err error) *MockClient_OrgRetentionUpdate_Call {
+	_c.Call.Return(retentionPolicy, err)
 	return _c
 }
 
-func (_c *MockClient_OrgRegistryUpdate_Call) RunAndReturn(run func(orgID int64, registry *woodpecker.Registry) (*woodpecker.Registry, error)) *MockClient_OrgRegistryUpdate_Call {
+func (_c *MockClient_OrgRetentionUpdate_Call) RunAndReturn(run func(orgID int64, policy *woodpecker.RetentionPolicyPatch) (*woodpecker.RetentionPolicy, error)) *MockClient_OrgRetentionUpdate_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3068,6 +4137,68 @@ func (_c *MockClient_PipelineStop_Call) RunAndReturn(run func(repoID int64, pipe
 	return _c
 }
 
+// QueueExplain provides a mock function for the type MockClient
+func (_mock *MockClient) QueueExplain(taskID string) (*woodpecker.QueueTaskExplanation, error) {
+	ret := _mock.Called(taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueueExplain")
+	}
+
+	var r0 *woodpecker.QueueTaskExplanation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*woodpecker.QueueTaskExplanation, error)); ok {
+		return returnFunc(taskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *woodpecker.QueueTaskExplanation); ok {
+		r0 = returnFunc(taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.QueueTaskExplanation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_QueueExplain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueExplain'
+type MockClient_QueueExplain_Call struct {
+	*mock.Call
+}
+
+// QueueExplain is a helper method to define mock.On call
+//   - taskID string
+func (_e *MockClient_Expecter) QueueExplain(taskID interface{}) *MockClient_QueueExplain_Call {
+	return &MockClient_QueueExplain_Call{Call: _e.mock.On("QueueExplain", taskID)}
+}
+
+func (_c *MockClient_QueueExplain_Call) Run(run func(taskID string)) *MockClient_QueueExplain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_QueueExplain_Call) Return(explanation *woodpecker.QueueTaskExplanation, err error) *MockClient_QueueExplain_Call {
+	_c.Call.Return(explanation, err)
+	return _c
+}
+
+func (_c *MockClient_QueueExplain_Call) RunAndReturn(run func(taskID string) (*woodpecker.QueueTaskExplanation, error)) *MockClient_QueueExplain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // QueueInfo provides a mock function for the type MockClient
 func (_mock *MockClient) QueueInfo() (*woodpecker.Info, error) {
 	ret := _mock.Called()
@@ -3989,6 +5120,255 @@ func (_c *MockClient_RepoRepair_Call) RunAndReturn(run func(repoID int64) error)
 	return _c
 }
 
+// RepoRetention provides a mock function for the type MockClient
+func (_mock *MockClient) RepoRetention(repoID int64) (*woodpecker.RetentionPolicy, error) {
+	ret := _mock.Called(repoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RepoRetention")
+	}
+
+	var r0 *woodpecker.RetentionPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64) (*woodpecker.RetentionPolicy, error)); ok {
+		return returnFunc(repoID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64) *woodpecker.RetentionPolicy); ok {
+		r0 = returnFunc(repoID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.RetentionPolicy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(repoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_RepoRetention_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepoRetention'
+type MockClient_RepoRetention_Call struct {
+	*mock.Call
+}
+
+// RepoRetention is a helper method to define mock.On call
+//   - repoID int64
+func (_e *MockClient_Expecter) RepoRetention(repoID interface{}) *MockClient_RepoRetention_Call {
+	return &MockClient_RepoRetention_Call{Call: _e.mock.On("RepoRetention", repoID)}
+}
+
+func (_c *MockClient_RepoRetention_Call) Run(run func(repoID int64)) *MockClient_RepoRetention_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_RepoRetention_Call) Return(retentionPolicy *woodpecker.RetentionPolicy, err error) *MockClient_RepoRetention_Call {
+	_c.Call.Return(retentionPolicy, err)
+	return _c
+}
+
+func (_c *MockClient_RepoRetention_Call) RunAndReturn(run func(repoID int64) (*woodpecker.RetentionPolicy, error)) *MockClient_RepoRetention_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RepoRetentionDelete provides a mock function for the type MockClient
+func (_mock *MockClient) RepoRetentionDelete(repoID int64) error {
+	ret := _mock.Called(repoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RepoRetentionDelete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = returnFunc(repoID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_RepoRetentionDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepoRetentionDelete'
+type MockClient_RepoRetentionDelete_Call struct {
+	*mock.Call
+}
+
+// RepoRetentionDelete is a helper method to define mock.On call
+//   - repoID int64
+func (_e *MockClient_Expecter) RepoRetentionDelete(repoID interface{}) *MockClient_RepoRetentionDelete_Call {
+	return &MockClient_RepoRetentionDelete_Call{Call: _e.mock.On("RepoRetentionDelete", repoID)}
+}
+
+func (_c *MockClient_RepoRetentionDelete_Call) Run(run func(repoID int64)) *MockClient_RepoRetentionDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_RepoRetentionDelete_Call) Return(err error) *MockClient_RepoRetentionDelete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_RepoRetentionDelete_Call) RunAndReturn(run func(repoID int64) error) *MockClient_RepoRetentionDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RepoRetentionUpdate provides a mock function for the type MockClient
+func (_mock *MockClient) RepoRetentionUpdate(repoID int64, policy *woodpecker.RetentionPolicyPatch) (*woodpecker.RetentionPolicy, error) {
+	ret := _mock.Called(repoID, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RepoRetentionUpdate")
+	}
+
+	var r0 *woodpecker.RetentionPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.RetentionPolicyPatch) (*woodpecker.RetentionPolicy, error)); ok {
+		return returnFunc(repoID, policy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.RetentionPolicyPatch) *woodpecker.RetentionPolicy); ok {
+		r0 = returnFunc(repoID, policy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.RetentionPolicy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, *woodpecker.RetentionPolicyPatch) error); ok {
+		r1 = returnFunc(repoID, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_RepoRetentionUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepoRetentionUpdate'
+type MockClient_RepoRetentionUpdate_Call struct {
+	*mock.Call
+}
+
+// RepoRetentionUpdate is a helper method to define mock.On call
+//   - repoID int64
+//   - policy *woodpecker.RetentionPolicyPatch
+func (_e *MockClient_Expecter) RepoRetentionUpdate(repoID interface{}, policy interface{}) *MockClient_RepoRetentionUpdate_Call {
+	return &MockClient_RepoRetentionUpdate_Call{Call: _e.mock.On("RepoRetentionUpdate", repoID, policy)}
+}
+
+func (_c *MockClient_RepoRetentionUpdate_Call) Run(run func(repoID int64, policy *woodpecker.RetentionPolicyPatch)) *MockClient_RepoRetentionUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 *woodpecker.RetentionPolicyPatch
+		if args[1] != nil {
+			arg1 = args[1].(*woodpecker.RetentionPolicyPatch)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_RepoRetentionUpdate_Call) Return(retentionPolicy *woodpecker.RetentionPolicy, err error) *MockClient_RepoRetentionUpdate_Call {
+	_c.Call.Return(retentionPolicy, err)
+	return _c
+}
+
+func (_c *MockClient_RepoRetentionUpdate_Call) RunAndReturn(run func(repoID int64, policy *woodpecker.RetentionPolicyPatch) (*woodpecker.RetentionPolicy, error)) *MockClient_RepoRetentionUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RepoStats provides a mock function for the type MockClient
+func (_mock *MockClient) RepoStats(repoID int64, opt woodpecker.RepoStatsOptions) (*woodpecker.Stats, error) {
+	ret := _mock.Called(repoID, opt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RepoStats")
+	}
+
+	var r0 *woodpecker.Stats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, woodpecker.RepoStatsOptions) (*woodpecker.Stats, error)); ok {
+		return returnFunc(repoID, opt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, woodpecker.RepoStatsOptions) *woodpecker.Stats); ok {
+		r0 = returnFunc(repoID, opt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.Stats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, woodpecker.RepoStatsOptions) error); ok {
+		r1 = returnFunc(repoID, opt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_RepoStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepoStats'
+type MockClient_RepoStats_Call struct {
+	*mock.Call
+}
+
+// RepoStats is a helper method to define mock.On call
+//   - repoID int64
+//   - opt woodpecker.RepoStatsOptions
+func (_e *MockClient_Expecter) RepoStats(repoID interface{}, opt interface{}) *MockClient_RepoStats_Call {
+	return &MockClient_RepoStats_Call{Call: _e.mock.On("RepoStats", repoID, opt)}
+}
+
+func (_c *MockClient_RepoStats_Call) Run(run func(repoID int64, opt woodpecker.RepoStatsOptions)) *MockClient_RepoStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 woodpecker.RepoStatsOptions
+		if args[1] != nil {
+			arg1 = args[1].(woodpecker.RepoStatsOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_RepoStats_Call) Return(stats *woodpecker.Stats, err error) *MockClient_RepoStats_Call {
+	_c.Call.Return(stats, err)
+	return _c
+}
+
+func (_c *MockClient_RepoStats_Call) RunAndReturn(run func(repoID int64, opt woodpecker.RepoStatsOptions) (*woodpecker.Stats, error)) *MockClient_RepoStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Secret provides a mock function for the type MockClient
 func (_mock *MockClient) Secret(repoID int64, secret string) (*woodpecker.Secret, error) {
 	ret := _mock.Called(repoID, secret)
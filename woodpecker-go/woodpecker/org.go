@@ -13,6 +13,9 @@ const (
 	pathOrgSecret     = "%s/api/orgs/%d/secrets/%s"
 	pathOrgRegistries = "%s/api/orgs/%d/registries"
 	pathOrgRegistry   = "%s/api/orgs/%d/registries/%s"
+	pathOrgRetention  = "%s/api/orgs/%d/retention"
+	pathOrgTemplates  = "%s/api/orgs/%d/templates"
+	pathOrgTemplate   = "%s/api/orgs/%d/templates/%s"
 )
 
 // Org returns an organization by id.
@@ -78,6 +81,45 @@ func (c *client) OrgSecretDelete(orgID int64, secret string) error {
 	return c.delete(uri)
 }
 
+// OrgConfigTemplate returns an organization config template by name.
+func (c *client) OrgConfigTemplate(orgID int64, template string) (*ConfigTemplate, error) {
+	out := new(ConfigTemplate)
+	uri := fmt.Sprintf(pathOrgTemplate, c.addr, orgID, template)
+	err := c.get(uri, out)
+	return out, err
+}
+
+// OrgConfigTemplateList returns a list of all organization config templates.
+func (c *client) OrgConfigTemplateList(orgID int64, opt ListOptions) ([]*ConfigTemplate, error) {
+	var out []*ConfigTemplate
+	uri, _ := url.Parse(fmt.Sprintf(pathOrgTemplates, c.addr, orgID))
+	uri.RawQuery = opt.getURLQuery().Encode()
+	err := c.get(uri.String(), &out)
+	return out, err
+}
+
+// OrgConfigTemplateCreate creates an organization config template.
+func (c *client) OrgConfigTemplateCreate(orgID int64, in *ConfigTemplate) (*ConfigTemplate, error) {
+	out := new(ConfigTemplate)
+	uri := fmt.Sprintf(pathOrgTemplates, c.addr, orgID)
+	err := c.post(uri, in, out)
+	return out, err
+}
+
+// OrgConfigTemplateUpdate updates an organization config template.
+func (c *client) OrgConfigTemplateUpdate(orgID int64, in *ConfigTemplate) (*ConfigTemplate, error) {
+	out := new(ConfigTemplate)
+	uri := fmt.Sprintf(pathOrgTemplate, c.addr, orgID, in.Name)
+	err := c.patch(uri, in, out)
+	return out, err
+}
+
+// OrgConfigTemplateDelete deletes an organization config template.
+func (c *client) OrgConfigTemplateDelete(orgID int64, template string) error {
+	uri := fmt.Sprintf(pathOrgTemplate, c.addr, orgID, template)
+	return c.delete(uri)
+}
+
 // OrgRegistry returns an organization registry by address.
 func (c *client) OrgRegistry(orgID int64, registry string) (*Registry, error) {
 	out := new(Registry)
@@ -116,3 +158,25 @@ func (c *client) OrgRegistryDelete(orgID int64, registry string) error {
 	uri := fmt.Sprintf(pathOrgRegistry, c.addr, orgID, registry)
 	return c.delete(uri)
 }
+
+// OrgRetention returns the retention policy used for an organization.
+func (c *client) OrgRetention(orgID int64) (*RetentionPolicy, error) {
+	out := new(RetentionPolicy)
+	uri := fmt.Sprintf(pathOrgRetention, c.addr, orgID)
+	err := c.get(uri, out)
+	return out, err
+}
+
+// OrgRetentionUpdate updates the retention policy of an organization.
+func (c *client) OrgRetentionUpdate(orgID int64, in *RetentionPolicyPatch) (*RetentionPolicy, error) {
+	out := new(RetentionPolicy)
+	uri := fmt.Sprintf(pathOrgRetention, c.addr, orgID)
+	err := c.patch(uri, in, out)
+	return out, err
+}
+
+// OrgRetentionDelete deletes the retention policy of an organization.
+func (c *client) OrgRetentionDelete(orgID int64) error {
+	uri := fmt.Sprintf(pathOrgRetention, c.addr, orgID)
+	return c.delete(uri)
+}
@@ -2,7 +2,10 @@ package woodpecker
 
 import "fmt"
 
-const pathQueue = "%s/api/queue"
+const (
+	pathQueue        = "%s/api/queue"
+	pathQueueExplain = "%s/api/queue/tasks/%s/explain"
+)
 
 // QueueInfo returns queue info.
 func (c *client) QueueInfo() (*Info, error) {
@@ -11,3 +14,11 @@ func (c *client) QueueInfo() (*Info, error) {
 	err := c.get(uri, out)
 	return out, err
 }
+
+// QueueExplain explains why a task of the queue does not run yet.
+func (c *client) QueueExplain(taskID string) (*QueueTaskExplanation, error) {
+	out := new(QueueTaskExplanation)
+	uri := fmt.Sprintf(pathQueueExplain, c.addr, taskID)
+	err := c.get(uri, out)
+	return out, err
+}
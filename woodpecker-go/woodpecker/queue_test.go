@@ -113,3 +113,78 @@ func TestClient_QueueInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_QueueExplain(t *testing.T) {
+	tests := []struct {
+		name     string
+		handler  http.HandlerFunc
+		expected *QueueTaskExplanation
+		wantErr  bool
+	}{
+		{
+			name: "success",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/queue/tasks/4696/explain", r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				_, err := fmt.Fprint(w, `{
+					"task": {"id": "4696", "name": "build", "pipeline_number": 12},
+					"state": "pending",
+					"reasons": ["no agent matches the labels and requirements of the task"],
+					"dependencies": [],
+					"agents": [
+						{
+							"agent_id": 1,
+							"agent_name": "runner",
+							"status": "idle",
+							"matched": false,
+							"reasons": ["agent has label platform=linux/amd64, workflow requires platform=linux/arm64"]
+						}
+					]
+				}`)
+				assert.NoError(t, err)
+			},
+			expected: &QueueTaskExplanation{
+				Task: QueueTask{
+					Task:           Task{ID: "4696", Name: "build"},
+					PipelineNumber: 12,
+				},
+				State:        "pending",
+				Reasons:      []string{"no agent matches the labels and requirements of the task"},
+				Dependencies: []QueueTaskDependency{},
+				Agents: []QueueAgentMatch{
+					{
+						AgentID:   1,
+						AgentName: "runner",
+						Status:    "idle",
+						Reasons:   []string{"agent has label platform=linux/amd64, workflow requires platform=linux/arm64"},
+					},
+				},
+			},
+		},
+		{
+			name: "not found",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(tt.handler)
+			defer ts.Close()
+
+			client := NewClient(ts.URL, http.DefaultClient)
+			explanation, err := client.QueueExplain("4696")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, explanation)
+		})
+	}
+}
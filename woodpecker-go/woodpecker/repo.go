@@ -15,10 +15,13 @@ const (
 	pathRepoMove       = "%s/api/repos/%d/move"
 	pathChown          = "%s/api/repos/%d/chown"
 	pathRepair         = "%s/api/repos/%d/repair"
+	pathRepoStats      = "%s/api/repos/%d/stats"
+	pathRepoRetention  = "%s/api/repos/%d/retention"
 	pathPipelines      = "%s/api/repos/%d/pipelines"
 	pathPipeline       = "%s/api/repos/%d/pipelines/%v"
 	pathPipelineLogs   = "%s/api/repos/%d/logs/%d"
 	pathStepLogs       = "%s/api/repos/%d/logs/%d/%d"
+	pathLogSearch      = "%s/api/repos/%d/logs/search"
 	pathApprove        = "%s/api/repos/%d/pipelines/%d/approve"
 	pathDecline        = "%s/api/repos/%d/pipelines/%d/decline"
 	pathStop           = "%s/api/repos/%d/pipelines/%d/cancel"
@@ -73,6 +76,24 @@ type RepoMoveOptions struct {
 	To string
 }
 
+type RepoStatsOptions struct {
+	After    time.Time // only use pipelines created after this date, defaults to 30 days ago
+	Before   time.Time // only use pipelines created before this date, defaults to now
+	Interval string    // duration of a timeline bucket, e.g. 24h
+	Limit    int       // max number of entries in the step and branch lists
+}
+
+type LogSearchOptions struct {
+	ListOptions        // pagination of the searched pipelines, newest first
+	Query       string // substring or regular expression to search for
+	Regex       bool   // treat the query as regular expression
+	IgnoreCase  bool
+	Branch      string // only search pipelines of this branch
+	Step        string // only search steps with this name
+	Limit       int    // max number of matches
+	Cursor      string // continue a truncated search after the match of the cursor
+}
+
 // QueryEncode returns the URL query parameters for the PipelineListOptions.
 func (opt *PipelineListOptions) QueryEncode() string {
 	query := opt.getURLQuery()
@@ -136,6 +157,49 @@ func (opt *RepoMoveOptions) QueryEncode() string {
 	return query.Encode()
 }
 
+// QueryEncode returns the URL query parameters for the RepoStatsOptions.
+func (opt *RepoStatsOptions) QueryEncode() string {
+	query := make(url.Values)
+	if !opt.After.IsZero() {
+		query.Add("after", opt.After.Format(time.RFC3339))
+	}
+	if !opt.Before.IsZero() {
+		query.Add("before", opt.Before.Format(time.RFC3339))
+	}
+	if opt.Interval != "" {
+		query.Add("interval", opt.Interval)
+	}
+	if opt.Limit > 0 {
+		query.Add("limit", strconv.Itoa(opt.Limit))
+	}
+	return query.Encode()
+}
+
+// QueryEncode returns the URL query parameters for the LogSearchOptions.
+func (opt *LogSearchOptions) QueryEncode() string {
+	query := opt.getURLQuery()
+	query.Add("query", opt.Query)
+	if opt.Regex {
+		query.Add("regex", "true")
+	}
+	if opt.IgnoreCase {
+		query.Add("ignore_case", "true")
+	}
+	if opt.Branch != "" {
+		query.Add("branch", opt.Branch)
+	}
+	if opt.Step != "" {
+		query.Add("step", opt.Step)
+	}
+	if opt.Limit > 0 {
+		query.Add("limit", strconv.Itoa(opt.Limit))
+	}
+	if opt.Cursor != "" {
+		query.Add("cursor", opt.Cursor)
+	}
+	return query.Encode()
+}
+
 // Repo returns a repository by id.
 func (c *client) Repo(repoID int64) (*Repo, error) {
 	out := new(Repo)
@@ -197,6 +261,37 @@ func (c *client) RepoMove(repoID int64, opt RepoMoveOptions) error {
 	return c.post(uri.String(), nil, nil)
 }
 
+// RepoStats returns the pipeline statistics of a repository.
+func (c *client) RepoStats(repoID int64, opt RepoStatsOptions) (*Stats, error) {
+	out := new(Stats)
+	uri, _ := url.Parse(fmt.Sprintf(pathRepoStats, c.addr, repoID))
+	uri.RawQuery = opt.QueryEncode()
+	err := c.get(uri.String(), out)
+	return out, err
+}
+
+// RepoRetention returns the retention policy used for a repository.
+func (c *client) RepoRetention(repoID int64) (*RetentionPolicy, error) {
+	out := new(RetentionPolicy)
+	uri := fmt.Sprintf(pathRepoRetention, c.addr, repoID)
+	err := c.get(uri, out)
+	return out, err
+}
+
+// RepoRetentionUpdate updates the retention policy of a repository.
+func (c *client) RepoRetentionUpdate(repoID int64, in *RetentionPolicyPatch) (*RetentionPolicy, error) {
+	out := new(RetentionPolicy)
+	uri := fmt.Sprintf(pathRepoRetention, c.addr, repoID)
+	err := c.patch(uri, in, out)
+	return out, err
+}
+
+// RepoRetentionDelete deletes the retention policy of a repository.
+func (c *client) RepoRetentionDelete(repoID int64) error {
+	uri := fmt.Sprintf(pathRepoRetention, c.addr, repoID)
+	return c.delete(uri)
+}
+
 // Registry returns a registry by hostname.
 func (c *client) Registry(repoID int64, hostname string) (*Registry, error) {
 	out := new(Registry)
@@ -410,6 +505,15 @@ func (c *client) StepLogEntries(repoID, num, step int64) ([]*LogEntry, error) {
 	return out, err
 }
 
+// LogSearch searches the step logs of the recent pipelines of a repository.
+func (c *client) LogSearch(repoID int64, opt LogSearchOptions) (*LogSearchResult, error) {
+	out := new(LogSearchResult)
+	uri, _ := url.Parse(fmt.Sprintf(pathLogSearch, c.addr, repoID))
+	uri.RawQuery = opt.QueryEncode()
+	err := c.get(uri.String(), out)
+	return out, err
+}
+
 // StepLogsPurge purges the pipeline logs for the specified step.
 func (c *client) StepLogsPurge(repoID, pipelineNumber, stepID int64) error {
 	uri := fmt.Sprintf(pathStepLogs, c.addr, repoID, pipelineNumber, stepID)
@@ -54,6 +54,13 @@ type (
 		Security bool `json:"security"`
 	}
 
+	// CommitDirectives are the keywords of the directives in commit messages.
+	CommitDirectives struct {
+		Skip []string `json:"skip"`
+		Only []string `json:"only"`
+		Full []string `json:"full"`
+	}
+
 	// Repo represents a repository.
 	Repo struct {
 		ID                           int64                `json:"id,omitempty"`
@@ -76,17 +83,43 @@ type (
 		Config                       string               `json:"config_file"`
 		CancelPreviousPipelineEvents []string             `json:"cancel_previous_pipeline_events"`
 		NetrcTrustedPlugins          []string             `json:"netrc_trusted"`
+		CommitDirectives             *CommitDirectives    `json:"commit_directives"`
+		QueuePriorities              map[string]int       `json:"queue_priorities"`
+		AgentAffinityWait            int64                `json:"agent_affinity_wait"`
 	}
 
 	// RepoPatch defines a repository patch request.
 	RepoPatch struct {
-		Config          *string       `json:"config_file,omitempty"`
-		IsTrusted       *bool         `json:"trusted,omitempty"`
-		RequireApproval *ApprovalMode `json:"require_approval,omitempty"`
-		Timeout         *int64        `json:"timeout,omitempty"`
-		Visibility      *string       `json:"visibility"`
-		AllowPull       *bool         `json:"allow_pr,omitempty"`
-		PipelineCounter *int          `json:"pipeline_counter,omitempty"`
+		Config            *string           `json:"config_file,omitempty"`
+		IsTrusted         *bool             `json:"trusted,omitempty"`
+		RequireApproval   *ApprovalMode     `json:"require_approval,omitempty"`
+		Timeout           *int64            `json:"timeout,omitempty"`
+		Visibility        *string           `json:"visibility"`
+		AllowPull         *bool             `json:"allow_pr,omitempty"`
+		PipelineCounter   *int              `json:"pipeline_counter,omitempty"`
+		CommitDirectives  *CommitDirectives `json:"commit_directives,omitempty"`
+		QueuePriorities   *map[string]int   `json:"queue_priorities,omitempty"`
+		AgentAffinityWait *int64            `json:"agent_affinity_wait,omitempty"`
+	}
+
+	// RetentionPolicy is the JSON data for a retention policy.
+	RetentionPolicy struct {
+		OrgID                int64 `json:"org_id"`
+		RepoID               int64 `json:"repo_id"`
+		KeepLast             int64 `json:"keep_last"`
+		KeepDays             int64 `json:"keep_days"`
+		KeepLatestPerBranch  bool  `json:"keep_latest_per_branch"`
+		KeepLatestDeployment bool  `json:"keep_latest_deployment"`
+		LogDays              int64 `json:"log_days"`
+	}
+
+	// RetentionPolicyPatch defines a retention policy patch request.
+	RetentionPolicyPatch struct {
+		KeepLast             *int64 `json:"keep_last,omitempty"`
+		KeepDays             *int64 `json:"keep_days,omitempty"`
+		KeepLatestPerBranch  *bool  `json:"keep_latest_per_branch,omitempty"`
+		KeepLatestDeployment *bool  `json:"keep_latest_deployment,omitempty"`
+		LogDays              *int64 `json:"log_days,omitempty"`
 	}
 
 	PipelineError struct {
@@ -223,6 +256,40 @@ type (
 		Paused        bool       `json:"paused,omitempty"`
 	}
 
+	// QueueTask is a task of the queue with its pipeline, agent and position.
+	QueueTask struct {
+		Task
+		PipelineNumber int64  `json:"pipeline_number"`
+		AgentName      string `json:"agent_name"`
+		Position       int    `json:"position,omitempty"`
+		Reason         string `json:"reason,omitempty"`
+	}
+
+	// QueueTaskExplanation explains why a task of the queue does not run yet.
+	QueueTaskExplanation struct {
+		Task         QueueTask             `json:"task"`
+		State        string                `json:"state"`
+		Reasons      []string              `json:"reasons"`
+		Dependencies []QueueTaskDependency `json:"dependencies"`
+		Agents       []QueueAgentMatch     `json:"agents"`
+	}
+
+	// QueueTaskDependency is a dependency of a task and its state in the queue.
+	QueueTaskDependency struct {
+		ID    string `json:"id"`
+		Name  string `json:"name"`
+		State string `json:"state"`
+	}
+
+	// QueueAgentMatch tells whether an agent can take a task of the queue.
+	QueueAgentMatch struct {
+		AgentID   int64    `json:"agent_id"`
+		AgentName string   `json:"agent_name"`
+		Status    string   `json:"status"`
+		Matched   bool     `json:"matched"`
+		Reasons   []string `json:"reasons"`
+	}
+
 	// LogLevel is for checking/setting logging level.
 	LogLevel struct {
 		Level string `json:"log-level"`
@@ -238,6 +305,41 @@ type (
 		Type   LogEntryType `json:"type"`
 	}
 
+	// ConfigTemplate represents a workflow fragment of an organization usable by include and extends.
+	ConfigTemplate struct {
+		ID      int64  `json:"id"`
+		OrgID   int64  `json:"org_id"`
+		Name    string `json:"name"`
+		Data    string `json:"data"`
+		Created int64  `json:"created"`
+		Updated int64  `json:"updated"`
+	}
+
+	// LogSearchResult is the result of a log search.
+	LogSearchResult struct {
+		Matches   []*LogMatch `json:"matches"`
+		Truncated bool        `json:"truncated"`
+		Cursor    string      `json:"cursor,omitempty"`
+		NextPage  int         `json:"next_page"`
+	}
+
+	// LogMatch is a log line matching a search query.
+	LogMatch struct {
+		PipelineNumber int64           `json:"pipeline_number"`
+		StepID         int64           `json:"step_id"`
+		StepName       string          `json:"step_name"`
+		Line           int             `json:"line"`
+		Time           int64           `json:"time"`
+		Data           string          `json:"data"`
+		Highlights     []LogMatchRange `json:"highlights"`
+	}
+
+	// LogMatchRange is the byte range [start, end) of a match in a log line.
+	LogMatchRange struct {
+		Start int `json:"start"`
+		End   int `json:"end"`
+	}
+
 	// Cron is the JSON data of a cron job.
 	Cron struct {
 		ID        int64  `json:"id"`
@@ -258,29 +360,76 @@ type (
 
 	// Agent is the JSON data for an agent.
 	Agent struct {
-		ID           int64             `json:"id"`
-		Created      int64             `json:"created"`
-		Updated      int64             `json:"updated"`
-		Name         string            `json:"name"`
-		OwnerID      int64             `json:"owner_id"`
-		OrgID        int64             `json:"org_id"`
-		Token        string            `json:"token"`
-		LastContact  int64             `json:"last_contact"`
-		LastWork     int64             `json:"last_work"`
-		Platform     string            `json:"platform"`
-		Backend      string            `json:"backend"`
-		Capacity     int32             `json:"capacity"`
-		Version      string            `json:"version"`
-		NoSchedule   bool              `json:"no_schedule"`
-		CustomLabels map[string]string `json:"custom_labels"`
+		ID            int64             `json:"id"`
+		Created       int64             `json:"created"`
+		Updated       int64             `json:"updated"`
+		Name          string            `json:"name"`
+		OwnerID       int64             `json:"owner_id"`
+		OrgID         int64             `json:"org_id"`
+		Token         string            `json:"token"`
+		LastContact   int64             `json:"last_contact"`
+		LastWork      int64             `json:"last_work"`
+		Platform      string            `json:"platform"`
+		Backend       string            `json:"backend"`
+		Capacity      int32             `json:"capacity"`
+		Version       string            `json:"version"`
+		NoSchedule    bool              `json:"no_schedule"`
+		CustomLabels  map[string]string `json:"custom_labels"`
+		Draining      bool              `json:"draining"`
+		DrainDeadline int64             `json:"drain_deadline"`
+		Status        string            `json:"status"`
+		RunningTasks  int               `json:"running_tasks"`
+		DrainETA      int64             `json:"drain_eta"`
+		Capabilities  AgentCapabilities `json:"capabilities"`
+		// CapacityOverride and LabelsOverride are pushed to the agent and take precedence over its own config
+		CapacityOverride int32             `json:"capacity_override"`
+		LabelsOverride   map[string]string `json:"labels_override"`
+	}
+
+	// AgentPool is the JSON data for an agent pool.
+	AgentPool struct {
+		ID            int64             `json:"id"`
+		Name          string            `json:"name"`
+		Agents        []int64           `json:"agents"`
+		LabelSelector map[string]string `json:"label_selector"`
+		AllowedOrgs   []int64           `json:"allowed_orgs"`
+		AllowedRepos  []int64           `json:"allowed_repos"`
+		MaxConcurrent int               `json:"max_concurrent"`
+		OrgQuotas     map[int64]int     `json:"org_quotas"`
+		RepoQuotas    map[int64]int     `json:"repo_quotas"`
+		Created       int64             `json:"created"`
+		Updated       int64             `json:"updated"`
+	}
+
+	// AgentPoolPatch defines an agent pool patch request.
+	AgentPoolPatch struct {
+		Name          *string            `json:"name,omitempty"`
+		Agents        *[]int64           `json:"agents,omitempty"`
+		LabelSelector *map[string]string `json:"label_selector,omitempty"`
+		AllowedOrgs   *[]int64           `json:"allowed_orgs,omitempty"`
+		AllowedRepos  *[]int64           `json:"allowed_repos,omitempty"`
+		MaxConcurrent *int               `json:"max_concurrent,omitempty"`
+		OrgQuotas     *map[int64]int     `json:"org_quotas,omitempty"`
+		RepoQuotas    *map[int64]int     `json:"repo_quotas,omitempty"`
+	}
+
+	// AgentCapabilities is the JSON data for the capabilities of an agent.
+	AgentCapabilities struct {
+		CPUs          int      `json:"cpus"`
+		Memory        int64    `json:"memory"`
+		DiskFree      int64    `json:"disk_free"`
+		Architectures []string `json:"architectures"`
+		Runtimes      []string `json:"runtimes"`
 	}
 
 	// Task is the JSON data for a task.
 	Task struct {
 		ID           string            `json:"id"`
+		Name         string            `json:"name"`
 		Labels       map[string]string `json:"labels"`
 		Dependencies []string          `json:"dependencies"`
 		RunOn        []string          `json:"run_on"`
+		Requires     map[string]string `json:"requires"`
 		DepStatus    map[string]string `json:"dep_status"`
 		AgentID      int64             `json:"agent_id"`
 	}
@@ -291,4 +440,51 @@ type (
 		Name   string `json:"name"`
 		IsUser bool   `json:"is_user"`
 	}
+
+	// Stats is the JSON data for aggregated pipeline statistics.
+	Stats struct {
+		After       int64          `json:"after"`
+		Before      int64          `json:"before"`
+		Pipelines   int            `json:"pipelines"`
+		Success     int            `json:"success"`
+		Failure     int            `json:"failure"`
+		SuccessRate float64        `json:"success_rate"`
+		Duration    StatsDuration  `json:"duration"`
+		QueueWait   StatsDuration  `json:"queue_wait"`
+		Steps       []*StepStats   `json:"steps"`
+		FlakySteps  []*StepStats   `json:"flaky_steps"`
+		Branches    []*BranchStats `json:"branches"`
+		Timeline    []*StatsBucket `json:"timeline"`
+	}
+
+	// StatsDuration is the JSON data for duration percentiles in seconds.
+	StatsDuration struct {
+		P50 int64 `json:"p50"`
+		P95 int64 `json:"p95"`
+	}
+
+	// StepStats is the JSON data for the statistics of all steps with the same name.
+	StepStats struct {
+		Name        string        `json:"name"`
+		Runs        int           `json:"runs"`
+		Failures    int           `json:"failures"`
+		SuccessRate float64       `json:"success_rate"`
+		Duration    StatsDuration `json:"duration"`
+		Flaky       int           `json:"flaky"`
+	}
+
+	// BranchStats is the JSON data for the statistics of a branch.
+	BranchStats struct {
+		Branch      string  `json:"branch"`
+		Pipelines   int     `json:"pipelines"`
+		SuccessRate float64 `json:"success_rate"`
+	}
+
+	// StatsBucket is the JSON data for one interval of the statistics timeline.
+	StatsBucket struct {
+		Start     int64 `json:"start"`
+		Pipelines int   `json:"pipelines"`
+		Success   int   `json:"success"`
+		Failure   int   `json:"failure"`
+	}
 )